@@ -3,6 +3,7 @@ package ante
 import (
 	blobante "github.com/celestiaorg/celestia-app/v3/x/blob/ante"
 	blob "github.com/celestiaorg/celestia-app/v3/x/blob/keeper"
+	namespaceante "github.com/celestiaorg/celestia-app/v3/x/namespace/ante"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -16,6 +17,7 @@ func NewAnteHandler(
 	accountKeeper ante.AccountKeeper,
 	bankKeeper authtypes.BankKeeper,
 	blobKeeper blob.Keeper,
+	namespaceKeeper namespaceante.NamespaceKeeper,
 	feegrantKeeper ante.FeegrantKeeper,
 	signModeHandler signing.SignModeHandler,
 	sigGasConsumer ante.SignatureVerificationGasConsumer,
@@ -73,6 +75,26 @@ func NewAnteHandler(
 		// available to blob data in a data square. Only applies to app version
 		// >= 2.
 		blobante.NewBlobShareDecorator(blobKeeper),
+		// Ensure that the number of blobs of a PFB is <= the MaxBlobsPerPFB
+		// param.
+		blobante.NewMaxBlobsPerPFBDecorator(blobKeeper),
+		// Ensure that the total blob size of a PFB is <= the
+		// MaxTotalBlobSizePerPFB param, if it is set.
+		blobante.NewMaxTotalBlobSizePerPFBDecorator(blobKeeper),
+		// Ensure that the signer of a PFB is in the AllowedSigners param, if
+		// it is set.
+		blobante.NewSignerAllowlistDecorator(blobKeeper),
+		// Ensure that the height is within the inclusion window of a PFB, if
+		// it has one.
+		blobante.NewInclusionWindowDecorator(),
+		// Ensure that the fee of a tx with a MsgPayForBlobs is within the blob
+		// fee budget of the fee payer, if it set one.
+		// Side effect: records the fee as spent in the budget.
+		blobante.NewBlobFeeBudgetDecorator(blobKeeper),
+		// Ensure that the signer of a PFB is allowed to pay for blobs in the
+		// namespaces of its blobs, if any of them are restricted. Only applies
+		// to app version >= 4.
+		namespaceante.NewRestrictedNamespaceDecorator(namespaceKeeper),
 		// Ensure that tx's with a MsgSubmitProposal have at least one proposal
 		// message.
 		NewGovProposalDecorator(),
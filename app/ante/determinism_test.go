@@ -0,0 +1,67 @@
+package ante_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/determinism"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSignatureVerificationDeterminism verifies that verifying the signatures
+// of txs concurrently always results in the same outcome for every tx.
+func TestSignatureVerificationDeterminism(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	account := signer.Account(testfactory.TestAccName)
+
+	rawTxs := make([][]byte, 16)
+	for i := range rawTxs {
+		rawTxs[i] = blobfactory.GenerateRawSendTx(signer, int64(i+1))
+	}
+	txs := make([]signing.SigVerifiableTx, len(rawTxs))
+	for i, rawTx := range rawTxs {
+		sdkTx, err := encCfg.TxConfig.TxDecoder()(rawTx)
+		require.NoError(t, err)
+		txs[i] = sdkTx.(signing.SigVerifiableTx)
+	}
+	// replace the signature of a tx with the signature of another tx
+	sigs, err := txs[0].GetSignaturesV2()
+	require.NoError(t, err)
+	builder, err := encCfg.TxConfig.WrapTxBuilder(txs[3])
+	require.NoError(t, err)
+	require.NoError(t, builder.SetSignatures(sigs...))
+	txs[3] = builder.GetTx()
+
+	signerData := signing.SignerData{
+		ChainID:       signer.ChainID(),
+		AccountNumber: account.AccountNumber(),
+		Sequence:      account.Sequence(),
+	}
+	determinism.Require(t, func(p *determinism.Perturber) (any, error) {
+		valid := make([]bool, len(txs))
+		var wg sync.WaitGroup
+		for i, tx := range txs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				p.Yield()
+				sigs, err := tx.GetSignaturesV2()
+				if err != nil || len(sigs) != 1 {
+					return
+				}
+				err = signing.VerifySignature(account.PubKey(), signerData, sigs[0].Data, encCfg.TxConfig.SignModeHandler(), tx)
+				valid[i] = err == nil
+			}()
+		}
+		wg.Wait()
+		return valid, nil
+	})
+}
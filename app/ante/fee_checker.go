@@ -5,7 +5,10 @@ import (
 	"cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	v1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
 	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerror "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -73,10 +76,34 @@ func ValidateTxFee(ctx sdk.Context, tx sdk.Tx, paramKeeper params.Keeper) (sdk.C
 		}
 	}
 
-	priority := getTxPriority(feeTx.GetFee(), int64(gas))
+	// The square, not the block gas, bounds the blob txs that PrepareProposal
+	// can fit in a block. Blob txs are therefore prioritised by the fee paid
+	// per share they occupy, including namespace padding, whenever that is
+	// lower than the fee paid per unit of gas.
+	priorityGas := max(gas, squareFootprintGas(feeTx.GetMsgs(), ctx.BlockHeader().Version.App))
+	priority := getTxPriority(feeTx.GetFee(), int64(priorityGas))
 	return feeTx.GetFee(), priority, nil
 }
 
+// squareFootprintGas returns the gas that the blobs of the PFBs in msgs would
+// consume if every share they may occupy in the square, including the worst
+// case namespace padding needed to align them, was charged as blob data.
+func squareFootprintGas(msgs []sdk.Msg, appVersion uint64) uint64 {
+	threshold := appconsts.SubtreeRootThreshold(appVersion)
+	var shares uint64
+	for _, msg := range msgs {
+		pfb, ok := msg.(*blobtypes.MsgPayForBlobs)
+		if !ok {
+			continue
+		}
+		for _, size := range pfb.BlobSizes {
+			blobShares := share.SparseSharesNeeded(size)
+			shares += uint64(blobShares + inclusion.SubTreeWidth(blobShares, threshold) - 1)
+		}
+	}
+	return shares * uint64(appconsts.ShareSize(appVersion)) * uint64(appconsts.GasPerBlobByte(appVersion))
+}
+
 // verifyMinFee validates that the provided transaction fee is sufficient given the provided minimum gas price.
 func verifyMinFee(fee math.Int, gas uint64, minGasPrice sdk.Dec, errMsg string) error {
 	// Determine the required fee by multiplying required minimum gas
@@ -4,8 +4,12 @@ import (
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetTxPriority(t *testing.T) {
@@ -54,3 +58,68 @@ func TestGetTxPriority(t *testing.T) {
 		})
 	}
 }
+
+func TestSquareFootprintGas(t *testing.T) {
+	gasPerShare := uint64(share.ShareSize) * uint64(appconsts.DefaultGasPerBlobByte)
+	threshold := appconsts.DefaultSubtreeRootThreshold
+	// a blob that is one share larger than the threshold is aligned to a
+	// subtree width of two so it may be preceded by one share of padding.
+	alignedBlobSize := uint32(share.FirstSparseShareContentSize + threshold*share.ContinuationSparseShareContentSize)
+
+	cases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		expected uint64
+	}{
+		{
+			name:     "no PFB",
+			msgs:     []sdk.Msg{&banktypes.MsgSend{}},
+			expected: 0,
+		},
+		{
+			name:     "single share blob",
+			msgs:     []sdk.Msg{&blobtypes.MsgPayForBlobs{BlobSizes: []uint32{100}}},
+			expected: gasPerShare,
+		},
+		{
+			name:     "blob with padding",
+			msgs:     []sdk.Msg{&blobtypes.MsgPayForBlobs{BlobSizes: []uint32{alignedBlobSize}}},
+			expected: uint64(threshold+2) * gasPerShare,
+		},
+		{
+			name: "multiple blobs and PFBs",
+			msgs: []sdk.Msg{
+				&blobtypes.MsgPayForBlobs{BlobSizes: []uint32{100, alignedBlobSize}},
+				&blobtypes.MsgPayForBlobs{BlobSizes: []uint32{100}},
+			},
+			expected: uint64(threshold+4) * gasPerShare,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, squareFootprintGas(tc.msgs, appconsts.LatestVersion))
+		})
+	}
+}
+
+// TestBlobTxPriorityAccountsForShareFootprint checks that a large blob tx that
+// may need more namespace padding than is covered by the fixed costs of a PFB
+// has a lower priority than its gas price.
+func TestBlobTxPriorityAccountsForShareFootprint(t *testing.T) {
+	threshold := appconsts.DefaultSubtreeRootThreshold
+	// the blob occupies 32 * threshold + 1 shares and is aligned to a subtree
+	// width of 64.
+	blobSize := uint32(share.FirstSparseShareContentSize + 32*threshold*share.ContinuationSparseShareContentSize)
+	msgs := []sdk.Msg{&blobtypes.MsgPayForBlobs{BlobSizes: []uint32{blobSize}}}
+	gas := blobtypes.DefaultEstimateGas([]uint32{blobSize})
+	fee := sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, int64(gas)))
+
+	footprintGas := squareFootprintGas(msgs, appconsts.LatestVersion)
+	require.Greater(t, footprintGas, gas)
+	assert.Less(t, getTxPriority(fee, int64(footprintGas)), getTxPriority(fee, int64(gas)))
+
+	// small blobs are prioritised by gas price
+	smallBlobMsgs := []sdk.Msg{&blobtypes.MsgPayForBlobs{BlobSizes: []uint32{100}}}
+	require.Less(t, squareFootprintGas(smallBlobMsgs, appconsts.LatestVersion), blobtypes.DefaultEstimateGas([]uint32{100}))
+}
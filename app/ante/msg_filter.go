@@ -0,0 +1,174 @@
+package ante
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+)
+
+var (
+	_ sdk.AnteDecorator      = MsgFilterDecorator{}
+	_ icatypes.MessageRouter = icaMsgRouter{}
+)
+
+// ExecMode is the execution context that a message is filtered in.
+type ExecMode uint8
+
+const (
+	// ExecModeCheckTx is used for txs that are checked before they are added
+	// to the mempool, including rechecks.
+	ExecModeCheckTx ExecMode = iota + 1
+	// ExecModeSimulate is used for txs that are simulated, e.g. to estimate
+	// gas.
+	ExecModeSimulate
+	// ExecModeDeliverTx is used for txs that are executed in a block,
+	// including the execution in PrepareProposal and ProcessProposal.
+	ExecModeDeliverTx
+	// ExecModeICA is used for messages that are executed on behalf of an
+	// interchain account. These messages are part of an IBC packet and never
+	// pass through the ante handler.
+	ExecModeICA
+)
+
+func (m ExecMode) String() string {
+	switch m {
+	case ExecModeCheckTx:
+		return "CheckTx"
+	case ExecModeSimulate:
+		return "Simulate"
+	case ExecModeDeliverTx:
+		return "DeliverTx"
+	case ExecModeICA:
+		return "ICA"
+	default:
+		return "Unknown"
+	}
+}
+
+// MsgFilterFunc returns an error if a message of msgTypeURL must not be
+// executed in mode.
+type MsgFilterFunc func(ctx sdk.Context, mode ExecMode, msgTypeURL string) error
+
+// ModePredicate reports whether a filter applies in the current context.
+type ModePredicate func(ctx sdk.Context, mode ExecMode) bool
+
+// InModes returns a predicate that is true in any of modes.
+func InModes(modes ...ExecMode) ModePredicate {
+	return func(_ sdk.Context, mode ExecMode) bool {
+		for _, m := range modes {
+			if m == mode {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// DenyMsgs returns a filter that rejects messages of msgTypeURLs whenever deny
+// returns true.
+func DenyMsgs(deny ModePredicate, msgTypeURLs ...string) MsgFilterFunc {
+	denied := toSet(msgTypeURLs)
+	return func(ctx sdk.Context, mode ExecMode, msgTypeURL string) error {
+		if _, ok := denied[msgTypeURL]; ok && deny(ctx, mode) {
+			return sdkerrors.ErrUnauthorized.Wrapf("message type %s is not allowed in %s", msgTypeURL, mode)
+		}
+		return nil
+	}
+}
+
+// AllowMsgs returns a filter that, whenever applies returns true, rejects all
+// messages that are not of msgTypeURLs.
+func AllowMsgs(applies ModePredicate, msgTypeURLs ...string) MsgFilterFunc {
+	allowed := toSet(msgTypeURLs)
+	return func(ctx sdk.Context, mode ExecMode, msgTypeURL string) error {
+		if _, ok := allowed[msgTypeURL]; !ok && applies(ctx, mode) {
+			return sdkerrors.ErrUnauthorized.Wrapf("message type %s is not allowed in %s", msgTypeURL, mode)
+		}
+		return nil
+	}
+}
+
+// MsgFilterDecorator rejects txs that contain a message that any of its
+// filters rejects. Messages nested in an authz MsgExec are filtered as well.
+type MsgFilterDecorator struct {
+	filters []MsgFilterFunc
+}
+
+func NewMsgFilterDecorator(filters ...MsgFilterFunc) MsgFilterDecorator {
+	return MsgFilterDecorator{filters: filters}
+}
+
+// AnteHandle implements the ante.Decorator interface
+func (d MsgFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	mode := ExecModeDeliverTx
+	switch {
+	case simulate:
+		mode = ExecModeSimulate
+	case ctx.IsCheckTx():
+		mode = ExecModeCheckTx
+	}
+
+	if err := d.FilterMsgs(ctx, mode, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// FilterMsgs returns the error of the first filter that rejects any of msgs.
+func (d MsgFilterDecorator) FilterMsgs(ctx sdk.Context, mode ExecMode, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		// Recursively check for rejected messages in nested authz messages.
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			nestedMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err = d.FilterMsgs(ctx, mode, nestedMsgs); err != nil {
+				return err
+			}
+		}
+
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		for _, filter := range d.filters {
+			if err := filter(ctx, mode, msgTypeURL); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ICAMsgRouter wraps router so that the messages that it routes are filtered
+// in ExecModeICA before they are handled. It is meant to be passed to the ICA
+// host keeper.
+func (d MsgFilterDecorator) ICAMsgRouter(router icatypes.MessageRouter) icatypes.MessageRouter {
+	return icaMsgRouter{router: router, filter: d}
+}
+
+type icaMsgRouter struct {
+	router icatypes.MessageRouter
+	filter MsgFilterDecorator
+}
+
+func (r icaMsgRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	handler := r.router.Handler(msg)
+	if handler == nil {
+		return nil
+	}
+	return func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+		if err := r.filter.FilterMsgs(ctx, ExecModeICA, []sdk.Msg{req}); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return set
+}
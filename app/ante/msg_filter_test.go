@@ -0,0 +1,97 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/ante"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestMsgFilterDecorator(t *testing.T) {
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	nestedVote := authz.NewMsgExec(sdk.AccAddress{}, []sdk.Msg{&govv1.MsgVote{}})
+
+	// Gov votes are disabled in DeliverTx from height 10 and only sends are
+	// accepted in CheckTx.
+	upgradeWindow := func(ctx sdk.Context, mode ante.ExecMode) bool {
+		return mode == ante.ExecModeDeliverTx && ctx.BlockHeight() >= 10
+	}
+	decorator := ante.NewMsgFilterDecorator(
+		ante.DenyMsgs(upgradeWindow, sdk.MsgTypeURL(&govv1.MsgVote{})),
+		ante.AllowMsgs(ante.InModes(ante.ExecModeCheckTx), sendURL),
+	)
+	cdc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	anteHandler := sdk.ChainAnteDecorators(decorator)
+
+	tests := []struct {
+		name      string
+		msg       sdk.Msg
+		height    int64
+		checkTx   bool
+		simulate  bool
+		acceptMsg bool
+	}{
+		{name: "accept vote before the window", msg: &govv1.MsgVote{}, height: 9, acceptMsg: true},
+		{name: "reject vote in the window", msg: &govv1.MsgVote{}, height: 10, acceptMsg: false},
+		{name: "reject nested vote in the window", msg: &nestedVote, height: 10, acceptMsg: false},
+		{name: "accept vote in the window when simulating", msg: &govv1.MsgVote{}, height: 10, simulate: true, acceptMsg: true},
+		{name: "accept send in CheckTx", msg: &banktypes.MsgSend{}, height: 10, checkTx: true, acceptMsg: true},
+		{name: "reject vote in CheckTx", msg: &govv1.MsgVote{}, height: 1, checkTx: true, acceptMsg: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.NewContext(nil, tmproto.Header{Height: tc.height}, tc.checkTx, nil)
+			txBuilder := cdc.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msg))
+			_, err := anteHandler(ctx, txBuilder.GetTx(), tc.simulate)
+			if tc.acceptMsg {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgFilterICAMsgRouter(t *testing.T) {
+	decorator := ante.NewMsgFilterDecorator(
+		ante.DenyMsgs(ante.InModes(ante.ExecModeICA), sdk.MsgTypeURL(&banktypes.MsgMultiSend{})),
+	)
+	router := decorator.ICAMsgRouter(stubMsgRouter{})
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+
+	handler := router.Handler(&banktypes.MsgSend{})
+	require.NotNil(t, handler)
+	_, err := handler(ctx, &banktypes.MsgSend{})
+	require.NoError(t, err)
+
+	handler = router.Handler(&banktypes.MsgMultiSend{})
+	require.NotNil(t, handler)
+	_, err = handler(ctx, &banktypes.MsgMultiSend{})
+	require.Error(t, err)
+
+	require.Nil(t, router.Handler(&govv1.MsgVote{}))
+}
+
+// stubMsgRouter routes bank messages to a handler that always succeeds.
+type stubMsgRouter struct{}
+
+func (stubMsgRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	switch msg.(type) {
+	case *banktypes.MsgSend, *banktypes.MsgMultiSend:
+		return func(sdk.Context, sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		}
+	default:
+		return nil
+	}
+}
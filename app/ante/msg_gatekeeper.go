@@ -6,7 +6,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 var (
@@ -29,38 +28,20 @@ func NewMsgVersioningGateKeeper(acceptedList map[uint64]map[string]struct{}) *Ms
 
 // AnteHandle implements the ante.Decorator interface
 func (mgk MsgVersioningGateKeeper) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	acceptedMsgs, exists := mgk.acceptedMsgs[ctx.BlockHeader().Version.App]
-	if !exists {
+	if _, exists := mgk.acceptedMsgs[ctx.BlockHeader().Version.App]; !exists {
 		return ctx, sdkerrors.ErrNotSupported.Wrapf("app version %d is not supported", ctx.BlockHeader().Version.App)
 	}
 
-	if err := mgk.hasInvalidMsg(ctx, acceptedMsgs, tx.GetMsgs()); err != nil {
-		return ctx, err
-	}
-
-	return next(ctx, tx, simulate)
+	return NewMsgFilterDecorator(mgk.FilterMsg).AnteHandle(ctx, tx, simulate, next)
 }
 
-func (mgk MsgVersioningGateKeeper) hasInvalidMsg(ctx sdk.Context, acceptedMsgs map[string]struct{}, msgs []sdk.Msg) error {
-	for _, msg := range msgs {
-		// Recursively check for invalid messages in nested authz messages.
-		if execMsg, ok := msg.(*authz.MsgExec); ok {
-			nestedMsgs, err := execMsg.GetMessages()
-			if err != nil {
-				return err
-			}
-			if err = mgk.hasInvalidMsg(ctx, acceptedMsgs, nestedMsgs); err != nil {
-				return err
-			}
-		}
-
-		msgTypeURL := sdk.MsgTypeURL(msg)
-		_, exists := acceptedMsgs[msgTypeURL]
-		if !exists {
-			return sdkerrors.ErrNotSupported.Wrapf("message type %s is not supported in version %d", msgTypeURL, ctx.BlockHeader().Version.App)
-		}
+// FilterMsg implements MsgFilterFunc. It rejects messages that aren't accepted
+// in the app version of ctx, regardless of the execution mode.
+func (mgk MsgVersioningGateKeeper) FilterMsg(ctx sdk.Context, _ ExecMode, msgTypeURL string) error {
+	appVersion := ctx.BlockHeader().Version.App
+	if _, exists := mgk.acceptedMsgs[appVersion][msgTypeURL]; !exists {
+		return sdkerrors.ErrNotSupported.Wrapf("message type %s is not supported in version %d", msgTypeURL, appVersion)
 	}
-
 	return nil
 }
 
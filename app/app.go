@@ -8,6 +8,10 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v3/app/ante"
 	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/app/forensics"
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/blobs"
+	celestiamempool "github.com/celestiaorg/celestia-app/v3/app/grpc/mempool"
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/retention"
 	celestiatx "github.com/celestiaorg/celestia-app/v3/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v3/app/module"
 	"github.com/celestiaorg/celestia-app/v3/app/posthandler"
@@ -15,24 +19,37 @@ import (
 	appv1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
 	appv2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
 	appv3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	appv4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	"github.com/celestiaorg/celestia-app/v3/pkg/blobindex"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/feestats"
 	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/pkg/telemetrypush"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
 	blobkeeper "github.com/celestiaorg/celestia-app/v3/x/blob/keeper"
 	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
 	blobstreamkeeper "github.com/celestiaorg/celestia-app/v3/x/blobstream/keeper"
 	blobstreamtypes "github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	icacontrollermodule "github.com/celestiaorg/celestia-app/v3/x/icacontroller"
+	"github.com/celestiaorg/celestia-app/v3/x/icahostfilter"
 	"github.com/celestiaorg/celestia-app/v3/x/minfee"
 	mintkeeper "github.com/celestiaorg/celestia-app/v3/x/mint/keeper"
 	minttypes "github.com/celestiaorg/celestia-app/v3/x/mint/types"
+	namespacekeeper "github.com/celestiaorg/celestia-app/v3/x/namespace/keeper"
+	namespacetypes "github.com/celestiaorg/celestia-app/v3/x/namespace/types"
 	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
+	"github.com/celestiaorg/celestia-app/v3/x/ratelimit"
 	"github.com/celestiaorg/celestia-app/v3/x/signal"
 	signaltypes "github.com/celestiaorg/celestia-app/v3/x/signal/types"
 	"github.com/celestiaorg/celestia-app/v3/x/tokenfilter"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -73,10 +90,16 @@ import (
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v6/packetforward"
 	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v6/packetforward/keeper"
 	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v6/packetforward/types"
+	icacontroller "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
 	icahost "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcfee "github.com/cosmos/ibc-go/v6/modules/apps/29-fee"
+	ibcfeekeeper "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/keeper"
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v6/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
@@ -106,12 +129,14 @@ var maccPerms = map[string][]string{
 	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 	ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 	icatypes.ModuleName:            nil,
+	ibcfeetypes.ModuleName:         nil,
 }
 
 const (
 	v1                    = appv1.Version
 	v2                    = appv2.Version
 	v3                    = appv3.Version
+	v4                    = appv4.Version
 	DefaultInitialVersion = v1
 )
 
@@ -158,13 +183,18 @@ type App struct {
 	TransferKeeper      ibctransferkeeper.Keeper
 	FeeGrantKeeper      feegrantkeeper.Keeper
 	ICAHostKeeper       icahostkeeper.Keeper
+	ICAControllerKeeper icacontrollerkeeper.Keeper
+	IBCFeeKeeper        ibcfeekeeper.Keeper
 	PacketForwardKeeper *packetforwardkeeper.Keeper
+	RateLimitKeeper     ratelimit.Keeper
 	BlobKeeper          blobkeeper.Keeper
 	BlobstreamKeeper    blobstreamkeeper.Keeper
+	NamespaceKeeper     namespacekeeper.Keeper
 
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper // This keeper is public for test purposes
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper // This keeper is public for test purposes
-	ScopedICAHostKeeper  capabilitykeeper.ScopedKeeper // This keeper is public for test purposes
+	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper // This keeper is public for test purposes
+	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper // This keeper is public for test purposes
+	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper // This keeper is public for test purposes
+	ScopedICAControllerKeeper capabilitykeeper.ScopedKeeper // This keeper is public for test purposes
 
 	manager      *module.Manager
 	configurator module.Configurator
@@ -178,6 +208,38 @@ type App struct {
 	// MsgGateKeeper is used to define which messages are accepted for a given
 	// app version.
 	MsgGateKeeper *ante.MsgVersioningGateKeeper
+	// proposalListeners are the streaming services that are passed the txs of
+	// every accepted proposal. See ProposalListener.
+	proposalListeners []ProposalListener
+	// rootCache caches the row and column roots of the data squares that are
+	// constructed in PrepareProposal and ProcessProposal.
+	rootCache *wrapper.RootCache
+	// upgradeChecker runs the upgrade checks after upgrades. It is nil if
+	// they are disabled.
+	upgradeChecker *upgradeChecker
+	// namespaceFairness enables the namespace fairness mode of
+	// PrepareProposal.
+	namespaceFairness bool
+	// proposalTimeout bounds the square work of PrepareProposal through
+	// proposalContext. ProcessProposal never uses it. See FlagProposalTimeout.
+	proposalTimeout time.Duration
+	// telemetryCollector collects the metrics that are pushed to a remote
+	// collector. It is nil if pushing is disabled.
+	telemetryCollector *telemetrypush.Collector
+	// rejections retains the most recent txs that CheckTx rejected for the
+	// recent rejections query.
+	rejections *celestiamempool.RejectionLog
+	// retention is the block retention of the node, which bounds the retain
+	// height returned on commit.
+	retention retention.Config
+	// emitBlobEvents enables the blob events. See FlagBlobEvents.
+	emitBlobEvents bool
+	// deliveredTxs are the txs that were delivered in the current block, from
+	// which the blob events are emitted at the end of the block.
+	deliveredTxs [][]byte
+	// failedPFBs are the PFBs of the current block that failed the checks of
+	// the ante handler, which are charged their fees at the end of the block.
+	failedPFBs []failedPFB
 }
 
 // New returns a reference to an uninitialized app. Callers must subsequently
@@ -221,6 +283,8 @@ func New(
 		memKeys:           memKeys,
 		upgradeHeightV2:   upgradeHeightV2,
 		timeoutCommit:     timeoutCommit,
+		rejections:        celestiamempool.NewRejectionLog(celestiamempool.DefaultRejectionLogSize),
+		retention:         retention.NewConfig(appOpts),
 	}
 
 	app.ParamsKeeper = initParamsKeeper(appCodec, encodingConfig.Amino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
@@ -232,6 +296,7 @@ func New(
 	app.ScopedIBCKeeper = app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	app.ScopedTransferKeeper = app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	app.ScopedICAHostKeeper = app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	app.ScopedICAControllerKeeper = app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
 		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms, sdk.GetConfig().GetBech32AccountAddrPrefix(),
@@ -298,19 +363,46 @@ func New(
 		app.ScopedIBCKeeper,
 	)
 
+	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
+		appCodec,
+		keys[ibcfeetypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		app.AccountKeeper,
+		app.BankKeeper,
+	)
+	// The IBC fee keeper wraps the acknowledgements of fee enabled channels
+	// and unwraps their versions. It is used only for version >= 4.
+	ics4Wrapper := module.NewVersionedICS4Wrapper(app.IBCFeeKeeper, app.IBCKeeper.ChannelKeeper, v4, v4)
+
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec,
 		keys[icahosttypes.StoreKey],
 		app.GetSubspace(icahosttypes.SubModuleName),
-		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
+		ics4Wrapper,
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
 		app.ScopedICAHostKeeper,
+		app.icaHostMsgRouter(),
+	)
+
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec,
+		keys[icacontrollertypes.StoreKey],
+		app.GetSubspace(icacontrollertypes.SubModuleName),
+		ics4Wrapper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		app.ScopedICAControllerKeeper,
 		app.MsgServiceRouter(),
 	)
 
-	paramBlockList := paramfilter.NewParamBlockList(app.BlockedParams()...)
+	paramBlockList := paramfilter.NewParamBlockList(app.BlockedParams()...).
+		WithAddedParams(app.AddedParams()...).
+		WithBounds(app.BoundedParams()...).
+		WithRateLimits(app.RateLimitedParams()...)
 
 	// Register the proposal types.
 	govRouter := oldgovtypes.NewRouter()
@@ -319,7 +411,16 @@ func New(
 		AddRoute(ibcclienttypes.RouterKey, NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
 
 	// Create Transfer Keepers.
-	tokenFilterKeeper := tokenfilter.NewKeeper(app.IBCKeeper.ChannelKeeper)
+	tokenFilterKeeper := tokenfilter.NewKeeper(ics4Wrapper)
+
+	// The rate limit keeper caps the outflow of all the transfers, including
+	// the ones forwarded by the packet forward middleware, for version >= 4.
+	app.RateLimitKeeper = ratelimit.NewKeeper(
+		appCodec,
+		keys[ratelimit.StoreKey],
+		app.GetSubspace(ratelimit.ModuleName),
+		tokenFilterKeeper,
+	)
 
 	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
 		appCodec,
@@ -329,7 +430,7 @@ func New(
 		app.IBCKeeper.ChannelKeeper,
 		app.DistrKeeper,
 		app.BankKeeper,
-		tokenFilterKeeper,
+		app.RateLimitKeeper,
 	)
 
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
@@ -338,7 +439,9 @@ func New(
 		app.AccountKeeper, app.BankKeeper, app.ScopedTransferKeeper,
 	)
 	// Transfer stack contains (from top to bottom):
+	// - IBC Fee
 	// - Token Filter
+	// - Rate Limit
 	// - Packet Forwarding Middleware
 	// - Transfer
 	var transferStack ibcporttypes.IBCModule
@@ -351,10 +454,19 @@ func New(
 		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,  // refund timeout
 	)
 	// PacketForwardMiddleware is used only for version >= 2.
-	transferStack = module.NewVersionedIBCModule(packetForwardMiddleware, transferStack, v2, v3)
-	// Token filter wraps packet forward middleware and is thus the first module in the transfer stack.
+	transferStack = module.NewVersionedIBCModule(packetForwardMiddleware, transferStack, v2, v4)
+	// The rate limit middleware reverts the outflow of failed transfers and is
+	// used only for version >= 4.
+	rateLimitMiddleware := ratelimit.NewIBCMiddleware(transferStack, app.RateLimitKeeper)
+	transferStack = module.NewVersionedIBCModule(rateLimitMiddleware, transferStack, v4, v4)
+	// Token filter wraps the rate limit middleware.
 	tokenFilterMiddelware := tokenfilter.NewIBCMiddleware(transferStack)
-	transferStack = module.NewVersionedIBCModule(tokenFilterMiddelware, transferStack, v1, v3)
+	transferStack = module.NewVersionedIBCModule(tokenFilterMiddelware, transferStack, v1, v4)
+	// The IBC fee middleware is the first module in the transfer stack so that
+	// it wraps all the acknowledgements of fee enabled channels, including the
+	// error acknowledgements of the token filter. It is used only for version
+	// >= 4.
+	transferStack = module.NewVersionedIBCModule(ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper), transferStack, v4, v4)
 
 	app.EvidenceKeeper = *evidencekeeper.NewKeeper(
 		appCodec,
@@ -377,13 +489,46 @@ func New(
 
 	app.BlobKeeper = *blobkeeper.NewKeeper(
 		appCodec,
+		keys[blobtypes.StoreKey],
 		app.GetSubspace(blobtypes.ModuleName),
 	)
 
+	app.NamespaceKeeper = *namespacekeeper.NewKeeper(
+		appCodec,
+		keys[namespacetypes.StoreKey],
+		app.GetSubspace(namespacetypes.ModuleName),
+		app.DistrKeeper,
+	)
+
+	// ICA host stack contains (from top to bottom):
+	// - IBC Fee
+	// - ICA Host Filter
+	// - ICA Host
+	var icaHostStack ibcporttypes.IBCModule
+	icaHostStack = icahost.NewIBCModule(app.ICAHostKeeper)
+	// The ICA host filter is used only for version >= 4.
+	icaHostFilterMiddleware := icahostfilter.NewIBCMiddleware(icaHostStack, appCodec, app.GetSubspace(icahostfilter.ModuleName), app.IBCKeeper.ChannelKeeper)
+	icaHostStack = module.NewVersionedIBCModule(icaHostFilterMiddleware, icaHostStack, v4, v4)
+	// The IBC fee middleware is used only for version >= 4.
+	icaHostStack = module.NewVersionedIBCModule(ibcfee.NewIBCMiddleware(icaHostStack, app.IBCFeeKeeper), icaHostStack, v4, v4)
+
+	// The ICA controller has no underlying application so the interchain
+	// accounts are controlled only by MsgRegisterInterchainAccount and
+	// MsgSendTx, which are accepted for version >= 4. The ICA controller stack
+	// contains (from top to bottom):
+	// - IBC Fee
+	// - ICA Controller
+	var icaControllerStack ibcporttypes.IBCModule
+	// The ICA controller is used only for version >= 4. Its store isn't
+	// mounted in the earlier versions, whose callbacks are all rejected.
+	icaControllerStack = module.NewVersionedIBCModule(icacontroller.NewIBCMiddleware(nil, app.ICAControllerKeeper), icacontrollermodule.NewDisabledIBCModule(), v4, v4)
+	icaControllerStack = module.NewVersionedIBCModule(ibcfee.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper), icaControllerStack, v4, v4)
+
 	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
-	ibcRouter := ibcporttypes.NewRouter()                                                   // Create static IBC router
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)                          // Add transfer route
-	ibcRouter.AddRoute(icahosttypes.SubModuleName, icahost.NewIBCModule(app.ICAHostKeeper)) // Add ICA route
+	ibcRouter := ibcporttypes.NewRouter()                                    // Create static IBC router
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)           // Add transfer route
+	ibcRouter.AddRoute(icahosttypes.SubModuleName, icaHostStack)             // Add ICA route
+	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icaControllerStack) // Add ICA controller route
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	/****  Module Options ****/
@@ -403,6 +548,7 @@ func New(
 
 	app.QueryRouter().AddRoute(proof.TxInclusionQueryPath, proof.QueryTxInclusionProof)
 	app.QueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.QueryShareInclusionProof)
+	app.QueryRouter().AddRoute(da.SummaryQueryPath, app.queryDASummary)
 
 	app.manager.RegisterInvariants(&app.CrisisKeeper)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -412,6 +558,7 @@ func New(
 	// which will be used both as the antehandler and as part of the circuit breaker in
 	// the msg service router
 	app.MsgGateKeeper = ante.NewMsgVersioningGateKeeper(app.configurator.GetAcceptedMessages())
+	app.rootCache = wrapper.NewRootCache(wrapper.DefaultRootCacheSize)
 	app.MsgServiceRouter().SetCircuit(app.MsgGateKeeper)
 
 	// Initialize the KV stores for the base modules (e.g. params). The base modules will be included in every app version.
@@ -419,6 +566,40 @@ func New(
 	app.MountTransientStores(tkeys)
 	app.MountMemoryStores(memKeys)
 
+	if dir := cast.ToString(appOpts.Get(forensics.FlagDir)); dir != "" {
+		recorder, err := forensics.NewRecorder(dir, cast.ToInt64(appOpts.Get(forensics.FlagRetainHeights)), keys, logger)
+		if err != nil {
+			panic(err)
+		}
+		app.SetStreamingService(recorder)
+	}
+	if cast.ToBool(appOpts.Get(blobindex.FlagEnable)) {
+		indexDB, err := blobindex.OpenDB(cast.ToString(appOpts.Get(flags.FlagHome)), server.GetAppDBBackend(appOpts))
+		if err != nil {
+			panic(err)
+		}
+		app.SetStreamingService(blobindex.NewListener(blobindex.New(indexDB), logger))
+	}
+	if window := cast.ToInt(appOpts.Get(feestats.FlagWindow)); window > 0 {
+		tracker := feestats.NewTracker(window, encodingConfig.TxConfig.TxDecoder(), logger)
+		app.SetStreamingService(tracker)
+		app.QueryRouter().AddRoute(feestats.QueryPath, tracker.Query)
+	}
+	if blocks := cast.ToInt64(appOpts.Get(FlagUpgradeCheckBlocks)); blocks > 0 {
+		app.upgradeChecker = newUpgradeChecker(blocks, logger)
+	}
+	app.namespaceFairness = cast.ToBool(appOpts.Get(FlagNamespaceFairness))
+	app.emitBlobEvents = cast.ToBool(appOpts.Get(FlagBlobEvents))
+	app.proposalTimeout = cast.ToDuration(appOpts.Get(FlagProposalTimeout))
+	if cast.ToString(appOpts.Get(telemetrypush.FlagEndpoint)) != "" {
+		app.telemetryCollector = telemetrypush.NewCollector()
+	}
+	if path := cast.ToString(appOpts.Get(FlagExportAtHalt)); path != "" {
+		if haltHeight := cast.ToInt64(appOpts.Get(server.FlagHaltHeight)); haltHeight > 0 {
+			app.SetStreamingService(newHaltExporter(app, haltHeight, path))
+		}
+	}
+
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
@@ -426,6 +607,7 @@ func New(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.BlobKeeper,
+		app.NamespaceKeeper,
 		app.FeeGrantKeeper,
 		encodingConfig.TxConfig.SignModeHandler(),
 		ante.DefaultSigVerificationGasConsumer,
@@ -458,12 +640,18 @@ func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.R
 	if req.Header.Height == app.upgradeHeightV2 {
 		app.BaseApp.Logger().Info("upgraded from app version 1 to 2")
 	}
+	app.upgradeChecker.beginBlock(app, ctx)
+	app.deliveredTxs = nil
+	app.failedPFBs = nil
 	return app.manager.BeginBlock(ctx, req)
 }
 
 // EndBlocker executes application updates at the end of every block.
 func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	failedPFBEvents := app.chargeFailedPFBs(ctx)
 	res := app.manager.EndBlock(ctx, req)
+	res.Events = append(res.Events, failedPFBEvents...)
+	res.Events = append(res.Events, app.blobEvents(ctx)...)
 	currentVersion := app.AppVersion()
 	// For v1 only we upgrade using an agreed upon height known ahead of time
 	if currentVersion == v1 {
@@ -518,7 +706,21 @@ func (app *App) migrateCommitStore(fromVersion, toVersion uint64) (baseapp.Store
 // migrateModules performs migrations on existing modules that have registered migrations
 // between versions and initializes the state of new modules for the specified app version.
 func (app *App) migrateModules(ctx sdk.Context, fromVersion, toVersion uint64) error {
-	return app.manager.RunMigrations(ctx, app.configurator, fromVersion, toVersion)
+	if err := app.manager.RunMigrations(ctx, app.configurator, fromVersion, toVersion); err != nil {
+		return err
+	}
+	// The governance bounds of the slashing params apply from v4 onwards.
+	if fromVersion < v4 && toVersion >= v4 {
+		app.boundSlashingParams(ctx)
+	}
+	return nil
+}
+
+// MigrationPlan returns the module migrations that are performed when
+// upgrading from fromVersion to toVersion. It returns an error if the upgrade
+// is not supported by this binary.
+func (app *App) MigrationPlan(fromVersion, toVersion uint64) ([]module.ModuleMigration, error) {
+	return app.manager.MigrationPlan(app.configurator, fromVersion, toVersion)
 }
 
 // Info implements the ABCI interface. This method is a wrapper around baseapp's
@@ -660,6 +862,12 @@ func (app *App) ModuleAccountAddrs() map[string]bool {
 	return modAccAddrs
 }
 
+// TelemetryCollector returns the collector of the metrics that are pushed to
+// a remote collector. It is nil if pushing is disabled.
+func (app *App) TelemetryCollector() *telemetrypush.Collector {
+	return app.telemetryCollector
+}
+
 // GetBaseApp implements the TestingApp interface.
 func (app *App) GetBaseApp() *baseapp.BaseApp {
 	return app.BaseApp
@@ -747,12 +955,14 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	celestiatx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	celestiamempool.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	retention.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 }
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *App) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
-	celestiatx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.interfaceRegistry)
+	celestiatx.RegisterSDKTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	celestiatx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.interfaceRegistry, app.rejections)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -762,6 +972,10 @@ func (app *App) RegisterTendermintService(clientCtx client.Context) {
 
 func (app *App) RegisterNodeService(clientCtx client.Context) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
+	blobtypes.RegisterProofQueryServer(app.GRPCQueryRouter(), blobkeeper.NewProofQueryServer(clientCtx))
+	celestiamempool.RegisterMempoolService(app.GRPCQueryRouter(), app.rejections)
+	retention.RegisterRetentionService(app.GRPCQueryRouter(), clientCtx.Client, app.retention, app.CommitMultiStore().GetPruning())
+	blobs.RegisterBlobsService(app.GRPCQueryRouter(), clientCtx.Client)
 }
 
 // BlockedParams returns the params that require a hardfork to change, and
@@ -779,6 +993,30 @@ func (app *App) BlockedParams() [][2]string {
 	}
 }
 
+// AddedParams returns the params that were added to the subspaces of existing
+// modules or with new modules, which governance can't change in the app
+// versions before they were added.
+func (app *App) AddedParams() []paramfilter.AddedParam {
+	return []paramfilter.AddedParam{
+		// blob.MaxBlobsPerPFB
+		{Subspace: blobtypes.ModuleName, Key: string(blobtypes.KeyMaxBlobsPerPFB), FromVersion: v4},
+		// blob.MaxPFBsPerBlock
+		{Subspace: blobtypes.ModuleName, Key: string(blobtypes.KeyMaxPFBsPerBlock), FromVersion: v4},
+		// blob.AllowedSigners
+		{Subspace: blobtypes.ModuleName, Key: string(blobtypes.KeyAllowedSigners), FromVersion: v4},
+		// blob.MaxTotalBlobSizePerPFB
+		{Subspace: blobtypes.ModuleName, Key: string(blobtypes.KeyMaxTotalBlobSizePerPFB), FromVersion: v4},
+		// icahostfilter.ConnectionAllowlists
+		{Subspace: icahostfilter.ModuleName, Key: string(icahostfilter.KeyConnectionAllowlists), FromVersion: v4},
+		// namespace.RegistrationFee
+		{Subspace: namespacetypes.ModuleName, Key: string(namespacetypes.KeyRegistrationFee), FromVersion: v4},
+		// icacontroller.ControllerEnabled
+		{Subspace: icacontrollertypes.SubModuleName, Key: string(icacontrollertypes.KeyControllerEnabled), FromVersion: v4},
+		// ratelimit.RateLimits
+		{Subspace: ratelimit.ModuleName, Key: string(ratelimit.KeyRateLimits), FromVersion: v4},
+	}
+}
+
 // initParamsKeeper initializes the params keeper and its subspaces.
 func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey storetypes.StoreKey) paramskeeper.Keeper {
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
@@ -794,10 +1032,14 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
 	paramsKeeper.Subspace(blobtypes.ModuleName)
 	paramsKeeper.Subspace(blobstreamtypes.ModuleName)
 	paramsKeeper.Subspace(minfee.ModuleName)
+	paramsKeeper.Subspace(icahostfilter.ModuleName)
 	paramsKeeper.Subspace(packetforwardtypes.ModuleName)
+	paramsKeeper.Subspace(namespacetypes.ModuleName)
+	paramsKeeper.Subspace(ratelimit.ModuleName)
 
 	return paramsKeeper
 }
@@ -854,7 +1096,7 @@ func (app *App) OfferSnapshot(req abci.RequestOfferSnapshot) abci.ResponseOfferS
 }
 
 func isSupportedAppVersion(appVersion uint64) bool {
-	return appVersion == v1 || appVersion == v2 || appVersion == v3
+	return appVersion == v1 || appVersion == v2 || appVersion == v3 || appVersion == v4
 }
 
 // getTimeoutCommit returns the timeoutCommit if a user has overridden it via the
@@ -41,6 +41,15 @@ func TestNew(t *testing.T) {
 	t.Run("initializes ScopedICAHostKeeper", func(t *testing.T) {
 		assert.NotNil(t, got.ScopedICAHostKeeper)
 	})
+	t.Run("initializes ICAControllerKeeper", func(t *testing.T) {
+		assert.NotNil(t, got.ICAControllerKeeper)
+	})
+	t.Run("initializes ScopedICAControllerKeeper", func(t *testing.T) {
+		assert.NotNil(t, got.ScopedICAControllerKeeper)
+	})
+	t.Run("initializes IBCFeeKeeper", func(t *testing.T) {
+		assert.NotNil(t, got.IBCFeeKeeper)
+	})
 	t.Run("initializes StakingKeeper", func(t *testing.T) {
 		assert.NotNil(t, got.StakingKeeper)
 	})
@@ -152,7 +161,7 @@ func TestOfferSnapshot(t *testing.T) {
 	t.Run("should REJECT a snapshot with unsupported app version", func(t *testing.T) {
 		app := createTestApp(t)
 		request := createRequest()
-		request.AppVersion = 4 // unsupported app version
+		request.AppVersion = 5 // unsupported app version
 		want := abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT}
 		got := app.OfferSnapshot(request)
 		assert.Equal(t, want, got)
@@ -0,0 +1,38 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// FlagBlobEvents is the flag to emit an EventBlob for every blob at the end of
+// every block. Emitting them reconstructs the square of every block, so nodes
+// that don't serve indexers or blob subscriptions shouldn't enable it.
+const FlagBlobEvents = "blob-events"
+
+// DeliverTx implements the ABCI interface and executes a tx. This method wraps
+// the default Baseapp's method to retain the txs of the block for the blob
+// events and to record the PFBs that fail the checks of the ante handler.
+func (app *App) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	if app.emitBlobEvents {
+		app.deliveredTxs = append(app.deliveredTxs, req.Tx)
+	}
+	return app.observeFailedPFB(req.Tx, app.BaseApp.DeliverTx(req))
+}
+
+// blobEvents returns the events that locate the blobs of the current block in
+// its data square if blob events are enabled, see
+// blobkeeper.Keeper.EmitBlobEvents. The events aren't part of consensus, so
+// errors are logged rather than returned.
+func (app *App) blobEvents(ctx sdk.Context) []abci.Event {
+	if !app.emitBlobEvents {
+		return nil
+	}
+	eventManager := sdk.NewEventManager()
+	err := app.BlobKeeper.EmitBlobEvents(ctx.WithEventManager(eventManager), app.deliveredTxs, app.txConfig.TxDecoder())
+	if err != nil {
+		app.Logger().Error("failed to emit blob events", "height", ctx.BlockHeight(), "err", err)
+		return nil
+	}
+	return eventManager.ABCIEvents()
+}
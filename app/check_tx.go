@@ -17,6 +17,12 @@ import (
 // method wraps the default Baseapp's method so that it can parse and check
 // transactions that contain blobs.
 func (app *App) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	res := app.checkTx(req)
+	app.observeCheckTx(req, res)
+	return res
+}
+
+func (app *App) checkTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	tx := req.Tx
 
 	// all txs must be less than or equal to the max tx size limit
@@ -49,13 +55,19 @@ func (app *App) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		return app.BaseApp.CheckTx(req)
 	}
 
+	// info is the breakdown of the sizes and fee of new blob txs that lets
+	// clients correct them if they are rejected.
+	var info string
 	switch req.Type {
 	// new transactions must be checked in their entirety
 	case abci.CheckTxType_New:
+		info = app.blobTxInfo(btx)
 		appVersion := app.AppVersion()
 		err := blobtypes.ValidateBlobTx(app.txConfig, btx, appconsts.SubtreeRootThreshold(appVersion), appVersion)
 		if err != nil {
-			return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false)
+			res := sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false)
+			res.Info = info
+			return res
 		}
 	case abci.CheckTxType_Recheck:
 	default:
@@ -63,5 +75,10 @@ func (app *App) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	}
 
 	req.Tx = btx.Tx
-	return app.BaseApp.CheckTx(req)
+	res := app.BaseApp.CheckTx(req)
+	res.Info = info
+	if app.telemetryCollector != nil && req.Type == abci.CheckTxType_New && res.IsOK() {
+		app.telemetryCollector.ObserveNewPFB()
+	}
+	return res
 }
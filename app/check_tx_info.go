@@ -0,0 +1,97 @@
+package app
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+// BlobTxInfo is the breakdown of a blob tx that is returned as JSON in the
+// info of its CheckTx response. It gives clients the numbers they need to
+// correct a tx that was rejected, e.g. because of an insufficient fee.
+type BlobTxInfo struct {
+	// TxSize is the size of the tx without its blobs.
+	TxSize    int      `json:"tx_size"`
+	BlobSizes []uint32 `json:"blob_sizes"`
+	// SharesNeeded is the number of shares that the blobs occupy, excluding
+	// namespace padding.
+	SharesNeeded int    `json:"shares_needed"`
+	GasWanted    uint64 `json:"gas_wanted"`
+	// EstimatedGas is the gas that the tx is estimated to consume.
+	EstimatedGas uint64 `json:"estimated_gas"`
+	// Fee is the fee of the tx in utia.
+	Fee string `json:"fee"`
+	// MinGasPrice is the higher of the min gas price of the node and of the
+	// network.
+	MinGasPrice string `json:"min_gas_price"`
+	// MinFee is the fee that GasWanted requires at MinGasPrice.
+	MinFee        string `json:"min_fee"`
+	GasSufficient bool   `json:"gas_sufficient"`
+	FeeSufficient bool   `json:"fee_sufficient"`
+}
+
+// blobTxInfo returns the JSON encoded BlobTxInfo of btx. It returns an empty
+// string if btx can't be decoded since the CheckTx response already explains
+// why.
+func (app *App) blobTxInfo(btx *blobtx.BlobTx) string {
+	sdkTx, err := app.txConfig.TxDecoder()(btx.Tx)
+	if err != nil {
+		return ""
+	}
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return ""
+	}
+
+	appVersion := app.AppVersion()
+	info := BlobTxInfo{
+		TxSize:    len(btx.Tx),
+		BlobSizes: make([]uint32, len(btx.Blobs)),
+		GasWanted: feeTx.GetGas(),
+	}
+	for i, blob := range btx.Blobs {
+		info.BlobSizes[i] = uint32(len(blob.Data()))
+		info.SharesNeeded += share.SparseSharesNeeded(info.BlobSizes[i])
+	}
+	info.EstimatedGas = blobtypes.EstimateGas(info.BlobSizes, appconsts.GasPerBlobByte(appVersion), appconsts.TxSizeCostPerByte(appVersion))
+
+	minGasPrice := app.minGasPrice(appVersion)
+	fee := feeTx.GetFee().AmountOf(appconsts.BondDenom)
+	minFee := minGasPrice.MulInt(math.NewIntFromUint64(info.GasWanted)).Ceil().TruncateInt()
+	info.Fee = fee.String()
+	info.MinGasPrice = minGasPrice.String()
+	info.MinFee = minFee.String()
+	info.GasSufficient = info.GasWanted >= info.EstimatedGas
+	info.FeeSufficient = fee.GTE(minFee)
+
+	bz, err := json.Marshal(info)
+	if err != nil {
+		return ""
+	}
+	return string(bz)
+}
+
+// minGasPrice returns the higher of the min gas price of the node and of the
+// network, which only exists after app version 1.
+func (app *App) minGasPrice(appVersion uint64) sdk.Dec {
+	ctx := app.NewContext(true, tmproto.Header{Version: version.Consensus{App: appVersion}})
+	minGasPrice := ctx.MinGasPrices().AmountOf(appconsts.BondDenom)
+	if appVersion <= v1 {
+		return minGasPrice
+	}
+	subspace, exists := app.ParamsKeeper.GetSubspace(minfee.ModuleName)
+	if !exists || !subspace.Has(ctx, minfee.KeyNetworkMinGasPrice) {
+		return minGasPrice
+	}
+	var networkMinGasPrice sdk.Dec
+	subspace.Get(ctx, minfee.KeyNetworkMinGasPrice, &networkMinGasPrice)
+	return sdk.MaxDec(minGasPrice, networkMinGasPrice)
+}
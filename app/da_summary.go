@@ -0,0 +1,50 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// queryDASummary handles the custom query of the DA summary of the block that
+// is passed as the data of the query. The Blobstream status is determined
+// from the state the query is executed against.
+func (app *App) queryDASummary(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+	if len(path) != 0 {
+		return nil, fmt.Errorf("expected query path length: 0 actual: %d ", len(path))
+	}
+	pbb := new(tmproto.Block)
+	if err := pbb.Unmarshal(req.Data); err != nil {
+		return nil, fmt.Errorf("error reading block: %w", err)
+	}
+	summary, err := da.NewSummary(pbb)
+	if err != nil {
+		return nil, err
+	}
+	summary.Blobstream = app.blobstreamStatus(ctx, pbb.Header.Height)
+	return json.Marshal(summary)
+}
+
+// blobstreamStatus returns the data commitment that covers height. The
+// Blobstream module only produces attestations up to app version 1.
+func (app *App) blobstreamStatus(ctx sdk.Context, height int64) da.BlobstreamStatus {
+	if app.AppVersion() != v1 {
+		return da.BlobstreamStatus{
+			Reason: fmt.Sprintf("the blobstream module doesn't produce attestations in app version %d", app.AppVersion()),
+		}
+	}
+	dataCommitment, err := app.BlobstreamKeeper.GetDataCommitmentForHeight(ctx, uint64(height))
+	if err != nil {
+		return da.BlobstreamStatus{Reason: err.Error()}
+	}
+	return da.BlobstreamStatus{
+		Attested:   true,
+		Nonce:      dataCommitment.Nonce,
+		BeginBlock: dataCommitment.BeginBlock,
+		EndBlock:   dataCommitment.EndBlock,
+	}
+}
@@ -96,17 +96,10 @@ type slashingModule struct {
 	slashing.AppModuleBasic
 }
 
-// DefaultGenesis returns custom x/staking module genesis state.
+// DefaultGenesis returns custom x/slashing module genesis state.
 func (slashingModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	params := slashingtypes.DefaultParams()
-	params.MinSignedPerWindow = sdk.NewDecWithPrec(75, 2) // 75%
-	params.SignedBlocksWindow = 5000
-	params.DowntimeJailDuration = time.Minute * 1
-	params.SlashFractionDoubleSign = sdk.NewDecWithPrec(2, 2) // 2%
-	params.SlashFractionDowntime = sdk.ZeroDec()              // 0%
-
 	return cdc.MustMarshalJSON(&slashingtypes.GenesisState{
-		Params: params,
+		Params: DASlashingParams(),
 	})
 }
 
@@ -157,7 +150,9 @@ type icaModule struct {
 	ica.AppModuleBasic
 }
 
-// DefaultGenesis returns custom ica module genesis state.
+// DefaultGenesis returns custom ica module genesis state. The controller
+// genesis state is unused because the ICA controller is managed by the
+// icacontroller module.
 func (icaModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	gs := icagenesistypes.DefaultGenesis()
 	gs.HostGenesisState.Params.AllowMessages = icaAllowMessages()
@@ -3,8 +3,7 @@ package app
 import (
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v3/pkg/da"
-	square "github.com/celestiaorg/go-square/v2"
-	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/celestia-app/v3/pkg/square"
 	"github.com/celestiaorg/rsmt2d"
 	coretypes "github.com/tendermint/tendermint/types"
 )
@@ -13,16 +12,12 @@ import (
 // version.
 func ExtendBlock(data coretypes.Data, appVersion uint64) (*rsmt2d.ExtendedDataSquare, error) {
 	// Construct the data square from the block's transactions
-	dataSquare, err := square.Construct(
-		data.Txs.ToSliceOfBytes(),
-		appconsts.SquareSizeUpperBound(appVersion),
-		appconsts.SubtreeRootThreshold(appVersion),
-	)
+	dataSquare, err := square.Construct(appVersion, data.Txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appVersion))
 	if err != nil {
 		return nil, err
 	}
 
-	return da.ExtendShares(share.ToBytes(dataSquare))
+	return da.ExtendShares(dataSquare)
 }
 
 // IsEmptyBlock returns true if the given block data is considered empty by the
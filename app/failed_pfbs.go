@@ -0,0 +1,161 @@
+package app
+
+import (
+	"fmt"
+
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// failedPFB is a PFB of the current block that failed the checks of the ante
+// handler in DeliverTx, so it didn't pay its fee.
+type failedPFB struct {
+	tx        sdk.Tx
+	txHash    string
+	codespace string
+	code      uint32
+}
+
+// observeFailedPFB records a PFB that failed the checks of the ante handler
+// in DeliverTx, so that it's charged its fee at the end of the block, and
+// returns res with the code of ErrFailedPFBCharged and the error of the ante
+// handler in its log.
+//
+// ProcessProposal runs the ante handler on every tx of a block without their
+// messages, so a PFB in a valid square can still fail the ante handler in
+// DeliverTx, e.g. if a previous tx of the block spent the funds of its fee
+// payer. Its blobs take up space in the square all the same, so from app
+// version 4 it pays for them. A PFB whose messages failed already paid its fee
+// in the ante handler, so res is returned unchanged.
+func (app *App) observeFailedPFB(rawTx []byte, res abci.ResponseDeliverTx) abci.ResponseDeliverTx {
+	if res.IsOK() || app.AppVersion() < v4 || feeDeducted(res.Events) {
+		return res
+	}
+	sdkTx, err := app.txConfig.TxDecoder()(rawTx)
+	if err != nil {
+		return res
+	}
+	if _, ok := hasPFB(sdkTx.GetMsgs()); !ok {
+		return res
+	}
+
+	app.failedPFBs = append(app.failedPFBs, failedPFB{
+		tx:        sdkTx,
+		txHash:    fmt.Sprintf("%X", tmtypes.Tx(rawTx).Hash()),
+		codespace: res.Codespace,
+		code:      res.Code,
+	})
+	res.Log = fmt.Sprintf("%s: %s", blobtypes.ErrFailedPFBCharged.Error(), res.Log)
+	res.Codespace = blobtypes.ErrFailedPFBCharged.Codespace()
+	res.Code = blobtypes.ErrFailedPFBCharged.ABCICode()
+	return res
+}
+
+// feeDeducted returns whether events contain the event of the fee deduction
+// of the ante handler, which is only part of the events of a failed tx if the
+// ante handler passed.
+func feeDeducted(events []abci.Event) bool {
+	for _, event := range events {
+		if event.Type != sdk.EventTypeTx {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == sdk.AttributeKeyFee {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// chargeFailedPFBs charges the failed PFBs of the current block and returns
+// their events, see chargeFailedPFB. A PFB that can't be charged is logged
+// and skipped, which all nodes do alike.
+func (app *App) chargeFailedPFBs(ctx sdk.Context) []abci.Event {
+	eventManager := sdk.NewEventManager()
+	for _, pfb := range app.failedPFBs {
+		cacheCtx, write := ctx.WithEventManager(eventManager).CacheContext()
+		if err := app.chargeFailedPFB(cacheCtx, pfb); err != nil {
+			app.Logger().Error("failed to charge failed PFB", "height", ctx.BlockHeight(), "tx", pfb.txHash, "err", err)
+			continue
+		}
+		write()
+	}
+	return eventManager.ABCIEvents()
+}
+
+// chargeFailedPFB settles a failed PFB as though it had passed the checks of
+// the ante handler: it pays its fee, or as much of it as the charged account
+// can spend, to the fee collector, and the sequences of its signers are moved
+// past the sequences of its signatures so that it can't be included again.
+// Like the DeductFeeDecorator, the fee is charged to the fee granter of the
+// PFB if its grant to the fee payer allows it, otherwise to the fee payer.
+func (app *App) chargeFailedPFB(ctx sdk.Context, pfb failedPFB) error {
+	feeTx, ok := pfb.tx.(sdk.FeeTx)
+	if !ok {
+		return fmt.Errorf("tx %T is not a fee tx", pfb.tx)
+	}
+	sigTx, ok := pfb.tx.(signing.SigVerifiableTx)
+	if !ok {
+		return fmt.Errorf("tx %T is not a signed tx", pfb.tx)
+	}
+
+	feePayer := feeTx.FeePayer()
+	if granter := feeTx.FeeGranter(); granter != nil && !granter.Equals(feePayer) && app.useGrantedFees(ctx, granter, feePayer, feeTx) {
+		feePayer = granter
+	}
+	fee := feeTx.GetFee().Min(app.BankKeeper.SpendableCoins(ctx, feePayer))
+	if !fee.IsZero() {
+		if err := app.BankKeeper.SendCoinsFromAccountToModule(ctx, feePayer, authtypes.FeeCollectorName, fee); err != nil {
+			return err
+		}
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+	for i, signer := range sigTx.GetSigners() {
+		if i >= len(sigs) {
+			break
+		}
+		acc := app.AccountKeeper.GetAccount(ctx, signer)
+		if acc == nil || acc.GetSequence() > sigs[i].Sequence {
+			continue
+		}
+		if err := acc.SetSequence(sigs[i].Sequence + 1); err != nil {
+			return err
+		}
+		app.AccountKeeper.SetAccount(ctx, acc)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&blobtypes.EventFailedPayForBlobs{
+		TxHash:    pfb.txHash,
+		FeePayer:  feePayer.String(),
+		Fee:       fee.String(),
+		Codespace: pfb.codespace,
+		Code:      pfb.code,
+	})
+}
+
+// useGrantedFees uses the allowance that granter granted to grantee for the
+// fee of tx, or as much of it as granter can spend, and returns whether the
+// allowance covers it. The allowance is left unchanged if it doesn't, so that
+// the fee payer is charged instead and a PFB can't avoid its fee by naming a
+// fee granter that doesn't pay for it.
+func (app *App) useGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, tx sdk.FeeTx) bool {
+	fee := tx.GetFee().Min(app.BankKeeper.SpendableCoins(ctx, granter))
+	if fee.IsZero() {
+		return false
+	}
+	grantCtx, write := ctx.CacheContext()
+	if err := app.FeeGrantKeeper.UseGrantedFees(grantCtx, granter, grantee, fee, tx.GetMsgs()); err != nil {
+		return false
+	}
+	write()
+	return true
+}
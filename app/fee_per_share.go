@@ -0,0 +1,38 @@
+package app
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// orderBlobTxsByFeePerShare reorders the blob txs of txs so that under
+// contention the square builder selects them greedily by the fee that they pay
+// per share of the square rather than by the gas price that the mempool
+// orders them by. The gas of a PFB has a fixed part, so ordering by gas price
+// favours large blobs over small ones that pay more for each share that they
+// take up. Filling the square by fee per share maximizes the fees of the
+// proposer and keeps small PFBs from being starved by huge cheap blobs.
+//
+// Txs with equal fees per share keep their priority order, and the txs of
+// every signer stay in sequence order, see reorderBlobTxs. If all blob txs fit
+// in a square of maxSquareSize, there's no contention and txs are returned
+// unchanged.
+func orderBlobTxsByFeePerShare(decoder sdk.TxDecoder, txs [][]byte, maxSquareSize int) [][]byte {
+	blobTxs, totalShares, ok := parseBlobTxs(decoder, txs)
+	if !ok {
+		return txs
+	}
+	if len(blobTxs) == 0 || totalShares <= maxSquareSize*maxSquareSize {
+		return txs
+	}
+
+	ordered := append([]fairBlobTx(nil), blobTxs...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		// a.fee / a.shares > b.fee / b.shares without the rounding of the
+		// division. Every blob takes up at least one share.
+		a, b := ordered[i], ordered[j]
+		return a.fee.MulRaw(int64(b.shares)).GT(b.fee.MulRaw(int64(a.shares)))
+	})
+	return reorderBlobTxs(txs, blobTxs, ordered)
+}
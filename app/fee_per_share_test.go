@@ -0,0 +1,79 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderBlobTxsByFeePerShare(t *testing.T) {
+	enc := encoding.MakeConfig(ModuleEncodingRegisters...)
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+
+	// newBlobTx returns a blob tx with a blob of the given number of shares.
+	newBlobTx := func(shares int, fee int64, signers ...blobTxSigner) []byte {
+		size := share.FirstSparseShareContentSize + (shares-1)*share.ContinuationSparseShareContentSize
+		return newSignedBlobTx(t, enc.TxConfig, ns, size, fee, signers...)
+	}
+	normalTx := []byte("normal tx")
+
+	t.Run("no contention", func(t *testing.T) {
+		txs := [][]byte{normalTx, newBlobTx(3, 300, blobTxSigner{account: 1}), newBlobTx(1, 1000, blobTxSigner{account: 2})}
+		assert.Equal(t, txs, orderBlobTxsByFeePerShare(enc.TxConfig.TxDecoder(), txs, 2))
+	})
+
+	t.Run("orders by fee per share", func(t *testing.T) {
+		// the huge blob pays the highest fee but the lowest fee per share.
+		huge := newBlobTx(4, 1000, blobTxSigner{account: 1})
+		small := newBlobTx(1, 500, blobTxSigner{account: 2})
+		medium := newBlobTx(2, 600, blobTxSigner{account: 3})
+		txs := [][]byte{normalTx, huge, small, medium}
+		got := orderBlobTxsByFeePerShare(enc.TxConfig.TxDecoder(), txs, 2)
+		assert.Equal(t, [][]byte{normalTx, small, medium, huge}, got)
+	})
+
+	t.Run("keeps the priority order of equal fees per share", func(t *testing.T) {
+		first := newBlobTx(2, 200, blobTxSigner{account: 1})
+		second := newBlobTx(1, 100, blobTxSigner{account: 2})
+		third := newBlobTx(2, 200, blobTxSigner{account: 3})
+		txs := [][]byte{first, second, third}
+		assert.Equal(t, txs, orderBlobTxsByFeePerShare(enc.TxConfig.TxDecoder(), txs, 2))
+	})
+
+	t.Run("keeps the sequence order of the txs of a signer", func(t *testing.T) {
+		// the second tx of signer 1 pays the most per share so its first tx
+		// is ordered before it.
+		txs := [][]byte{
+			newBlobTx(4, 100, blobTxSigner{account: 1, sequence: 0}),
+			newBlobTx(1, 200, blobTxSigner{account: 2, sequence: 0}),
+			newBlobTx(1, 1000, blobTxSigner{account: 1, sequence: 1}),
+		}
+		got := orderBlobTxsByFeePerShare(enc.TxConfig.TxDecoder(), txs, 2)
+		assert.Equal(t, [][]byte{txs[0], txs[2], txs[1]}, got)
+	})
+
+	t.Run("keeps the sequence order of every signer of a tx", func(t *testing.T) {
+		// the last tx is paid for by signer 3 but also signed by signer 1
+		// after its first tx.
+		txs := [][]byte{
+			newBlobTx(4, 100, blobTxSigner{account: 1, sequence: 0}),
+			newBlobTx(1, 200, blobTxSigner{account: 2, sequence: 0}),
+			newBlobTx(1, 1000, blobTxSigner{account: 1, sequence: 1}, blobTxSigner{account: 3, sequence: 0}),
+		}
+		got := orderBlobTxsByFeePerShare(enc.TxConfig.TxDecoder(), txs, 2)
+		assert.Equal(t, [][]byte{txs[0], txs[2], txs[1]}, got)
+	})
+
+	t.Run("orders the txs of a signer by sequence", func(t *testing.T) {
+		txs := [][]byte{
+			newBlobTx(1, 1000, blobTxSigner{account: 1, sequence: 1}),
+			newBlobTx(4, 100, blobTxSigner{account: 1, sequence: 0}),
+			newBlobTx(1, 200, blobTxSigner{account: 2, sequence: 0}),
+		}
+		got := orderBlobTxsByFeePerShare(enc.TxConfig.TxDecoder(), txs, 2)
+		assert.Equal(t, [][]byte{txs[1], txs[0], txs[2]}, got)
+	})
+}
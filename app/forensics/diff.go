@@ -0,0 +1,163 @@
+package forensics
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Diff returns a human readable description of every difference between two
+// dumps of the same height. It returns nil if the dumps are equal.
+func Diff(a, b Dump) []string {
+	var diffs []string
+	if a.Height != b.Height {
+		return []string{fmt.Sprintf("height: %d != %d", a.Height, b.Height)}
+	}
+	if a.AppVersion != b.AppVersion {
+		diffs = append(diffs, fmt.Sprintf("app version: %d != %d", a.AppVersion, b.AppVersion))
+	}
+	if !bytes.Equal(a.LastAppHash, b.LastAppHash) {
+		diffs = append(diffs, fmt.Sprintf("last app hash: %s != %s", a.LastAppHash, b.LastAppHash))
+	}
+	if !bytes.Equal(a.AppHash, b.AppHash) {
+		diffs = append(diffs, fmt.Sprintf("app hash: %s != %s", a.AppHash, b.AppHash))
+	}
+	if !bytes.Equal(a.DataHash, b.DataHash) {
+		diffs = append(diffs, fmt.Sprintf("data hash: %s != %s", a.DataHash, b.DataHash))
+	}
+	diffs = append(diffs, diffSquareLayouts(a.Square, b.Square)...)
+	diffs = append(diffs, diffTxResults(a.TxResults, b.TxResults)...)
+	diffs = append(diffs, diffWrites(a.Writes, b.Writes)...)
+	return diffs
+}
+
+// DiffDirs compares the dumps of the heights present in both dirA and dirB and
+// returns the first height at which they differ together with the
+// differences. It returns a zero height if no differences were found.
+func DiffDirs(dirA, dirB string) (int64, []string, error) {
+	heightsA, err := Heights(dirA)
+	if err != nil {
+		return 0, nil, err
+	}
+	heightsB, err := Heights(dirB)
+	if err != nil {
+		return 0, nil, err
+	}
+	inB := make(map[int64]bool, len(heightsB))
+	for _, height := range heightsB {
+		inB[height] = true
+	}
+
+	common := 0
+	for _, height := range heightsA {
+		if !inB[height] {
+			continue
+		}
+		common++
+		a, err := ReadDump(dirA, height)
+		if err != nil {
+			return 0, nil, err
+		}
+		b, err := ReadDump(dirB, height)
+		if err != nil {
+			return 0, nil, err
+		}
+		if diffs := Diff(a, b); len(diffs) > 0 {
+			return height, diffs, nil
+		}
+	}
+	if common == 0 {
+		return 0, nil, fmt.Errorf("no common heights found in %s and %s", dirA, dirB)
+	}
+	return 0, nil, nil
+}
+
+func diffSquareLayouts(a, b SquareLayout) []string {
+	var diffs []string
+	if a.Size != b.Size {
+		diffs = append(diffs, fmt.Sprintf("square size: %d != %d", a.Size, b.Size))
+	}
+	if a.Error != b.Error {
+		diffs = append(diffs, fmt.Sprintf("square error: %q != %q", a.Error, b.Error))
+	}
+	if len(a.Namespaces) != len(b.Namespaces) {
+		diffs = append(diffs, fmt.Sprintf("square namespace ranges: %d != %d", len(a.Namespaces), len(b.Namespaces)))
+	}
+	for i := 0; i < min(len(a.Namespaces), len(b.Namespaces)); i++ {
+		nsA, nsB := a.Namespaces[i], b.Namespaces[i]
+		if !bytes.Equal(nsA.Namespace, nsB.Namespace) || nsA.Start != nsB.Start || nsA.End != nsB.End {
+			diffs = append(diffs, fmt.Sprintf("square namespace range %d: %s [%d, %d) != %s [%d, %d)",
+				i, nsA.Namespace, nsA.Start, nsA.End, nsB.Namespace, nsB.Start, nsB.End))
+		}
+	}
+	return diffs
+}
+
+func diffTxResults(a, b []TxResult) []string {
+	var diffs []string
+	if len(a) != len(b) {
+		diffs = append(diffs, fmt.Sprintf("tx results: %d != %d", len(a), len(b)))
+	}
+	for i := 0; i < min(len(a), len(b)); i++ {
+		txA, txB := a[i], b[i]
+		if !bytes.Equal(txA.Hash, txB.Hash) {
+			diffs = append(diffs, fmt.Sprintf("tx %d hash: %s != %s", i, txA.Hash, txB.Hash))
+			continue
+		}
+		if txA.Code != txB.Code || txA.Codespace != txB.Codespace {
+			diffs = append(diffs, fmt.Sprintf("tx %d (%s) code: %s/%d != %s/%d", i, txA.Hash, txA.Codespace, txA.Code, txB.Codespace, txB.Code))
+		}
+		if txA.GasWanted != txB.GasWanted || txA.GasUsed != txB.GasUsed {
+			diffs = append(diffs, fmt.Sprintf("tx %d (%s) gas: %d/%d != %d/%d", i, txA.Hash, txA.GasUsed, txA.GasWanted, txB.GasUsed, txB.GasWanted))
+		}
+		if txA.Log != txB.Log {
+			diffs = append(diffs, fmt.Sprintf("tx %d (%s) log: %q != %q", i, txA.Hash, txA.Log, txB.Log))
+		}
+	}
+	return diffs
+}
+
+// diffWrites compares the final write of every store key. Keys are written at
+// most once per commit so the order of the writes is not compared.
+func diffWrites(a, b []StoreWrite) []string {
+	writesB := make(map[string]StoreWrite, len(b))
+	for _, write := range b {
+		writesB[writeID(write)] = write
+	}
+
+	var diffs []string
+	seen := make(map[string]bool, len(a))
+	for _, writeA := range a {
+		id := writeID(writeA)
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		writeB, ok := writesB[id]
+		switch {
+		case !ok:
+			diffs = append(diffs, fmt.Sprintf("write %s only in first dump: %s", id, describeWrite(writeA)))
+		case writeA.Delete != writeB.Delete || !bytes.Equal(writeA.Value, writeB.Value):
+			diffs = append(diffs, fmt.Sprintf("write %s: %s != %s", id, describeWrite(writeA), describeWrite(writeB)))
+		}
+	}
+	for _, writeB := range b {
+		id := writeID(writeB)
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		diffs = append(diffs, fmt.Sprintf("write %s only in second dump: %s", id, describeWrite(writeB)))
+	}
+	return diffs
+}
+
+func writeID(write StoreWrite) string {
+	return write.Store + "/" + write.Key.String()
+}
+
+func describeWrite(write StoreWrite) string {
+	if write.Delete {
+		return "delete"
+	}
+	return "set " + write.Value.String()
+}
@@ -0,0 +1,119 @@
+package forensics
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+const dumpExtension = ".json"
+
+// Dump is the forensic record of the execution of a single block. Comparing
+// the dumps of two nodes that disagree on the app hash for a height points to
+// the store writes and tx results that caused the divergence.
+type Dump struct {
+	Height     int64  `json:"height"`
+	AppVersion uint64 `json:"app_version"`
+	// LastAppHash is the app hash of the previous height as committed to in
+	// the block header.
+	LastAppHash tmbytes.HexBytes `json:"last_app_hash"`
+	// AppHash is the app hash computed by this node after executing the block.
+	AppHash   tmbytes.HexBytes `json:"app_hash"`
+	DataHash  tmbytes.HexBytes `json:"data_hash"`
+	Square    SquareLayout     `json:"square"`
+	TxResults []TxResult       `json:"tx_results"`
+	// Writes are the writes to the commit multistore in the order they were
+	// flushed at commit.
+	Writes []StoreWrite `json:"writes"`
+}
+
+// SquareLayout describes how the block txs were laid out in the original data
+// square.
+type SquareLayout struct {
+	Size int `json:"size"`
+	// Namespaces are the contiguous ranges of shares that belong to the same
+	// namespace, in share order.
+	Namespaces []NamespaceRange `json:"namespaces"`
+	// Error is set if the square could not be constructed from the txs.
+	Error string `json:"error,omitempty"`
+}
+
+// NamespaceRange is the end exclusive range of shares of a namespace.
+type NamespaceRange struct {
+	Namespace tmbytes.HexBytes `json:"namespace"`
+	Start     int              `json:"start"`
+	End       int              `json:"end"`
+}
+
+// TxResult is the execution result of a tx in DeliverTx.
+type TxResult struct {
+	Hash      tmbytes.HexBytes `json:"hash"`
+	Code      uint32           `json:"code"`
+	Codespace string           `json:"codespace,omitempty"`
+	GasWanted int64            `json:"gas_wanted"`
+	GasUsed   int64            `json:"gas_used"`
+	Log       string           `json:"log,omitempty"`
+}
+
+// StoreWrite is a single set or delete of a key in a store.
+type StoreWrite struct {
+	Store  string           `json:"store"`
+	Key    tmbytes.HexBytes `json:"key"`
+	Value  tmbytes.HexBytes `json:"value,omitempty"`
+	Delete bool             `json:"delete,omitempty"`
+}
+
+// DumpPath returns the path of the dump of height in dir.
+func DumpPath(dir string, height int64) string {
+	return filepath.Join(dir, strconv.FormatInt(height, 10)+dumpExtension)
+}
+
+// WriteDump writes the dump to its path in dir.
+func WriteDump(dir string, dump Dump) error {
+	bz, err := json.MarshalIndent(dump, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(DumpPath(dir, dump.Height), bz, 0o644)
+}
+
+// ReadDump reads the dump of height from dir.
+func ReadDump(dir string, height int64) (Dump, error) {
+	bz, err := os.ReadFile(DumpPath(dir, height))
+	if err != nil {
+		return Dump{}, err
+	}
+	var dump Dump
+	if err := json.Unmarshal(bz, &dump); err != nil {
+		return Dump{}, fmt.Errorf("decoding dump of height %d: %w", height, err)
+	}
+	return dump, nil
+}
+
+// Heights returns the heights of the dumps in dir in ascending order.
+func Heights(dir string) ([]int64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	heights := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), dumpExtension)
+		if entry.IsDir() || !ok {
+			continue
+		}
+		height, err := strconv.ParseInt(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights, nil
+}
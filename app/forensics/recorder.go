@@ -0,0 +1,200 @@
+package forensics
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"sync"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/streaming"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+const (
+	// FlagDir is the flag to specify the directory that forensic dumps are
+	// written to. Recording is disabled if the flag is empty.
+	FlagDir = "forensics-dir"
+	// FlagRetainHeights is the flag to specify the number of most recent
+	// heights for which dumps are kept.
+	FlagRetainHeights = "forensics-retain-heights"
+
+	// DefaultRetainHeights is the default number of dumps that are kept.
+	DefaultRetainHeights = 100
+)
+
+var _ baseapp.StreamingService = (*Recorder)(nil)
+
+// errNoBlockData is recorded as the square layout error of blocks that were
+// not processed as a proposal, e.g. blocks applied during block sync.
+const errNoBlockData = "block data is only available for blocks processed as a proposal"
+
+// Recorder is a streaming service that records the store writes, square
+// layout and tx results of every committed block and writes them to a dump in
+// a forensic directory. Tendermint detects an app hash mismatch when
+// validating the block after the one that diverged so by the time a node
+// halts, the dump of the divergent height is already on disk. Dumps that
+// fail to be written are logged and skipped rather than halting the node
+// before the divergent height is reached.
+type Recorder struct {
+	streaming.BaseService
+
+	dir           string
+	retainHeights int64
+	keys          []storetypes.StoreKey
+	logger        log.Logger
+
+	current Dump
+	txs     [][]byte
+
+	mtx sync.Mutex
+	// proposals are the txs of the accepted proposals of the next height by
+	// data hash.
+	proposals map[string][][]byte
+}
+
+// NewRecorder returns a recorder that writes dumps to dir and keeps the dumps
+// of the latest retainHeights heights. All dumps are kept if retainHeights is
+// zero.
+func NewRecorder(dir string, retainHeights int64, keys map[string]*storetypes.KVStoreKey, logger log.Logger) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	storeKeys := make([]storetypes.StoreKey, 0, len(keys))
+	for _, key := range keys {
+		storeKeys = append(storeKeys, key)
+	}
+	return &Recorder{
+		dir:           dir,
+		retainHeights: retainHeights,
+		keys:          storeKeys,
+		logger:        logger.With("module", "forensics"),
+		proposals:     make(map[string][][]byte),
+	}, nil
+}
+
+// Listeners implements baseapp.StreamingService.
+func (r *Recorder) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	listeners := make(map[storetypes.StoreKey][]storetypes.WriteListener, len(r.keys))
+	for _, key := range r.keys {
+		listeners[key] = []storetypes.WriteListener{r}
+	}
+	return listeners
+}
+
+// OnWrite implements storetypes.WriteListener.
+func (r *Recorder) OnWrite(storeKey storetypes.StoreKey, key []byte, value []byte, delete bool) error {
+	r.current.Writes = append(r.current.Writes, StoreWrite{
+		Store:  storeKey.Name(),
+		Key:    append([]byte(nil), key...),
+		Value:  append([]byte(nil), value...),
+		Delete: delete,
+	})
+	return nil
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (r *Recorder) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	// writes of InitChain are flushed together with the first block so they
+	// must not be discarded here.
+	r.current = Dump{
+		Height:      req.Header.Height,
+		AppVersion:  req.Header.Version.App,
+		LastAppHash: req.Header.AppHash,
+		DataHash:    req.Header.DataHash,
+		Writes:      r.current.Writes,
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.txs = r.proposals[string(req.Header.DataHash)]
+	r.proposals = make(map[string][][]byte)
+	return nil
+}
+
+// ListenProcessProposal implements the ProposalListener interface of the app. The blobs of blob txs
+// are stripped before DeliverTx so the square layout is constructed from the
+// txs of the accepted proposal.
+func (r *Recorder) ListenProcessProposal(header tmproto.Header, txs [][]byte) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.proposals[string(header.DataHash)] = txs
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (r *Recorder) ListenDeliverTx(_ context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	r.current.TxResults = append(r.current.TxResults, TxResult{
+		Hash:      tmhash.Sum(req.Tx),
+		Code:      res.Code,
+		Codespace: res.Codespace,
+		GasWanted: res.GasWanted,
+		GasUsed:   res.GasUsed,
+		Log:       res.Log,
+	})
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener. It writes the dump of the
+// committed height and prunes the dumps that are no longer retained.
+func (r *Recorder) ListenCommit(_ context.Context, res abci.ResponseCommit) error {
+	dump := r.current
+	dump.AppHash = res.Data
+	if r.txs != nil || len(dump.TxResults) == 0 {
+		dump.Square = squareLayout(r.txs, dump.AppVersion)
+	} else {
+		dump.Square = SquareLayout{Error: errNoBlockData}
+	}
+	r.current = Dump{}
+	r.txs = nil
+
+	if err := WriteDump(r.dir, dump); err != nil {
+		r.logger.Error("failed to write forensic dump", "height", dump.Height, "err", err)
+		return nil
+	}
+	if r.retainHeights > 0 {
+		r.prune(dump.Height - r.retainHeights)
+	}
+	return nil
+}
+
+// prune removes the dumps of all heights up to and including height.
+func (r *Recorder) prune(height int64) {
+	heights, err := Heights(r.dir)
+	if err != nil {
+		r.logger.Error("failed to list forensic dumps", "err", err)
+		return
+	}
+	for _, h := range heights {
+		if h > height {
+			break
+		}
+		if err := os.Remove(DumpPath(r.dir, h)); err != nil {
+			r.logger.Error("failed to prune forensic dump", "height", h, "err", err)
+		}
+	}
+}
+
+// squareLayout constructs the data square from the block txs and returns the
+// ranges of shares occupied by each namespace.
+func squareLayout(txs [][]byte, appVersion uint64) SquareLayout {
+	dataSquare, err := square.Construct(txs, appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion))
+	if err != nil {
+		return SquareLayout{Error: err.Error()}
+	}
+	layout := SquareLayout{Size: dataSquare.Size()}
+	for i, sh := range dataSquare {
+		ns := sh.Namespace().Bytes()
+		if last := len(layout.Namespaces) - 1; last >= 0 && bytes.Equal(layout.Namespaces[last].Namespace, ns) {
+			layout.Namespaces[last].End = i + 1
+			continue
+		}
+		layout.Namespaces = append(layout.Namespaces, NamespaceRange{Namespace: ns, Start: i, End: i + 1})
+	}
+	return layout
+}
@@ -0,0 +1,107 @@
+package forensics_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app/forensics"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+var bankKey = storetypes.NewKVStoreKey("bank")
+
+func newRecorder(t *testing.T, dir string, retainHeights int64) *forensics.Recorder {
+	recorder, err := forensics.NewRecorder(dir, retainHeights, map[string]*storetypes.KVStoreKey{"bank": bankKey}, log.NewNopLogger())
+	require.NoError(t, err)
+	return recorder
+}
+
+// commitBlock simulates the execution of a block with a single tx that writes
+// value to key.
+func commitBlock(t *testing.T, recorder *forensics.Recorder, height int64, key, value []byte) {
+	ctx := context.Background()
+	header := tmproto.Header{Height: height, Version: tmversion.Consensus{App: appconsts.LatestVersion}}
+	recorder.ListenProcessProposal(header, [][]byte{[]byte("tx")})
+	require.NoError(t, recorder.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: header}, abci.ResponseBeginBlock{}))
+	require.NoError(t, recorder.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx")}, abci.ResponseDeliverTx{GasUsed: 10}))
+	require.NoError(t, recorder.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+	require.NoError(t, recorder.OnWrite(bankKey, key, value, false))
+	require.NoError(t, recorder.ListenCommit(ctx, abci.ResponseCommit{Data: value}))
+}
+
+func TestRecorder(t *testing.T) {
+	dir := t.TempDir()
+	recorder := newRecorder(t, dir, 2)
+	require.Contains(t, recorder.Listeners(), storetypes.StoreKey(bankKey))
+
+	for height := int64(1); height <= 4; height++ {
+		commitBlock(t, recorder, height, []byte("key"), []byte{byte(height)})
+	}
+
+	heights, err := forensics.Heights(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 4}, heights)
+
+	dump, err := forensics.ReadDump(dir, 4)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), dump.Height)
+	assert.Equal(t, []byte{4}, []byte(dump.AppHash))
+	require.Len(t, dump.TxResults, 1)
+	assert.Equal(t, int64(10), dump.TxResults[0].GasUsed)
+	require.Len(t, dump.Writes, 1)
+	assert.Equal(t, forensics.StoreWrite{Store: "bank", Key: []byte("key"), Value: []byte{4}}, dump.Writes[0])
+	assert.Empty(t, dump.Square.Error)
+	assert.Equal(t, 1, dump.Square.Size)
+	require.Len(t, dump.Square.Namespaces, 1)
+}
+
+func TestRecorderWithoutProposal(t *testing.T) {
+	dir := t.TempDir()
+	recorder := newRecorder(t, dir, 0)
+
+	ctx := context.Background()
+	header := tmproto.Header{Height: 1, Version: tmversion.Consensus{App: appconsts.LatestVersion}}
+	require.NoError(t, recorder.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: header}, abci.ResponseBeginBlock{}))
+	require.NoError(t, recorder.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx")}, abci.ResponseDeliverTx{}))
+	require.NoError(t, recorder.ListenCommit(ctx, abci.ResponseCommit{}))
+
+	dump, err := forensics.ReadDump(dir, 1)
+	require.NoError(t, err)
+	assert.NotEmpty(t, dump.Square.Error)
+	assert.Zero(t, dump.Square.Size)
+}
+
+func TestDiffDirs(t *testing.T) {
+	dirA, dirB := t.TempDir(), t.TempDir()
+	recorderA, recorderB := newRecorder(t, dirA, 0), newRecorder(t, dirB, 0)
+
+	commitBlock(t, recorderA, 1, []byte("key"), []byte{1})
+	commitBlock(t, recorderB, 1, []byte("key"), []byte{1})
+
+	height, diffs, err := forensics.DiffDirs(dirA, dirB)
+	require.NoError(t, err)
+	assert.Zero(t, height)
+	assert.Empty(t, diffs)
+
+	commitBlock(t, recorderA, 2, []byte("key"), []byte{2})
+	commitBlock(t, recorderB, 2, []byte("other"), []byte{3})
+
+	height, diffs, err = forensics.DiffDirs(dirA, dirB)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), height)
+	assert.Equal(t, []string{
+		"app hash: 02 != 03",
+		"write bank/6B6579 only in first dump: set 02",
+		"write bank/6F74686572 only in second dump: set 03",
+	}, diffs)
+
+	_, _, err = forensics.DiffDirs(dirA, t.TempDir())
+	require.Error(t, err)
+}
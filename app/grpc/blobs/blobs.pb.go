@@ -0,0 +1,669 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/blobs/blobs.proto
+
+package blobs
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribeRequest is the request type for the Subscribe gRPC method.
+type SubscribeRequest struct {
+	// namespace is the namespace of the blobs. The blobs of all namespaces are
+	// streamed if it is empty.
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc9da19f51dc8709, []int{0}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+// SubscribeResponse is the response type for the Subscribe gRPC method.
+type SubscribeResponse struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// blobs are the blobs of the namespace in the block in the order of the
+	// data square.
+	Blobs []types.EventBlob `protobuf:"bytes,2,rep,name=blobs,proto3" json:"blobs"`
+}
+
+func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
+func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeResponse) ProtoMessage()    {}
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc9da19f51dc8709, []int{1}
+}
+func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeResponse.Merge(m, src)
+}
+func (m *SubscribeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeResponse proto.InternalMessageInfo
+
+func (m *SubscribeResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SubscribeResponse) GetBlobs() []types.EventBlob {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "celestia.core.v1.blobs.SubscribeRequest")
+	proto.RegisterType((*SubscribeResponse)(nil), "celestia.core.v1.blobs.SubscribeResponse")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/blobs/blobs.proto", fileDescriptor_fc9da19f51dc8709)
+}
+
+var fileDescriptor_fc9da19f51dc8709 = []byte{
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xe3, 0xbf, 0x7f, 0x2b, 0xd5, 0x30, 0x80, 0x85, 0xaa, 0xaa, 0x54, 0xa6, 0xea, 0x54,
+	0x06, 0xec, 0xb6, 0x0c, 0xec, 0x95, 0xd8, 0x98, 0xc2, 0xc6, 0x16, 0x9b, 0x2b, 0x37, 0xa2, 0x8d,
+	0x4d, 0xec, 0xe4, 0x39, 0x78, 0xac, 0x8e, 0x1d, 0x99, 0x10, 0x4a, 0x5e, 0x04, 0x39, 0xa6, 0x01,
+	0x21, 0x06, 0x86, 0x44, 0xd7, 0xe7, 0x7e, 0x3e, 0x3e, 0xd7, 0xc6, 0x53, 0x09, 0x1b, 0xb0, 0x2e,
+	0x4d, 0xb8, 0xd4, 0x39, 0xf0, 0x72, 0xc1, 0xc5, 0x46, 0x0b, 0x1b, 0xfe, 0xcc, 0xe4, 0xda, 0x69,
+	0x32, 0x38, 0x30, 0xcc, 0x33, 0xac, 0x5c, 0xb0, 0xa6, 0x3b, 0x3a, 0x53, 0x5a, 0xe9, 0x06, 0xe1,
+	0xbe, 0x0a, 0xf4, 0x68, 0xdc, 0x3a, 0x7a, 0xca, 0x3b, 0x42, 0x09, 0x99, 0x0b, 0xdd, 0xe9, 0x1c,
+	0x9f, 0xdc, 0x17, 0xc2, 0xca, 0x3c, 0x15, 0x10, 0xc3, 0x73, 0x01, 0xd6, 0x91, 0x31, 0xee, 0x67,
+	0xc9, 0x16, 0xac, 0x49, 0x24, 0x0c, 0xd1, 0x04, 0xcd, 0x8e, 0xe3, 0x2f, 0x61, 0xfa, 0x88, 0x4f,
+	0xbf, 0xed, 0xb0, 0x46, 0x67, 0x16, 0xc8, 0x00, 0xf7, 0xd6, 0x90, 0xaa, 0xb5, 0x6b, 0xf8, 0x4e,
+	0xfc, 0xb9, 0x22, 0x37, 0xb8, 0xdb, 0x64, 0x1b, 0xfe, 0x9b, 0x74, 0x66, 0x47, 0xcb, 0x73, 0xd6,
+	0x46, 0xf7, 0xb2, 0x8f, 0x7e, 0xeb, 0xc3, 0xac, 0x36, 0x5a, 0xac, 0xfe, 0xef, 0xde, 0x2e, 0xa2,
+	0x38, 0xf0, 0xcb, 0x27, 0xdc, 0xf5, 0xa2, 0x25, 0x02, 0xf7, 0xdb, 0xe3, 0xc8, 0x8c, 0xfd, 0x3e,
+	0x3a, 0xfb, 0x39, 0xc3, 0xe8, 0xf2, 0x0f, 0x64, 0xc8, 0x3e, 0x47, 0xab, 0xbb, 0x5d, 0x45, 0xd1,
+	0xbe, 0xa2, 0xe8, 0xbd, 0xa2, 0xe8, 0xa5, 0xa6, 0xd1, 0xbe, 0xa6, 0xd1, 0x6b, 0x4d, 0xa3, 0x87,
+	0xa5, 0x4a, 0xdd, 0xba, 0x10, 0x4c, 0xea, 0x2d, 0x3f, 0x18, 0xea, 0x5c, 0xb5, 0xf5, 0x55, 0x62,
+	0x0c, 0xf7, 0x9f, 0xca, 0x8d, 0x0c, 0x8f, 0x24, 0x7a, 0xcd, 0xcd, 0x5e, 0x7f, 0x0c, 0x00, 0x95,
+	0x51, 0xef, 0xac, 0xcb, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BlobsClient is the client API for Blobs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlobsClient interface {
+	// Subscribe streams the blobs of a namespace of every new block that
+	// includes blobs of it. The blobs are located in the data square of the
+	// block, so they can be fetched without reconstructing the square.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Blobs_SubscribeClient, error)
+}
+
+type blobsClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewBlobsClient(cc grpc1.ClientConn) BlobsClient {
+	return &blobsClient{cc}
+}
+
+func (c *blobsClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Blobs_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Blobs_serviceDesc.Streams[0], "/celestia.core.v1.blobs.Blobs/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blobsSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Blobs_SubscribeClient interface {
+	Recv() (*SubscribeResponse, error)
+	grpc.ClientStream
+}
+
+type blobsSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *blobsSubscribeClient) Recv() (*SubscribeResponse, error) {
+	m := new(SubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BlobsServer is the server API for Blobs service.
+type BlobsServer interface {
+	// Subscribe streams the blobs of a namespace of every new block that
+	// includes blobs of it. The blobs are located in the data square of the
+	// block, so they can be fetched without reconstructing the square.
+	Subscribe(*SubscribeRequest, Blobs_SubscribeServer) error
+}
+
+// UnimplementedBlobsServer can be embedded to have forward compatible implementations.
+type UnimplementedBlobsServer struct {
+}
+
+func (*UnimplementedBlobsServer) Subscribe(req *SubscribeRequest, srv Blobs_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterBlobsServer(s grpc1.Server, srv BlobsServer) {
+	s.RegisterService(&_Blobs_serviceDesc, srv)
+}
+
+func _Blobs_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlobsServer).Subscribe(m, &blobsSubscribeServer{stream})
+}
+
+type Blobs_SubscribeServer interface {
+	Send(*SubscribeResponse) error
+	grpc.ServerStream
+}
+
+type blobsSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *blobsSubscribeServer) Send(m *SubscribeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Blobs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.blobs.Blobs",
+	HandlerType: (*BlobsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Blobs_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "celestia/core/v1/blobs/blobs.proto",
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintBlobs(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlobs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintBlobs(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlobs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlobs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovBlobs(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscribeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlobs(uint64(m.Height))
+	}
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovBlobs(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBlobs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlobs(x uint64) (n int) {
+	return sovBlobs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, types.EventBlob{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlobs(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlobs
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlobs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlobs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlobs
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlobs
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlobs
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlobs        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlobs          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlobs = fmt.Errorf("proto: unexpected end of group")
+)
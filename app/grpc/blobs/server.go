@@ -0,0 +1,128 @@
+package blobs
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sync/atomic"
+
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// subscriptionCapacity is the number of blocks that are buffered for a
+// subscriber.
+const subscriptionCapacity = 100
+
+// RegisterBlobsService registers the blobs service on the gRPC router.
+func RegisterBlobsService(qrt gogogrpc.Server, client rpcclient.EventsClient) {
+	RegisterBlobsServer(qrt, NewBlobsServer(client))
+}
+
+var _ BlobsServer = &blobsServer{}
+
+type blobsServer struct {
+	client rpcclient.EventsClient
+	// subscriptions counts the subscriptions to name their subscribers.
+	subscriptions atomic.Uint64
+}
+
+// NewBlobsServer returns a server of the blob events of the new blocks that
+// client reports. The node only emits blob events if it is started with the
+// blob-events flag, otherwise subscriptions receive no blobs.
+func NewBlobsServer(client rpcclient.EventsClient) BlobsServer {
+	return &blobsServer{client: client}
+}
+
+// Subscribe implements the BlobsServer.Subscribe method.
+func (s *blobsServer) Subscribe(req *SubscribeRequest, stream Blobs_SubscribeServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if len(req.Namespace) != 0 {
+		if _, err := share.NewNamespaceFromBytes(req.Namespace); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid namespace: %s", err)
+		}
+	}
+	if s.client == nil {
+		return status.Error(codes.Unavailable, "the node doesn't run Tendermint")
+	}
+
+	ctx := stream.Context()
+	subscriber := fmt.Sprintf("blobs-subscription-%d", s.subscriptions.Add(1))
+	events, err := s.client.Subscribe(ctx, subscriber, Query(req.Namespace), subscriptionCapacity)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "subscribing to new blocks: %s", err)
+	}
+	defer func() {
+		_ = s.client.UnsubscribeAll(context.Background(), subscriber)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.Unavailable, "the subscription was closed by the node")
+			}
+			newBlock, ok := event.Data.(tmtypes.EventDataNewBlock)
+			if !ok {
+				continue
+			}
+			blobs, err := BlobEvents(newBlock.ResultEndBlock.Events, req.Namespace)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			if len(blobs) == 0 {
+				continue
+			}
+			if err := stream.Send(&SubscribeResponse{Height: newBlock.Block.Height, Blobs: blobs}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Query returns the Tendermint event query of the new blocks that include
+// blobs of namespace, or blobs of any namespace if namespace is empty. The
+// attributes of the blob events are JSON encoded, so the namespace is matched
+// by its base64 encoding. The query can also be used to subscribe through the
+// websocket of the Tendermint RPC.
+func Query(namespace []byte) string {
+	if len(namespace) == 0 {
+		return fmt.Sprintf("%s='%s' AND %s.namespace EXISTS", tmtypes.EventTypeKey, tmtypes.EventNewBlock, blobtypes.EventTypeBlob)
+	}
+	return fmt.Sprintf("%s='%s' AND %s.namespace CONTAINS '%s'", tmtypes.EventTypeKey, tmtypes.EventNewBlock, blobtypes.EventTypeBlob, base64.StdEncoding.EncodeToString(namespace))
+}
+
+// BlobEvents returns the blob events of namespace in events, or the blob
+// events of all namespaces if namespace is empty.
+func BlobEvents(events []abci.Event, namespace []byte) ([]blobtypes.EventBlob, error) {
+	var blobs []blobtypes.EventBlob
+	for _, event := range events {
+		if event.Type != blobtypes.EventTypeBlob {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		blob, ok := msg.(*blobtypes.EventBlob)
+		if !ok {
+			return nil, fmt.Errorf("unexpected event %T", msg)
+		}
+		if len(namespace) == 0 || bytes.Equal(blob.Namespace, namespace) {
+			blobs = append(blobs, *blob)
+		}
+	}
+	return blobs, nil
+}
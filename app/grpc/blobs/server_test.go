@@ -0,0 +1,136 @@
+package blobs_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/blobs"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestQuery(t *testing.T) {
+	namespace := share.RandomBlobNamespace().Bytes()
+	blobEvents := blockEvents(t, namespace, share.RandomBlobNamespace().Bytes())
+
+	q, err := query.New(blobs.Query(namespace))
+	require.NoError(t, err)
+	matches, err := q.Matches(blobEvents)
+	require.NoError(t, err)
+	assert.True(t, matches)
+	matches, err = q.Matches(blockEvents(t, share.RandomBlobNamespace().Bytes()))
+	require.NoError(t, err)
+	assert.False(t, matches)
+
+	q, err = query.New(blobs.Query(nil))
+	require.NoError(t, err)
+	matches, err = q.Matches(blobEvents)
+	require.NoError(t, err)
+	assert.True(t, matches)
+	matches, err = q.Matches(map[string][]string{tmtypes.EventTypeKey: {tmtypes.EventNewBlock}})
+	require.NoError(t, err)
+	assert.False(t, matches)
+}
+
+func TestSubscribe(t *testing.T) {
+	namespace := share.RandomBlobNamespace().Bytes()
+	other := share.RandomBlobNamespace().Bytes()
+	client := &eventsClient{events: make(chan coretypes.ResultEvent, 3)}
+	client.events <- newBlock(t, 1, other)
+	client.events <- newBlock(t, 2, namespace, other, namespace)
+	close(client.events)
+	server := blobs.NewBlobsServer(client)
+
+	stream := &subscribeStream{ctx: context.Background()}
+	err := server.Subscribe(&blobs.SubscribeRequest{Namespace: namespace}, stream)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, blobs.Query(namespace), client.query)
+	assert.True(t, client.unsubscribed)
+	// only the blobs of the namespace are streamed
+	require.Len(t, stream.responses, 1)
+	assert.EqualValues(t, 2, stream.responses[0].Height)
+	require.Len(t, stream.responses[0].Blobs, 2)
+	assert.EqualValues(t, 0, stream.responses[0].Blobs[0].BlobIndex)
+	assert.EqualValues(t, 2, stream.responses[0].Blobs[1].BlobIndex)
+
+	err = server.Subscribe(&blobs.SubscribeRequest{Namespace: []byte{1}}, stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = blobs.NewBlobsServer(nil).Subscribe(&blobs.SubscribeRequest{}, stream)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+// blobEvents returns a blob event for every namespace in the order of
+// namespaces.
+func blobEvents(t *testing.T, namespaces ...[]byte) []abci.Event {
+	events := make([]abci.Event, len(namespaces))
+	for i, namespace := range namespaces {
+		event, err := sdk.TypedEventToEvent(&blobtypes.EventBlob{BlobIndex: uint32(i), Namespace: namespace})
+		require.NoError(t, err)
+		events[i] = abci.Event(event)
+	}
+	return events
+}
+
+// blockEvents returns the events of a new block with blobs of namespaces as
+// they are matched by Tendermint queries.
+func blockEvents(t *testing.T, namespaces ...[]byte) map[string][]string {
+	events := map[string][]string{tmtypes.EventTypeKey: {tmtypes.EventNewBlock}}
+	for _, event := range blobEvents(t, namespaces...) {
+		for _, attr := range event.Attributes {
+			key := event.Type + "." + string(attr.Key)
+			events[key] = append(events[key], string(attr.Value))
+		}
+	}
+	return events
+}
+
+func newBlock(t *testing.T, height int64, namespaces ...[]byte) coretypes.ResultEvent {
+	return coretypes.ResultEvent{Data: tmtypes.EventDataNewBlock{
+		Block:          &tmtypes.Block{Header: tmtypes.Header{Height: height}},
+		ResultEndBlock: abci.ResponseEndBlock{Events: blobEvents(t, namespaces...)},
+	}}
+}
+
+// eventsClient is a client whose subscriptions receive events.
+type eventsClient struct {
+	rpcclient.EventsClient
+	events       chan coretypes.ResultEvent
+	query        string
+	unsubscribed bool
+}
+
+func (c *eventsClient) Subscribe(_ context.Context, _, query string, _ ...int) (<-chan coretypes.ResultEvent, error) {
+	c.query = query
+	return c.events, nil
+}
+
+func (c *eventsClient) UnsubscribeAll(context.Context, string) error {
+	c.unsubscribed = true
+	return nil
+}
+
+type subscribeStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*blobs.SubscribeResponse
+}
+
+func (s *subscribeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *subscribeStream) Send(res *blobs.SubscribeResponse) error {
+	s.responses = append(s.responses, res)
+	return nil
+}
@@ -0,0 +1,232 @@
+// Package guard provides optional checks that protect the query endpoints of
+// nodes that expose gRPC publicly: token authentication, per-IP rate limiting
+// and a cap on the size of requests. Blob and proof queries are expensive so
+// operators of public endpoints should enable at least one of them.
+//
+// Requests from loopback addresses, e.g. from the gRPC gateway of the API
+// server of the node, are checked like any other request unless the operator
+// exempts them explicitly.
+package guard
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	FlagAuthTokens      = "grpc-guard.auth-tokens"
+	FlagRateLimit       = "grpc-guard.rate-limit"
+	FlagRateBurst       = "grpc-guard.rate-burst"
+	FlagMaxRequestBytes = "grpc-guard.max-request-bytes"
+	FlagExemptLoopback  = "grpc-guard.exempt-loopback"
+
+	// APIKeyHeader is the metadata key of the token of a request. The token can
+	// also be passed as "authorization: Bearer <token>".
+	APIKeyHeader = "x-api-key"
+
+	// limiterIdleTimeout is the duration after which the rate limiter of an IP
+	// that didn't send requests is dropped.
+	limiterIdleTimeout = 10 * time.Minute
+)
+
+// ConfigTemplate is the section of app.toml that configures the guard.
+const ConfigTemplate = `
+###############################################################################
+###                         gRPC Guard Configuration                        ###
+###############################################################################
+
+# Optional protection of the gRPC server for nodes that expose it publicly.
+[grpc-guard]
+
+# Tokens that requests must present in the "x-api-key" metadata or as
+# "authorization: Bearer <token>". Authentication is disabled if empty.
+auth-tokens = []
+
+# Number of requests per second that each IP can send. Disabled if 0.
+rate-limit = 0
+
+# Number of requests that each IP can send in a burst above the rate limit.
+# Defaults to the rate limit rounded up if 0.
+rate-burst = 0
+
+# Max size of a request in bytes. Defaults to grpc.max-recv-msg-size if 0.
+max-request-bytes = 0
+
+# Exempt requests from loopback addresses from authentication and rate
+# limiting, e.g. so that the gRPC gateway of the API server works without
+# tokens. Anyone who can reach the API server then bypasses the guard.
+exempt-loopback = false
+`
+
+// Config configures the interceptors. The zero value disables all of them.
+type Config struct {
+	AuthTokens      []string
+	RateLimit       float64
+	RateBurst       int
+	MaxRequestBytes int
+	ExemptLoopback  bool
+}
+
+// Guard holds the state of the interceptors.
+type Guard struct {
+	cfg Config
+
+	mtx       sync.Mutex
+	limiters  map[string]*ipLimiter
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type ipLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func New(cfg Config) *Guard {
+	if cfg.RateBurst <= 0 {
+		cfg.RateBurst = max(1, int(cfg.RateLimit+0.999))
+	}
+	return &Guard{
+		cfg:      cfg,
+		limiters: make(map[string]*ipLimiter),
+		now:      time.Now,
+	}
+}
+
+// Server wraps server so that the services registered with it authenticate
+// and rate limit their requests and streams. Pass it to the
+// RegisterGRPCServer method of the app.
+func (g *Guard) Server(server gogogrpc.Server) gogogrpc.Server {
+	return &guardedServer{Server: server, guard: g}
+}
+
+// guardedServer wraps a gRPC server and checks every request and stream of
+// the registered services with the guard before invoking their handlers.
+type guardedServer struct {
+	gogogrpc.Server
+	guard *Guard
+}
+
+func (s *guardedServer) RegisterService(desc *grpc.ServiceDesc, handler interface{}) {
+	methods := make([]grpc.MethodDesc, len(desc.Methods))
+	for i, method := range desc.Methods {
+		methodHandler := method.Handler
+		methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				if err := s.guard.check(ctx); err != nil {
+					return nil, err
+				}
+				return methodHandler(srv, ctx, dec, interceptor)
+			},
+		}
+	}
+	streams := make([]grpc.StreamDesc, len(desc.Streams))
+	for i, stream := range desc.Streams {
+		streamHandler := stream.Handler
+		streams[i] = stream
+		streams[i].Handler = func(srv interface{}, ss grpc.ServerStream) error {
+			if err := s.guard.check(ss.Context()); err != nil {
+				return err
+			}
+			return streamHandler(srv, ss)
+		}
+	}
+
+	s.Server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: desc.ServiceName,
+		HandlerType: desc.HandlerType,
+		Methods:     methods,
+		Streams:     streams,
+		Metadata:    desc.Metadata,
+	}, handler)
+}
+
+func (g *Guard) check(ctx context.Context) error {
+	ip, ok := peerIP(ctx)
+	if ok && ip.IsLoopback() && g.cfg.ExemptLoopback {
+		return nil
+	}
+	if err := g.authenticate(ctx); err != nil {
+		return err
+	}
+	if g.cfg.RateLimit <= 0 {
+		return nil
+	}
+	key := "unknown"
+	if ok {
+		key = ip.String()
+	}
+	if !g.allow(key) {
+		return status.Errorf(codes.ResourceExhausted, "rate limit of %g requests per second exceeded", g.cfg.RateLimit)
+	}
+	return nil
+}
+
+func (g *Guard) authenticate(ctx context.Context) error {
+	if len(g.cfg.AuthTokens) == 0 {
+		return nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	var tokens []string
+	tokens = append(tokens, md.Get(APIKeyHeader)...)
+	for _, auth := range md.Get("authorization") {
+		if token, ok := strings.CutPrefix(auth, "Bearer "); ok {
+			tokens = append(tokens, token)
+		}
+	}
+	for _, token := range tokens {
+		for _, valid := range g.cfg.AuthTokens {
+			if subtle.ConstantTimeCompare([]byte(token), []byte(valid)) == 1 {
+				return nil
+			}
+		}
+	}
+	return status.Error(codes.Unauthenticated, "missing or invalid auth token")
+}
+
+func (g *Guard) allow(key string) bool {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	now := g.now()
+	if now.Sub(g.lastSweep) > limiterIdleTimeout {
+		for k, l := range g.limiters {
+			if now.Sub(l.lastSeen) > limiterIdleTimeout {
+				delete(g.limiters, k)
+			}
+		}
+		g.lastSweep = now
+	}
+	l, ok := g.limiters[key]
+	if !ok {
+		l = &ipLimiter{limiter: rate.NewLimiter(rate.Limit(g.cfg.RateLimit), g.cfg.RateBurst)}
+		g.limiters[key] = l
+	}
+	l.lastSeen = now
+	return l.limiter.AllowN(now, 1)
+}
+
+func peerIP(ctx context.Context) (net.IP, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil, false
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	ip := net.ParseIP(host)
+	return ip, ip != nil
+}
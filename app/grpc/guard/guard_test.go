@@ -0,0 +1,133 @@
+package guard
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func peerContext(ip string, md ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 9090}})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(md...))
+}
+
+func call(g *Guard, ctx context.Context) error {
+	return g.check(ctx)
+}
+
+func TestAuthentication(t *testing.T) {
+	g := New(Config{AuthTokens: []string{"secret"}})
+
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		wantCode codes.Code
+	}{
+		{"no token", peerContext("1.2.3.4"), codes.Unauthenticated},
+		{"invalid token", peerContext("1.2.3.4", APIKeyHeader, "wrong"), codes.Unauthenticated},
+		{"api key", peerContext("1.2.3.4", APIKeyHeader, "secret"), codes.OK},
+		{"bearer token", peerContext("1.2.3.4", "authorization", "Bearer secret"), codes.OK},
+		{"token without bearer prefix", peerContext("1.2.3.4", "authorization", "secret"), codes.Unauthenticated},
+		{"loopback is not exempt", peerContext("127.0.0.1"), codes.Unauthenticated},
+		{"unknown peer", metadata.NewIncomingContext(context.Background(), metadata.MD{}), codes.Unauthenticated},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantCode, status.Code(call(g, tc.ctx)))
+		})
+	}
+
+	exempt := New(Config{AuthTokens: []string{"secret"}, ExemptLoopback: true})
+	assert.NoError(t, call(exempt, peerContext("127.0.0.1")))
+	assert.Equal(t, codes.Unauthenticated, status.Code(call(exempt, peerContext("1.2.3.4"))))
+}
+
+func TestRateLimit(t *testing.T) {
+	g := New(Config{RateLimit: 1, RateBurst: 2})
+	now := time.Unix(1000, 0)
+	g.now = func() time.Time { return now }
+
+	require.NoError(t, call(g, peerContext("1.2.3.4")))
+	require.NoError(t, call(g, peerContext("1.2.3.4")))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call(g, peerContext("1.2.3.4"))))
+
+	// other IPs have their own limits
+	require.NoError(t, call(g, peerContext("5.6.7.8")))
+	// loopback is rate limited too
+	require.NoError(t, call(g, peerContext("::1")))
+	require.NoError(t, call(g, peerContext("::1")))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call(g, peerContext("::1"))))
+
+	// the limit refills over time
+	now = now.Add(time.Second)
+	require.NoError(t, call(g, peerContext("1.2.3.4")))
+
+	// idle limiters are dropped
+	now = now.Add(2 * limiterIdleTimeout)
+	require.NoError(t, call(g, peerContext("5.6.7.8")))
+	assert.Len(t, g.limiters, 1)
+}
+
+func TestServer(t *testing.T) {
+	g := New(Config{AuthTokens: []string{"secret"}})
+	server := &mockServer{}
+	called := 0
+	g.Server(server).RegisterService(&grpc.ServiceDesc{
+		ServiceName: "test.Service",
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Unary",
+			Handler: func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+				called++
+				return nil, nil
+			},
+		}},
+		Streams: []grpc.StreamDesc{{
+			StreamName: "Stream",
+			Handler: func(interface{}, grpc.ServerStream) error {
+				called++
+				return nil
+			},
+			ClientStreams: true,
+		}},
+	}, nil)
+	desc := server.desc
+	require.NotNil(t, desc)
+	require.True(t, desc.Streams[0].ClientStreams)
+
+	_, err := desc.Methods[0].Handler(nil, peerContext("1.2.3.4"), nil, nil)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	err = desc.Streams[0].Handler(nil, &mockServerStream{ctx: peerContext("1.2.3.4")})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Zero(t, called)
+
+	_, err = desc.Methods[0].Handler(nil, peerContext("1.2.3.4", APIKeyHeader, "secret"), nil, nil)
+	require.NoError(t, err)
+	err = desc.Streams[0].Handler(nil, &mockServerStream{ctx: peerContext("1.2.3.4", APIKeyHeader, "secret")})
+	require.NoError(t, err)
+	assert.Equal(t, 2, called)
+}
+
+// mockServer records the last registered service.
+type mockServer struct {
+	desc *grpc.ServiceDesc
+}
+
+func (s *mockServer) RegisterService(desc *grpc.ServiceDesc, _ interface{}) {
+	s.desc = desc
+}
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *mockServerStream) Context() context.Context { return s.ctx }
@@ -0,0 +1,1111 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/mempool/mempool.proto
+
+package mempool
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RecentRejectionsRequest is the request type for the RecentRejections gRPC
+// method.
+type RecentRejectionsRequest struct {
+	// limit is the max number of rejections to return. All the retained
+	// rejections are returned if it is 0.
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// reason optionally only returns the rejections with this reason.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *RecentRejectionsRequest) Reset()         { *m = RecentRejectionsRequest{} }
+func (m *RecentRejectionsRequest) String() string { return proto.CompactTextString(m) }
+func (*RecentRejectionsRequest) ProtoMessage()    {}
+func (*RecentRejectionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2081ea8a42bcdef8, []int{0}
+}
+func (m *RecentRejectionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecentRejectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecentRejectionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecentRejectionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecentRejectionsRequest.Merge(m, src)
+}
+func (m *RecentRejectionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecentRejectionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecentRejectionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecentRejectionsRequest proto.InternalMessageInfo
+
+func (m *RecentRejectionsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *RecentRejectionsRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// RecentRejectionsResponse is the response type for the RecentRejections gRPC
+// method.
+type RecentRejectionsResponse struct {
+	Rejections []Rejection `protobuf:"bytes,1,rep,name=rejections,proto3" json:"rejections"`
+}
+
+func (m *RecentRejectionsResponse) Reset()         { *m = RecentRejectionsResponse{} }
+func (m *RecentRejectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*RecentRejectionsResponse) ProtoMessage()    {}
+func (*RecentRejectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2081ea8a42bcdef8, []int{1}
+}
+func (m *RecentRejectionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecentRejectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecentRejectionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecentRejectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecentRejectionsResponse.Merge(m, src)
+}
+func (m *RecentRejectionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecentRejectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecentRejectionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecentRejectionsResponse proto.InternalMessageInfo
+
+func (m *RecentRejectionsResponse) GetRejections() []Rejection {
+	if m != nil {
+		return m.Rejections
+	}
+	return nil
+}
+
+// Rejection is a tx that the node rejected from its mempool.
+type Rejection struct {
+	// tx_hash is the hex encoded hash of the tx as it was broadcast.
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// reason is the reason of the rejection, e.g. fee_too_low.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// codespace and code are the error of the CheckTx response.
+	Codespace string `protobuf:"bytes,3,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code      uint32 `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	// log is the log of the CheckTx response.
+	Log string `protobuf:"bytes,5,opt,name=log,proto3" json:"log,omitempty"`
+	// evicted is true if the tx was in the mempool and failed when it was
+	// rechecked after a block.
+	Evicted bool `protobuf:"varint,6,opt,name=evicted,proto3" json:"evicted,omitempty"`
+	// time is the time of the rejection.
+	Time time.Time `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *Rejection) Reset()         { *m = Rejection{} }
+func (m *Rejection) String() string { return proto.CompactTextString(m) }
+func (*Rejection) ProtoMessage()    {}
+func (*Rejection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2081ea8a42bcdef8, []int{2}
+}
+func (m *Rejection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Rejection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Rejection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Rejection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rejection.Merge(m, src)
+}
+func (m *Rejection) XXX_Size() int {
+	return m.Size()
+}
+func (m *Rejection) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rejection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rejection proto.InternalMessageInfo
+
+func (m *Rejection) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *Rejection) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Rejection) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *Rejection) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *Rejection) GetLog() string {
+	if m != nil {
+		return m.Log
+	}
+	return ""
+}
+
+func (m *Rejection) GetEvicted() bool {
+	if m != nil {
+		return m.Evicted
+	}
+	return false
+}
+
+func (m *Rejection) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*RecentRejectionsRequest)(nil), "celestia.core.v1.mempool.RecentRejectionsRequest")
+	proto.RegisterType((*RecentRejectionsResponse)(nil), "celestia.core.v1.mempool.RecentRejectionsResponse")
+	proto.RegisterType((*Rejection)(nil), "celestia.core.v1.mempool.Rejection")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/mempool/mempool.proto", fileDescriptor_2081ea8a42bcdef8)
+}
+
+var fileDescriptor_2081ea8a42bcdef8 = []byte{
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xae, 0xd7, 0xae, 0x5d, 0x3d, 0x21, 0x4d, 0xd6, 0xc4, 0xac, 0x6a, 0x4a, 0xa3, 0x22, 0xa1,
+	0x48, 0x68, 0xb6, 0xda, 0x71, 0xe0, 0xdc, 0x0b, 0x70, 0x80, 0x83, 0xc5, 0x89, 0xcb, 0xe4, 0x7a,
+	0x3f, 0x69, 0x50, 0x12, 0x9b, 0xd8, 0xad, 0x76, 0xe6, 0x09, 0x26, 0xf1, 0x1e, 0x3c, 0x00, 0x4f,
+	0xb0, 0xe3, 0x24, 0x38, 0x70, 0x02, 0xd4, 0xf2, 0x20, 0x28, 0x4e, 0x93, 0x22, 0xa0, 0x48, 0x3b,
+	0x44, 0xf9, 0x3f, 0x7f, 0xdf, 0xf7, 0xc7, 0xff, 0xf7, 0x07, 0x3f, 0x54, 0x90, 0x82, 0x75, 0x89,
+	0xe4, 0x4a, 0x17, 0xc0, 0x97, 0x63, 0x9e, 0x41, 0x66, 0xb4, 0x4e, 0xeb, 0x37, 0x33, 0x85, 0x76,
+	0x9a, 0xd0, 0x5a, 0xc7, 0x4a, 0x1d, 0x5b, 0x8e, 0xd9, 0x86, 0x1f, 0x1c, 0xc7, 0x3a, 0xd6, 0x5e,
+	0xc4, 0xcb, 0xaa, 0xd2, 0x0f, 0x4e, 0x63, 0xad, 0xe3, 0x14, 0xb8, 0x34, 0x09, 0x97, 0x79, 0xae,
+	0x9d, 0x74, 0x89, 0xce, 0xed, 0x86, 0x1d, 0x6e, 0x58, 0x8f, 0x66, 0x8b, 0x37, 0xdc, 0x25, 0x19,
+	0x58, 0x27, 0x33, 0x53, 0x09, 0x46, 0x4f, 0xf1, 0x89, 0x00, 0x05, 0xb9, 0x13, 0xf0, 0x16, 0x94,
+	0xb7, 0x0a, 0x78, 0xb7, 0x00, 0xeb, 0xc8, 0x31, 0xde, 0x4f, 0x93, 0x2c, 0x71, 0x14, 0x85, 0x28,
+	0xba, 0x27, 0x2a, 0x40, 0xee, 0xe3, 0x6e, 0x01, 0xd2, 0xea, 0x9c, 0xee, 0x85, 0x28, 0xea, 0x8b,
+	0x0d, 0x1a, 0x01, 0xa6, 0x7f, 0x37, 0xb2, 0x46, 0xe7, 0x16, 0xc8, 0x73, 0x8c, 0x8b, 0xe6, 0x94,
+	0xa2, 0xb0, 0x1d, 0x1d, 0x4e, 0x1e, 0xb0, 0x5d, 0x83, 0xb2, 0xa6, 0xc3, 0xb4, 0x73, 0xf3, 0x6d,
+	0xd8, 0x12, 0xbf, 0x99, 0x47, 0x5f, 0x10, 0xee, 0x37, 0x3c, 0x39, 0xc1, 0x3d, 0x77, 0x75, 0x31,
+	0x97, 0x76, 0xee, 0x2f, 0xd9, 0x17, 0x5d, 0x77, 0xf5, 0x4c, 0xda, 0xf9, 0xae, 0x5b, 0x92, 0x53,
+	0xdc, 0x57, 0xfa, 0x12, 0xac, 0x91, 0x0a, 0x68, 0xdb, 0x53, 0xdb, 0x03, 0x42, 0x70, 0xa7, 0x04,
+	0xb4, 0xe3, 0x07, 0xf6, 0x35, 0x39, 0xc2, 0xed, 0x54, 0xc7, 0x74, 0xdf, 0x6b, 0xcb, 0x92, 0x50,
+	0xdc, 0x83, 0x65, 0xa2, 0x1c, 0x5c, 0xd2, 0x6e, 0x88, 0xa2, 0x03, 0x51, 0x43, 0xf2, 0x04, 0x77,
+	0xca, 0x7c, 0x69, 0x2f, 0x44, 0xd1, 0xe1, 0x64, 0xc0, 0xaa, 0xf0, 0x59, 0x1d, 0x3e, 0x7b, 0x55,
+	0x87, 0x3f, 0x3d, 0x28, 0x07, 0xbb, 0xfe, 0x3e, 0x44, 0xc2, 0x3b, 0x26, 0x9f, 0x10, 0xee, 0xbd,
+	0xa8, 0xc6, 0x27, 0x1f, 0x11, 0x3e, 0xfa, 0x33, 0x4a, 0x32, 0xfe, 0x5f, 0x5c, 0xff, 0xdc, 0xdf,
+	0x60, 0x72, 0x17, 0x4b, 0xb5, 0xa9, 0xd1, 0xf9, 0xfb, 0xcf, 0x3f, 0x3f, 0xec, 0x9d, 0x91, 0x47,
+	0x7c, 0xe7, 0xef, 0x5a, 0x78, 0xef, 0xc5, 0x76, 0x27, 0xd3, 0x97, 0x37, 0xab, 0x00, 0xdd, 0xae,
+	0x02, 0xf4, 0x63, 0x15, 0xa0, 0xeb, 0x75, 0xd0, 0xba, 0x5d, 0x07, 0xad, 0xaf, 0xeb, 0xa0, 0xf5,
+	0xfa, 0x71, 0x9c, 0xb8, 0xf9, 0x62, 0xc6, 0x94, 0xce, 0x9a, 0x86, 0xba, 0x88, 0x9b, 0xfa, 0x4c,
+	0x1a, 0xc3, 0xcb, 0x27, 0x2e, 0x8c, 0xaa, 0xbf, 0x30, 0xeb, 0xfa, 0xc0, 0xce, 0x7f, 0x0d, 0x00,
+	0xbc, 0x4a, 0x80, 0x41, 0x33, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MempoolClient is the client API for Mempool service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MempoolClient interface {
+	// RecentRejections returns the most recent txs that the node rejected in
+	// CheckTx or evicted when rechecking them after a block, newest first.
+	RecentRejections(ctx context.Context, in *RecentRejectionsRequest, opts ...grpc.CallOption) (*RecentRejectionsResponse, error)
+}
+
+type mempoolClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMempoolClient(cc grpc1.ClientConn) MempoolClient {
+	return &mempoolClient{cc}
+}
+
+func (c *mempoolClient) RecentRejections(ctx context.Context, in *RecentRejectionsRequest, opts ...grpc.CallOption) (*RecentRejectionsResponse, error) {
+	out := new(RecentRejectionsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.mempool.Mempool/RecentRejections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MempoolServer is the server API for Mempool service.
+type MempoolServer interface {
+	// RecentRejections returns the most recent txs that the node rejected in
+	// CheckTx or evicted when rechecking them after a block, newest first.
+	RecentRejections(context.Context, *RecentRejectionsRequest) (*RecentRejectionsResponse, error)
+}
+
+// UnimplementedMempoolServer can be embedded to have forward compatible implementations.
+type UnimplementedMempoolServer struct {
+}
+
+func (*UnimplementedMempoolServer) RecentRejections(ctx context.Context, req *RecentRejectionsRequest) (*RecentRejectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecentRejections not implemented")
+}
+
+func RegisterMempoolServer(s grpc1.Server, srv MempoolServer) {
+	s.RegisterService(&_Mempool_serviceDesc, srv)
+}
+
+func _Mempool_RecentRejections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecentRejectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServer).RecentRejections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.mempool.Mempool/RecentRejections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServer).RecentRejections(ctx, req.(*RecentRejectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Mempool_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.mempool.Mempool",
+	HandlerType: (*MempoolServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RecentRejections",
+			Handler:    _Mempool_RecentRejections_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/mempool/mempool.proto",
+}
+
+func (m *RecentRejectionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecentRejectionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecentRejectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMempool(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Limit != 0 {
+		i = encodeVarintMempool(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecentRejectionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecentRejectionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecentRejectionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rejections) > 0 {
+		for iNdEx := len(m.Rejections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rejections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMempool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Rejection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Rejection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Rejection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMempool(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.Evicted {
+		i--
+		if m.Evicted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintMempool(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Code != 0 {
+		i = encodeVarintMempool(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintMempool(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMempool(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintMempool(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMempool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMempool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RecentRejectionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovMempool(uint64(m.Limit))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMempool(uint64(l))
+	}
+	return n
+}
+
+func (m *RecentRejectionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rejections) > 0 {
+		for _, e := range m.Rejections {
+			l = e.Size()
+			n += 1 + l + sovMempool(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Rejection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovMempool(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMempool(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovMempool(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovMempool(uint64(m.Code))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovMempool(uint64(l))
+	}
+	if m.Evicted {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMempool(uint64(l))
+	return n
+}
+
+func sovMempool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMempool(x uint64) (n int) {
+	return sovMempool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RecentRejectionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecentRejectionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecentRejectionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMempool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecentRejectionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecentRejectionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecentRejectionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMempool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rejections = append(m.Rejections, Rejection{})
+			if err := m.Rejections[len(m.Rejections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Rejection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Rejection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Rejection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMempool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMempool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMempool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMempool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evicted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Evicted = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMempool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMempool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMempool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMempool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMempool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMempool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMempool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMempool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMempool = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,144 @@
+package mempool
+
+import (
+	"context"
+	"sync"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultRejectionLogSize is the default number of rejections that a
+	// RejectionLog retains.
+	DefaultRejectionLogSize = 1000
+
+	// maxLogLength is the max length of the log of a retained rejection.
+	maxLogLength = 1024
+)
+
+// The reasons of the rejections. A tx that the mempool evicts when it is full
+// or drops once its TTL expires is never rechecked by the app, so these are
+// counted by the mempool_evicted_txs and mempool_expired_txs metrics of
+// celestia-core instead. The CAT mempool doesn't replace txs.
+const (
+	ReasonFeeTooLow      = "fee_too_low"
+	ReasonOverBlobBudget = "over_blob_budget"
+	// ReasonExpired is the reason of a PFB whose inclusion window passed.
+	ReasonExpired           = "expired"
+	ReasonInsufficientFunds = "insufficient_funds"
+	ReasonOutOfGas          = "out_of_gas"
+	ReasonWrongSequence     = "wrong_sequence"
+	ReasonTxTooLarge        = "tx_too_large"
+	ReasonBlobsTooLarge     = "blobs_too_large"
+	ReasonInvalidBlobTx     = "invalid_blob_tx"
+	ReasonOther             = "other"
+	// ReasonMempoolEviction is the reason of a tx that the mempool evicted
+	// because it was full or because the TTL of the tx expired. It is only
+	// reported by the tx status query.
+	ReasonMempoolEviction = "mempool_eviction"
+)
+
+// RejectionLog retains the most recent rejections of the mempool. It is safe
+// for concurrent use.
+type RejectionLog struct {
+	mtx sync.Mutex
+	// rejections is a ring buffer whose oldest entry is at next once it is
+	// full.
+	rejections []Rejection
+	next       int
+	full       bool
+}
+
+// NewRejectionLog returns a log that retains the latest size rejections.
+func NewRejectionLog(size int) *RejectionLog {
+	return &RejectionLog{rejections: make([]Rejection, size)}
+}
+
+// Add adds a rejection to the log, dropping the oldest one if the log is
+// full.
+func (l *RejectionLog) Add(rejection Rejection) {
+	if len(rejection.Log) > maxLogLength {
+		rejection.Log = rejection.Log[:maxLogLength]
+	}
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if len(l.rejections) == 0 {
+		return
+	}
+	l.rejections[l.next] = rejection
+	l.next = (l.next + 1) % len(l.rejections)
+	l.full = l.full || l.next == 0
+}
+
+// Recent returns up to limit of the latest rejections with reason, or of all
+// reasons if reason is empty, newest first. All matching rejections are
+// returned if limit is 0.
+func (l *RejectionLog) Recent(limit int, reason string) []Rejection {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	count := l.next
+	if l.full {
+		count = len(l.rejections)
+	}
+	rejections := []Rejection{}
+	for i := 1; i <= count && (limit == 0 || len(rejections) < limit); i++ {
+		rejection := l.rejections[(l.next-i+len(l.rejections))%len(l.rejections)]
+		if reason == "" || rejection.Reason == reason {
+			rejections = append(rejections, rejection)
+		}
+	}
+	return rejections
+}
+
+// Find returns the latest rejection of the tx with the hex encoded txHash.
+func (l *RejectionLog) Find(txHash string) (Rejection, bool) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	count := l.next
+	if l.full {
+		count = len(l.rejections)
+	}
+	for i := 1; i <= count; i++ {
+		rejection := l.rejections[(l.next-i+len(l.rejections))%len(l.rejections)]
+		if rejection.TxHash == txHash {
+			return rejection, true
+		}
+	}
+	return Rejection{}, false
+}
+
+// RegisterMempoolService registers the mempool service on the gRPC router.
+func RegisterMempoolService(qrt gogogrpc.Server, log *RejectionLog) {
+	RegisterMempoolServer(qrt, NewMempoolServer(log))
+}
+
+// RegisterGRPCGatewayRoutes mounts the mempool service's GRPC-gateway routes
+// on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterMempoolHandlerClient(context.Background(), mux, NewMempoolClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ MempoolServer = &mempoolServer{}
+
+type mempoolServer struct {
+	log *RejectionLog
+}
+
+// NewMempoolServer returns a server of the rejections of log.
+func NewMempoolServer(log *RejectionLog) MempoolServer {
+	return &mempoolServer{log: log}
+}
+
+// RecentRejections implements the MempoolServer.RecentRejections method.
+func (s *mempoolServer) RecentRejections(_ context.Context, req *RecentRejectionsRequest) (*RecentRejectionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	return &RecentRejectionsResponse{Rejections: s.log.Recent(int(req.Limit), req.Reason)}, nil
+}
@@ -0,0 +1,72 @@
+package mempool_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/mempool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRejectionLog(t *testing.T) {
+	log := mempool.NewRejectionLog(3)
+	assert.Empty(t, log.Recent(0, ""))
+
+	for i := 0; i < 5; i++ {
+		reason := mempool.ReasonFeeTooLow
+		if i%2 == 1 {
+			reason = mempool.ReasonWrongSequence
+		}
+		log.Add(mempool.Rejection{TxHash: fmt.Sprint(i), Reason: reason})
+	}
+
+	// only the latest 3 rejections are retained, newest first
+	assert.Equal(t, []string{"4", "3", "2"}, txHashes(log.Recent(0, "")))
+	assert.Equal(t, []string{"4", "3"}, txHashes(log.Recent(2, "")))
+	assert.Equal(t, []string{"4", "2"}, txHashes(log.Recent(0, mempool.ReasonFeeTooLow)))
+	assert.Equal(t, []string{"3"}, txHashes(log.Recent(0, mempool.ReasonWrongSequence)))
+	assert.Empty(t, log.Recent(0, mempool.ReasonOther))
+
+	rejection, found := log.Find("3")
+	assert.True(t, found)
+	assert.Equal(t, mempool.ReasonWrongSequence, rejection.Reason)
+	// the oldest rejections were dropped
+	_, found = log.Find("1")
+	assert.False(t, found)
+
+	// the latest rejection of a tx is returned
+	log.Add(mempool.Rejection{TxHash: "3", Reason: mempool.ReasonOutOfGas})
+	rejection, _ = log.Find("3")
+	assert.Equal(t, mempool.ReasonOutOfGas, rejection.Reason)
+}
+
+func TestRejectionLogTruncatesLogs(t *testing.T) {
+	log := mempool.NewRejectionLog(1)
+	log.Add(mempool.Rejection{Log: strings.Repeat("a", 5000)})
+	assert.Len(t, log.Recent(0, "")[0].Log, 1024)
+}
+
+func TestRecentRejections(t *testing.T) {
+	log := mempool.NewRejectionLog(10)
+	log.Add(mempool.Rejection{TxHash: "1", Reason: mempool.ReasonFeeTooLow})
+	log.Add(mempool.Rejection{TxHash: "2", Reason: mempool.ReasonOverBlobBudget})
+	server := mempool.NewMempoolServer(log)
+
+	res, err := server.RecentRejections(context.Background(), &mempool.RecentRejectionsRequest{Reason: mempool.ReasonOverBlobBudget})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2"}, txHashes(res.Rejections))
+
+	_, err = server.RecentRejections(context.Background(), nil)
+	assert.Error(t, err)
+}
+
+func txHashes(rejections []mempool.Rejection) []string {
+	hashes := make([]string, 0, len(rejections))
+	for _, r := range rejections {
+		hashes = append(hashes, r.TxHash)
+	}
+	return hashes
+}
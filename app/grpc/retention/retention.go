@@ -0,0 +1,92 @@
+// Package retention coordinates the pruning settings of a node so that it
+// keeps serving the proofs and Blobstream data commitments it must serve, and
+// reports the earliest heights whose data the node retains.
+//
+// A node prunes its data in two places. Tendermint prunes whole blocks below
+// the retain height that the app returns on commit, which retains
+// min-retain-blocks blocks. The app prunes its state according to its pruning
+// options. Share and blob proofs are constructed from the txs and blobs of the
+// block store, and Blobstream data commitments from the headers of the blocks
+// of the data commitment window. Entries of the blob index below the earliest
+// blob height point to pruned blob data.
+package retention
+
+import (
+	"fmt"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	srvrtypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// FlagProofRetainHeights is the flag to specify the number of most recent
+// heights for which the node must be able to serve share and blob proofs.
+const FlagProofRetainHeights = "proof-retain-heights"
+
+// Config is the retention of the blocks of a node.
+type Config struct {
+	// MinRetainBlocks is the number of latest blocks that Tendermint retains.
+	// 0 retains all blocks.
+	MinRetainBlocks uint64
+	// ProofRetainHeights is the number of latest heights for which the node
+	// must serve share and blob proofs. 0 means no guarantee.
+	ProofRetainHeights uint64
+}
+
+// NewConfig returns the retention configured by appOpts.
+func NewConfig(appOpts srvrtypes.AppOptions) Config {
+	return Config{
+		MinRetainBlocks:    cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks)),
+		ProofRetainHeights: cast.ToUint64(appOpts.Get(FlagProofRetainHeights)),
+	}
+}
+
+// Validate returns an error if the block pruning settings prune the blocks of
+// the heights for which the node must serve proofs.
+func (c Config) Validate() error {
+	if c.MinRetainBlocks != 0 && c.MinRetainBlocks < c.ProofRetainHeights {
+		return fmt.Errorf("%s = %d prunes blocks that are needed to serve proofs for the last %d heights (%s): set %s to 0 to disable block pruning or to at least %d",
+			server.FlagMinRetainBlocks, c.MinRetainBlocks, c.ProofRetainHeights, FlagProofRetainHeights, server.FlagMinRetainBlocks, c.ProofRetainHeights)
+	}
+	return nil
+}
+
+// RetainHeight lowers retainHeight, the height below which Tendermint prunes
+// blocks after committing commitHeight, so that the blocks of the proof
+// heights and of the latest windowBlocks heights are retained. windowBlocks
+// is the number of blocks that Blobstream needs to commit to data, or 0. A
+// retainHeight of 0 prunes nothing and is returned as is.
+func (c Config) RetainHeight(commitHeight, retainHeight int64, windowBlocks uint64) int64 {
+	if retainHeight <= 0 {
+		return 0
+	}
+	retain := max(c.ProofRetainHeights, windowBlocks)
+	if retain == 0 {
+		return retainHeight
+	}
+	lowest := commitHeight - int64(retain)
+	if lowest <= 0 {
+		return 0
+	}
+	return min(retainHeight, lowest)
+}
+
+// EarliestHeights returns the earliest heights whose data the node serves
+// given the earliest and latest heights of its block store and the pruning
+// options of its state. Blob data is pruned with its block, so the earliest
+// blob height is the earliest block height.
+func (c Config) EarliestHeights(earliestBlock, latest int64, statePruning pruningtypes.PruningOptions) *EarliestHeightsResponse {
+	res := &EarliestHeightsResponse{
+		LatestHeight:        latest,
+		EarliestBlockHeight: earliestBlock,
+		EarliestBlobHeight:  earliestBlock,
+		EarliestStateHeight: 1,
+		ProofRetainHeights:  c.ProofRetainHeights,
+	}
+	strategy := statePruning.GetPruningStrategy()
+	if strategy != pruningtypes.PruningNothing && strategy != pruningtypes.PruningUndefined {
+		res.EarliestStateHeight = max(1, latest-int64(statePruning.KeepRecent))
+	}
+	return res
+}
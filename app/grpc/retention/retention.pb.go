@@ -0,0 +1,675 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/retention/retention.proto
+
+package retention
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EarliestHeightsRequest is the request type for the EarliestHeights gRPC
+// method.
+type EarliestHeightsRequest struct {
+}
+
+func (m *EarliestHeightsRequest) Reset()         { *m = EarliestHeightsRequest{} }
+func (m *EarliestHeightsRequest) String() string { return proto.CompactTextString(m) }
+func (*EarliestHeightsRequest) ProtoMessage()    {}
+func (*EarliestHeightsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42b2cabf741f8d68, []int{0}
+}
+func (m *EarliestHeightsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EarliestHeightsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EarliestHeightsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EarliestHeightsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EarliestHeightsRequest.Merge(m, src)
+}
+func (m *EarliestHeightsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EarliestHeightsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EarliestHeightsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EarliestHeightsRequest proto.InternalMessageInfo
+
+// EarliestHeightsResponse is the response type for the EarliestHeights gRPC
+// method.
+type EarliestHeightsResponse struct {
+	// latest_height is the latest height of the block store.
+	LatestHeight int64 `protobuf:"varint,1,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height,omitempty"`
+	// earliest_block_height is the earliest height whose header and commit the
+	// node serves.
+	EarliestBlockHeight int64 `protobuf:"varint,2,opt,name=earliest_block_height,json=earliestBlockHeight,proto3" json:"earliest_block_height,omitempty"`
+	// earliest_blob_height is the earliest height whose txs and blobs, and
+	// hence share and blob proofs, the node serves.
+	EarliestBlobHeight int64 `protobuf:"varint,3,opt,name=earliest_blob_height,json=earliestBlobHeight,proto3" json:"earliest_blob_height,omitempty"`
+	// earliest_state_height is the earliest height at which the node serves
+	// state queries.
+	EarliestStateHeight int64 `protobuf:"varint,4,opt,name=earliest_state_height,json=earliestStateHeight,proto3" json:"earliest_state_height,omitempty"`
+	// proof_retain_heights is the number of latest heights for which the node
+	// guarantees to serve share and blob proofs. 0 means no guarantee.
+	ProofRetainHeights uint64 `protobuf:"varint,5,opt,name=proof_retain_heights,json=proofRetainHeights,proto3" json:"proof_retain_heights,omitempty"`
+}
+
+func (m *EarliestHeightsResponse) Reset()         { *m = EarliestHeightsResponse{} }
+func (m *EarliestHeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*EarliestHeightsResponse) ProtoMessage()    {}
+func (*EarliestHeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42b2cabf741f8d68, []int{1}
+}
+func (m *EarliestHeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EarliestHeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EarliestHeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EarliestHeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EarliestHeightsResponse.Merge(m, src)
+}
+func (m *EarliestHeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EarliestHeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EarliestHeightsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EarliestHeightsResponse proto.InternalMessageInfo
+
+func (m *EarliestHeightsResponse) GetLatestHeight() int64 {
+	if m != nil {
+		return m.LatestHeight
+	}
+	return 0
+}
+
+func (m *EarliestHeightsResponse) GetEarliestBlockHeight() int64 {
+	if m != nil {
+		return m.EarliestBlockHeight
+	}
+	return 0
+}
+
+func (m *EarliestHeightsResponse) GetEarliestBlobHeight() int64 {
+	if m != nil {
+		return m.EarliestBlobHeight
+	}
+	return 0
+}
+
+func (m *EarliestHeightsResponse) GetEarliestStateHeight() int64 {
+	if m != nil {
+		return m.EarliestStateHeight
+	}
+	return 0
+}
+
+func (m *EarliestHeightsResponse) GetProofRetainHeights() uint64 {
+	if m != nil {
+		return m.ProofRetainHeights
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EarliestHeightsRequest)(nil), "celestia.core.v1.retention.EarliestHeightsRequest")
+	proto.RegisterType((*EarliestHeightsResponse)(nil), "celestia.core.v1.retention.EarliestHeightsResponse")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/retention/retention.proto", fileDescriptor_42b2cabf741f8d68)
+}
+
+var fileDescriptor_42b2cabf741f8d68 = []byte{
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcd, 0x4a, 0xc3, 0x40,
+	0x14, 0x85, 0x3b, 0x6d, 0x15, 0x1c, 0x14, 0x61, 0xfc, 0x2b, 0x45, 0x42, 0xa9, 0x9b, 0x22, 0x3a,
+	0xb1, 0xad, 0xf8, 0x00, 0x05, 0xc1, 0xa5, 0xc4, 0x9d, 0x9b, 0x32, 0x09, 0xd7, 0x34, 0x18, 0x33,
+	0xe3, 0xcc, 0x6d, 0x1f, 0xc0, 0xa5, 0x2b, 0xc1, 0x17, 0xf1, 0x09, 0x5c, 0xbb, 0x2c, 0xb8, 0x71,
+	0x29, 0xad, 0x0f, 0x22, 0xf9, 0x6d, 0xd5, 0x2a, 0xb8, 0x08, 0x0c, 0x73, 0xce, 0x97, 0xc3, 0x3d,
+	0x73, 0xe9, 0xbe, 0x07, 0x21, 0x18, 0x0c, 0x84, 0xed, 0x49, 0x0d, 0xf6, 0xa8, 0x6d, 0x6b, 0x40,
+	0x88, 0x30, 0x90, 0xd1, 0xec, 0xc4, 0x95, 0x96, 0x28, 0x59, 0x3d, 0xf7, 0xf2, 0xd8, 0xcb, 0x47,
+	0x6d, 0x5e, 0x38, 0xea, 0xbb, 0xbe, 0x94, 0x7e, 0x08, 0xb6, 0x50, 0x81, 0x2d, 0xa2, 0x48, 0xa2,
+	0x88, 0xaf, 0x4d, 0x4a, 0x36, 0x6b, 0x74, 0xfb, 0x54, 0xe8, 0x30, 0x00, 0x83, 0x67, 0x10, 0xf8,
+	0x03, 0x34, 0x0e, 0xdc, 0x0e, 0xc1, 0x60, 0xf3, 0xbe, 0x4c, 0x77, 0x7e, 0x48, 0x46, 0xc9, 0xc8,
+	0x00, 0xdb, 0xa3, 0x6b, 0xa1, 0x40, 0x30, 0xd8, 0x1f, 0x24, 0x4a, 0x8d, 0x34, 0x48, 0xab, 0xe2,
+	0xac, 0xa6, 0x97, 0xa9, 0x9b, 0x75, 0xe8, 0x16, 0x64, 0x7c, 0xdf, 0x0d, 0xa5, 0x77, 0x9d, 0x9b,
+	0xcb, 0x89, 0x79, 0x23, 0x17, 0x7b, 0xb1, 0x96, 0x31, 0x47, 0x74, 0x73, 0x9e, 0x71, 0x73, 0xa4,
+	0x92, 0x20, 0x6c, 0x0e, 0x71, 0x17, 0xa4, 0x18, 0x14, 0x08, 0x39, 0x52, 0xfd, 0x9a, 0x72, 0x11,
+	0x6b, 0xb3, 0x14, 0xa5, 0xa5, 0xbc, 0xea, 0x6b, 0x40, 0x11, 0x44, 0x19, 0x61, 0x6a, 0x4b, 0x0d,
+	0xd2, 0xaa, 0x3a, 0x2c, 0xd1, 0x9c, 0x44, 0xca, 0x06, 0xef, 0x3c, 0x13, 0xba, 0xe2, 0xe4, 0x95,
+	0xb2, 0x27, 0x42, 0xd7, 0xbf, 0x55, 0xc3, 0x3a, 0xfc, 0xf7, 0x37, 0xe0, 0x8b, 0x2b, 0xae, 0x77,
+	0xff, 0xc5, 0xa4, 0xdd, 0x37, 0x8f, 0xef, 0x5e, 0x3f, 0x1e, 0xcb, 0x9c, 0x1d, 0xd8, 0x7f, 0x2c,
+	0x48, 0x51, 0x49, 0x36, 0x5a, 0xef, 0xfc, 0x65, 0x62, 0x91, 0xf1, 0xc4, 0x22, 0xef, 0x13, 0x8b,
+	0x3c, 0x4c, 0xad, 0xd2, 0x78, 0x6a, 0x95, 0xde, 0xa6, 0x56, 0xe9, 0xf2, 0xc4, 0x0f, 0x70, 0x30,
+	0x74, 0xb9, 0x27, 0x6f, 0x8a, 0x3f, 0x4a, 0xed, 0x17, 0xe7, 0x43, 0xa1, 0x94, 0x1d, 0x7f, 0xbe,
+	0x56, 0xde, 0x2c, 0xc2, 0x5d, 0x4e, 0x16, 0xa8, 0xfb, 0x39, 0x00, 0xea, 0xb6, 0xaa, 0x84, 0xa8,
+	0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RetentionClient is the client API for Retention service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RetentionClient interface {
+	// EarliestHeights returns the earliest heights whose blocks, blob data and
+	// state the node serves.
+	EarliestHeights(ctx context.Context, in *EarliestHeightsRequest, opts ...grpc.CallOption) (*EarliestHeightsResponse, error)
+}
+
+type retentionClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewRetentionClient(cc grpc1.ClientConn) RetentionClient {
+	return &retentionClient{cc}
+}
+
+func (c *retentionClient) EarliestHeights(ctx context.Context, in *EarliestHeightsRequest, opts ...grpc.CallOption) (*EarliestHeightsResponse, error) {
+	out := new(EarliestHeightsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.retention.Retention/EarliestHeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RetentionServer is the server API for Retention service.
+type RetentionServer interface {
+	// EarliestHeights returns the earliest heights whose blocks, blob data and
+	// state the node serves.
+	EarliestHeights(context.Context, *EarliestHeightsRequest) (*EarliestHeightsResponse, error)
+}
+
+// UnimplementedRetentionServer can be embedded to have forward compatible implementations.
+type UnimplementedRetentionServer struct {
+}
+
+func (*UnimplementedRetentionServer) EarliestHeights(ctx context.Context, req *EarliestHeightsRequest) (*EarliestHeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EarliestHeights not implemented")
+}
+
+func RegisterRetentionServer(s grpc1.Server, srv RetentionServer) {
+	s.RegisterService(&_Retention_serviceDesc, srv)
+}
+
+func _Retention_EarliestHeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EarliestHeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RetentionServer).EarliestHeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.retention.Retention/EarliestHeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RetentionServer).EarliestHeights(ctx, req.(*EarliestHeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Retention_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.retention.Retention",
+	HandlerType: (*RetentionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EarliestHeights",
+			Handler:    _Retention_EarliestHeights_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/retention/retention.proto",
+}
+
+func (m *EarliestHeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EarliestHeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EarliestHeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EarliestHeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EarliestHeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EarliestHeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofRetainHeights != 0 {
+		i = encodeVarintRetention(dAtA, i, uint64(m.ProofRetainHeights))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EarliestStateHeight != 0 {
+		i = encodeVarintRetention(dAtA, i, uint64(m.EarliestStateHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EarliestBlobHeight != 0 {
+		i = encodeVarintRetention(dAtA, i, uint64(m.EarliestBlobHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EarliestBlockHeight != 0 {
+		i = encodeVarintRetention(dAtA, i, uint64(m.EarliestBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LatestHeight != 0 {
+		i = encodeVarintRetention(dAtA, i, uint64(m.LatestHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRetention(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRetention(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EarliestHeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EarliestHeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestHeight != 0 {
+		n += 1 + sovRetention(uint64(m.LatestHeight))
+	}
+	if m.EarliestBlockHeight != 0 {
+		n += 1 + sovRetention(uint64(m.EarliestBlockHeight))
+	}
+	if m.EarliestBlobHeight != 0 {
+		n += 1 + sovRetention(uint64(m.EarliestBlobHeight))
+	}
+	if m.EarliestStateHeight != 0 {
+		n += 1 + sovRetention(uint64(m.EarliestStateHeight))
+	}
+	if m.ProofRetainHeights != 0 {
+		n += 1 + sovRetention(uint64(m.ProofRetainHeights))
+	}
+	return n
+}
+
+func sovRetention(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRetention(x uint64) (n int) {
+	return sovRetention(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EarliestHeightsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRetention
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EarliestHeightsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EarliestHeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRetention(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRetention
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EarliestHeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRetention
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EarliestHeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EarliestHeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			m.LatestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestBlockHeight", wireType)
+			}
+			m.EarliestBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarliestBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestBlobHeight", wireType)
+			}
+			m.EarliestBlobHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarliestBlobHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestStateHeight", wireType)
+			}
+			m.EarliestStateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarliestStateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofRetainHeights", wireType)
+			}
+			m.ProofRetainHeights = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofRetainHeights |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRetention(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRetention
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRetention(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRetention
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRetention
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRetention
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRetention
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRetention
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRetention
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRetention        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRetention          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRetention = fmt.Errorf("proto: unexpected end of group")
+)
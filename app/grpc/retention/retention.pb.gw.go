@@ -0,0 +1,153 @@
+// Code generated by protoc-gen-grpc-gateway. DO NOT EDIT.
+// source: celestia/core/v1/retention/retention.proto
+
+/*
+Package retention is a reverse proxy.
+
+It translates gRPC into RESTful JSON APIs.
+*/
+package retention
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Suppress "imported and not used" errors
+var _ codes.Code
+var _ io.Reader
+var _ status.Status
+var _ = runtime.String
+var _ = utilities.NewDoubleArray
+var _ = descriptor.ForMessage
+var _ = metadata.Join
+
+func request_Retention_EarliestHeights_0(ctx context.Context, marshaler runtime.Marshaler, client RetentionClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq EarliestHeightsRequest
+	var metadata runtime.ServerMetadata
+
+	msg, err := client.EarliestHeights(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_Retention_EarliestHeights_0(ctx context.Context, marshaler runtime.Marshaler, server RetentionServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq EarliestHeightsRequest
+	var metadata runtime.ServerMetadata
+
+	msg, err := server.EarliestHeights(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+// RegisterRetentionHandlerServer registers the http handlers for service Retention to "mux".
+// UnaryRPC     :call RetentionServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterRetentionHandlerFromEndpoint instead.
+func RegisterRetentionHandlerServer(ctx context.Context, mux *runtime.ServeMux, server RetentionServer) error {
+
+	mux.Handle("GET", pattern_Retention_EarliestHeights_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Retention_EarliestHeights_0(rctx, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_Retention_EarliestHeights_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
+// RegisterRetentionHandlerFromEndpoint is same as RegisterRetentionHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterRetentionHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+
+	return RegisterRetentionHandler(ctx, mux, conn)
+}
+
+// RegisterRetentionHandler registers the http handlers for service Retention to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterRetentionHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterRetentionHandlerClient(ctx, mux, NewRetentionClient(conn))
+}
+
+// RegisterRetentionHandlerClient registers the http handlers for service Retention
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "RetentionClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "RetentionClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "RetentionClient" to call the correct interceptors.
+func RegisterRetentionHandlerClient(ctx context.Context, mux *runtime.ServeMux, client RetentionClient) error {
+
+	mux.Handle("GET", pattern_Retention_EarliestHeights_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Retention_EarliestHeights_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_Retention_EarliestHeights_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
+var (
+	pattern_Retention_EarliestHeights_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"celestia", "core", "v1", "retention", "earliest_heights"}, "", runtime.AssumeColonVerbOpt(false)))
+)
+
+var (
+	forward_Retention_EarliestHeights_0 = runtime.ForwardResponseMessage
+)
@@ -0,0 +1,117 @@
+package retention_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/retention"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+func TestValidateProofRetention(t *testing.T) {
+	type testCase struct {
+		name               string
+		proofRetainHeights uint64
+		minRetainBlocks    uint64
+		wantErr            bool
+	}
+	testCases := []testCase{
+		{"no proof retention", 0, 10, false},
+		{"pruning disabled", 1000, 0, false},
+		{"retains exactly the proof heights", 1000, 1000, false},
+		{"retains more than the proof heights", 1000, 2000, false},
+		{"prunes proof heights", 1000, 999, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := retention.NewConfig(mapAppOptions{
+				retention.FlagProofRetainHeights: tc.proofRetainHeights,
+				server.FlagMinRetainBlocks:       tc.minRetainBlocks,
+			}).Validate()
+			if tc.wantErr {
+				assert.ErrorContains(t, err, server.FlagMinRetainBlocks)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRetainHeight(t *testing.T) {
+	testCases := []struct {
+		name         string
+		config       retention.Config
+		retainHeight int64
+		windowBlocks uint64
+		want         int64
+	}{
+		{"pruning disabled", retention.Config{ProofRetainHeights: 100}, 0, 400, 0},
+		{"nothing to retain", retention.Config{}, 900, 0, 900},
+		{"retains more than the proof heights", retention.Config{ProofRetainHeights: 50}, 900, 0, 900},
+		{"retains the proof heights", retention.Config{ProofRetainHeights: 500}, 900, 0, 500},
+		{"retains the Blobstream window", retention.Config{ProofRetainHeights: 50}, 900, 800, 200},
+		{"retains all heights", retention.Config{ProofRetainHeights: 2000}, 900, 0, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.config.RetainHeight(1000, tc.retainHeight, tc.windowBlocks))
+		})
+	}
+}
+
+func TestEarliestHeights(t *testing.T) {
+	config := retention.Config{MinRetainBlocks: 100, ProofRetainHeights: 50}
+	pruneNothing := pruningtypes.NewPruningOptions(pruningtypes.PruningNothing)
+	pruneEverything := pruningtypes.NewPruningOptions(pruningtypes.PruningEverything)
+
+	res := config.EarliestHeights(10, 1000, pruneNothing)
+	assert.Equal(t, &retention.EarliestHeightsResponse{
+		LatestHeight:        1000,
+		EarliestBlockHeight: 10,
+		EarliestBlobHeight:  10,
+		EarliestStateHeight: 1,
+		ProofRetainHeights:  50,
+	}, res)
+
+	res = config.EarliestHeights(950, 1000, pruneEverything)
+	assert.Equal(t, int64(950), res.EarliestBlobHeight)
+	assert.Equal(t, int64(998), res.EarliestStateHeight)
+}
+
+func TestEarliestHeightsQuery(t *testing.T) {
+	config := retention.Config{MinRetainBlocks: 100}
+	pruning := pruningtypes.NewPruningOptions(pruningtypes.PruningNothing)
+
+	client := statusClient{status: &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{EarliestBlockHeight: 1, LatestBlockHeight: 1000}}}
+	res, err := retention.NewRetentionServer(client, config, pruning).EarliestHeights(context.Background(), &retention.EarliestHeightsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, config.EarliestHeights(1, 1000, pruning), res)
+
+	_, err = retention.NewRetentionServer(statusClient{err: errors.New("unavailable")}, config, pruning).EarliestHeights(context.Background(), &retention.EarliestHeightsRequest{})
+	assert.Error(t, err)
+
+	_, err = retention.NewRetentionServer(nil, config, pruning).EarliestHeights(context.Background(), &retention.EarliestHeightsRequest{})
+	assert.Error(t, err)
+}
+
+type statusClient struct {
+	status *coretypes.ResultStatus
+	err    error
+}
+
+func (c statusClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return c.status, c.err
+}
+
+type mapAppOptions map[string]interface{}
+
+func (o mapAppOptions) Get(key string) interface{} {
+	return o[key]
+}
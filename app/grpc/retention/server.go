@@ -0,0 +1,56 @@
+package retention
+
+import (
+	"context"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterRetentionService registers the retention service on the gRPC
+// router.
+func RegisterRetentionService(qrt gogogrpc.Server, client rpcclient.StatusClient, config Config, statePruning pruningtypes.PruningOptions) {
+	RegisterRetentionServer(qrt, NewRetentionServer(client, config, statePruning))
+}
+
+// RegisterGRPCGatewayRoutes mounts the retention service's GRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterRetentionHandlerClient(context.Background(), mux, NewRetentionClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ RetentionServer = &retentionServer{}
+
+type retentionServer struct {
+	client       rpcclient.StatusClient
+	config       Config
+	statePruning pruningtypes.PruningOptions
+}
+
+// NewRetentionServer returns a server of the earliest heights of the block
+// store that client reports.
+func NewRetentionServer(client rpcclient.StatusClient, config Config, statePruning pruningtypes.PruningOptions) RetentionServer {
+	return &retentionServer{client: client, config: config, statePruning: statePruning}
+}
+
+// EarliestHeights implements the RetentionServer.EarliestHeights method.
+func (s *retentionServer) EarliestHeights(ctx context.Context, req *EarliestHeightsRequest) (*EarliestHeightsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.client == nil {
+		return nil, status.Error(codes.Unavailable, "the node doesn't run Tendermint")
+	}
+	res, err := s.client.Status(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return s.config.EarliestHeights(res.SyncInfo.EarliestBlockHeight, res.SyncInfo.LatestBlockHeight, s.statePruning), nil
+}
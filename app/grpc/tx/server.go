@@ -4,23 +4,33 @@ import (
 	"context"
 	"encoding/hex"
 
+	celestiamempool "github.com/celestiaorg/celestia-app/v3/app/grpc/mempool"
+	blobkeeper "github.com/celestiaorg/celestia-app/v3/x/blob/keeper"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	core "github.com/tendermint/tendermint/rpc/core"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 )
 
+// TxStatusRejected is the status of a tx that the mempool of the node
+// rejected when it was submitted. The other statuses are those of
+// celestia-core.
+const TxStatusRejected = "REJECTED"
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	rejections *celestiamempool.RejectionLog,
 ) {
 	RegisterTxServer(
 		qrt,
-		NewTxServer(clientCtx, interfaceRegistry),
+		NewTxServer(clientCtx, interfaceRegistry, rejections),
 	)
 }
 
@@ -38,16 +48,28 @@ var _ TxServer = &txServer{}
 type txServer struct {
 	clientCtx         client.Context
 	interfaceRegistry codectypes.InterfaceRegistry
+	uploads           *uploadStore
+	// rejections are the recent rejections of the mempool of the node, which
+	// tell why txs were dropped.
+	rejections *celestiamempool.RejectionLog
+	proofs     blobtypes.ProofQueryServer
 }
 
-func NewTxServer(clientCtx client.Context, interfaceRegistry codectypes.InterfaceRegistry) TxServer {
+func NewTxServer(clientCtx client.Context, interfaceRegistry codectypes.InterfaceRegistry, rejections *celestiamempool.RejectionLog) TxServer {
 	return &txServer{
 		clientCtx:         clientCtx,
 		interfaceRegistry: interfaceRegistry,
+		uploads:           newUploadStore(),
+		rejections:        rejections,
+		proofs:            blobkeeper.NewProofQueryServer(clientCtx),
 	}
 }
 
-// TxStatus implements the TxServer.TxStatus method proxying to the underlying celestia-core RPC server
+// TxStatus implements the TxServer.TxStatus method proxying to the underlying
+// celestia-core RPC server. It adds the share range of committed txs and the
+// reason why evicted txs were dropped. A tx that celestia-core doesn't know but
+// that the app rejected when it was rechecked after a block is reported as
+// evicted, and one that the app rejected when it was submitted as rejected.
 func (s *txServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatusResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
@@ -72,11 +94,58 @@ func (s *txServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatu
 		return nil, err
 	}
 
-	return &TxStatusResponse{
+	res := &TxStatusResponse{
 		Height:        resTx.Height,
 		Index:         resTx.Index,
 		ExecutionCode: resTx.ExecutionCode,
 		Error:         resTx.Error,
 		Status:        resTx.Status,
-	}, nil
+	}
+	switch res.Status {
+	case core.TxStatusCommitted:
+		if err := s.addShareRange(ctx, res); err != nil {
+			return nil, err
+		}
+	case core.TxStatusEvicted:
+		res.Reason = celestiamempool.ReasonMempoolEviction
+	case core.TxStatusUnknown:
+		s.addRejection(res, hex.EncodeToString(txID))
+	}
+	return res, nil
+}
+
+// addShareRange sets the share range of the committed tx of res. The range is
+// left empty if the node pruned the blob data of the block.
+func (s *txServer) addShareRange(ctx context.Context, res *TxStatusResponse) error {
+	ranges, err := s.proofs.TxShareRanges(ctx, &blobtypes.QueryTxShareRangesRequest{Height: res.Height})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if int(res.Index) >= len(ranges.Ranges) {
+		return status.Errorf(codes.Internal, "tx index %d is out of range of the %d txs of height %d", res.Index, len(ranges.Ranges), res.Height)
+	}
+	res.ShareStart = ranges.Ranges[res.Index].StartShare
+	res.ShareEnd = ranges.Ranges[res.Index].EndShare
+	return nil
+}
+
+// addRejection sets the status of the unknown tx of res with the hex encoded
+// txHash to evicted or rejected if the mempool rejected it recently.
+func (s *txServer) addRejection(res *TxStatusResponse, txHash string) {
+	if s.rejections == nil {
+		return
+	}
+	rejection, found := s.rejections.Find(txHash)
+	if !found {
+		return
+	}
+	res.Status = TxStatusRejected
+	if rejection.Evicted {
+		res.Status = core.TxStatusEvicted
+	}
+	res.Reason = rejection.Reason
+	res.Error = rejection.Log
 }
@@ -0,0 +1,88 @@
+package tx
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	celestiamempool "github.com/celestiaorg/celestia-app/v3/app/grpc/mempool"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	core "github.com/tendermint/tendermint/rpc/core"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestTxStatus(t *testing.T) {
+	txs := testfactory.GenerateRandomTxs(5, 700).ToSliceOfBytes()
+	block := &tmtypes.Block{
+		Header: tmtypes.Header{Version: tmversion.Consensus{App: appconsts.LatestVersion}},
+		Data:   tmtypes.Data{Txs: tmtypes.ToTxs(txs)},
+	}
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion), txs...)
+	require.NoError(t, err)
+	want, err := builder.FindTxShareRange(3)
+	require.NoError(t, err)
+
+	rejections := celestiamempool.NewRejectionLog(10)
+	rejections.Add(celestiamempool.Rejection{TxHash: "aa", Reason: celestiamempool.ReasonFeeTooLow, Log: "insufficient fee"})
+	rejections.Add(celestiamempool.Rejection{TxHash: "bb", Reason: celestiamempool.ReasonWrongSequence, Log: "account sequence mismatch", Evicted: true})
+
+	node := &statusNode{block: block, statuses: map[string]*coretypes.ResultTxStatus{
+		"01": {Status: core.TxStatusCommitted, Height: 10, Index: 3},
+		"02": {Status: core.TxStatusPending},
+		"03": {Status: core.TxStatusEvicted},
+	}}
+	server := NewTxServer(client.Context{}.WithClient(node), nil, rejections)
+
+	type testCase struct {
+		txID string
+		want *TxStatusResponse
+	}
+	testCases := []testCase{
+		{"01", &TxStatusResponse{Status: core.TxStatusCommitted, Height: 10, Index: 3, ShareStart: uint32(want.Start), ShareEnd: uint32(want.End)}},
+		{"02", &TxStatusResponse{Status: core.TxStatusPending}},
+		{"03", &TxStatusResponse{Status: core.TxStatusEvicted, Reason: celestiamempool.ReasonMempoolEviction}},
+		// the hash is matched case insensitively
+		{"AA", &TxStatusResponse{Status: TxStatusRejected, Reason: celestiamempool.ReasonFeeTooLow, Error: "insufficient fee"}},
+		{"bb", &TxStatusResponse{Status: core.TxStatusEvicted, Reason: celestiamempool.ReasonWrongSequence, Error: "account sequence mismatch"}},
+		{"cc", &TxStatusResponse{Status: core.TxStatusUnknown}},
+	}
+	for _, tc := range testCases {
+		res, err := server.TxStatus(context.Background(), &TxStatusRequest{TxId: tc.txID})
+		require.NoError(t, err, tc.txID)
+		assert.Equal(t, tc.want, res, tc.txID)
+	}
+
+	// the share range is left empty if the blob data is pruned
+	node.block = nil
+	res, err := server.TxStatus(context.Background(), &TxStatusRequest{TxId: "01"})
+	require.NoError(t, err)
+	assert.Equal(t, core.TxStatusCommitted, res.Status)
+	assert.Zero(t, res.ShareEnd)
+}
+
+// statusNode is a node that knows the statuses of txs by their hex encoded
+// hashes and returns block at every height.
+type statusNode struct {
+	rpcclient.Client
+	statuses map[string]*coretypes.ResultTxStatus
+	block    *tmtypes.Block
+}
+
+func (n *statusNode) TxStatus(_ context.Context, hash []byte) (*coretypes.ResultTxStatus, error) {
+	if res, ok := n.statuses[hex.EncodeToString(hash)]; ok {
+		return res, nil
+	}
+	return &coretypes.ResultTxStatus{Status: core.TxStatusUnknown}, nil
+}
+
+func (n *statusNode) Block(context.Context, *int64) (*coretypes.ResultBlock, error) {
+	return &coretypes.ResultBlock{Block: n.block}, nil
+}
@@ -0,0 +1,77 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// RegisterSDKTxService registers the tx service of the SDK on the gRPC router.
+// Unlike the SDK, which returns codes.Unknown for every error, its Simulate
+// method returns the gRPC code that an error was registered with, e.g.
+// codes.InvalidArgument for blobs that are too large, and a
+// google.rpc.ErrorInfo that describes the error. See blobtypes.GRPCStatus.
+func RegisterSDKTxService(
+	qrt gogogrpc.Server,
+	clientCtx client.Context,
+	simulate func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error),
+	interfaceRegistry codectypes.InterfaceRegistry,
+) {
+	sdktx.RegisterServiceServer(qrt, &sdkTxServer{
+		ServiceServer: authtx.NewTxServer(clientCtx, simulate, interfaceRegistry),
+		simulate:      simulate,
+	})
+}
+
+type sdkTxServer struct {
+	sdktx.ServiceServer
+	simulate func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
+}
+
+// Simulate implements the ServiceServer.Simulate method.
+func (s *sdkTxServer) Simulate(ctx context.Context, req *sdktx.SimulateRequest) (*sdktx.SimulateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid empty tx")
+	}
+
+	txBytes := req.TxBytes
+	if txBytes == nil && req.Tx != nil {
+		var err error
+		txBytes, err = proto.Marshal(req.Tx)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tx; %v", err)
+		}
+	}
+	if txBytes == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+
+	gasInfo, result, err := s.simulate(txBytes)
+	if err != nil {
+		// the message is the one of the SDK so that clients that parse it
+		// keep working.
+		msg := fmt.Sprintf("%v With gas wanted: '%d' and gas used: '%d' ", err, gasInfo.GasWanted, gasInfo.GasUsed)
+		st, ok := blobtypes.GRPCStatus(err)
+		if !ok {
+			return nil, status.Error(codes.Unknown, msg)
+		}
+		pb := st.Proto()
+		pb.Message = msg
+		return nil, status.ErrorProto(pb)
+	}
+
+	return &sdktx.SimulateResponse{
+		GasInfo: &gasInfo,
+		Result:  result,
+	}, nil
+}
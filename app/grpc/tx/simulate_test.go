@@ -0,0 +1,43 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+func TestSimulateStatus(t *testing.T) {
+	simulateErr := func(err error) *sdkTxServer {
+		return &sdkTxServer{simulate: func([]byte) (sdk.GasInfo, *sdk.Result, error) {
+			return sdk.GasInfo{GasWanted: 10, GasUsed: 5}, nil, err
+		}}
+	}
+	req := &sdktx.SimulateRequest{TxBytes: []byte("tx")}
+
+	_, err := simulateErr(blobtypes.WithDetails(
+		blobtypes.ErrBlobsTooLarge.Wrap("too many shares"),
+		map[string]string{blobtypes.MetadataSharesNeeded: "100"},
+	)).Simulate(context.Background(), req)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "too many shares: blob(s) too large With gas wanted: '10' and gas used: '5' ", st.Message())
+	info := blobtypes.ErrorInfoFromStatus(st)
+	require.NotNil(t, info)
+	assert.Equal(t, "BLOBS_TOO_LARGE", info.Reason)
+	assert.Equal(t, "100", info.Metadata[blobtypes.MetadataSharesNeeded])
+
+	// errors that aren't registered keep the status of the SDK
+	_, err = simulateErr(fmt.Errorf("panic")).Simulate(context.Background(), req)
+	assert.Equal(t, codes.Unknown, status.Code(err))
+	_, err = simulateErr(nil).Simulate(context.Background(), &sdktx.SimulateRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -86,6 +86,15 @@ type TxStatusResponse struct {
 	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	// status is the status of the transaction.
 	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// share_start and share_end are the range [share_start, share_end) of the
+	// shares of the original data square that contain a committed
+	// transaction. They are 0 if the node pruned the blob data of the block.
+	ShareStart uint32 `protobuf:"varint,6,opt,name=share_start,json=shareStart,proto3" json:"share_start,omitempty"`
+	ShareEnd   uint32 `protobuf:"varint,7,opt,name=share_end,json=shareEnd,proto3" json:"share_end,omitempty"`
+	// reason is the reason why an evicted or rejected transaction was dropped
+	// by the mempool, e.g. fee_too_low. The error log of the rejection is
+	// returned in error.
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *TxStatusResponse) Reset()         { *m = TxStatusResponse{} }
@@ -156,268 +165,2140 @@ func (m *TxStatusResponse) GetStatus() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*TxStatusRequest)(nil), "celestia.core.v1.tx.TxStatusRequest")
-	proto.RegisterType((*TxStatusResponse)(nil), "celestia.core.v1.tx.TxStatusResponse")
+func (m *TxStatusResponse) GetShareStart() uint32 {
+	if m != nil {
+		return m.ShareStart
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("celestia/core/v1/tx/tx.proto", fileDescriptor_7d8b070565b0dcb6) }
-
-var fileDescriptor_7d8b070565b0dcb6 = []byte{
-	// 337 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0xcd, 0x4a, 0x33, 0x31,
-	0x14, 0x6d, 0xfa, 0xc7, 0xf7, 0x05, 0xfa, 0x7d, 0x92, 0x8a, 0x0c, 0xa5, 0x0c, 0xa5, 0xb4, 0xd2,
-	0x8d, 0x13, 0xaa, 0x6f, 0xa0, 0xab, 0x6e, 0xc7, 0xae, 0xdc, 0x94, 0xe9, 0xcc, 0x65, 0x1a, 0xa8,
-	0x93, 0x31, 0xb9, 0x53, 0x02, 0xd2, 0x8d, 0xbe, 0x80, 0x20, 0xbe, 0x93, 0xcb, 0x82, 0x1b, 0x97,
-	0xd2, 0xfa, 0x20, 0x32, 0x99, 0xb6, 0x82, 0x14, 0x5c, 0x04, 0x72, 0xee, 0x39, 0x37, 0x27, 0xf7,
-	0x5c, 0xda, 0x0e, 0x61, 0x0e, 0x1a, 0x45, 0xc0, 0x43, 0xa9, 0x80, 0x2f, 0x86, 0x1c, 0x0d, 0x47,
-	0xe3, 0xa5, 0x4a, 0xa2, 0x64, 0xcd, 0x1d, 0xeb, 0xe5, 0xac, 0xb7, 0x18, 0x7a, 0x68, 0x5a, 0xed,
-	0x58, 0xca, 0x78, 0x0e, 0x3c, 0x48, 0x05, 0x0f, 0x92, 0x44, 0x62, 0x80, 0x42, 0x26, 0xba, 0x68,
-	0xe9, 0x9e, 0xd2, 0xff, 0x63, 0x73, 0x8d, 0x01, 0x66, 0xda, 0x87, 0xbb, 0x0c, 0x34, 0xb2, 0x26,
-	0xad, 0xa1, 0x99, 0x88, 0xc8, 0x21, 0x1d, 0x32, 0xf8, 0xeb, 0x57, 0xd1, 0x8c, 0xa2, 0xee, 0x0b,
-	0xa1, 0x47, 0xdf, 0x42, 0x9d, 0xca, 0x44, 0x03, 0x3b, 0xa1, 0xf5, 0x19, 0x88, 0x78, 0x86, 0x56,
-	0x5a, 0xf1, 0xb7, 0x88, 0x1d, 0xd3, 0x9a, 0x48, 0x22, 0x30, 0x4e, 0xb9, 0x43, 0x06, 0x0d, 0xbf,
-	0x00, 0xac, 0x4f, 0xff, 0x81, 0x81, 0x30, 0xcb, 0xed, 0x27, 0xa1, 0x8c, 0xc0, 0xa9, 0x58, 0xba,
-	0xb1, 0xaf, 0x5e, 0xc9, 0x08, 0xf2, 0x66, 0x50, 0x4a, 0x2a, 0xa7, 0x6a, 0xed, 0x0b, 0x90, 0x5b,
-	0x69, 0x6b, 0xee, 0xd4, 0x6c, 0x79, 0x8b, 0xce, 0x1f, 0x09, 0x2d, 0x8f, 0x0d, 0x5b, 0xd2, 0x3f,
-	0xbb, 0xdf, 0xb1, 0x9e, 0x77, 0x20, 0x06, 0xef, 0xc7, 0x94, 0xad, 0xfe, 0x2f, 0xaa, 0x62, 0xc4,
-	0x6e, 0xef, 0xe1, 0xed, 0xf3, 0xb9, 0xec, 0xb2, 0x36, 0x3f, 0x94, 0xfc, 0xbd, 0x0d, 0x6a, 0x79,
-	0x39, 0x7a, 0x5d, 0xbb, 0x64, 0xb5, 0x76, 0xc9, 0xc7, 0xda, 0x25, 0x4f, 0x1b, 0xb7, 0xb4, 0xda,
-	0xb8, 0xa5, 0xf7, 0x8d, 0x5b, 0xba, 0xe1, 0xb1, 0xc0, 0x59, 0x36, 0xf5, 0x42, 0x79, 0xbb, 0x7f,
-	0x41, 0xaa, 0x78, 0x7f, 0x3f, 0x0b, 0xd2, 0x94, 0xe7, 0x27, 0x56, 0x69, 0xc8, 0xd1, 0x4c, 0xeb,
-	0x76, 0x2f, 0x17, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x2f, 0x1b, 0xd1, 0xea, 0x01, 0x00,
-	0x00,
+func (m *TxStatusResponse) GetShareEnd() uint32 {
+	if m != nil {
+		return m.ShareEnd
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// TxClient is the client API for Tx service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type TxClient interface {
-	// TxStatus returns the status of a transaction. There are four possible states:
-	// - Committed
-	// - Pending
-	// - Evicted
-	// - Unknown
-	TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error)
+func (m *TxStatusResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-type txClient struct {
-	cc grpc1.ClientConn
+// SubmitBlobTxRequest is the request type for the SubmitBlobTx gRPC method.
+// The first request of a stream must either start a new upload by setting the
+// header or resume an upload by setting the upload token. Subsequent requests
+// carry chunks of the blobs.
+type SubmitBlobTxRequest struct {
+	// upload_token identifies the upload to resume.
+	UploadToken string `protobuf:"bytes,1,opt,name=upload_token,json=uploadToken,proto3" json:"upload_token,omitempty"`
+	// header starts a new upload.
+	Header *BlobTxHeader `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	// chunk is a part of a blob of the upload.
+	Chunk *BlobChunk `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
 }
 
-func NewTxClient(cc grpc1.ClientConn) TxClient {
-	return &txClient{cc}
+func (m *SubmitBlobTxRequest) Reset()         { *m = SubmitBlobTxRequest{} }
+func (m *SubmitBlobTxRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitBlobTxRequest) ProtoMessage()    {}
+func (*SubmitBlobTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{2}
 }
-
-func (c *txClient) TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error) {
-	out := new(TxStatusResponse)
-	err := c.cc.Invoke(ctx, "/celestia.core.v1.tx.Tx/TxStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SubmitBlobTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmitBlobTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmitBlobTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SubmitBlobTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitBlobTxRequest.Merge(m, src)
+}
+func (m *SubmitBlobTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmitBlobTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitBlobTxRequest.DiscardUnknown(m)
 }
 
-// TxServer is the server API for Tx service.
-type TxServer interface {
-	// TxStatus returns the status of a transaction. There are four possible states:
-	// - Committed
-	// - Pending
-	// - Evicted
-	// - Unknown
-	TxStatus(context.Context, *TxStatusRequest) (*TxStatusResponse, error)
+var xxx_messageInfo_SubmitBlobTxRequest proto.InternalMessageInfo
+
+func (m *SubmitBlobTxRequest) GetUploadToken() string {
+	if m != nil {
+		return m.UploadToken
+	}
+	return ""
 }
 
-// UnimplementedTxServer can be embedded to have forward compatible implementations.
-type UnimplementedTxServer struct {
+func (m *SubmitBlobTxRequest) GetHeader() *BlobTxHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
 }
 
-func (*UnimplementedTxServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TxStatus not implemented")
+func (m *SubmitBlobTxRequest) GetChunk() *BlobChunk {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
 }
 
-func RegisterTxServer(s grpc1.Server, srv TxServer) {
-	s.RegisterService(&_Tx_serviceDesc, srv)
+// BlobTxHeader describes a blob tx whose blobs are uploaded in chunks.
+type BlobTxHeader struct {
+	// tx is the signed sdk tx that contains the MsgPayForBlobs.
+	Tx []byte `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// blobs describes the blobs in the order of the MsgPayForBlobs.
+	Blobs []*BlobHeader `protobuf:"bytes,2,rep,name=blobs,proto3" json:"blobs,omitempty"`
 }
 
-func _Tx_TxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TxStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TxServer).TxStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.core.v1.tx.Tx/TxStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TxServer).TxStatus(ctx, req.(*TxStatusRequest))
+func (m *BlobTxHeader) Reset()         { *m = BlobTxHeader{} }
+func (m *BlobTxHeader) String() string { return proto.CompactTextString(m) }
+func (*BlobTxHeader) ProtoMessage()    {}
+func (*BlobTxHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{3}
+}
+func (m *BlobTxHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobTxHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobTxHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *BlobTxHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobTxHeader.Merge(m, src)
+}
+func (m *BlobTxHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobTxHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobTxHeader.DiscardUnknown(m)
 }
 
-var _Tx_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celestia.core.v1.tx.Tx",
-	HandlerType: (*TxServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TxStatus",
-			Handler:    _Tx_TxStatus_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "celestia/core/v1/tx/tx.proto",
+var xxx_messageInfo_BlobTxHeader proto.InternalMessageInfo
+
+func (m *BlobTxHeader) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
 }
 
-func (m *TxStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlobTxHeader) GetBlobs() []*BlobHeader {
+	if m != nil {
+		return m.Blobs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TxStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BlobHeader describes a blob without its data.
+type BlobHeader struct {
+	Namespace    []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ShareVersion uint32 `protobuf:"varint,2,opt,name=share_version,json=shareVersion,proto3" json:"share_version,omitempty"`
+	Signer       []byte `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	// size is the length of the blob data in bytes.
+	Size_ uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (m *TxStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxId) > 0 {
-		i -= len(m.TxId)
-		copy(dAtA[i:], m.TxId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxId)))
-		i--
-		dAtA[i] = 0xa
+func (m *BlobHeader) Reset()         { *m = BlobHeader{} }
+func (m *BlobHeader) String() string { return proto.CompactTextString(m) }
+func (*BlobHeader) ProtoMessage()    {}
+func (*BlobHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{4}
+}
+func (m *BlobHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BlobHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobHeader.Merge(m, src)
+}
+func (m *BlobHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobHeader.DiscardUnknown(m)
 }
 
-func (m *TxStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BlobHeader proto.InternalMessageInfo
+
+func (m *BlobHeader) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TxStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BlobHeader) GetShareVersion() uint32 {
+	if m != nil {
+		return m.ShareVersion
+	}
+	return 0
 }
 
-func (m *TxStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x22
+func (m *BlobHeader) GetSigner() []byte {
+	if m != nil {
+		return m.Signer
 	}
-	if m.ExecutionCode != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionCode))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+func (m *BlobHeader) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
 	}
-	if m.Index != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+// BlobChunk is a consecutive part of the data of a blob.
+type BlobChunk struct {
+	// blob_index is the index of the blob in the header.
+	BlobIndex uint32 `protobuf:"varint,1,opt,name=blob_index,json=blobIndex,proto3" json:"blob_index,omitempty"`
+	// offset is the position of the chunk in the blob data. It must be equal
+	// to the number of bytes of the blob that have been received.
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data   []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *BlobChunk) Reset()         { *m = BlobChunk{} }
+func (m *BlobChunk) String() string { return proto.CompactTextString(m) }
+func (*BlobChunk) ProtoMessage()    {}
+func (*BlobChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{5}
+}
+func (m *BlobChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *BlobChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobChunk.Merge(m, src)
+}
+func (m *BlobChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobChunk proto.InternalMessageInfo
+
+func (m *BlobChunk) GetBlobIndex() uint32 {
+	if m != nil {
+		return m.BlobIndex
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BlobChunk) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *TxStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *BlobChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	var l int
-	_ = l
-	l = len(m.TxId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// SubmitBlobTxResponse is the response type for the SubmitBlobTx gRPC method.
+type SubmitBlobTxResponse struct {
+	// upload_token identifies the upload. It is the hex encoded hash of the tx
+	// in the header.
+	UploadToken string `protobuf:"bytes,1,opt,name=upload_token,json=uploadToken,proto3" json:"upload_token,omitempty"`
+	// received is the number of bytes received of each blob.
+	Received []uint64 `protobuf:"varint,2,rep,packed,name=received,proto3" json:"received,omitempty"`
+	// complete is true if all blobs were received and the blob tx has been
+	// broadcast. Otherwise the upload can be resumed.
+	Complete bool `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"`
+	// tx_hash, code and raw_log are the result of broadcasting the blob tx.
+	TxHash string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Code   uint32 `protobuf:"varint,5,opt,name=code,proto3" json:"code,omitempty"`
+	RawLog string `protobuf:"bytes,6,opt,name=raw_log,json=rawLog,proto3" json:"raw_log,omitempty"`
+}
+
+func (m *SubmitBlobTxResponse) Reset()         { *m = SubmitBlobTxResponse{} }
+func (m *SubmitBlobTxResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmitBlobTxResponse) ProtoMessage()    {}
+func (*SubmitBlobTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{6}
+}
+func (m *SubmitBlobTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmitBlobTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmitBlobTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *SubmitBlobTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitBlobTxResponse.Merge(m, src)
+}
+func (m *SubmitBlobTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmitBlobTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitBlobTxResponse.DiscardUnknown(m)
 }
 
-func (m *TxStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_SubmitBlobTxResponse proto.InternalMessageInfo
+
+func (m *SubmitBlobTxResponse) GetUploadToken() string {
+	if m != nil {
+		return m.UploadToken
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
+	return ""
+}
+
+func (m *SubmitBlobTxResponse) GetReceived() []uint64 {
+	if m != nil {
+		return m.Received
 	}
-	if m.Index != 0 {
-		n += 1 + sovTx(uint64(m.Index))
+	return nil
+}
+
+func (m *SubmitBlobTxResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
 	}
-	if m.ExecutionCode != 0 {
-		n += 1 + sovTx(uint64(m.ExecutionCode))
+	return false
+}
+
+func (m *SubmitBlobTxResponse) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *SubmitBlobTxResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *SubmitBlobTxResponse) GetRawLog() string {
+	if m != nil {
+		return m.RawLog
+	}
+	return ""
+}
+
+// BlobUploadStatusRequest is the request type for the BlobUploadStatus gRPC
+// method.
+type BlobUploadStatusRequest struct {
+	UploadToken string `protobuf:"bytes,1,opt,name=upload_token,json=uploadToken,proto3" json:"upload_token,omitempty"`
+}
+
+func (m *BlobUploadStatusRequest) Reset()         { *m = BlobUploadStatusRequest{} }
+func (m *BlobUploadStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*BlobUploadStatusRequest) ProtoMessage()    {}
+func (*BlobUploadStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{7}
+}
+func (m *BlobUploadStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobUploadStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobUploadStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobUploadStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobUploadStatusRequest.Merge(m, src)
+}
+func (m *BlobUploadStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobUploadStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobUploadStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobUploadStatusRequest proto.InternalMessageInfo
+
+func (m *BlobUploadStatusRequest) GetUploadToken() string {
+	if m != nil {
+		return m.UploadToken
+	}
+	return ""
+}
+
+// BlobUploadStatusResponse is the response type for the BlobUploadStatus gRPC
+// method.
+type BlobUploadStatusResponse struct {
+	// received is the number of bytes received of each blob.
+	Received []uint64 `protobuf:"varint,1,rep,packed,name=received,proto3" json:"received,omitempty"`
+}
+
+func (m *BlobUploadStatusResponse) Reset()         { *m = BlobUploadStatusResponse{} }
+func (m *BlobUploadStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*BlobUploadStatusResponse) ProtoMessage()    {}
+func (*BlobUploadStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{8}
+}
+func (m *BlobUploadStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobUploadStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobUploadStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobUploadStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobUploadStatusResponse.Merge(m, src)
+}
+func (m *BlobUploadStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobUploadStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobUploadStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobUploadStatusResponse proto.InternalMessageInfo
+
+func (m *BlobUploadStatusResponse) GetReceived() []uint64 {
+	if m != nil {
+		return m.Received
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TxStatusRequest)(nil), "celestia.core.v1.tx.TxStatusRequest")
+	proto.RegisterType((*TxStatusResponse)(nil), "celestia.core.v1.tx.TxStatusResponse")
+	proto.RegisterType((*SubmitBlobTxRequest)(nil), "celestia.core.v1.tx.SubmitBlobTxRequest")
+	proto.RegisterType((*BlobTxHeader)(nil), "celestia.core.v1.tx.BlobTxHeader")
+	proto.RegisterType((*BlobHeader)(nil), "celestia.core.v1.tx.BlobHeader")
+	proto.RegisterType((*BlobChunk)(nil), "celestia.core.v1.tx.BlobChunk")
+	proto.RegisterType((*SubmitBlobTxResponse)(nil), "celestia.core.v1.tx.SubmitBlobTxResponse")
+	proto.RegisterType((*BlobUploadStatusRequest)(nil), "celestia.core.v1.tx.BlobUploadStatusRequest")
+	proto.RegisterType((*BlobUploadStatusResponse)(nil), "celestia.core.v1.tx.BlobUploadStatusResponse")
+}
+
+func init() { proto.RegisterFile("celestia/core/v1/tx/tx.proto", fileDescriptor_7d8b070565b0dcb6) }
+
+var fileDescriptor_7d8b070565b0dcb6 = []byte{
+	// 749 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x4f, 0xdb, 0x48,
+	0x18, 0xc6, 0x21, 0x09, 0xc9, 0x9b, 0xc0, 0xa2, 0x01, 0x2d, 0x56, 0x36, 0x6b, 0xc0, 0x0b, 0xab,
+	0xac, 0xb4, 0xc4, 0x22, 0xfb, 0x21, 0xad, 0xb4, 0x27, 0x50, 0x25, 0x90, 0x7a, 0x32, 0x81, 0x43,
+	0x2f, 0xd1, 0xc4, 0x7e, 0xb1, 0x2d, 0x12, 0x8f, 0xeb, 0x99, 0x04, 0xab, 0x88, 0x4b, 0x7f, 0x41,
+	0xa5, 0xfe, 0x8a, 0xfe, 0x82, 0xfe, 0x85, 0x1e, 0x91, 0x7a, 0xa9, 0xd4, 0x4b, 0x05, 0xbd, 0xf5,
+	0x4f, 0x54, 0x33, 0x63, 0xc2, 0x47, 0x83, 0xe0, 0x60, 0xc9, 0xef, 0x33, 0xcf, 0x3c, 0xef, 0xf7,
+	0x40, 0xd3, 0xc3, 0x01, 0x72, 0x11, 0x51, 0xc7, 0x63, 0x29, 0x3a, 0xe3, 0x6d, 0x47, 0x64, 0x8e,
+	0xc8, 0xda, 0x49, 0xca, 0x04, 0x23, 0x4b, 0xd7, 0xa7, 0x6d, 0x79, 0xda, 0x1e, 0x6f, 0xb7, 0x45,
+	0xd6, 0x68, 0x06, 0x8c, 0x05, 0x03, 0x74, 0x68, 0x12, 0x39, 0x34, 0x8e, 0x99, 0xa0, 0x22, 0x62,
+	0x31, 0xd7, 0x57, 0xec, 0xdf, 0xe1, 0xa7, 0x6e, 0x76, 0x20, 0xa8, 0x18, 0x71, 0x17, 0x5f, 0x8e,
+	0x90, 0x0b, 0xb2, 0x04, 0x25, 0x91, 0xf5, 0x22, 0xdf, 0x34, 0xd6, 0x8c, 0x56, 0xd5, 0x2d, 0x8a,
+	0x6c, 0xdf, 0xb7, 0xbf, 0x19, 0xb0, 0x78, 0x43, 0xe4, 0x09, 0x8b, 0x39, 0x92, 0x9f, 0xa1, 0x1c,
+	0x62, 0x14, 0x84, 0x42, 0x51, 0x67, 0xdd, 0xdc, 0x22, 0xcb, 0x50, 0x8a, 0x62, 0x1f, 0x33, 0xb3,
+	0xb0, 0x66, 0xb4, 0xe6, 0x5d, 0x6d, 0x90, 0x4d, 0x58, 0xc0, 0x0c, 0xbd, 0x91, 0x74, 0xdf, 0xf3,
+	0x98, 0x8f, 0xe6, 0xac, 0x3a, 0x9e, 0x9f, 0xa0, 0xbb, 0xcc, 0x47, 0x79, 0x19, 0xd3, 0x94, 0xa5,
+	0x66, 0x51, 0xb9, 0xd7, 0x86, 0x74, 0xc5, 0x95, 0x73, 0xb3, 0xa4, 0xe0, 0xdc, 0x22, 0xab, 0x50,
+	0xe3, 0x21, 0x4d, 0xb1, 0xc7, 0x05, 0x4d, 0x85, 0x59, 0x56, 0x8a, 0xa0, 0xa0, 0x03, 0x89, 0x90,
+	0x5f, 0xa0, 0xaa, 0x09, 0x18, 0xfb, 0xe6, 0x9c, 0x3a, 0xae, 0x28, 0xe0, 0x59, 0xec, 0x4b, 0xd5,
+	0x14, 0x29, 0x67, 0xb1, 0x59, 0xd1, 0xaa, 0xda, 0xb2, 0xdf, 0x19, 0xb0, 0x74, 0x30, 0xea, 0x0f,
+	0x23, 0xb1, 0x33, 0x60, 0xfd, 0x6e, 0x76, 0x5d, 0x9a, 0x75, 0xa8, 0x8f, 0x92, 0x01, 0xa3, 0x7e,
+	0x4f, 0xb0, 0x13, 0x8c, 0xf3, 0x0a, 0xd5, 0x34, 0xd6, 0x95, 0x10, 0xf9, 0x4f, 0xd6, 0x84, 0xfa,
+	0x98, 0xaa, 0xe4, 0x6b, 0x9d, 0xf5, 0xf6, 0x94, 0xa6, 0xb4, 0xb5, 0xec, 0x9e, 0x22, 0xba, 0xf9,
+	0x05, 0xf2, 0x37, 0x94, 0xbc, 0x70, 0x14, 0x9f, 0xa8, 0xba, 0xd4, 0x3a, 0xd6, 0x83, 0x37, 0x77,
+	0x25, 0xcb, 0xd5, 0x64, 0xfb, 0x10, 0xea, 0xb7, 0xd5, 0xc8, 0x02, 0x14, 0x44, 0xa6, 0x22, 0xab,
+	0xbb, 0x05, 0x91, 0x91, 0x7f, 0xa0, 0xd4, 0x1f, 0xb0, 0x3e, 0x37, 0x0b, 0x6b, 0xb3, 0xad, 0x5a,
+	0x67, 0xf5, 0x41, 0xd5, 0x3c, 0x1a, 0xcd, 0xb6, 0xcf, 0x00, 0x6e, 0x40, 0xd2, 0x84, 0x6a, 0x4c,
+	0x87, 0xc8, 0x13, 0xea, 0x61, 0xae, 0x7d, 0x03, 0x90, 0xdf, 0x60, 0x5e, 0xd7, 0x78, 0x8c, 0x29,
+	0x8f, 0x58, 0x9c, 0xf7, 0xbd, 0xae, 0xc0, 0x23, 0x8d, 0xa9, 0x0e, 0x46, 0x41, 0x8c, 0xa9, 0x4a,
+	0xaf, 0xee, 0xe6, 0x16, 0x21, 0x50, 0xe4, 0xd1, 0x2b, 0x54, 0xed, 0x2e, 0xba, 0xea, 0xdf, 0x3e,
+	0x82, 0xea, 0x24, 0x4f, 0xf2, 0x2b, 0x80, 0x0c, 0xa9, 0xa7, 0x47, 0xca, 0x50, 0xd2, 0x55, 0x89,
+	0xec, 0x4b, 0x40, 0xea, 0xb2, 0xe3, 0x63, 0x8e, 0x42, 0x79, 0x2d, 0xba, 0xb9, 0x25, 0x75, 0x7d,
+	0x2a, 0x68, 0xee, 0x4d, 0xfd, 0xdb, 0xef, 0x0d, 0x58, 0xbe, 0xdb, 0xd7, 0x7c, 0x92, 0x9f, 0xd0,
+	0xd8, 0x06, 0x54, 0x52, 0xf4, 0x30, 0x1a, 0xa3, 0xaf, 0x4a, 0x59, 0x74, 0x27, 0xb6, 0x3c, 0xf3,
+	0xd8, 0x30, 0x19, 0xa0, 0xd0, 0x43, 0x5d, 0x71, 0x27, 0x36, 0x59, 0x81, 0x39, 0x91, 0xf5, 0x42,
+	0xca, 0xc3, 0x7c, 0xa2, 0xcb, 0x22, 0xdb, 0xa3, 0x3c, 0x94, 0x01, 0xaa, 0x2d, 0x28, 0xa9, 0x8c,
+	0xd4, 0xbf, 0x24, 0xa7, 0xf4, 0xb4, 0x37, 0x60, 0x81, 0x59, 0xce, 0x27, 0x92, 0x9e, 0x3e, 0x67,
+	0x81, 0xfd, 0x3f, 0xac, 0xc8, 0x90, 0x0f, 0x55, 0x40, 0x77, 0xf7, 0xf5, 0xf1, 0xd8, 0xed, 0x7f,
+	0xc1, 0xfc, 0xf1, 0x76, 0x9e, 0xfa, 0xed, 0xbc, 0x8c, 0xbb, 0x79, 0x75, 0x3e, 0x17, 0xa0, 0xd0,
+	0xcd, 0xc8, 0x39, 0x54, 0xae, 0x77, 0x9f, 0x6c, 0x4c, 0x9d, 0x9f, 0x7b, 0x6f, 0x48, 0x63, 0xf3,
+	0x11, 0x96, 0xf6, 0x6d, 0x6f, 0xbc, 0xfe, 0xf8, 0xf5, 0x6d, 0xc1, 0x22, 0x4d, 0x67, 0xda, 0xbb,
+	0x76, 0xa6, 0x9e, 0xa1, 0x73, 0x82, 0x50, 0xbf, 0xdd, 0x34, 0xd2, 0x9a, 0x2a, 0x3e, 0x65, 0x5f,
+	0x1b, 0x7f, 0x3c, 0x81, 0xa9, 0x43, 0x69, 0x19, 0x84, 0xc1, 0xe2, 0xfd, 0x22, 0x91, 0x3f, 0x1f,
+	0xdc, 0x96, 0x29, 0x9d, 0x68, 0x6c, 0x3d, 0x91, 0xad, 0x5d, 0xee, 0xec, 0x7f, 0xb8, 0xb4, 0x8c,
+	0x8b, 0x4b, 0xcb, 0xf8, 0x72, 0x69, 0x19, 0x6f, 0xae, 0xac, 0x99, 0x8b, 0x2b, 0x6b, 0xe6, 0xd3,
+	0x95, 0x35, 0xf3, 0xc2, 0x09, 0x22, 0x11, 0x8e, 0xfa, 0x6d, 0x8f, 0x0d, 0x27, 0x95, 0x61, 0x69,
+	0x30, 0xf9, 0xdf, 0xa2, 0x49, 0xe2, 0xc8, 0x2f, 0x48, 0x13, 0xcf, 0x11, 0x59, 0xbf, 0xac, 0x5e,
+	0xf3, 0xbf, 0xbe, 0x0f, 0x00, 0x4f, 0x12, 0xf8, 0xbe, 0x20, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TxClient is the client API for Tx service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TxClient interface {
+	// TxStatus returns the status of a transaction. There are five possible states:
+	// - Committed
+	// - Pending
+	// - Evicted
+	// - Rejected
+	// - Unknown
+	TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error)
+	// SubmitBlobTx accepts a signed PFB and its blobs in chunks, assembles the
+	// blob tx and broadcasts it. Blobs that exceed the message size limit of
+	// unary gRPC calls can be submitted this way. If the stream is interrupted,
+	// the upload can be resumed with the upload token from the offsets returned
+	// by BlobUploadStatus.
+	SubmitBlobTx(ctx context.Context, opts ...grpc.CallOption) (Tx_SubmitBlobTxClient, error)
+	// BlobUploadStatus returns the number of bytes of each blob that have been
+	// received for an upload.
+	BlobUploadStatus(ctx context.Context, in *BlobUploadStatusRequest, opts ...grpc.CallOption) (*BlobUploadStatusResponse, error)
+}
+
+type txClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewTxClient(cc grpc1.ClientConn) TxClient {
+	return &txClient{cc}
+}
+
+func (c *txClient) TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error) {
+	out := new(TxStatusResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.tx.Tx/TxStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txClient) SubmitBlobTx(ctx context.Context, opts ...grpc.CallOption) (Tx_SubmitBlobTxClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tx_serviceDesc.Streams[0], "/celestia.core.v1.tx.Tx/SubmitBlobTx", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &txSubmitBlobTxClient{stream}
+	return x, nil
+}
+
+type Tx_SubmitBlobTxClient interface {
+	Send(*SubmitBlobTxRequest) error
+	CloseAndRecv() (*SubmitBlobTxResponse, error)
+	grpc.ClientStream
+}
+
+type txSubmitBlobTxClient struct {
+	grpc.ClientStream
+}
+
+func (x *txSubmitBlobTxClient) Send(m *SubmitBlobTxRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *txSubmitBlobTxClient) CloseAndRecv() (*SubmitBlobTxResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(SubmitBlobTxResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *txClient) BlobUploadStatus(ctx context.Context, in *BlobUploadStatusRequest, opts ...grpc.CallOption) (*BlobUploadStatusResponse, error) {
+	out := new(BlobUploadStatusResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.tx.Tx/BlobUploadStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TxServer is the server API for Tx service.
+type TxServer interface {
+	// TxStatus returns the status of a transaction. There are five possible states:
+	// - Committed
+	// - Pending
+	// - Evicted
+	// - Rejected
+	// - Unknown
+	TxStatus(context.Context, *TxStatusRequest) (*TxStatusResponse, error)
+	// SubmitBlobTx accepts a signed PFB and its blobs in chunks, assembles the
+	// blob tx and broadcasts it. Blobs that exceed the message size limit of
+	// unary gRPC calls can be submitted this way. If the stream is interrupted,
+	// the upload can be resumed with the upload token from the offsets returned
+	// by BlobUploadStatus.
+	SubmitBlobTx(Tx_SubmitBlobTxServer) error
+	// BlobUploadStatus returns the number of bytes of each blob that have been
+	// received for an upload.
+	BlobUploadStatus(context.Context, *BlobUploadStatusRequest) (*BlobUploadStatusResponse, error)
+}
+
+// UnimplementedTxServer can be embedded to have forward compatible implementations.
+type UnimplementedTxServer struct {
+}
+
+func (*UnimplementedTxServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxStatus not implemented")
+}
+func (*UnimplementedTxServer) SubmitBlobTx(srv Tx_SubmitBlobTxServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubmitBlobTx not implemented")
+}
+func (*UnimplementedTxServer) BlobUploadStatus(ctx context.Context, req *BlobUploadStatusRequest) (*BlobUploadStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobUploadStatus not implemented")
+}
+
+func RegisterTxServer(s grpc1.Server, srv TxServer) {
+	s.RegisterService(&_Tx_serviceDesc, srv)
+}
+
+func _Tx_TxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServer).TxStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.tx.Tx/TxStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServer).TxStatus(ctx, req.(*TxStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tx_SubmitBlobTx_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TxServer).SubmitBlobTx(&txSubmitBlobTxServer{stream})
+}
+
+type Tx_SubmitBlobTxServer interface {
+	SendAndClose(*SubmitBlobTxResponse) error
+	Recv() (*SubmitBlobTxRequest, error)
+	grpc.ServerStream
+}
+
+type txSubmitBlobTxServer struct {
+	grpc.ServerStream
+}
+
+func (x *txSubmitBlobTxServer) SendAndClose(m *SubmitBlobTxResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *txSubmitBlobTxServer) Recv() (*SubmitBlobTxRequest, error) {
+	m := new(SubmitBlobTxRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Tx_BlobUploadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobUploadStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServer).BlobUploadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.tx.Tx/BlobUploadStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServer).BlobUploadStatus(ctx, req.(*BlobUploadStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Tx_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.tx.Tx",
+	HandlerType: (*TxServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TxStatus",
+			Handler:    _Tx_TxStatus_Handler,
+		},
+		{
+			MethodName: "BlobUploadStatus",
+			Handler:    _Tx_BlobUploadStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubmitBlobTx",
+			Handler:       _Tx_SubmitBlobTx_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "celestia/core/v1/tx/tx.proto",
+}
+
+func (m *TxStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ShareEnd != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ShareEnd))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ShareStart != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ShareStart))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExecutionCode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionCode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitBlobTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitBlobTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitBlobTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Chunk != nil {
+		{
+			size, err := m.Chunk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UploadToken) > 0 {
+		i -= len(m.UploadToken)
+		copy(dAtA[i:], m.UploadToken)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UploadToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobTxHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobTxHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobTxHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShareVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ShareVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Offset != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlobIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlobIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitBlobTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitBlobTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitBlobTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RawLog) > 0 {
+		i -= len(m.RawLog)
+		copy(dAtA[i:], m.RawLog)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RawLog)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Code != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Received) > 0 {
+		dAtA4 := make([]byte, len(m.Received)*10)
+		var j3 int
+		for _, num := range m.Received {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UploadToken) > 0 {
+		i -= len(m.UploadToken)
+		copy(dAtA[i:], m.UploadToken)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UploadToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobUploadStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobUploadStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobUploadStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UploadToken) > 0 {
+		i -= len(m.UploadToken)
+		copy(dAtA[i:], m.UploadToken)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UploadToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobUploadStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobUploadStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobUploadStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Received) > 0 {
+		dAtA6 := make([]byte, len(m.Received)*10)
+		var j5 int
+		for _, num := range m.Received {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TxStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *TxStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	if m.ExecutionCode != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionCode))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ShareStart != 0 {
+		n += 1 + sovTx(uint64(m.ShareStart))
+	}
+	if m.ShareEnd != 0 {
+		n += 1 + sovTx(uint64(m.ShareEnd))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmitBlobTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UploadToken)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Chunk != nil {
+		l = m.Chunk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *BlobTxHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlobHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ShareVersion != 0 {
+		n += 1 + sovTx(uint64(m.ShareVersion))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovTx(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *BlobChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlobIndex != 0 {
+		n += 1 + sovTx(uint64(m.BlobIndex))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovTx(uint64(m.Offset))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmitBlobTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UploadToken)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Received) > 0 {
+		l = 0
+		for _, e := range m.Received {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.Complete {
+		n += 2
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovTx(uint64(m.Code))
+	}
+	l = len(m.RawLog)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *BlobUploadStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UploadToken)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *BlobUploadStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Received) > 0 {
+		l = 0
+		for _, e := range m.Received {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionCode", wireType)
+			}
+			m.ExecutionCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareStart", wireType)
+			}
+			m.ShareStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareStart |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareEnd", wireType)
+			}
+			m.ShareEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareEnd |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitBlobTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitBlobTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitBlobTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &BlobTxHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Chunk == nil {
+				m.Chunk = &BlobChunk{}
+			}
+			if err := m.Chunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobTxHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobTxHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobTxHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, &BlobHeader{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersion", wireType)
+			}
+			m.ShareVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *BlobChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -440,17 +2321,55 @@ func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlobChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlobChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobIndex", wireType)
+			}
+			m.BlobIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -460,23 +2379,25 @@ func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxId = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -499,7 +2420,7 @@ func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *SubmitBlobTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -522,17 +2443,17 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubmitBlobTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubmitBlobTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadToken", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -542,16 +2463,105 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Received = append(m.Received, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Received) == 0 {
+					m.Received = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Received = append(m.Received, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
 			}
-			m.Index = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -561,16 +2571,49 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.Complete = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.ExecutionCode = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -580,14 +2623,14 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecutionCode |= uint32(b&0x7F) << shift
+				m.Code |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawLog", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -615,11 +2658,61 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.RawLog = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobUploadStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobUploadStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobUploadStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -647,7 +2740,7 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.UploadToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -670,6 +2763,132 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlobUploadStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobUploadStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobUploadStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Received = append(m.Received, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Received) == 0 {
+					m.Received = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Received = append(m.Received, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,430 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	codes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	status "google.golang.org/grpc/status"
+)
+
+const (
+	// uploadTTL is the duration after which an upload that hasn't received
+	// any data is discarded.
+	uploadTTL = 2 * time.Minute
+	// maxUploadsPerPeer is the maximum number of incomplete uploads of a
+	// single peer that are kept in memory.
+	maxUploadsPerPeer = 2
+	// maxUploadBytes is the maximum sum of the sizes of the blobs of all the
+	// incomplete uploads that are kept in memory.
+	maxUploadBytes = 16 << 20 // 16 MiB
+	// DefaultChunkSize is the default size of the chunks that blobs are
+	// split into by SubmitBlobTxInChunks.
+	DefaultChunkSize = 1 << 20 // 1 MiB
+)
+
+// SubmitBlobTx implements the TxServer.SubmitBlobTx method.
+func (s *txServer) SubmitBlobTx(stream Tx_SubmitBlobTxServer) error {
+	req, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "stream contains no requests")
+	}
+	if err != nil {
+		return err
+	}
+	appVersion, err := s.appVersion(stream.Context())
+	if err != nil {
+		return err
+	}
+	peer := peerHost(stream.Context())
+	token, err := s.uploads.open(peer, req, appVersion)
+	if err != nil {
+		return err
+	}
+
+	for {
+		if req.Chunk != nil {
+			if err := s.uploads.addChunk(peer, token, req.Chunk); err != nil {
+				return err
+			}
+		}
+		req, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// The upload is kept so that it can be resumed.
+			return err
+		}
+	}
+
+	blobTx, received, err := s.uploads.assemble(peer, token)
+	if err != nil {
+		return err
+	}
+	if blobTx == nil {
+		return stream.SendAndClose(&SubmitBlobTxResponse{UploadToken: token, Received: received})
+	}
+
+	if err := blobtypes.ValidateBlobTx(s.clientCtx.TxConfig, blobTx, appconsts.SubtreeRootThreshold(appVersion), appVersion); err != nil {
+		s.uploads.remove(token)
+		return status.Errorf(codes.InvalidArgument, "invalid blob tx: %s", err)
+	}
+	rawBlobTx, err := blobtx.MarshalBlobTx(blobTx.Tx, blobTx.Blobs...)
+	if err != nil {
+		return err
+	}
+	res, err := s.clientCtx.BroadcastTxSync(rawBlobTx)
+	if err != nil {
+		return err
+	}
+	s.uploads.remove(token)
+
+	return stream.SendAndClose(&SubmitBlobTxResponse{
+		UploadToken: token,
+		Received:    received,
+		Complete:    true,
+		TxHash:      res.TxHash,
+		Code:        res.Code,
+		RawLog:      res.RawLog,
+	})
+}
+
+// BlobUploadStatus implements the TxServer.BlobUploadStatus method.
+func (s *txServer) BlobUploadStatus(ctx context.Context, req *BlobUploadStatusRequest) (*BlobUploadStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	received, err := s.uploads.received(peerHost(ctx), req.UploadToken)
+	if err != nil {
+		return nil, err
+	}
+	return &BlobUploadStatusResponse{Received: received}, nil
+}
+
+// appVersion returns the app version of the latest block of the node, which
+// uploaded blob txs are validated with.
+func (s *txServer) appVersion(ctx context.Context) (uint64, error) {
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+	info, err := node.ABCIInfo(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return info.Response.AppVersion, nil
+}
+
+// peerHost returns the host of the peer of ctx, which the uploads are
+// accounted to. Uploads of different ports of a host share its quota.
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// SubmitBlobTxInChunks submits the signed blob tx rawBlobTx through the
+// SubmitBlobTx stream with blobs split into chunks of chunkSize bytes. If the
+// node already received parts of the blobs, e.g. because a previous call was
+// interrupted, only the remaining parts are sent.
+func SubmitBlobTxInChunks(ctx context.Context, client TxClient, rawBlobTx []byte, chunkSize int) (*SubmitBlobTxResponse, error) {
+	if chunkSize <= 0 {
+		return nil, fmt.Errorf("chunk size must be positive, got %d", chunkSize)
+	}
+	blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawBlobTx)
+	if err != nil {
+		return nil, err
+	}
+	if !isBlobTx {
+		return nil, errors.New("tx is not a blob tx")
+	}
+
+	header := &BlobTxHeader{Tx: blobTx.Tx, Blobs: make([]*BlobHeader, len(blobTx.Blobs))}
+	for i, blob := range blobTx.Blobs {
+		header.Blobs[i] = &BlobHeader{
+			Namespace:    blob.Namespace().Bytes(),
+			ShareVersion: uint32(blob.ShareVersion()),
+			Signer:       blob.Signer(),
+			Size_:        uint64(len(blob.Data())),
+		}
+	}
+	token := uploadToken(header.Tx)
+
+	first := &SubmitBlobTxRequest{Header: header}
+	offsets := make([]uint64, len(blobTx.Blobs))
+	statusRes, err := client.BlobUploadStatus(ctx, &BlobUploadStatusRequest{UploadToken: token})
+	switch {
+	case err == nil:
+		first = &SubmitBlobTxRequest{UploadToken: token}
+		copy(offsets, statusRes.Received)
+	case status.Code(err) != codes.NotFound:
+		return nil, err
+	}
+
+	stream, err := client.SubmitBlobTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(first); err != nil {
+		return nil, err
+	}
+	for i, blob := range blobTx.Blobs {
+		data := blob.Data()
+		for offset := offsets[i]; offset < uint64(len(data)); offset += uint64(chunkSize) {
+			end := min(offset+uint64(chunkSize), uint64(len(data)))
+			chunk := &BlobChunk{BlobIndex: uint32(i), Offset: offset, Data: data[offset:end]}
+			if err := stream.Send(&SubmitBlobTxRequest{Chunk: chunk}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+// upload is a blob tx whose blobs are being received from peer.
+type upload struct {
+	peer        string
+	header      *BlobTxHeader
+	data        [][]byte
+	size        uint64
+	lastUpdated time.Time
+}
+
+func (u *upload) received() []uint64 {
+	received := make([]uint64, len(u.data))
+	for i, data := range u.data {
+		received[i] = uint64(len(data))
+	}
+	return received
+}
+
+func (u *upload) complete() bool {
+	for i, blob := range u.header.Blobs {
+		if uint64(len(u.data[i])) != blob.Size_ {
+			return false
+		}
+	}
+	return true
+}
+
+// uploadStore keeps the incomplete uploads of SubmitBlobTx in memory. Each
+// peer can only access its own uploads and keep up to maxUploadsPerPeer of
+// them, and all the uploads together are limited to maxUploadBytes.
+type uploadStore struct {
+	mtx     sync.Mutex
+	uploads map[string]*upload
+	// size is the sum of the sizes of the blobs of the uploads.
+	size uint64
+	now  func() time.Time
+}
+
+func newUploadStore() *uploadStore {
+	return &uploadStore{
+		uploads: make(map[string]*upload),
+		now:     time.Now,
+	}
+}
+
+// open starts a new upload of peer from the header of req or resumes the
+// upload of its token. The header is validated against the constants of
+// appVersion. It returns the token of the upload.
+func (s *uploadStore) open(peer string, req *SubmitBlobTxRequest, appVersion uint64) (string, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.pruneExpired()
+
+	if req.Header == nil {
+		if _, err := s.get(peer, req.UploadToken); err != nil {
+			return "", err
+		}
+		return req.UploadToken, nil
+	}
+
+	if err := validateHeader(req.Header, appVersion); err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	token := uploadToken(req.Header.Tx)
+	if req.UploadToken != "" && req.UploadToken != token {
+		return "", status.Errorf(codes.InvalidArgument, "upload token %q doesn't match the tx of the header", req.UploadToken)
+	}
+	if existing, ok := s.uploads[token]; ok {
+		if existing.peer != peer {
+			return "", status.Errorf(codes.AlreadyExists, "upload %q is in progress from another peer", token)
+		}
+		if !proto.Equal(existing.header, req.Header) {
+			return "", status.Errorf(codes.FailedPrecondition, "upload %q was started with a different header", token)
+		}
+		return token, nil
+	}
+	peerUploads := 0
+	for _, u := range s.uploads {
+		if u.peer == peer {
+			peerUploads++
+		}
+	}
+	if peerUploads >= maxUploadsPerPeer {
+		return "", status.Errorf(codes.ResourceExhausted, "too many uploads in progress, max %d per peer", maxUploadsPerPeer)
+	}
+	size := uint64(0)
+	for _, blob := range req.Header.Blobs {
+		size += blob.Size_
+	}
+	if s.size+size > maxUploadBytes {
+		return "", status.Errorf(codes.ResourceExhausted, "uploads in progress exceed the budget of %d bytes", maxUploadBytes)
+	}
+	s.uploads[token] = &upload{
+		peer:        peer,
+		header:      req.Header,
+		data:        make([][]byte, len(req.Header.Blobs)),
+		size:        size,
+		lastUpdated: s.now(),
+	}
+	s.size += size
+	return token, nil
+}
+
+// get returns the upload of token. It must be called with the lock held.
+// Uploads of other peers aren't found so that peers can't interfere with or
+// learn about each other's uploads.
+func (s *uploadStore) get(peer, token string) (*upload, error) {
+	u, ok := s.uploads[token]
+	if !ok || u.peer != peer {
+		return nil, status.Errorf(codes.NotFound, "upload %q not found", token)
+	}
+	return u, nil
+}
+
+func (s *uploadStore) addChunk(peer, token string, chunk *BlobChunk) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	u, err := s.get(peer, token)
+	if err != nil {
+		return err
+	}
+	if int(chunk.BlobIndex) >= len(u.header.Blobs) {
+		return status.Errorf(codes.InvalidArgument, "blob index %d out of range, upload has %d blobs", chunk.BlobIndex, len(u.header.Blobs))
+	}
+	data := u.data[chunk.BlobIndex]
+	if chunk.Offset != uint64(len(data)) {
+		return status.Errorf(codes.FailedPrecondition, "chunk offset %d of blob %d doesn't match the %d bytes received", chunk.Offset, chunk.BlobIndex, len(data))
+	}
+	if chunk.Offset+uint64(len(chunk.Data)) > u.header.Blobs[chunk.BlobIndex].Size_ {
+		return status.Errorf(codes.InvalidArgument, "chunk exceeds the size %d of blob %d", u.header.Blobs[chunk.BlobIndex].Size_, chunk.BlobIndex)
+	}
+	u.data[chunk.BlobIndex] = append(data, chunk.Data...)
+	u.lastUpdated = s.now()
+	return nil
+}
+
+// assemble returns the blob tx of the upload and the bytes received of each
+// blob. The blob tx is nil if the upload is incomplete.
+func (s *uploadStore) assemble(peer, token string) (*blobtx.BlobTx, []uint64, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	u, err := s.get(peer, token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !u.complete() {
+		return nil, u.received(), nil
+	}
+
+	blobs := make([]*share.Blob, len(u.header.Blobs))
+	for i, header := range u.header.Blobs {
+		ns, err := share.NewNamespaceFromBytes(header.Namespace)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid namespace of blob %d: %s", i, err)
+		}
+		blobs[i], err = share.NewBlob(ns, u.data[i], uint8(header.ShareVersion), header.Signer)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid blob %d: %s", i, err)
+		}
+	}
+	return &blobtx.BlobTx{Tx: u.header.Tx, Blobs: blobs}, u.received(), nil
+}
+
+func (s *uploadStore) received(peer, token string) ([]uint64, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	u, err := s.get(peer, token)
+	if err != nil {
+		return nil, err
+	}
+	return u.received(), nil
+}
+
+func (s *uploadStore) remove(token string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.delete(token)
+}
+
+// delete must be called with the lock held.
+func (s *uploadStore) delete(token string) {
+	if u, ok := s.uploads[token]; ok {
+		s.size -= u.size
+		delete(s.uploads, token)
+	}
+}
+
+// pruneExpired must be called with the lock held.
+func (s *uploadStore) pruneExpired() {
+	for token, u := range s.uploads {
+		if s.now().Sub(u.lastUpdated) > uploadTTL {
+			s.delete(token)
+		}
+	}
+}
+
+func validateHeader(header *BlobTxHeader, appVersion uint64) error {
+	if len(header.Tx) == 0 {
+		return errors.New("header contains no tx")
+	}
+	if len(header.Blobs) == 0 {
+		return errors.New("header contains no blobs")
+	}
+	maxSize := uint64(appconsts.MaxTxSize(appVersion))
+	total := uint64(len(header.Tx))
+	for i, blob := range header.Blobs {
+		if blob.Size_ == 0 {
+			return fmt.Errorf("blob %d is empty", i)
+		}
+		if len(blob.Namespace) != share.NamespaceSize {
+			return fmt.Errorf("namespace of blob %d has %d bytes, expected %d", i, len(blob.Namespace), share.NamespaceSize)
+		}
+		total += blob.Size_
+		if total > maxSize {
+			return fmt.Errorf("blob tx exceeds the max tx size of %d bytes", maxSize)
+		}
+	}
+	return nil
+}
+
+// uploadToken returns the token of an upload, which is the hash of its tx.
+// Clients can therefore derive it from the tx if the response that included
+// it was lost.
+func uploadToken(tx []byte) string {
+	return fmt.Sprintf("%X", tmhash.Sum(tx))
+}
@@ -0,0 +1,149 @@
+package tx
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/require"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+func TestUploadStore(t *testing.T) {
+	header := &BlobTxHeader{
+		Tx: []byte("tx"),
+		Blobs: []*BlobHeader{
+			{Namespace: share.RandomBlobNamespace().Bytes(), Size_: 4},
+			{Namespace: share.RandomBlobNamespace().Bytes(), Size_: 2},
+		},
+	}
+	now := time.Now()
+	store := newUploadStore()
+	store.now = func() time.Time { return now }
+
+	token, err := store.open(testPeer, &SubmitBlobTxRequest{Header: header}, appconsts.LatestVersion)
+	require.NoError(t, err)
+	require.Equal(t, uploadToken(header.Tx), token)
+
+	require.NoError(t, store.addChunk(testPeer, token, &BlobChunk{BlobIndex: 0, Offset: 0, Data: []byte{1, 2}}))
+	// chunks must be consecutive and within the blob
+	requireCode(t, codes.FailedPrecondition, store.addChunk(testPeer, token, &BlobChunk{BlobIndex: 0, Offset: 3, Data: []byte{4}}))
+	requireCode(t, codes.InvalidArgument, store.addChunk(testPeer, token, &BlobChunk{BlobIndex: 0, Offset: 2, Data: []byte{3, 4, 5}}))
+	requireCode(t, codes.InvalidArgument, store.addChunk(testPeer, token, &BlobChunk{BlobIndex: 2, Offset: 0, Data: []byte{1}}))
+
+	blobTx, received, err := store.assemble(testPeer, token)
+	require.NoError(t, err)
+	require.Nil(t, blobTx)
+	require.Equal(t, []uint64{2, 0}, received)
+
+	// the upload is resumed by its token or by the same header
+	resumed, err := store.open(testPeer, &SubmitBlobTxRequest{UploadToken: token}, appconsts.LatestVersion)
+	require.NoError(t, err)
+	require.Equal(t, token, resumed)
+	resumed, err = store.open(testPeer, &SubmitBlobTxRequest{Header: header}, appconsts.LatestVersion)
+	require.NoError(t, err)
+	require.Equal(t, token, resumed)
+	otherHeader := *header
+	otherHeader.Blobs = header.Blobs[:1]
+	_, err = store.open(testPeer, &SubmitBlobTxRequest{Header: &otherHeader}, appconsts.LatestVersion)
+	requireCode(t, codes.FailedPrecondition, err)
+
+	require.NoError(t, store.addChunk(testPeer, token, &BlobChunk{BlobIndex: 0, Offset: 2, Data: []byte{3, 4}}))
+	require.NoError(t, store.addChunk(testPeer, token, &BlobChunk{BlobIndex: 1, Offset: 0, Data: []byte{5, 6}}))
+	blobTx, received, err = store.assemble(testPeer, token)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4, 2}, received)
+	require.Len(t, blobTx.Blobs, 2)
+	require.Equal(t, []byte{1, 2, 3, 4}, blobTx.Blobs[0].Data())
+	require.Equal(t, []byte{5, 6}, blobTx.Blobs[1].Data())
+
+	// uploads without progress expire
+	now = now.Add(uploadTTL + time.Second)
+	_, err = store.open(testPeer, &SubmitBlobTxRequest{Header: &BlobTxHeader{Tx: []byte("other"), Blobs: header.Blobs}}, appconsts.LatestVersion)
+	require.NoError(t, err)
+	_, err = store.received(testPeer, token)
+	requireCode(t, codes.NotFound, err)
+}
+
+func TestUploadStoreLimits(t *testing.T) {
+	ns := share.RandomBlobNamespace().Bytes()
+	newRequest := func(tx string, size uint64) *SubmitBlobTxRequest {
+		return &SubmitBlobTxRequest{Header: &BlobTxHeader{Tx: []byte(tx), Blobs: []*BlobHeader{{Namespace: ns, Size_: size}}}}
+	}
+	store := newUploadStore()
+
+	token, err := store.open(testPeer, newRequest("tx0", 1), appconsts.LatestVersion)
+	require.NoError(t, err)
+
+	// uploads of other peers can't be accessed
+	_, err = store.received("other", token)
+	requireCode(t, codes.NotFound, err)
+	requireCode(t, codes.NotFound, store.addChunk("other", token, &BlobChunk{Data: []byte{1}}))
+	_, err = store.open("other", &SubmitBlobTxRequest{UploadToken: token}, appconsts.LatestVersion)
+	requireCode(t, codes.NotFound, err)
+	_, err = store.open("other", newRequest("tx0", 1), appconsts.LatestVersion)
+	requireCode(t, codes.AlreadyExists, err)
+
+	// a peer can only have maxUploadsPerPeer uploads in progress
+	for i := 1; i < maxUploadsPerPeer; i++ {
+		_, err = store.open(testPeer, newRequest(fmt.Sprintf("tx%d", i), 1), appconsts.LatestVersion)
+		require.NoError(t, err)
+	}
+	_, err = store.open(testPeer, newRequest("one too many", 1), appconsts.LatestVersion)
+	requireCode(t, codes.ResourceExhausted, err)
+
+	// all the uploads together are limited to maxUploadBytes
+	maxSize := uint64(appconsts.MaxTxSize(appconsts.LatestVersion)) / 2
+	opened := 0
+	for i := 0; ; i++ {
+		_, err = store.open(fmt.Sprintf("peer%d", i), newRequest(fmt.Sprintf("large%d", i), maxSize), appconsts.LatestVersion)
+		if err != nil {
+			requireCode(t, codes.ResourceExhausted, err)
+			break
+		}
+		opened++
+	}
+	require.LessOrEqual(t, uint64(opened)*maxSize, uint64(maxUploadBytes))
+
+	// removing an upload frees its share of the budget
+	size := store.size
+	store.remove(token)
+	require.Equal(t, size-1, store.size)
+}
+
+func TestValidateHeader(t *testing.T) {
+	ns := share.RandomBlobNamespace().Bytes()
+	tests := []struct {
+		name    string
+		header  *BlobTxHeader
+		wantErr bool
+	}{
+		{"valid", &BlobTxHeader{Tx: []byte("tx"), Blobs: []*BlobHeader{{Namespace: ns, Size_: 1}}}, false},
+		{"no tx", &BlobTxHeader{Blobs: []*BlobHeader{{Namespace: ns, Size_: 1}}}, true},
+		{"no blobs", &BlobTxHeader{Tx: []byte("tx")}, true},
+		{"empty blob", &BlobTxHeader{Tx: []byte("tx"), Blobs: []*BlobHeader{{Namespace: ns}}}, true},
+		{"short namespace", &BlobTxHeader{Tx: []byte("tx"), Blobs: []*BlobHeader{{Namespace: ns[1:], Size_: 1}}}, true},
+		{"too large", &BlobTxHeader{Tx: []byte("tx"), Blobs: []*BlobHeader{{Namespace: ns, Size_: 1 << 30}}}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateHeader(tc.header, appconsts.LatestVersion)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+const testPeer = "127.0.0.1"
+
+func requireCode(t *testing.T, code codes.Code, err error) {
+	t.Helper()
+	require.Error(t, err)
+	require.Equal(t, code, status.Code(err))
+}
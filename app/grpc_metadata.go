@@ -0,0 +1,81 @@
+package app
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// AppVersionHeader is the gRPC response header that contains the app
+	// version of the latest committed state of the node.
+	AppVersionHeader = "x-celestia-app-version"
+	// ChainIDHeader is the gRPC response header that contains the chain ID of
+	// the node.
+	ChainIDHeader = "x-celestia-chain-id"
+	// ConstantsDigestHeader is the gRPC response header that contains the
+	// digest of the versioned constants for the reported app version. See
+	// appconsts.ConstantsDigest.
+	ConstantsDigestHeader = "x-celestia-constants-digest"
+)
+
+// RegisterGRPCServer registers the gRPC query services with the gRPC server.
+// It behaves like the BaseApp implementation but additionally attaches the app
+// version, chain ID and constants digest to the headers of every response so
+// that clients can adapt to network upgrades that change wire formats.
+func (app *App) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(&versionMetadataServer{Server: server, app: app})
+}
+
+// versionMetadataServer wraps a gRPC server and sets the version metadata
+// headers before invoking the handler of each registered unary method.
+type versionMetadataServer struct {
+	gogogrpc.Server
+	app *App
+}
+
+func (s *versionMetadataServer) RegisterService(desc *grpc.ServiceDesc, handler interface{}) {
+	methods := make([]grpc.MethodDesc, len(desc.Methods))
+	for i, method := range desc.Methods {
+		methodHandler := method.Handler
+		methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				if err := grpc.SetHeader(ctx, s.app.versionMetadata()); err != nil {
+					s.app.Logger().Error("failed to set gRPC header", "err", err)
+				}
+				return methodHandler(srv, ctx, dec, interceptor)
+			},
+		}
+	}
+
+	s.Server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: desc.ServiceName,
+		HandlerType: desc.HandlerType,
+		Methods:     methods,
+		Streams:     desc.Streams,
+		Metadata:    desc.Metadata,
+	}, handler)
+}
+
+// versionMetadata returns the version metadata headers of the latest
+// committed state.
+func (app *App) versionMetadata() metadata.MD {
+	md := metadata.MD{}
+	ctx, err := app.CreateQueryContext(0, false)
+	if err != nil {
+		return md
+	}
+	appVersion := ctx.BlockHeader().Version.App
+	if appVersion == 0 {
+		appVersion = app.AppVersion()
+	}
+	md.Set(AppVersionHeader, strconv.FormatUint(appVersion, 10))
+	md.Set(ChainIDHeader, ctx.ChainID())
+	md.Set(ConstantsDigestHeader, appconsts.ConstantsDigest(appVersion))
+	return md
+}
@@ -0,0 +1,100 @@
+package app
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/streaming"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+// FlagExportAtHalt is the flag to specify the file that the state is exported
+// to as a genesis file when the node reaches the halt height. No state is
+// exported if the flag is empty or no halt height is set.
+const FlagExportAtHalt = "export-at-halt"
+
+var _ baseapp.StreamingService = (*haltExporter)(nil)
+
+// haltExporter is a streaming service that exports the committed state of the
+// halt height before the node shuts down. It allows operators of a
+// coordinated upgrade to keep a snapshot of the state prior to the upgrade
+// without having to export it manually after the halt. A failed export is
+// logged and doesn't prevent the node from halting as scheduled.
+type haltExporter struct {
+	streaming.BaseService
+
+	app        *App
+	haltHeight int64
+	path       string
+	exported   bool
+}
+
+func newHaltExporter(app *App, haltHeight int64, path string) *haltExporter {
+	return &haltExporter{app: app, haltHeight: haltHeight, path: path}
+}
+
+// ListenCommit implements baseapp.ABCIListener. The node halts at the first
+// commit at or above the halt height so the state is exported at that commit.
+func (e *haltExporter) ListenCommit(ctx context.Context, _ abci.ResponseCommit) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if e.exported || sdkCtx.BlockHeight() < e.haltHeight {
+		return nil
+	}
+	e.exported = true
+
+	logger := e.app.Logger().With("module", "export-at-halt")
+	logger.Info("exporting state at halt height", "height", sdkCtx.BlockHeight(), "path", e.path)
+	if err := e.export(sdkCtx); err != nil {
+		logger.Error("failed to export state at halt height", "height", sdkCtx.BlockHeight(), "err", err)
+		return nil
+	}
+	logger.Info("exported state at halt height", "height", sdkCtx.BlockHeight(), "path", e.path)
+	return nil
+}
+
+func (e *haltExporter) export(ctx sdk.Context) error {
+	exported, err := e.app.ExportAppStateAndValidators(false, nil)
+	if err != nil {
+		return err
+	}
+
+	doc := &coretypes.GenesisDoc{
+		GenesisTime:   ctx.BlockTime(),
+		ChainID:       ctx.ChainID(),
+		InitialHeight: exported.Height,
+		AppState:      exported.AppState,
+		Validators:    exported.Validators,
+		ConsensusParams: &tmproto.ConsensusParams{
+			Block: tmproto.BlockParams{
+				MaxBytes:   exported.ConsensusParams.Block.MaxBytes,
+				MaxGas:     exported.ConsensusParams.Block.MaxGas,
+				TimeIotaMs: DefaultBlockParams().TimeIotaMs,
+			},
+			Evidence: tmproto.EvidenceParams{
+				MaxAgeNumBlocks: exported.ConsensusParams.Evidence.MaxAgeNumBlocks,
+				MaxAgeDuration:  exported.ConsensusParams.Evidence.MaxAgeDuration,
+				MaxBytes:        exported.ConsensusParams.Evidence.MaxBytes,
+			},
+			Validator: tmproto.ValidatorParams{
+				PubKeyTypes: exported.ConsensusParams.Validator.PubKeyTypes,
+			},
+			Version: tmproto.VersionParams{
+				AppVersion: exported.ConsensusParams.GetVersion().GetAppVersion(),
+			},
+		},
+	}
+	bz, err := tmjson.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(e.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(e.path, bz, 0o644)
+}
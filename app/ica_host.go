@@ -1,5 +1,22 @@
 package app
 
+import (
+	"fmt"
+	"slices"
+
+	"github.com/celestiaorg/celestia-app/v3/app/ante"
+	"github.com/celestiaorg/celestia-app/v3/x/icahostfilter"
+	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+)
+
+// icaAllowMessages returns the default genesis value of the icahost
+// AllowMessages param. The param can be changed by a param change proposal,
+// see icaAllowMessagesBoundedParam for the values that governance can set.
 func icaAllowMessages() []string {
 	return []string{
 		"/ibc.applications.transfer.v1.MsgTransfer",
@@ -16,3 +33,89 @@ func icaAllowMessages() []string {
 		"/cosmos.feegrant.v1beta1.MsgRevokeAllowance",
 	}
 }
+
+// icaAllowMessagesBoundedParam restricts the icahost AllowMessages param that
+// governance can set to an explicit list of distinct messages that the app
+// can route. The wildcard that allows all messages is rejected, as is a typo
+// in a type URL, which would otherwise only surface when a host tx fails. The
+// restriction applies from app version 4 onwards.
+func (app *App) icaAllowMessagesBoundedParam() paramfilter.BoundedParam {
+	return paramfilter.BoundedParam{
+		Subspace:    icahosttypes.SubModuleName,
+		Key:         string(icahosttypes.KeyAllowMessages),
+		FromVersion: v4,
+		Check: func(value string) error {
+			var allowMessages []string
+			if err := codec.NewLegacyAmino().UnmarshalJSON([]byte(value), &allowMessages); err != nil {
+				return err
+			}
+			seen := make(map[string]bool, len(allowMessages))
+			for _, typeURL := range allowMessages {
+				if typeURL == icahosttypes.AllowAllHostMsgs {
+					return fmt.Errorf("allowing all messages with %q is not supported", icahosttypes.AllowAllHostMsgs)
+				}
+				if seen[typeURL] {
+					return fmt.Errorf("duplicate message %s", typeURL)
+				}
+				seen[typeURL] = true
+				if app.MsgServiceRouter().HandlerByTypeURL(typeURL) == nil {
+					return fmt.Errorf("unknown message %s", typeURL)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// icaConnectionAllowlistsBoundedParam restricts the allowlists of the
+// connections that governance can set in the icahostfilter module to valid
+// allowlists of messages that the app can route.
+func (app *App) icaConnectionAllowlistsBoundedParam() paramfilter.BoundedParam {
+	return paramfilter.BoundedParam{
+		Subspace: icahostfilter.ModuleName,
+		Key:      string(icahostfilter.KeyConnectionAllowlists),
+		Check: func(value string) error {
+			var allowlists []icahostfilter.ConnectionAllowlist
+			if err := codec.NewLegacyAmino().UnmarshalJSON([]byte(value), &allowlists); err != nil {
+				return err
+			}
+			if err := icahostfilter.ValidateConnectionAllowlists(allowlists); err != nil {
+				return err
+			}
+			for _, allowlist := range allowlists {
+				for _, typeURL := range allowlist.AllowMessages {
+					if app.MsgServiceRouter().HandlerByTypeURL(typeURL) == nil {
+						return fmt.Errorf("unknown message %s", typeURL)
+					}
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// icaHostMsgRouter returns the router that the ICA host uses to execute the
+// messages of interchain accounts. It filters them in ante.ExecModeICA before
+// they are handled, since they never pass through the ante handler.
+func (app *App) icaHostMsgRouter() icatypes.MessageRouter {
+	return ante.NewMsgFilterDecorator(icaHostAllowlistFilter(&app.ICAHostKeeper)).ICAMsgRouter(app.MsgServiceRouter())
+}
+
+// icaHostAllowlistFilter returns a filter that rejects the messages of
+// interchain accounts that are not in the allowlist of the ICA host. The ICA
+// host only checks the messages of a tx against its allowlist, while the
+// filter decorator also checks the messages nested in an authz MsgExec, so an
+// interchain account can't execute any message by wrapping it. The filter
+// applies from app version 4 onwards.
+func icaHostAllowlistFilter(hostKeeper icahostfilter.HostKeeper) ante.MsgFilterFunc {
+	return func(ctx sdk.Context, mode ante.ExecMode, msgTypeURL string) error {
+		if mode != ante.ExecModeICA || ctx.BlockHeader().Version.App < v4 {
+			return nil
+		}
+		allowMessages := hostKeeper.GetAllowMessages(ctx)
+		if slices.Contains(allowMessages, icahosttypes.AllowAllHostMsgs) || slices.Contains(allowMessages, msgTypeURL) {
+			return nil
+		}
+		return sdkerrors.ErrUnauthorized.Wrapf("message type %s is not allowed in %s", msgTypeURL, mode)
+	}
+}
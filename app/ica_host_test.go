@@ -3,7 +3,18 @@ package app
 import (
 	"testing"
 
+	"github.com/celestiaorg/celestia-app/v3/app/ante"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
 )
 
 func Test_icaAllowMessages(t *testing.T) {
@@ -24,3 +35,65 @@ func Test_icaAllowMessages(t *testing.T) {
 	}
 	assert.Equal(t, want, got)
 }
+
+func Test_icaHostAllowlistFilter(t *testing.T) {
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	nestedVote := authz.NewMsgExec(sdk.AccAddress{}, []sdk.Msg{&govv1.MsgVote{}})
+	nestedSend := authz.NewMsgExec(sdk.AccAddress{}, []sdk.Msg{&banktypes.MsgSend{}})
+	execURL := sdk.MsgTypeURL(&nestedSend)
+
+	testCases := []struct {
+		name          string
+		allowMessages []string
+		appVersion    uint64
+		msg           sdk.Msg
+		wantErr       bool
+	}{
+		{"allowed message", []string{sendURL}, v4, &banktypes.MsgSend{}, false},
+		{"message not allowed", []string{execURL}, v4, &banktypes.MsgSend{}, true},
+		{"allowed nested message", []string{execURL, sendURL}, v4, &nestedSend, false},
+		{"nested message not allowed", []string{execURL, sendURL}, v4, &nestedVote, true},
+		{"all messages allowed", []string{icahosttypes.AllowAllHostMsgs}, v4, &nestedVote, false},
+		{"nested message not allowed before v4", []string{execURL, sendURL}, v3, &nestedVote, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := icaHostAllowlistFilter(stubHostKeeper{allowMessages: tc.allowMessages})
+			router := ante.NewMsgFilterDecorator(filter).ICAMsgRouter(stubMsgRouter{})
+			ctx := sdk.NewContext(nil, tmproto.Header{Version: version.Consensus{App: tc.appVersion}}, false, nil)
+
+			handler := router.Handler(tc.msg)
+			require.NotNil(t, handler)
+			_, err := handler(ctx, tc.msg)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_icaHostAllowlistFilterOutsideICA(t *testing.T) {
+	filter := icaHostAllowlistFilter(stubHostKeeper{})
+	ctx := sdk.NewContext(nil, tmproto.Header{Version: version.Consensus{App: v4}}, false, nil)
+	assert.NoError(t, filter(ctx, ante.ExecModeDeliverTx, sdk.MsgTypeURL(&banktypes.MsgSend{})))
+}
+
+type stubHostKeeper struct {
+	allowMessages []string
+}
+
+func (k stubHostKeeper) GetAllowMessages(sdk.Context) []string {
+	return k.allowMessages
+}
+
+// stubMsgRouter routes every message to a handler that always succeeds.
+type stubMsgRouter struct{}
+
+func (stubMsgRouter) Handler(sdk.Msg) baseapp.MsgServiceHandler {
+	return func(sdk.Context, sdk.Msg) (*sdk.Result, error) {
+		return &sdk.Result{}, nil
+	}
+}
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxPFBMessages returns the max number of PFB messages that PrepareProposal
+// includes in a block. It is the lower of the soft limit of
+// appconsts.MaxPFBMessages and the MaxPFBsPerBlock param, if set. The param
+// only applies from app version 4 onwards.
+func (app *App) MaxPFBMessages(ctx sdk.Context) int {
+	if paramMax := app.maxPFBsPerBlock(ctx); paramMax > 0 {
+		return min(paramMax, appconsts.MaxPFBMessages)
+	}
+	return appconsts.MaxPFBMessages
+}
+
+// maxPFBsPerBlock returns the MaxPFBsPerBlock param in the app versions that
+// have it and 0, i.e. no limit, in the others.
+func (app *App) maxPFBsPerBlock(ctx sdk.Context) int {
+	if app.AppVersion() < v4 {
+		return 0
+	}
+	return int(app.BlobKeeper.MaxPFBsPerBlock(ctx))
+}
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"encoding/hex"
+	"time"
+
+	"cosmossdk.io/errors"
+	metrics "github.com/armon/go-metrics"
+	apperr "github.com/celestiaorg/celestia-app/v3/app/errors"
+	celestiamempool "github.com/celestiaorg/celestia-app/v3/app/grpc/mempool"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// rejectionReasons maps the errors of CheckTx to the reasons of the
+// rejections. The errors of x/blob that aren't listed are counted as
+// ReasonInvalidBlobTx and all other errors as ReasonOther.
+var rejectionReasons = []struct {
+	err    *errors.Error
+	reason string
+}{
+	{sdkerrors.ErrInsufficientFee, celestiamempool.ReasonFeeTooLow},
+	{blobtypes.ErrBlobFeeBudgetExceeded, celestiamempool.ReasonOverBlobBudget},
+	{blobtypes.ErrOutsideInclusionWindow, celestiamempool.ReasonExpired},
+	{sdkerrors.ErrInsufficientFunds, celestiamempool.ReasonInsufficientFunds},
+	{sdkerrors.ErrOutOfGas, celestiamempool.ReasonOutOfGas},
+	{sdkerrors.ErrWrongSequence, celestiamempool.ReasonWrongSequence},
+	{sdkerrors.ErrTxTooLarge, celestiamempool.ReasonTxTooLarge},
+	{apperr.ErrTxExceedsMaxSize, celestiamempool.ReasonTxTooLarge},
+	{blobtypes.ErrTotalBlobSizeTooLarge, celestiamempool.ReasonBlobsTooLarge},
+	{blobtypes.ErrBlobsTooLarge, celestiamempool.ReasonBlobsTooLarge},
+}
+
+// rejectionReason returns the reason of the rejection of a tx with the error
+// of res.
+func rejectionReason(res abci.ResponseCheckTx) string {
+	for _, r := range rejectionReasons {
+		if res.Codespace == r.err.Codespace() && res.Code == r.err.ABCICode() {
+			return r.reason
+		}
+	}
+	if res.Codespace == blobtypes.ModuleName {
+		return celestiamempool.ReasonInvalidBlobTx
+	}
+	return celestiamempool.ReasonOther
+}
+
+// observeCheckTx counts the txs that CheckTx rejects by reason and retains
+// them for the recent rejections query. A tx that fails when it is rechecked
+// is evicted from the mempool, so it counts as an eviction.
+func (app *App) observeCheckTx(req abci.RequestCheckTx, res abci.ResponseCheckTx) {
+	if res.IsOK() {
+		return
+	}
+	reason := rejectionReason(res)
+	evicted := req.Type == abci.CheckTxType_Recheck
+	key := []string{"mempool", "rejected_txs"}
+	if evicted {
+		key = []string{"mempool", "recheck_evicted_txs"}
+	}
+	telemetry.IncrCounterWithLabels(key, 1, []metrics.Label{telemetry.NewLabel("reason", reason)})
+
+	app.rejections.Add(celestiamempool.Rejection{
+		TxHash:    hex.EncodeToString(tmtypes.Tx(req.Tx).Hash()),
+		Reason:    reason,
+		Codespace: res.Codespace,
+		Code:      res.Code,
+		Log:       res.Log,
+		Evicted:   evicted,
+		Time:      time.Now().UTC(),
+	})
+}
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"testing"
+
+	"cosmossdk.io/errors"
+	apperr "github.com/celestiaorg/celestia-app/v3/app/errors"
+	celestiamempool "github.com/celestiaorg/celestia-app/v3/app/grpc/mempool"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestRejectionReason(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{errors.Wrap(sdkerrors.ErrInsufficientFee, "insufficient minimum gas price"), celestiamempool.ReasonFeeTooLow},
+		{blobtypes.ErrBlobFeeBudgetExceeded, celestiamempool.ReasonOverBlobBudget},
+		{blobtypes.ErrOutsideInclusionWindow, celestiamempool.ReasonExpired},
+		{sdkerrors.ErrWrongSequence, celestiamempool.ReasonWrongSequence},
+		{apperr.ErrTxExceedsMaxSize, celestiamempool.ReasonTxTooLarge},
+		{blobtypes.ErrBlobsTooLarge, celestiamempool.ReasonBlobsTooLarge},
+		{blobtypes.ErrInvalidShareCommitment, celestiamempool.ReasonInvalidBlobTx},
+		{sdkerrors.ErrUnauthorized, celestiamempool.ReasonOther},
+	}
+	for _, tt := range tests {
+		res := sdkerrors.ResponseCheckTxWithEvents(tt.err, 0, 0, nil, false)
+		assert.Equal(t, tt.want, rejectionReason(res), tt.err)
+	}
+}
+
+func TestObserveCheckTx(t *testing.T) {
+	app := &App{rejections: celestiamempool.NewRejectionLog(10)}
+
+	app.observeCheckTx(abci.RequestCheckTx{Tx: []byte("ok")}, abci.ResponseCheckTx{})
+	fee := sdkerrors.ResponseCheckTxWithEvents(sdkerrors.ErrInsufficientFee, 0, 0, nil, false)
+	app.observeCheckTx(abci.RequestCheckTx{Tx: []byte("fee")}, fee)
+	window := sdkerrors.ResponseCheckTxWithEvents(blobtypes.ErrOutsideInclusionWindow, 0, 0, nil, false)
+	app.observeCheckTx(abci.RequestCheckTx{Tx: []byte("window"), Type: abci.CheckTxType_Recheck}, window)
+
+	rejections := app.rejections.Recent(0, "")
+	require.Len(t, rejections, 2)
+	assert.Equal(t, celestiamempool.ReasonExpired, rejections[0].Reason)
+	assert.True(t, rejections[0].Evicted)
+	assert.Equal(t, celestiamempool.ReasonFeeTooLow, rejections[1].Reason)
+	assert.False(t, rejections[1].Evicted)
+	assert.Equal(t, fee.Log, rejections[1].Log)
+	assert.Len(t, rejections[1].TxHash, 64)
+}
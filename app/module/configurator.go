@@ -28,16 +28,24 @@ type Configurator struct {
 	acceptedMessages map[uint64]map[string]struct{}
 	// migrations is a map of moduleName -> fromVersion -> migration script handler.
 	migrations map[string]map[uint64]module.MigrationHandler
+	// registeredMsgs and registeredQueryServices are the msg type URLs and
+	// query services that are registered with the routers. A module with
+	// several consensus versions registers its services once per version but
+	// every msg and query service must only be routed once.
+	registeredMsgs          map[string]struct{}
+	registeredQueryServices map[string]struct{}
 }
 
 // NewConfigurator returns a new Configurator instance.
 func NewConfigurator(cdc codec.Codec, msgServer, queryServer pbgrpc.Server) Configurator {
 	return Configurator{
-		cdc:              cdc,
-		msgServer:        msgServer,
-		queryServer:      queryServer,
-		migrations:       map[string]map[uint64]module.MigrationHandler{},
-		acceptedMessages: map[uint64]map[string]struct{}{},
+		cdc:                     cdc,
+		msgServer:               msgServer,
+		queryServer:             queryServer,
+		migrations:              map[string]map[uint64]module.MigrationHandler{},
+		acceptedMessages:        map[uint64]map[string]struct{}{},
+		registeredMsgs:          map[string]struct{}{},
+		registeredQueryServices: map[string]struct{}{},
 	}
 }
 
@@ -52,6 +60,7 @@ func (c Configurator) MsgServer() pbgrpc.Server {
 	return &serverWrapper{
 		addMessages: c.addMessages,
 		msgServer:   c.msgServer,
+		registered:  c.registeredMsgs,
 	}
 }
 
@@ -63,7 +72,10 @@ func (c Configurator) GetAcceptedMessages() map[uint64]map[string]struct{} {
 
 // QueryServer implements the Configurator.QueryServer method.
 func (c Configurator) QueryServer() pbgrpc.Server {
-	return c.queryServer
+	return &queryServerWrapper{
+		queryServer: c.queryServer,
+		registered:  c.registeredQueryServices,
+	}
 }
 
 // RegisterMigration implements the Configurator.RegisterMigration method.
@@ -96,6 +108,28 @@ func (c Configurator) addMessages(msgs []string) {
 	}
 }
 
+// migrationSteps returns the consensus versions of moduleName from which a
+// migration is run when migrating from fromVersion to toVersion. It returns
+// the same error as runModuleMigrations if the module has no migrations.
+func (c Configurator) migrationSteps(moduleName string, fromVersion, toVersion uint64) ([]uint64, error) {
+	if toVersion <= 1 || fromVersion == toVersion {
+		return nil, nil
+	}
+
+	moduleMigrationsMap, found := c.migrations[moduleName]
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no migrations found for module %s", moduleName)
+	}
+
+	var steps []uint64
+	for i := fromVersion; i < toVersion; i++ {
+		if _, found := moduleMigrationsMap[i]; found {
+			steps = append(steps, i)
+		}
+	}
+	return steps, nil
+}
+
 // runModuleMigrations runs all in-place store migrations for one given module from a
 // version to another version.
 func (c Configurator) runModuleMigrations(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64) error {
@@ -6,6 +6,8 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/app"
 	"github.com/celestiaorg/celestia-app/v3/app/encoding"
 	"github.com/celestiaorg/celestia-app/v3/app/module"
+	"github.com/celestiaorg/celestia-app/v3/x/blob"
+	blobkeeper "github.com/celestiaorg/celestia-app/v3/x/blob/keeper"
 	"github.com/celestiaorg/celestia-app/v3/x/signal"
 	signaltypes "github.com/celestiaorg/celestia-app/v3/x/signal/types"
 	"github.com/cosmos/cosmos-sdk/store"
@@ -56,6 +58,35 @@ func TestConfigurator(t *testing.T) {
 		}, acceptedMessages)
 	})
 
+	t.Run("registers the services of several consensus versions once", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		t.Cleanup(mockCtrl.Finish)
+
+		// the msg service of every consensus version registers its new method
+		// and the query service is registered once.
+		mockServer := mocks.NewMockServer(mockCtrl)
+		mockServer.EXPECT().RegisterService(gomock.Any(), gomock.Any()).Times(3).Return()
+
+		config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+		configurator := module.NewConfigurator(config.Codec, mockServer, mockServer)
+		manager, err := module.NewManager([]module.VersionedModule{
+			{Module: blob.NewAppModuleV2(config.Codec, blobkeeper.Keeper{}), FromVersion: 1, ToVersion: 1},
+			{Module: blob.NewAppModule(config.Codec, blobkeeper.Keeper{}), FromVersion: 2, ToVersion: 2},
+		})
+		require.NoError(t, err)
+
+		manager.RegisterServices(configurator)
+		assert.Equal(t, map[uint64]map[string]struct{}{
+			1: {
+				"/celestia.blob.v1.MsgPayForBlobs": {},
+			},
+			2: {
+				"/celestia.blob.v1.MsgPayForBlobs":      {},
+				"/celestia.blob.v1.MsgSetBlobFeeBudget": {},
+			},
+		}, configurator.GetAcceptedMessages())
+	})
+
 	t.Run("register migration", func(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		t.Cleanup(mockCtrl.Finish)
@@ -269,6 +269,75 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg sdkmodule.Configurator, from
 	return nil
 }
 
+// ModuleMigration describes how the state of a module changes when the app
+// version is upgraded.
+type ModuleMigration struct {
+	Module string
+	// FromVersion and ToVersion are the consensus versions of the module
+	// before and after the upgrade. FromVersion is zero if the module is added
+	// by the upgrade, in which case its default genesis is initialized.
+	FromVersion uint64
+	ToVersion   uint64
+	// Steps are the consensus versions from which a registered migration is
+	// run.
+	Steps []uint64
+}
+
+// MigrationPlan returns the migrations that RunMigrations performs when
+// upgrading from fromVersion to toVersion without running them. Modules whose
+// consensus version doesn't change are omitted. It returns the error that
+// RunMigrations would return if a module lacks the migrations for the
+// upgrade.
+func (m Manager) MigrationPlan(cfg sdkmodule.Configurator, fromVersion, toVersion uint64) ([]ModuleMigration, error) {
+	c, ok := cfg.(Configurator)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", Configurator{}, cfg)
+	}
+	modules := m.OrderMigrations
+	if modules == nil {
+		modules = defaultMigrationsOrder(m.ModuleNames(toVersion))
+	}
+	currentVersionModules, exists := m.versionedModules[fromVersion]
+	if !exists {
+		return nil, sdkerrors.ErrInvalidVersion.Wrapf("fromVersion %d not supported", fromVersion)
+	}
+	nextVersionModules, exists := m.versionedModules[toVersion]
+	if !exists {
+		return nil, sdkerrors.ErrInvalidVersion.Wrapf("toVersion %d not supported", toVersion)
+	}
+
+	var plan []ModuleMigration
+	for _, moduleName := range modules {
+		currentModule, currentModuleExists := currentVersionModules[moduleName]
+		nextModule, nextModuleExists := nextVersionModules[moduleName]
+
+		switch {
+		case currentModuleExists && nextModuleExists:
+			fromModuleVersion := currentModule.ConsensusVersion()
+			toModuleVersion := nextModule.ConsensusVersion()
+			if fromModuleVersion == toModuleVersion {
+				continue
+			}
+			steps, err := c.migrationSteps(moduleName, fromModuleVersion, toModuleVersion)
+			if err != nil {
+				return nil, err
+			}
+			plan = append(plan, ModuleMigration{
+				Module:      moduleName,
+				FromVersion: fromModuleVersion,
+				ToVersion:   toModuleVersion,
+				Steps:       steps,
+			})
+		case !currentModuleExists && nextModuleExists:
+			plan = append(plan, ModuleMigration{
+				Module:    moduleName,
+				ToVersion: nextModule.ConsensusVersion(),
+			})
+		}
+	}
+	return plan, nil
+}
+
 // BeginBlock performs begin block functionality for all modules. It creates a
 // child context with an event manager to aggregate events emitted from all
 // modules.
@@ -338,3 +338,48 @@ func TestManager_SupportedVersions(t *testing.T) {
 	got := mm.SupportedVersions()
 	assert.Equal(t, []uint64{1, 3, 4}, got)
 }
+
+func TestManager_MigrationPlan(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule3 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule4 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().AnyTimes().Return("module1")
+	mockAppModule2.EXPECT().Name().AnyTimes().Return("module1")
+	mockAppModule3.EXPECT().Name().AnyTimes().Return("module2")
+	mockAppModule4.EXPECT().Name().AnyTimes().Return("module3")
+	mockAppModule1.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(1))
+	mockAppModule2.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(3))
+	mockAppModule3.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(1))
+	mockAppModule4.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(2))
+
+	mm, err := module.NewManager([]module.VersionedModule{
+		{Module: mockAppModule1, FromVersion: 1, ToVersion: 1},
+		{Module: mockAppModule2, FromVersion: 2, ToVersion: 2},
+		{Module: mockAppModule3, FromVersion: 1, ToVersion: 2},
+		{Module: mockAppModule4, FromVersion: 2, ToVersion: 2},
+	})
+	require.NoError(t, err)
+
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	cfg := module.NewConfigurator(cdc, nil, nil)
+
+	_, err = mm.MigrationPlan(cfg, 1, 2)
+	require.Error(t, err, "module1 changes consensus version without migrations")
+
+	noop := func(sdk.Context) error { return nil }
+	require.NoError(t, cfg.RegisterMigration("module1", 2, noop))
+	plan, err := mm.MigrationPlan(cfg, 1, 2)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []module.ModuleMigration{
+		{Module: "module1", FromVersion: 1, ToVersion: 3, Steps: []uint64{2}},
+		{Module: "module3", ToVersion: 2},
+	}, plan)
+
+	_, err = mm.MigrationPlan(cfg, 2, 3)
+	require.Error(t, err, "version 3 is not supported")
+}
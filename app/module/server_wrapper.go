@@ -13,9 +13,12 @@ import (
 // logic to extract all the sdk.Msg types that the service declares in its
 // methods and fires a callback to add them to the configurator. This allows us
 // to create a map of which messages are accepted across which versions.
+// Methods whose messages are already registered, e.g. by another consensus
+// version of the same module, are not registered again.
 type serverWrapper struct {
 	addMessages func(msgs []string)
 	msgServer   pbgrpc.Server
+	registered  map[string]struct{}
 }
 
 func (s *serverWrapper) RegisterService(sd *grpc.ServiceDesc, v interface{}) {
@@ -32,8 +35,37 @@ func (s *serverWrapper) RegisterService(sd *grpc.ServiceDesc, v interface{}) {
 		}, noopInterceptor)
 	}
 	s.addMessages(msgs)
+
+	unregistered := *sd
+	unregistered.Methods = make([]grpc.MethodDesc, 0, len(sd.Methods))
+	for idx, method := range sd.Methods {
+		if _, ok := s.registered[msgs[idx]]; ok {
+			continue
+		}
+		s.registered[msgs[idx]] = struct{}{}
+		unregistered.Methods = append(unregistered.Methods, method)
+	}
+	if len(unregistered.Methods) == 0 {
+		return
+	}
 	// call the underlying msg server to actually register the grpc server
-	s.msgServer.RegisterService(sd, v)
+	s.msgServer.RegisterService(&unregistered, v)
+}
+
+// queryServerWrapper wraps the pbgrpc.Server for registering a query service
+// so that a service that is registered by several consensus versions of the
+// same module is only registered once.
+type queryServerWrapper struct {
+	queryServer pbgrpc.Server
+	registered  map[string]struct{}
+}
+
+func (s *queryServerWrapper) RegisterService(sd *grpc.ServiceDesc, v interface{}) {
+	if _, ok := s.registered[sd.ServiceName]; ok {
+		return
+	}
+	s.registered[sd.ServiceName] = struct{}{}
+	s.queryServer.RegisterService(sd, v)
 }
 
 func noopInterceptor(_ context.Context, _ interface{}, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
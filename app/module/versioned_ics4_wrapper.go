@@ -0,0 +1,75 @@
+package module
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	exported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+// NewVersionedICS4Wrapper returns an ICS4Wrapper that calls wrappedWrapper
+// for the app versions from fromVersion to toVersion and nextWrapper for the
+// other versions. It is the ICS4Wrapper counterpart of VersionedIBCModule for
+// middleware whose keeper can only be called once its store is added.
+func NewVersionedICS4Wrapper(
+	wrappedWrapper, nextWrapper porttypes.ICS4Wrapper,
+	fromVersion, toVersion uint64,
+) porttypes.ICS4Wrapper {
+	return &VersionedICS4Wrapper{
+		wrappedWrapper: wrappedWrapper,
+		nextWrapper:    nextWrapper,
+		fromVersion:    fromVersion,
+		toVersion:      toVersion,
+	}
+}
+
+var _ porttypes.ICS4Wrapper = (*VersionedICS4Wrapper)(nil)
+
+type VersionedICS4Wrapper struct {
+	wrappedWrapper, nextWrapper porttypes.ICS4Wrapper
+	fromVersion, toVersion      uint64
+}
+
+func (v *VersionedICS4Wrapper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	if v.isVersionSupported(ctx) {
+		return v.wrappedWrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+	return v.nextWrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+func (v *VersionedICS4Wrapper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	if v.isVersionSupported(ctx) {
+		return v.wrappedWrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+	}
+	return v.nextWrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+func (v *VersionedICS4Wrapper) GetAppVersion(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) (string, bool) {
+	if v.isVersionSupported(ctx) {
+		return v.wrappedWrapper.GetAppVersion(ctx, portID, channelID)
+	}
+	return v.nextWrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+func (v *VersionedICS4Wrapper) isVersionSupported(ctx sdk.Context) bool {
+	currentAppVersion := ctx.BlockHeader().Version.App
+	return currentAppVersion >= v.fromVersion && currentAppVersion <= v.toVersion
+}
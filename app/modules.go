@@ -9,9 +9,14 @@ import (
 	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream"
 	blobstreamtypes "github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	"github.com/celestiaorg/celestia-app/v3/x/icacontroller"
+	"github.com/celestiaorg/celestia-app/v3/x/icahostfilter"
 	"github.com/celestiaorg/celestia-app/v3/x/minfee"
 	"github.com/celestiaorg/celestia-app/v3/x/mint"
 	minttypes "github.com/celestiaorg/celestia-app/v3/x/mint/types"
+	"github.com/celestiaorg/celestia-app/v3/x/namespace"
+	namespacetypes "github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	"github.com/celestiaorg/celestia-app/v3/x/ratelimit"
 	"github.com/celestiaorg/celestia-app/v3/x/signal"
 	signaltypes "github.com/celestiaorg/celestia-app/v3/x/signal/types"
 	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
@@ -48,8 +53,11 @@ import (
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v6/packetforward"
 	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v6/packetforward/types"
 	ica "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts"
+	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
 	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcfee "github.com/cosmos/ibc-go/v6/modules/apps/29-fee"
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v6/modules/core"
@@ -82,8 +90,13 @@ var (
 		blobstream.AppModuleBasic{},
 		signal.AppModuleBasic{},
 		minfee.AppModuleBasic{},
+		icahostfilter.AppModuleBasic{},
+		icacontroller.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
+		ibcfee.AppModuleBasic{},
 		packetforward.AppModuleBasic{},
 		icaModule{},
+		namespace.AppModuleBasic{},
 	)
 
 	// ModuleEncodingRegisters keeps track of all the module methods needed to
@@ -96,75 +109,79 @@ func (app *App) setupModuleManager(skipGenesisInvariants bool) error {
 	app.manager, err = module.NewManager([]module.VersionedModule{
 		{
 			Module:      genutil.NewAppModule(app.AccountKeeper, app.StakingKeeper, app.BaseApp.DeliverTx, app.txConfig),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      auth.NewAppModule(app.appCodec, app.AccountKeeper, nil),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      bank.NewAppModule(app.appCodec, app.BankKeeper, app.AccountKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      capability.NewAppModule(app.appCodec, *app.CapabilityKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      feegrantmodule.NewAppModule(app.appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      gov.NewAppModule(app.appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      mint.NewAppModule(app.appCodec, app.MintKeeper, app.AccountKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      slashing.NewAppModule(app.appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      distr.NewAppModule(app.appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      staking.NewAppModule(app.appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      evidence.NewAppModule(app.EvidenceKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      authzmodule.NewAppModule(app.appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      ibc.NewAppModule(app.IBCKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      params.NewAppModule(app.ParamsKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      transfer.NewAppModule(app.TransferKeeper),
+			FromVersion: v1, ToVersion: v4,
+		},
+		{
+			Module:      blob.NewAppModuleV2(app.appCodec, app.BlobKeeper),
 			FromVersion: v1, ToVersion: v3,
 		},
 		{
 			Module:      blob.NewAppModule(app.appCodec, app.BlobKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v4, ToVersion: v4,
 		},
 		{
 			Module:      blobstream.NewAppModule(app.appCodec, app.BlobstreamKeeper),
@@ -172,19 +189,39 @@ func (app *App) setupModuleManager(skipGenesisInvariants bool) error {
 		},
 		{
 			Module:      signal.NewAppModule(app.SignalKeeper),
-			FromVersion: v2, ToVersion: v3,
+			FromVersion: v2, ToVersion: v4,
 		},
 		{
 			Module:      minfee.NewAppModule(app.ParamsKeeper),
-			FromVersion: v2, ToVersion: v3,
+			FromVersion: v2, ToVersion: v4,
 		},
 		{
 			Module:      packetforward.NewAppModule(app.PacketForwardKeeper),
-			FromVersion: v2, ToVersion: v3,
+			FromVersion: v2, ToVersion: v4,
 		},
 		{
 			Module:      ica.NewAppModule(nil, &app.ICAHostKeeper),
-			FromVersion: v2, ToVersion: v3,
+			FromVersion: v2, ToVersion: v4,
+		},
+		{
+			Module:      icahostfilter.NewAppModule(app.GetSubspace(icahostfilter.ModuleName), app.ICAHostKeeper),
+			FromVersion: v4, ToVersion: v4,
+		},
+		{
+			Module:      namespace.NewAppModule(app.NamespaceKeeper),
+			FromVersion: v4, ToVersion: v4,
+		},
+		{
+			Module:      icacontroller.NewAppModule(&app.ICAControllerKeeper),
+			FromVersion: v4, ToVersion: v4,
+		},
+		{
+			Module:      ratelimit.NewAppModule(app.RateLimitKeeper),
+			FromVersion: v4, ToVersion: v4,
+		},
+		{
+			Module:      ibcfee.NewAppModule(app.IBCFeeKeeper),
+			FromVersion: v4, ToVersion: v4,
 		},
 	})
 	if err != nil {
@@ -221,7 +258,12 @@ func (app *App) setModuleOrder() {
 		signaltypes.ModuleName,
 		minfee.ModuleName,
 		icatypes.ModuleName,
+		icahostfilter.ModuleName,
 		packetforwardtypes.ModuleName,
+		namespacetypes.ModuleName,
+		icacontroller.ModuleName,
+		ratelimit.ModuleName,
+		ibcfeetypes.ModuleName,
 	)
 
 	app.manager.SetOrderEndBlockers(
@@ -248,6 +290,11 @@ func (app *App) setModuleOrder() {
 		minfee.ModuleName,
 		packetforwardtypes.ModuleName,
 		icatypes.ModuleName,
+		icahostfilter.ModuleName,
+		namespacetypes.ModuleName,
+		icacontroller.ModuleName,
+		ratelimit.ModuleName,
+		ibcfeetypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -281,6 +328,11 @@ func (app *App) setModuleOrder() {
 		signaltypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		icatypes.ModuleName,
+		icahostfilter.ModuleName,
+		namespacetypes.ModuleName,
+		icacontroller.ModuleName,
+		ratelimit.ModuleName,
+		ibcfeetypes.ModuleName,
 	)
 }
 
@@ -295,8 +347,12 @@ func allStoreKeys() []string {
 		ibchost.StoreKey,
 		packetforwardtypes.StoreKey,
 		icahosttypes.StoreKey,
+		icacontrollertypes.StoreKey,
 		signaltypes.StoreKey,
 		blobtypes.StoreKey,
+		namespacetypes.StoreKey,
+		ratelimit.StoreKey,
+		ibcfeetypes.StoreKey,
 	}
 }
 
@@ -341,7 +397,27 @@ func versionedStoreKeys() map[uint64][]string {
 			stakingtypes.StoreKey,
 			upgradetypes.StoreKey,
 		},
-		v3: { // same as v2
+		v3: {
+			authtypes.StoreKey,
+			authzkeeper.StoreKey,
+			banktypes.StoreKey,
+			blobtypes.StoreKey,
+			capabilitytypes.StoreKey,
+			distrtypes.StoreKey,
+			evidencetypes.StoreKey,
+			feegrant.StoreKey,
+			govtypes.StoreKey,
+			ibchost.StoreKey,
+			ibctransfertypes.StoreKey,
+			icahosttypes.StoreKey,
+			minttypes.StoreKey,
+			packetforwardtypes.StoreKey,
+			signaltypes.StoreKey,
+			slashingtypes.StoreKey,
+			stakingtypes.StoreKey,
+			upgradetypes.StoreKey,
+		},
+		v4: {
 			authtypes.StoreKey,
 			authzkeeper.StoreKey,
 			banktypes.StoreKey,
@@ -351,11 +427,15 @@ func versionedStoreKeys() map[uint64][]string {
 			evidencetypes.StoreKey,
 			feegrant.StoreKey,
 			govtypes.StoreKey,
+			ibcfeetypes.StoreKey, // added in v4
 			ibchost.StoreKey,
 			ibctransfertypes.StoreKey,
+			icacontrollertypes.StoreKey, // added in v4
 			icahosttypes.StoreKey,
 			minttypes.StoreKey,
+			namespacetypes.StoreKey, // added in v4
 			packetforwardtypes.StoreKey,
+			ratelimit.StoreKey, // added in v4
 			signaltypes.StoreKey,
 			slashingtypes.StoreKey,
 			stakingtypes.StoreKey,
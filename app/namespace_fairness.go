@@ -0,0 +1,229 @@
+package app
+
+import (
+	"sort"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// FlagNamespaceFairness is the flag to enable the namespace fairness mode of
+// PrepareProposal, see orderBlobTxsFairly.
+const FlagNamespaceFairness = "namespace-fairness"
+
+// fairBlobTx is a blob tx of txs passed to orderBlobTxsFairly.
+type fairBlobTx struct {
+	// index is the index of the tx in txs.
+	index int
+	// namespace is the namespace of the first blob of the tx, which the shares
+	// of all of its blobs are attributed to.
+	namespace string
+	// signers are the signers of the tx with the sequences that they signed
+	// it with.
+	signers []txSigner
+	shares  int
+	fee     math.Int
+}
+
+// txSigner is a signer of a tx with the sequence that it signed the tx with.
+type txSigner struct {
+	address  string
+	sequence uint64
+}
+
+// fairQueue holds the blob txs of a namespace in priority order.
+type fairQueue struct {
+	txs []fairBlobTx
+	// weight is the sum of the fees of the txs of the namespace.
+	weight math.Int
+	// served is the number of shares of the txs that were already ordered.
+	served int
+}
+
+// orderBlobTxsFairly reorders the blob txs of txs so that under contention the
+// square builder allocates the shares of the square across namespaces in
+// proportion to the fees that each namespace pays rather than in pure
+// priority order. This prevents a single namespace with higher fees from
+// filling every block while other namespaces that pay fees wait.
+//
+// The blob txs are ordered with weighted fair queueing: the next tx is the
+// head of the namespace that would have the lowest number of ordered shares
+// per fee paid after it. The txs of a namespace stay in priority order, and
+// the txs of every signer stay in sequence order, see reorderBlobTxs. If all
+// blob txs fit in a square of maxSquareSize, there's no contention and txs are
+// returned unchanged.
+func orderBlobTxsFairly(decoder sdk.TxDecoder, txs [][]byte, maxSquareSize int) [][]byte {
+	blobTxs, totalShares, ok := parseBlobTxs(decoder, txs)
+	if !ok {
+		return txs
+	}
+	if len(blobTxs) == 0 || totalShares <= maxSquareSize*maxSquareSize {
+		return txs
+	}
+
+	queues := make(map[string]*fairQueue)
+	namespaces := make([]string, 0)
+	for _, fairTx := range blobTxs {
+		queue, ok := queues[fairTx.namespace]
+		if !ok {
+			queue = &fairQueue{weight: math.ZeroInt()}
+			queues[fairTx.namespace] = queue
+			namespaces = append(namespaces, fairTx.namespace)
+		}
+		queue.txs = append(queue.txs, fairTx)
+		queue.weight = queue.weight.Add(fairTx.fee)
+	}
+	for _, queue := range queues {
+		// namespaces that don't pay fees still get a turn eventually.
+		if !queue.weight.IsPositive() {
+			queue.weight = math.OneInt()
+		}
+	}
+
+	ordered := make([]fairBlobTx, 0, len(blobTxs))
+	for len(ordered) < len(blobTxs) {
+		var next *fairQueue
+		for _, namespace := range namespaces {
+			queue := queues[namespace]
+			if len(queue.txs) == 0 {
+				continue
+			}
+			if next == nil || fairQueueBefore(queue, next) {
+				next = queue
+			}
+		}
+		ordered = append(ordered, next.txs[0])
+		next.served += next.txs[0].shares
+		next.txs = next.txs[1:]
+	}
+
+	return reorderBlobTxs(txs, blobTxs, ordered)
+}
+
+// fairQueueBefore returns whether the head of a should be ordered before the
+// head of b, i.e. whether a has fewer shares per fee than b after ordering
+// its head. Ties go to the tx with the higher priority.
+func fairQueueBefore(a, b *fairQueue) bool {
+	aFinish := math.NewInt(int64(a.served + a.txs[0].shares)).Mul(b.weight)
+	bFinish := math.NewInt(int64(b.served + b.txs[0].shares)).Mul(a.weight)
+	if !aFinish.Equal(bFinish) {
+		return aFinish.LT(bFinish)
+	}
+	return a.txs[0].index < b.txs[0].index
+}
+
+// parseBlobTxs returns the blob txs of txs in order and the total number of
+// shares of their blobs. It returns false if a blob tx can't be decoded or
+// doesn't have a signature for each of its signers.
+func parseBlobTxs(decoder sdk.TxDecoder, txs [][]byte) ([]fairBlobTx, int, bool) {
+	blobTxs := make([]fairBlobTx, 0, len(txs))
+	totalShares := 0
+	for i, rawTx := range txs {
+		blobTx, isBlobTx, err := tx.UnmarshalBlobTx(rawTx)
+		if !isBlobTx || err != nil || len(blobTx.Blobs) == 0 {
+			continue
+		}
+		sdkTx, err := decoder(blobTx.Tx)
+		if err != nil {
+			// txs were already filtered so this doesn't happen. Leave the
+			// order untouched rather than guessing.
+			return nil, 0, false
+		}
+		fairTx := fairBlobTx{index: i, namespace: string(blobTx.Blobs[0].Namespace().Bytes()), fee: math.ZeroInt()}
+		if feeTx, ok := sdkTx.(sdk.FeeTx); ok {
+			fairTx.fee = feeTx.GetFee().AmountOf(appconsts.BondDenom)
+		}
+		sigTx, ok := sdkTx.(authsigning.SigVerifiableTx)
+		if !ok {
+			return nil, 0, false
+		}
+		sigs, err := sigTx.GetSignaturesV2()
+		signers := sigTx.GetSigners()
+		if err != nil || len(sigs) != len(signers) {
+			return nil, 0, false
+		}
+		for j, signer := range signers {
+			fairTx.signers = append(fairTx.signers, txSigner{address: signer.String(), sequence: sigs[j].Sequence})
+		}
+		for _, blob := range blobTx.Blobs {
+			// the shares are approximate for blobs with a signer, which is
+			// good enough to weigh txs against each other.
+			fairTx.shares += share.SparseSharesNeeded(uint32(len(blob.Data()) + len(blob.Signer())))
+		}
+		totalShares += fairTx.shares
+		blobTxs = append(blobTxs, fairTx)
+	}
+	return blobTxs, totalShares, true
+}
+
+// reorderBlobTxs returns txs with its blobTxs in the order of ordered, except
+// that the txs of every signer are kept in the order of the sequences that
+// they were signed with so that their sequences remain valid. A tx is
+// preceded by the txs that its signers signed with lower sequences and that
+// aren't ordered yet. The blob txs take the positions of the blob txs of txs
+// in the new order, and normal txs keep their positions. If the sequences of
+// the signers of the txs contradict each other, txs are returned unchanged.
+func reorderBlobTxs(txs [][]byte, blobTxs, ordered []fairBlobTx) [][]byte {
+	byIndex := make(map[int]fairBlobTx, len(blobTxs))
+	// pending are the txs of each signer that aren't placed yet in the order
+	// of their sequences.
+	pending := make(map[string][]fairBlobTx)
+	for _, fairTx := range blobTxs {
+		byIndex[fairTx.index] = fairTx
+		for _, signer := range fairTx.signers {
+			pending[signer.address] = append(pending[signer.address], fairTx)
+		}
+	}
+	for address, signerTxs := range pending {
+		sort.SliceStable(signerTxs, func(i, j int) bool {
+			return signerTxs[i].sequenceOf(address) < signerTxs[j].sequenceOf(address)
+		})
+	}
+
+	result := append([][]byte(nil), txs...)
+	placed := make(map[int]bool, len(blobTxs))
+	visiting := make(map[int]bool)
+	var place func(fairTx fairBlobTx) bool
+	place = func(fairTx fairBlobTx) bool {
+		if placed[fairTx.index] {
+			return true
+		}
+		if visiting[fairTx.index] {
+			return false
+		}
+		visiting[fairTx.index] = true
+		for _, signer := range fairTx.signers {
+			for pending[signer.address][0].index != fairTx.index {
+				if !place(byIndex[pending[signer.address][0].index]) {
+					return false
+				}
+			}
+		}
+		for _, signer := range fairTx.signers {
+			pending[signer.address] = pending[signer.address][1:]
+		}
+		result[blobTxs[len(placed)].index] = txs[fairTx.index]
+		placed[fairTx.index] = true
+		return true
+	}
+	for _, fairTx := range ordered {
+		if !place(fairTx) {
+			return txs
+		}
+	}
+	return result
+}
+
+// sequenceOf returns the sequence that address signed the tx with.
+func (t fairBlobTx) sequenceOf(address string) uint64 {
+	for _, signer := range t.signers {
+		if signer.address == address {
+			return signer.sequence
+		}
+	}
+	return 0
+}
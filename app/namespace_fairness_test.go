@@ -0,0 +1,122 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderBlobTxsFairly(t *testing.T) {
+	enc := encoding.MakeConfig(ModuleEncodingRegisters...)
+	nsA := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	nsB := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+
+	newBlobTx := func(signer byte, ns share.Namespace, fee int64, sequence uint64) []byte {
+		return newSignedBlobTx(t, enc.TxConfig, ns, 100, fee, blobTxSigner{account: signer, sequence: sequence})
+	}
+	namespaceOf := func(rawTx []byte) share.Namespace {
+		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+		require.NoError(t, err)
+		require.True(t, isBlobTx)
+		return blobTx.Blobs[0].Namespace()
+	}
+	normalTx := []byte("normal tx")
+
+	t.Run("no contention", func(t *testing.T) {
+		txs := [][]byte{normalTx, newBlobTx(1, nsA, 100, 0), newBlobTx(2, nsA, 100, 0), newBlobTx(3, nsB, 1, 0)}
+		assert.Equal(t, txs, orderBlobTxsFairly(enc.TxConfig.TxDecoder(), txs, 2))
+	})
+
+	t.Run("interleaves namespaces by the fees they pay", func(t *testing.T) {
+		// namespace A pays twice the fees of namespace B and all of its txs
+		// have a higher priority.
+		txs := [][]byte{normalTx}
+		for i := range 8 {
+			txs = append(txs, newBlobTx(byte(10+i), nsA, 100, 0))
+		}
+		for i := range 4 {
+			txs = append(txs, newBlobTx(byte(20+i), nsB, 100, 0))
+		}
+		got := orderBlobTxsFairly(enc.TxConfig.TxDecoder(), txs, 2)
+		require.Len(t, got, len(txs))
+		assert.Equal(t, normalTx, got[0])
+		want := []share.Namespace{nsA, nsA, nsB, nsA, nsA, nsB, nsA, nsA, nsB, nsA, nsA, nsB}
+		for i, ns := range want {
+			assert.Equal(t, ns, namespaceOf(got[i+1]), i)
+		}
+		assert.ElementsMatch(t, txs, got)
+	})
+
+	t.Run("keeps the order of the txs of a signer", func(t *testing.T) {
+		// the tx of signer 1 in namespace B comes before its earlier tx in
+		// namespace A in fair order.
+		txs := [][]byte{
+			newBlobTx(2, nsA, 100, 0),
+			newBlobTx(3, nsA, 100, 0),
+			newBlobTx(4, nsA, 100, 0),
+			newBlobTx(1, nsA, 100, 0),
+			newBlobTx(1, nsB, 10000, 1),
+		}
+		got := orderBlobTxsFairly(enc.TxConfig.TxDecoder(), txs, 2)
+		assert.ElementsMatch(t, txs, got)
+		var signerOneTxs [][]byte
+		for _, rawTx := range got {
+			if bytes.Equal(rawTx, txs[3]) || bytes.Equal(rawTx, txs[4]) {
+				signerOneTxs = append(signerOneTxs, rawTx)
+			}
+		}
+		assert.Equal(t, [][]byte{txs[3], txs[4]}, signerOneTxs)
+		assert.Equal(t, txs[3], got[0])
+	})
+}
+
+// blobTxSigner is a signer of a tx built by newSignedBlobTx with the sequence
+// that it signs the tx with.
+type blobTxSigner struct {
+	account  byte
+	sequence uint64
+}
+
+// newSignedBlobTx returns a blob tx with a blob of ns of size bytes that pays
+// fee. The PFB is signed by signers[0] and the fee is paid by signers[1] if it
+// is set. The signatures are empty as only their sequences are used.
+func newSignedBlobTx(t *testing.T, txConfig client.TxConfig, ns share.Namespace, size int, fee int64, signers ...blobTxSigner) []byte {
+	sigs := make([]signing.SignatureV2, len(signers))
+	addrs := make([]sdk.AccAddress, len(signers))
+	for i, signer := range signers {
+		pubKey := secp256k1.GenPrivKeyFromSecret([]byte{signer.account}).PubKey()
+		addrs[i] = sdk.AccAddress(pubKey.Address())
+		sigs[i] = signing.SignatureV2{
+			PubKey:   pubKey,
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			Sequence: signer.sequence,
+		}
+	}
+	blob, err := share.NewV0Blob(ns, bytes.Repeat([]byte{signers[0].account}, size))
+	require.NoError(t, err)
+	msg, err := blobtypes.NewMsgPayForBlobs(addrs[0].String(), appconsts.LatestVersion, blob)
+	require.NoError(t, err)
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msg))
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, fee)))
+	if len(signers) > 1 {
+		builder.SetFeePayer(addrs[1])
+	}
+	require.NoError(t, builder.SetSignatures(sigs...))
+	txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	rawTx, err := blobtx.MarshalBlobTx(txBytes, blob)
+	require.NoError(t, err)
+	return rawTx
+}
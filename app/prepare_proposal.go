@@ -1,16 +1,11 @@
 package app
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/celestiaorg/celestia-app/v3/app/ante"
-	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v3/pkg/da"
-	shares "github.com/celestiaorg/go-square/shares"
-	square "github.com/celestiaorg/go-square/square"
-	squarev2 "github.com/celestiaorg/go-square/v2"
-	sharev2 "github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/celestia-app/v3/pkg/square"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	abci "github.com/tendermint/tendermint/abci/types"
 	core "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -37,6 +32,7 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.BlobKeeper,
+		app.NamespaceKeeper,
 		app.FeeGrantKeeper,
 		app.GetTxConfig().SignModeHandler(),
 		ante.DefaultSigVerificationGasConsumer,
@@ -46,34 +42,22 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 	)
 
 	// Filter out invalid transactions.
-	txs := FilterTxs(app.Logger(), sdkCtx, handler, app.txConfig, req.BlockData.Txs)
+	txs := FilterTxs(app.Logger(), sdkCtx, handler, app.txConfig, req.BlockData.Txs, app.MaxPFBMessages(sdkCtx))
+	txs = orderBlobTxsByFeePerShare(app.txConfig.TxDecoder(), txs, app.MaxEffectiveSquareSize(sdkCtx))
+	if app.namespaceFairness {
+		txs = orderBlobTxsFairly(app.txConfig.TxDecoder(), txs, app.MaxEffectiveSquareSize(sdkCtx))
+	}
+
+	// The square work is abandoned after the proposal timeout in which case
+	// an empty block is proposed.
+	ctx, cancel := app.proposalContext()
+	defer cancel()
 
 	// Build the square from the set of valid and prioritised transactions.
 	// The txs returned are the ones used in the square and block.
-	var (
-		dataSquareBytes [][]byte
-		err             error
-		size            uint64
-	)
-	switch app.AppVersion() {
-	case v3:
-		var dataSquare squarev2.Square
-		dataSquare, txs, err = squarev2.Build(txs,
-			app.MaxEffectiveSquareSize(sdkCtx),
-			appconsts.SubtreeRootThreshold(app.GetBaseApp().AppVersion()),
-		)
-		dataSquareBytes = sharev2.ToBytes(dataSquare)
-		size = uint64(dataSquare.Size())
-	case v2, v1:
-		var dataSquare square.Square
-		dataSquare, txs, err = square.Build(txs,
-			app.MaxEffectiveSquareSize(sdkCtx),
-			appconsts.SubtreeRootThreshold(app.GetBaseApp().AppVersion()),
-		)
-		dataSquareBytes = shares.ToBytes(dataSquare)
-		size = uint64(dataSquare.Size())
-	default:
-		err = fmt.Errorf("unsupported app version: %d", app.AppVersion())
+	dataSquare, txs, err := square.BuildContext(ctx, app.AppVersion(), txs, app.MaxEffectiveSquareSize(sdkCtx))
+	if ctx.Err() != nil {
+		return app.abandonProposal(ctx.Err())
 	}
 	if err != nil {
 		panic(err)
@@ -82,7 +66,10 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 	// Erasure encode the data square to create the extended data square (eds).
 	// Note: uses the nmt wrapper to construct the tree. See
 	// pkg/wrapper/nmt_wrapper.go for more information.
-	eds, err := da.ExtendShares(dataSquareBytes)
+	eds, err := da.ExtendSharesContext(ctx, dataSquare, app.rootCache)
+	if ctx.Err() != nil {
+		return app.abandonProposal(ctx.Err())
+	}
 	if err != nil {
 		app.Logger().Error(
 			"failure to erasure the data square while creating a proposal block",
@@ -93,6 +80,9 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 	}
 
 	dah, err := da.NewDataAvailabilityHeader(eds)
+	if ctx.Err() != nil {
+		return app.abandonProposal(ctx.Err())
+	}
 	if err != nil {
 		app.Logger().Error(
 			"failure to create new data availability header",
@@ -108,8 +98,16 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 	return abci.ResponsePrepareProposal{
 		BlockData: &core.Data{
 			Txs:        txs,
-			SquareSize: size,
+			SquareSize: uint64(dataSquare.Size()),
 			Hash:       dah.Hash(), // also known as the data root
 		},
 	}
 }
+
+// abandonProposal returns an empty proposal because the square work of
+// PrepareProposal was abandoned with err.
+func (app *App) abandonProposal(err error) abci.ResponsePrepareProposal {
+	app.Logger().Error("abandoned the square of the proposal block, proposing an empty block", "error", err.Error())
+	telemetry.IncrCounter(1, "prepare_proposal", "abandoned")
+	return emptyProposal()
+}
@@ -8,10 +8,9 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/app/ante"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/shares"
+	"github.com/celestiaorg/celestia-app/v3/pkg/square"
 	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
-	shares "github.com/celestiaorg/go-square/shares"
-	square "github.com/celestiaorg/go-square/square"
-	squarev2 "github.com/celestiaorg/go-square/v2"
 	sharev2 "github.com/celestiaorg/go-square/v2/share"
 	blobtx "github.com/celestiaorg/go-square/v2/tx"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -25,6 +24,9 @@ const rejectedPropBlockLog = "Rejected proposal block:"
 
 func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.ResponseProcessProposal) {
 	defer telemetry.MeasureSince(time.Now(), "process_proposal")
+	if app.telemetryCollector != nil {
+		defer func(start time.Time) { app.telemetryCollector.ObserveProcessProposal(time.Since(start)) }(time.Now())
+	}
 	// In the case of a panic resulting from an unexpected condition, it is
 	// better for the liveness of the network to catch it, log an error, and
 	// vote nil rather than crashing the node.
@@ -44,6 +46,7 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.BlobKeeper,
+		app.NamespaceKeeper,
 		app.FeeGrantKeeper,
 		app.GetTxConfig().SignModeHandler(),
 		ante.DefaultSigVerificationGasConsumer,
@@ -54,6 +57,9 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 	sdkCtx := app.NewProposalContext(req.Header)
 	subtreeRootThreshold := appconsts.SubtreeRootThreshold(app.GetBaseApp().AppVersion())
 
+	maxPFBsPerBlock := app.maxPFBsPerBlock(sdkCtx)
+	pfbCount := 0
+
 	// iterate over all txs and ensure that all blobTxs are valid, PFBs are correctly signed and non
 	// blobTxs have no PFBs present
 	for idx, rawTx := range req.BlockData.Txs {
@@ -123,6 +129,12 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 			return reject()
 		}
 
+		pfbCount += len(sdkTx.GetMsgs())
+		if maxPFBsPerBlock > 0 && pfbCount > maxPFBsPerBlock {
+			logInvalidPropBlock(app.Logger(), req.Header, fmt.Sprintf("block contains more than the max of %d PFBs", maxPFBsPerBlock))
+			return reject()
+		}
+
 		// validated the PFB signature
 		sdkCtx, err = handler(sdkCtx, sdkTx, false)
 		if err != nil {
@@ -132,40 +144,33 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 
 	}
 
-	var (
-		dataSquareBytes [][]byte
-		err             error
-	)
-
-	switch app.AppVersion() {
-	case v3:
-		var dataSquare squarev2.Square
-		dataSquare, err = squarev2.Construct(req.BlockData.Txs, app.MaxEffectiveSquareSize(sdkCtx), subtreeRootThreshold)
-		dataSquareBytes = sharev2.ToBytes(dataSquare)
-		// Assert that the square size stated by the proposer is correct
-		if uint64(dataSquare.Size()) != req.BlockData.SquareSize {
-			logInvalidPropBlock(app.Logger(), req.Header, "proposed square size differs from calculated square size")
-			return reject()
-		}
-	case v2, v1:
-		var dataSquare square.Square
-		dataSquare, err = square.Construct(req.BlockData.Txs, app.MaxEffectiveSquareSize(sdkCtx), subtreeRootThreshold)
-		dataSquareBytes = shares.ToBytes(dataSquare)
-		// Assert that the square size stated by the proposer is correct
-		if uint64(dataSquare.Size()) != req.BlockData.SquareSize {
-			logInvalidPropBlock(app.Logger(), req.Header, "proposed square size differs from calculated square size")
-			return reject()
-		}
-	default:
-		logInvalidPropBlock(app.Logger(), req.Header, "unsupported app version")
+	dataSquare, err := square.Construct(app.AppVersion(), req.BlockData.Txs, app.MaxEffectiveSquareSize(sdkCtx))
+	if err != nil {
+		logInvalidPropBlockError(app.Logger(), req.Header, "failure to compute data square from transactions:", err)
+		return reject()
+	}
+	// Assert that the square size stated by the proposer is correct
+	if uint64(dataSquare.Size()) != req.BlockData.SquareSize {
+		logInvalidPropBlock(app.Logger(), req.Header, "proposed square size differs from calculated square size")
 		return reject()
 	}
+
+	// Light nodes and fraud proofs locate txs in compact shares by their
+	// reserved bytes so from app version 4 onwards they must match the actual
+	// tx boundaries.
+	dataSquareShares, err := sharev2.FromBytes(dataSquare)
 	if err != nil {
-		logInvalidPropBlockError(app.Logger(), req.Header, "failure to compute data square from transactions:", err)
+		logInvalidPropBlockError(app.Logger(), req.Header, "failure to parse the shares of the data square", err)
 		return reject()
 	}
+	if app.AppVersion() >= v4 {
+		if err := shares.ValidateReservedBytes(dataSquareShares); err != nil {
+			logInvalidPropBlockError(app.Logger(), req.Header, "invalid reserved bytes in compact shares", err)
+			return reject()
+		}
+	}
 
-	eds, err := da.ExtendShares(dataSquareBytes)
+	eds, err := da.ExtendSharesWithRootCache(dataSquare, app.rootCache)
 	if err != nil {
 		logInvalidPropBlockError(app.Logger(), req.Header, "failure to erasure the data square", err)
 		return reject()
@@ -184,6 +189,18 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 		return reject()
 	}
 
+	for _, listener := range app.proposalListeners {
+		listener.ListenProcessProposal(req.Header, req.BlockData.Txs)
+	}
+	if app.telemetryCollector != nil {
+		usedShares := 0
+		for _, sh := range dataSquareShares {
+			if !sh.IsPadding() {
+				usedShares++
+			}
+		}
+		app.telemetryCollector.ObserveBlock(int(req.BlockData.SquareSize), usedShares, app.MaxEffectiveSquareSize(sdkCtx))
+	}
 	return accept()
 }
 
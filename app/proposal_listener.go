@@ -0,0 +1,28 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// ProposalListener is implemented by streaming services that need the txs of
+// a block including their blobs. Tendermint strips the blobs from blob txs
+// before passing them to DeliverTx so the original block txs are only
+// available when the proposal is processed.
+type ProposalListener interface {
+	// ListenProcessProposal is called with the header and txs of every
+	// proposal that is accepted. More than one proposal may be accepted for
+	// the same height if consensus takes multiple rounds; the data hash of the
+	// header identifies the one that is eventually committed.
+	ListenProcessProposal(header tmproto.Header, txs [][]byte)
+}
+
+// SetStreamingService registers the streaming service with the BaseApp. If the
+// service implements ProposalListener it is additionally passed the txs of
+// every accepted proposal.
+func (app *App) SetStreamingService(s baseapp.StreamingService) {
+	app.BaseApp.SetStreamingService(s)
+	if listener, ok := s.(ProposalListener); ok {
+		app.proposalListeners = append(app.proposalListeners, listener)
+	}
+}
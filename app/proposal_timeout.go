@@ -0,0 +1,38 @@
+package app
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	abci "github.com/tendermint/tendermint/abci/types"
+	core "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// FlagProposalTimeout is the flag to specify how long PrepareProposal may
+// build and erasure code the square of a block before it proposes an empty
+// block instead. ProcessProposal is never abandoned: whether a proposal is
+// accepted must not depend on the local clock of the node. Disabled if 0.
+const FlagProposalTimeout = "proposal-timeout"
+
+// proposalContext returns the context that the square work of
+// PrepareProposal is cancelled with once the proposal timeout passes. The
+// returned cancel func must be called when the work is done.
+func (app *App) proposalContext() (context.Context, context.CancelFunc) {
+	if app.proposalTimeout <= 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), app.proposalTimeout)
+}
+
+// emptyProposal returns the proposal of a block without txs. PrepareProposal
+// proposes it if the square of the block with txs isn't built in time so that
+// the round still has a proposal.
+func emptyProposal() abci.ResponsePrepareProposal {
+	dah := da.MinDataAvailabilityHeader()
+	return abci.ResponsePrepareProposal{
+		BlockData: &core.Data{
+			SquareSize: 1,
+			Hash:       dah.Hash(),
+		},
+	}
+}
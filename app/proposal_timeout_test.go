@@ -0,0 +1,44 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/square"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalContext(t *testing.T) {
+	ctx, cancel := (&App{}).proposalContext()
+	_, hasDeadline := ctx.Deadline()
+	assert.False(t, hasDeadline)
+	cancel()
+	assert.Error(t, ctx.Err())
+
+	ctx, cancel = (&App{proposalTimeout: time.Minute}).proposalContext()
+	defer cancel()
+	deadline, hasDeadline := ctx.Deadline()
+	require.True(t, hasDeadline)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+}
+
+// TestEmptyProposal verifies that the empty proposal is the block that
+// ProcessProposal constructs for no txs in every app version.
+func TestEmptyProposal(t *testing.T) {
+	got := emptyProposal()
+	for _, appVersion := range []uint64{v1, v2, v3} {
+		dataSquare, err := square.Construct(appVersion, nil, appconsts.SquareSizeUpperBound(appVersion))
+		require.NoError(t, err)
+		eds, err := da.ExtendShares(dataSquare)
+		require.NoError(t, err)
+		dah, err := da.NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+
+		assert.Empty(t, got.BlockData.Txs, appVersion)
+		assert.EqualValues(t, dataSquare.Size(), got.BlockData.SquareSize, appVersion)
+		assert.Equal(t, dah.Hash(), got.BlockData.Hash, appVersion)
+	}
+}
@@ -0,0 +1,61 @@
+package app
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Max percentages by which a single governance proposal can change the x/blob
+// params that block production depends on from app version 4 onwards. A param can be increased by up to
+// the percentage or decreased by the inverse factor, e.g. 100% allows doubling
+// or halving it. The gov max square size must stay a power of two, so
+// doubling or halving is its smallest possible change.
+const (
+	MaxGovMaxSquareSizeChangePercent = 100
+	MaxGasPerBlobByteChangePercent   = 25
+)
+
+// RateLimitedParams returns the params that can only be changed by a bounded
+// percentage per governance proposal.
+func (app *App) RateLimitedParams() []paramfilter.RateLimitedParam {
+	return []paramfilter.RateLimitedParam{
+		{
+			Subspace:    blobtypes.ModuleName,
+			Key:         string(blobtypes.KeyGovMaxSquareSize),
+			Check:       percentChangeCheck[uint64](MaxGovMaxSquareSizeChangePercent),
+			FromVersion: v4,
+		},
+		{
+			Subspace:    blobtypes.ModuleName,
+			Key:         string(blobtypes.KeyGasPerBlobByte),
+			Check:       percentChangeCheck[uint32](MaxGasPerBlobByteChangePercent),
+			FromVersion: v4,
+		},
+	}
+}
+
+// percentChangeCheck returns a check that rejects values above current
+// increased by maxPercent or below current decreased by the inverse factor.
+func percentChangeCheck[T uint32 | uint64](maxPercent uint64) paramfilter.ChangeCheck {
+	return func(current, value string) error {
+		var currentValue, newValue T
+		if err := codec.NewLegacyAmino().UnmarshalJSON([]byte(current), &currentValue); err != nil {
+			return err
+		}
+		if err := codec.NewLegacyAmino().UnmarshalJSON([]byte(value), &newValue); err != nil {
+			return err
+		}
+		currentInt := sdkmath.NewIntFromUint64(uint64(currentValue))
+		newInt := sdkmath.NewIntFromUint64(uint64(newValue))
+		factor := int64(100 + maxPercent)
+		// value must be within [current * 100 / factor, current * factor / 100]
+		if newInt.MulRaw(100).GT(currentInt.MulRaw(factor)) || newInt.MulRaw(factor).LT(currentInt.MulRaw(100)) {
+			return fmt.Errorf("%d is more than %d%% away from %d", newValue, maxPercent, currentValue)
+		}
+		return nil
+	}
+}
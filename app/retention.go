@@ -0,0 +1,28 @@
+package app
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// Commit implements the ABCI interface and commits the state of the block.
+// This method wraps the default Baseapp's method so that Tendermint doesn't
+// prune the blocks needed to serve proofs or to commit to data with
+// Blobstream, see retention.Config.RetainHeight.
+func (app *App) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	res.RetainHeight = app.retention.RetainHeight(app.LastBlockHeight(), res.RetainHeight, app.blobstreamRetainBlocks())
+	return res
+}
+
+// blobstreamRetainBlocks returns the number of latest blocks that Blobstream
+// needs to commit to data. The latest data commitment ends up to a data
+// commitment window below the latest height, so the blocks of two windows are
+// needed. Blobstream only exists in app version 1.
+func (app *App) blobstreamRetainBlocks() uint64 {
+	if app.AppVersion() != v1 {
+		return 0
+	}
+	ctx := app.NewContext(true, tmproto.Header{})
+	return 2 * app.BlobstreamKeeper.GetDataCommitmentWindowParam(ctx)
+}
@@ -0,0 +1,163 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// Bounds of the x/slashing params that governance proposals are allowed to
+// set from app version 4 onwards. Blocks on a DA chain can take a long time to propagate and process, so
+// the downtime window must stay long enough that validators aren't jailed for
+// missing a handful of large blocks.
+var (
+	MinSignedBlocksWindow      int64 = 5_000
+	MaxSignedBlocksWindow      int64 = 100_000
+	MinMinSignedPerWindow            = sdk.NewDecWithPrec(50, 2) // 50%
+	MaxMinSignedPerWindow            = sdk.NewDecWithPrec(95, 2) // 95%
+	MinDowntimeJailDuration          = time.Minute
+	MaxDowntimeJailDuration          = 7 * 24 * time.Hour
+	MinSlashFractionDoubleSign       = sdk.NewDecWithPrec(1, 2)  // 1%
+	MaxSlashFractionDoubleSign       = sdk.NewDecWithPrec(10, 2) // 10%
+	MaxSlashFractionDowntime         = sdk.NewDecWithPrec(1, 2)  // 1%
+)
+
+// DASlashingParams returns the x/slashing params preset used by default for
+// new networks. The values are within the governance bounds above.
+func DASlashingParams() slashingtypes.Params {
+	params := slashingtypes.DefaultParams()
+	params.MinSignedPerWindow = sdk.NewDecWithPrec(75, 2) // 75%
+	params.SignedBlocksWindow = 5000
+	params.DowntimeJailDuration = time.Minute * 1
+	params.SlashFractionDoubleSign = sdk.NewDecWithPrec(2, 2) // 2%
+	params.SlashFractionDowntime = sdk.ZeroDec()              // 0%
+	return params
+}
+
+// boundSlashingParams moves the x/slashing params that are outside of the
+// governance bounds to the closest bound. It runs on the upgrade to app
+// version 4 so that the params of every network are within the bounds once
+// they apply.
+func (app *App) boundSlashingParams(ctx sdk.Context) {
+	params := app.SlashingKeeper.GetParams(ctx)
+	bounded := params
+	bounded.SignedBlocksWindow = clampInt64(params.SignedBlocksWindow, MinSignedBlocksWindow, MaxSignedBlocksWindow)
+	bounded.MinSignedPerWindow = clampDec(params.MinSignedPerWindow, MinMinSignedPerWindow, MaxMinSignedPerWindow)
+	bounded.DowntimeJailDuration = time.Duration(clampInt64(int64(params.DowntimeJailDuration), int64(MinDowntimeJailDuration), int64(MaxDowntimeJailDuration)))
+	bounded.SlashFractionDoubleSign = clampDec(params.SlashFractionDoubleSign, MinSlashFractionDoubleSign, MaxSlashFractionDoubleSign)
+	bounded.SlashFractionDowntime = clampDec(params.SlashFractionDowntime, sdk.ZeroDec(), MaxSlashFractionDowntime)
+	if bounded.String() == params.String() {
+		return
+	}
+	app.Logger().Info("moving the slashing params within the governance bounds", "before", params.String(), "after", bounded.String())
+	app.SlashingKeeper.SetParams(ctx, bounded)
+}
+
+// BoundedParams returns the params that can only be changed by governance
+// within a range of values.
+func (app *App) BoundedParams() []paramfilter.BoundedParam {
+	return []paramfilter.BoundedParam{
+		{
+			Subspace:    slashingtypes.ModuleName,
+			Key:         string(slashingtypes.KeySignedBlocksWindow),
+			FromVersion: v4,
+			Check: func(value string) error {
+				var window int64
+				if err := codec.NewLegacyAmino().UnmarshalJSON([]byte(value), &window); err != nil {
+					return err
+				}
+				return checkInt64Bounds(window, MinSignedBlocksWindow, MaxSignedBlocksWindow)
+			},
+		},
+		{
+			Subspace:    slashingtypes.ModuleName,
+			Key:         string(slashingtypes.KeyMinSignedPerWindow),
+			Check:       decBoundsCheck(MinMinSignedPerWindow, MaxMinSignedPerWindow),
+			FromVersion: v4,
+		},
+		{
+			Subspace:    slashingtypes.ModuleName,
+			Key:         string(slashingtypes.KeyDowntimeJailDuration),
+			FromVersion: v4,
+			Check: func(value string) error {
+				var duration time.Duration
+				if err := codec.NewLegacyAmino().UnmarshalJSON([]byte(value), &duration); err != nil {
+					return err
+				}
+				return checkInt64Bounds(int64(duration), int64(MinDowntimeJailDuration), int64(MaxDowntimeJailDuration))
+			},
+		},
+		{
+			Subspace:    slashingtypes.ModuleName,
+			Key:         string(slashingtypes.KeySlashFractionDoubleSign),
+			Check:       decBoundsCheck(MinSlashFractionDoubleSign, MaxSlashFractionDoubleSign),
+			FromVersion: v4,
+		},
+		{
+			Subspace:    slashingtypes.ModuleName,
+			Key:         string(slashingtypes.KeySlashFractionDowntime),
+			Check:       decBoundsCheck(sdk.ZeroDec(), MaxSlashFractionDowntime),
+			FromVersion: v4,
+		},
+		app.icaAllowMessagesBoundedParam(),
+		app.icaConnectionAllowlistsBoundedParam(),
+		app.govMaxSquareSizeBoundedParam(),
+		{
+			Subspace:    minfee.ModuleName,
+			Key:         string(minfee.KeyNetworkMinGasPrice),
+			FromVersion: v4,
+			Check: func(value string) error {
+				var minGasPrice sdk.Dec
+				if err := codec.NewLegacyAmino().UnmarshalJSON([]byte(value), &minGasPrice); err != nil {
+					return err
+				}
+				return minfee.ValidateMinGasPrice(minGasPrice)
+			},
+		},
+	}
+}
+
+func checkInt64Bounds(value, min, max int64) error {
+	if value < min || value > max {
+		return fmt.Errorf("%d must be between %d and %d", value, min, max)
+	}
+	return nil
+}
+
+func clampInt64(value, min, max int64) int64 {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
+
+func clampDec(value, min, max sdk.Dec) sdk.Dec {
+	if value.LT(min) {
+		return min
+	}
+	if value.GT(max) {
+		return max
+	}
+	return value
+}
+
+func decBoundsCheck(min, max sdk.Dec) paramfilter.BoundsCheck {
+	return func(value string) error {
+		var dec sdk.Dec
+		if err := codec.NewLegacyAmino().UnmarshalJSON([]byte(value), &dec); err != nil {
+			return err
+		}
+		if dec.LT(min) || dec.GT(max) {
+			return fmt.Errorf("%s must be between %s and %s", dec, min, max)
+		}
+		return nil
+	}
+}
@@ -1,7 +1,12 @@
 package app
 
 import (
+	"fmt"
+
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -21,3 +26,25 @@ func (app *App) MaxEffectiveSquareSize(ctx sdk.Context) int {
 	hardMax := appconsts.SquareSizeUpperBound(app.AppVersion())
 	return min(govMax, hardMax)
 }
+
+// govMaxSquareSizeBoundedParam rejects governance proposals that set the gov
+// max square size above the square size upper bound of the current app
+// version, which MaxEffectiveSquareSize would silently ignore. The bound
+// applies from app version 4 onwards.
+func (app *App) govMaxSquareSizeBoundedParam() paramfilter.BoundedParam {
+	return paramfilter.BoundedParam{
+		Subspace:    blobtypes.ModuleName,
+		Key:         string(blobtypes.KeyGovMaxSquareSize),
+		FromVersion: v4,
+		Check: func(value string) error {
+			var govMaxSquareSize uint64
+			if err := codec.NewLegacyAmino().UnmarshalJSON([]byte(value), &govMaxSquareSize); err != nil {
+				return err
+			}
+			if upperBound := appconsts.SquareSizeUpperBound(app.AppVersion()); govMaxSquareSize > uint64(upperBound) {
+				return fmt.Errorf("gov max square size %d exceeds the square size upper bound %d", govMaxSquareSize, upperBound)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package app_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/shares"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/require"
+)
+
+// BenchmarkSparseShareSplitter_SmallBlobs measures splitting a block worth of
+// small blobs into shares. With many small blobs the go-square splitter spends
+// most of its time growing its share buffer on every write, which the
+// splitters of pkg/shares avoid by allocating the shares of a blob at once
+// from its sequence length.
+func BenchmarkSparseShareSplitter_SmallBlobs(b *testing.B) {
+	testCases := []struct {
+		numberOfBlobs, blobSize int
+	}{
+		{numberOfBlobs: 1_000, blobSize: 100},
+		{numberOfBlobs: 10_000, blobSize: 100},
+		{numberOfBlobs: 10_000, blobSize: share.FirstSparseShareContentSize + 1},
+	}
+	for _, testCase := range testCases {
+		blobs := smallBlobs(b, testCase.numberOfBlobs, testCase.blobSize)
+		name := fmt.Sprintf("%d blobs of %d bytes", testCase.numberOfBlobs, testCase.blobSize)
+		b.Run(name+" go-square", func(b *testing.B) {
+			benchmarkSparseShareSplitter(b, blobs, func() sparseShareSplitter {
+				return share.NewSparseShareSplitter()
+			})
+		})
+		b.Run(name+" shares", func(b *testing.B) {
+			benchmarkSparseShareSplitter(b, blobs, func() sparseShareSplitter {
+				return shares.NewSparseShareSplitter(shares.SplitterHooks{})
+			})
+		})
+		b.Run(name+" streaming", func(b *testing.B) {
+			benchmarkStreamingSparseShareSplitter(b, blobs)
+		})
+	}
+}
+
+type sparseShareSplitter interface {
+	Write(blob *share.Blob) error
+	Export() []share.Share
+}
+
+func smallBlobs(b *testing.B, count, size int) []*share.Blob {
+	blobs := make([]*share.Blob, count)
+	for i := range blobs {
+		blob, err := share.NewBlob(share.RandomBlobNamespace(), make([]byte, size), share.ShareVersionZero, nil)
+		require.NoError(b, err)
+		blobs[i] = blob
+	}
+	return blobs
+}
+
+func benchmarkSparseShareSplitter(b *testing.B, blobs []*share.Blob, newSplitter func() sparseShareSplitter) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	var exported []share.Share
+	for i := 0; i < b.N; i++ {
+		splitter := newSplitter()
+		for _, blob := range blobs {
+			require.NoError(b, splitter.Write(blob))
+		}
+		exported = splitter.Export()
+	}
+	b.StopTimer()
+
+	b.ReportMetric(float64(len(exported)), "shares")
+}
+
+func benchmarkStreamingSparseShareSplitter(b *testing.B, blobs []*share.Blob) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	var count int
+	for i := 0; i < b.N; i++ {
+		splitter := shares.NewStreamingSparseShareSplitter(func(share.Share) error { return nil })
+		for _, blob := range blobs {
+			require.NoError(b, splitter.WriteBlobFrom(bytes.NewReader(blob.Data()), blob.Namespace(), blob.ShareVersion(), blob.Signer(), uint32(blob.DataLen())))
+		}
+		count = splitter.Count()
+	}
+	b.StopTimer()
+
+	b.ReportMetric(float64(count), "shares")
+}
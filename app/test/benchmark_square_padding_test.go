@@ -0,0 +1,172 @@
+package app_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/shares"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// BenchmarkSquareBuild_NamespacePadding measures the full block layout of a
+// square where blobs alternate between a single share and a size that
+// requires alignment, so that a large number of namespace padding shares are
+// inserted between them.
+func BenchmarkSquareBuild_NamespacePadding(b *testing.B) {
+	testCases := []struct {
+		numberOfTransactions, squareSize int
+	}{
+		{numberOfTransactions: 100, squareSize: 64},
+		{numberOfTransactions: 400, squareSize: 128},
+		{numberOfTransactions: 1_600, squareSize: 256},
+	}
+	for _, testCase := range testCases {
+		b.Run(fmt.Sprintf("%d transactions square size %d", testCase.numberOfTransactions, testCase.squareSize), func(b *testing.B) {
+			benchmarkSquareBuildNamespacePadding(b, testCase.numberOfTransactions, testCase.squareSize)
+		})
+	}
+}
+
+func benchmarkSquareBuildNamespacePadding(b *testing.B, count, squareSize int) {
+	txs := generatePaddedBlobTransactions(b, count)
+	threshold := appconsts.SubtreeRootThreshold(appconsts.LatestVersion)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	var dataSquare square.Square
+	for i := 0; i < b.N; i++ {
+		var err error
+		dataSquare, _, err = square.Build(txs, squareSize, threshold)
+		require.NoError(b, err)
+	}
+	b.StopTimer()
+
+	paddingShares := 0
+	for _, sh := range dataSquare {
+		if sh.IsPadding() {
+			paddingShares++
+		}
+	}
+	b.ReportMetric(float64(paddingShares), "padding_shares")
+	b.ReportMetric(float64(len(dataSquare)), "total_shares")
+}
+
+// BenchmarkSparseShareSplitter_NamespacePadding measures laying out the blobs
+// of a full square with the same padding as
+// BenchmarkSquareBuild_NamespacePadding: namespace padding between the blobs to
+// align them and tail padding up to the square size. The splitter of
+// pkg/shares copies the padding shares from templates while the go-square
+// splitter builds each padding share separately.
+func BenchmarkSparseShareSplitter_NamespacePadding(b *testing.B) {
+	testCases := []struct {
+		numberOfBlobs, squareSize int
+	}{
+		{numberOfBlobs: 100, squareSize: 64},
+		{numberOfBlobs: 400, squareSize: 128},
+		{numberOfBlobs: 1_600, squareSize: 256},
+	}
+	for _, testCase := range testCases {
+		blobs := generatePaddedBlobs(b, testCase.numberOfBlobs)
+		name := fmt.Sprintf("%d blobs square size %d", testCase.numberOfBlobs, testCase.squareSize)
+		b.Run(name+" go-square", func(b *testing.B) {
+			benchmarkSparseShareSplitterNamespacePadding(b, blobs, testCase.squareSize, func() paddingSplitter {
+				return share.NewSparseShareSplitter()
+			}, share.TailPaddingShares)
+		})
+		b.Run(name+" shares", func(b *testing.B) {
+			benchmarkSparseShareSplitterNamespacePadding(b, blobs, testCase.squareSize, func() paddingSplitter {
+				return shares.NewSparseShareSplitter(shares.SplitterHooks{})
+			}, shares.TailPaddingShares)
+		})
+	}
+}
+
+type paddingSplitter interface {
+	sparseShareSplitter
+	WriteNamespacePaddingShares(count int) error
+	Count() int
+}
+
+func benchmarkSparseShareSplitterNamespacePadding(b *testing.B, blobs []*share.Blob, squareSize int, newSplitter func() paddingSplitter, tailPadding func(n int) []share.Share) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	var layout []share.Share
+	for i := 0; i < b.N; i++ {
+		splitter := newSplitter()
+		for _, blob := range blobs {
+			blobShareLen, err := shares.BlobShareLen(blob.DataLen(), blob.ShareVersion())
+			require.NoError(b, err)
+			if splitter.Count() > 0 {
+				padding := shares.PaddingShares(appconsts.LatestVersion, splitter.Count(), blobShareLen)
+				require.NoError(b, splitter.WriteNamespacePaddingShares(padding))
+			}
+			require.NoError(b, splitter.Write(blob))
+		}
+		layout = splitter.Export()
+		require.LessOrEqual(b, len(layout), squareSize*squareSize)
+		layout = append(layout, tailPadding(squareSize*squareSize-len(layout))...)
+	}
+	b.StopTimer()
+
+	paddingShares := 0
+	for _, sh := range layout {
+		if sh.IsPadding() {
+			paddingShares++
+		}
+	}
+	b.ReportMetric(float64(paddingShares), "padding_shares")
+	b.ReportMetric(float64(len(layout)), "total_shares")
+}
+
+// generatePaddedBlobs returns count blobs whose sizes alternate like those of
+// generatePaddedBlobTransactions.
+func generatePaddedBlobs(b *testing.B, count int) []*share.Blob {
+	threshold := appconsts.SubtreeRootThreshold(appconsts.LatestVersion)
+	sizes := []int{
+		share.FirstSparseShareContentSize,
+		share.FirstSparseShareContentSize + threshold*share.ContinuationSparseShareContentSize,
+	}
+	blobs := make([]*share.Blob, count)
+	for i := range blobs {
+		blob, err := share.NewBlob(share.RandomBlobNamespace(), crypto.CRandBytes(sizes[i%len(sizes)]), share.ShareVersionZero, nil)
+		require.NoError(b, err)
+		blobs[i] = blob
+	}
+	return blobs
+}
+
+// generatePaddedBlobTransactions returns count signed blob transactions whose
+// blob sizes alternate between one share and one share more than the subtree
+// root threshold, which maximises the namespace padding in the square.
+func generatePaddedBlobTransactions(b *testing.B, count int) [][]byte {
+	account := "test"
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(enc.Codec, account)
+	signer, err := user.NewSigner(kr, enc.TxConfig, testutil.ChainID, appconsts.LatestVersion, user.NewAccount(account, 0, 0))
+	require.NoError(b, err)
+
+	threshold := appconsts.SubtreeRootThreshold(appconsts.LatestVersion)
+	sizes := []int{
+		share.FirstSparseShareContentSize,
+		share.FirstSparseShareContentSize + threshold*share.ContinuationSparseShareContentSize,
+	}
+	rawTxs := make([][]byte, 0, count)
+	for i := 0; i < count; i++ {
+		blob, err := share.NewBlob(share.RandomBlobNamespace(), crypto.CRandBytes(sizes[i%len(sizes)]), share.ShareVersionZero, nil)
+		require.NoError(b, err)
+		tx, _, err := signer.CreatePayForBlobs(account, []*share.Blob{blob}, user.SetGasLimit(2549760000), user.SetFee(10000))
+		require.NoError(b, err)
+		rawTxs = append(rawTxs, tx)
+		require.NoError(b, signer.SetSequence(account, uint64(i+1)))
+	}
+	return rawTxs
+}
@@ -0,0 +1,68 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/blobs"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+// TestBlobEvents verifies that the end of a block emits events that locate
+// its blobs in the data square.
+func TestBlobEvents(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := testfactory.GenerateAccounts(1)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	signer, err := user.NewSigner(kr, encCfg.TxConfig, testutil.ChainID, appconsts.LatestVersion, user.NewAccount(accounts[0], 1, 0))
+	require.NoError(t, err)
+
+	namespaces := []share.Namespace{share.RandomBlobNamespace(), share.RandomBlobNamespace()}
+	blobList := make([]*share.Blob, len(namespaces))
+	for i, ns := range namespaces {
+		blobList[i], err = share.NewV0Blob(ns, []byte("blob data"))
+		require.NoError(t, err)
+	}
+	rawTx, _, err := signer.CreatePayForBlobs(accounts[0], blobList, user.SetGasLimitAndGasPrice(1e6, appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+	blobTx, _, err := blobtx.UnmarshalBlobTx(rawTx)
+	require.NoError(t, err)
+
+	height := testApp.LastBlockHeight() + 1
+	testApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+		ChainID: testutil.ChainID,
+		Height:  height,
+		Time:    time.Now(),
+		Version: tmversion.Consensus{App: appconsts.LatestVersion},
+	}})
+	res := testApp.DeliverTx(abci.RequestDeliverTx{Tx: blobTx.Tx})
+	require.EqualValues(t, abci.CodeTypeOK, res.Code, res.Log)
+	endBlock := testApp.EndBlock(abci.RequestEndBlock{Height: height})
+	testApp.Commit()
+
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion), rawTx)
+	require.NoError(t, err)
+	for i, ns := range namespaces {
+		events, err := blobs.BlobEvents(endBlock.Events, ns.Bytes())
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		start, err := builder.FindBlobStartingIndex(0, i)
+		require.NoError(t, err)
+		assert.EqualValues(t, i, events[0].BlobIndex)
+		assert.EqualValues(t, start, events[0].ShareStart)
+		assert.EqualValues(t, start+1, events[0].ShareEnd)
+	}
+}
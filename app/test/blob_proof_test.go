@@ -0,0 +1,143 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestQueryBlobProof(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping blob proof test in short mode.")
+	}
+
+	accounts := testfactory.GenerateAccounts(1)
+	cfg := testnode.DefaultConfig().WithFundedAccounts(accounts...)
+	cctx, _, _ := testnode.NewNetwork(t, cfg)
+	require.NoError(t, cctx.WaitForNextBlock())
+
+	txClient, err := testnode.NewTxClientFromContext(cctx)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(cctx.GoContext(), time.Minute)
+	defer cancel()
+	blobs := blobfactory.ManyRandBlobs(tmrand.NewRand(), 1000, 10_000)
+	res, err := txClient.SubmitPayForBlob(ctx, blobs, user.SetGasLimitAndGasPrice(1e6, appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+
+	queryClient := blobtypes.NewProofQueryClient(cctx.GRPCClient)
+	block, err := cctx.Client.Block(ctx, &res.Height)
+	require.NoError(t, err)
+	for _, blob := range blobs {
+		commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+		require.NoError(t, err)
+		got, err := queryClient.BlobProof(ctx, &blobtypes.QueryBlobProofRequest{
+			Height:     res.Height,
+			Namespace:  blob.Namespace().Bytes(),
+			Commitment: commitment,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []byte(block.Block.DataHash), got.DataRoot)
+
+		var shareProof proof.ShareProof
+		require.NoError(t, shareProof.Unmarshal(got.Proof))
+		require.NoError(t, shareProof.Validate(got.DataRoot))
+		blobShares, err := blob.ToShares()
+		require.NoError(t, err)
+		assert.Len(t, shareProof.Data, len(blobShares))
+		assert.EqualValues(t, len(blobShares), got.EndShare-got.StartShare)
+	}
+
+	_, err = queryClient.BlobProof(ctx, &blobtypes.QueryBlobProofRequest{
+		Height:     res.Height,
+		Namespace:  blobs[0].Namespace().Bytes(),
+		Commitment: []byte("commitment"),
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = queryClient.BlobProof(ctx, &blobtypes.QueryBlobProofRequest{Height: res.Height, Namespace: []byte("namespace")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryNamespaceShares(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping namespace shares test in short mode.")
+	}
+
+	accounts := testfactory.GenerateAccounts(1)
+	cfg := testnode.DefaultConfig().WithFundedAccounts(accounts...)
+	cctx, _, _ := testnode.NewNetwork(t, cfg)
+	require.NoError(t, cctx.WaitForNextBlock())
+
+	txClient, err := testnode.NewTxClientFromContext(cctx)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(cctx.GoContext(), time.Minute)
+	defer cancel()
+	blobs := blobfactory.ManyRandBlobs(tmrand.NewRand(), 10_000, 1000)
+	res, err := txClient.SubmitPayForBlob(ctx, blobs, user.SetGasLimitAndGasPrice(1e6, appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+	block, err := cctx.Client.Block(ctx, &res.Height)
+	require.NoError(t, err)
+
+	queryClient := blobtypes.NewProofQueryClient(cctx.GRPCClient)
+	blobShares, err := blobs[0].ToShares()
+	require.NoError(t, err)
+
+	// page through the shares of the namespace of the first blob.
+	var got []blobtypes.NamespaceShare
+	pageReq := &query.PageRequest{Limit: 5, CountTotal: true}
+	for {
+		page, err := queryClient.NamespaceShares(ctx, &blobtypes.QueryNamespaceSharesRequest{
+			Height:     res.Height,
+			Namespace:  blobs[0].Namespace().Bytes(),
+			Pagination: pageReq,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []byte(block.Block.DataHash), page.DataRoot)
+		assert.LessOrEqual(t, len(page.Shares), 5)
+		if pageReq.CountTotal {
+			assert.GreaterOrEqual(t, page.Pagination.Total, uint64(len(blobShares)))
+		}
+		got = append(got, page.Shares...)
+		if page.Pagination.NextKey == nil {
+			break
+		}
+		pageReq = &query.PageRequest{Key: page.Pagination.NextKey, Limit: 5}
+	}
+
+	var data [][]byte
+	for i, sh := range got {
+		if i > 0 {
+			assert.Equal(t, got[i-1].Index+1, sh.Index, "the shares of a namespace are contiguous")
+		}
+		if !sh.Padding {
+			data = append(data, sh.Data)
+		}
+	}
+	require.NotEmpty(t, got)
+	squareSize := block.Block.SquareSize
+	assert.EqualValues(t, got[0].Index/uint32(squareSize), got[0].Row)
+	assert.EqualValues(t, got[0].Index%uint32(squareSize), got[0].Col)
+	assert.Equal(t, share.ToBytes(blobShares), data)
+
+	_, err = queryClient.NamespaceShares(ctx, &blobtypes.QueryNamespaceSharesRequest{Height: res.Height, Namespace: []byte("namespace")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
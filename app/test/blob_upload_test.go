@@ -0,0 +1,93 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"google.golang.org/grpc"
+)
+
+// TestSubmitBlobTxInChunks verifies that a blob tx uploaded in chunks over the
+// SubmitBlobTx stream is included in a block, including after resuming an
+// interrupted upload.
+func TestSubmitBlobTxInChunks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping chunked blob upload test in short mode.")
+	}
+
+	accounts := testfactory.GenerateAccounts(1)
+	cfg := testnode.DefaultConfig().WithFundedAccounts(accounts...)
+	cctx, _, _ := testnode.NewNetwork(t, cfg)
+	require.NoError(t, cctx.WaitForNextBlock())
+
+	txClient, err := testnode.NewTxClientFromContext(cctx)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(cctx.GoContext(), time.Minute)
+	defer cancel()
+
+	blobs := blobfactory.ManyRandBlobs(tmrand.NewRand(), 300_000, 100)
+	rawBlobTx, _, err := txClient.Signer().CreatePayForBlobs(txClient.DefaultAccountName(), blobs, user.SetGasLimitAndGasPrice(1e7, appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+
+	chunkSize := 64 * 1024
+	uploadClient := tx.NewTxClient(cctx.GRPCClient)
+
+	// Only the first chunk reaches the node so the upload is incomplete.
+	partialRes, err := tx.SubmitBlobTxInChunks(ctx, stubUploadLimit{TxClient: uploadClient, maxChunks: 1}, rawBlobTx, chunkSize)
+	require.NoError(t, err)
+	require.False(t, partialRes.Complete)
+	require.Equal(t, []uint64{uint64(chunkSize), 0}, partialRes.Received)
+
+	status, err := uploadClient.BlobUploadStatus(ctx, &tx.BlobUploadStatusRequest{UploadToken: partialRes.UploadToken})
+	require.NoError(t, err)
+	require.Equal(t, partialRes.Received, status.Received)
+
+	res, err := tx.SubmitBlobTxInChunks(ctx, uploadClient, rawBlobTx, chunkSize)
+	require.NoError(t, err)
+	require.True(t, res.Complete)
+	require.Equal(t, abci.CodeTypeOK, res.Code, res.RawLog)
+	require.Equal(t, partialRes.UploadToken, res.TxHash)
+
+	confirmed, err := txClient.ConfirmTx(ctx, res.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, confirmed.Code)
+}
+
+// stubUploadLimit drops all chunks of SubmitBlobTx streams after maxChunks.
+type stubUploadLimit struct {
+	tx.TxClient
+	maxChunks int
+}
+
+func (c stubUploadLimit) SubmitBlobTx(ctx context.Context, opts ...grpc.CallOption) (tx.Tx_SubmitBlobTxClient, error) {
+	stream, err := c.TxClient.SubmitBlobTx(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &limitedUploadStream{Tx_SubmitBlobTxClient: stream, remaining: c.maxChunks}, nil
+}
+
+type limitedUploadStream struct {
+	tx.Tx_SubmitBlobTxClient
+	remaining int
+}
+
+func (s *limitedUploadStream) Send(req *tx.SubmitBlobTxRequest) error {
+	if req.Chunk != nil {
+		if s.remaining == 0 {
+			return nil
+		}
+		s.remaining--
+	}
+	return s.Tx_SubmitBlobTxClient.Send(req)
+}
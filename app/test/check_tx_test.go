@@ -2,17 +2,23 @@ package app_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
+	"time"
 
+	"cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 
 	"github.com/celestiaorg/celestia-app/v3/app"
 	"github.com/celestiaorg/celestia-app/v3/app/encoding"
 	apperr "github.com/celestiaorg/celestia-app/v3/app/errors"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
 	"github.com/celestiaorg/celestia-app/v3/pkg/user"
 	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
 	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
@@ -23,6 +29,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
 	coretypes "github.com/tendermint/tendermint/types"
 )
 
@@ -259,3 +267,154 @@ func createSigner(t *testing.T, kr keyring.Keyring, accountName string, enc clie
 	require.NoError(t, err)
 	return signer
 }
+
+func TestCheckTxBlobTxInfo(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accs := []string{"a", "b"}
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accs...)
+	testApp.Commit()
+
+	type test struct {
+		name              string
+		account           string
+		accNum            uint64
+		opts              []user.TxOption
+		expectedABCICode  uint32
+		wantGasSufficient bool
+		wantFeeSufficient bool
+	}
+	tests := []test{
+		{
+			name:              "sufficient fee",
+			account:           accs[0],
+			accNum:            1,
+			opts:              blobfactory.FeeTxOpts(1e9),
+			expectedABCICode:  abci.CodeTypeOK,
+			wantGasSufficient: true,
+			wantFeeSufficient: true,
+		},
+		{
+			name:              "insufficient fee",
+			account:           accs[1],
+			accNum:            2,
+			opts:              []user.TxOption{user.SetGasLimitAndGasPrice(1e9, appconsts.DefaultNetworkMinGasPrice/10)},
+			expectedABCICode:  sdkerrors.ErrInsufficientFee.ABCICode(),
+			wantGasSufficient: true,
+			wantFeeSufficient: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer := createSigner(t, kr, tt.account, encCfg.TxConfig, tt.accNum)
+			blob, err := share.NewV0Blob(share.RandomBlobNamespace(), bytes.Repeat([]byte{1}, 1000))
+			require.NoError(t, err)
+			blobTx, _, err := signer.CreatePayForBlobs(tt.account, []*share.Blob{blob}, tt.opts...)
+			require.NoError(t, err)
+
+			resp := testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: blobTx})
+			assert.Equal(t, tt.expectedABCICode, resp.Code, resp.Log)
+
+			var info app.BlobTxInfo
+			require.NoError(t, json.Unmarshal([]byte(resp.Info), &info))
+			assert.Equal(t, []uint32{1000}, info.BlobSizes)
+			assert.Equal(t, share.SparseSharesNeeded(1000), info.SharesNeeded)
+			assert.EqualValues(t, 1e9, info.GasWanted)
+			assert.Equal(t, tt.wantGasSufficient, info.GasSufficient)
+			assert.Equal(t, tt.wantFeeSufficient, info.FeeSufficient)
+		})
+	}
+}
+
+// TestCheckTxReservedNamespaces checks that CheckTx rejects PFBs that use a
+// reserved namespace with the code of its reserved namespace range.
+func TestCheckTxReservedNamespaces(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accs := []string{"a"}
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accs...)
+	testApp.Commit()
+
+	tests := []struct {
+		name      string
+		namespace share.Namespace
+		wantErr   *errors.Error
+	}{
+		{"tx namespace", share.TxNamespace, blobtypes.ErrReservedNamespace},
+		{"intermediate state roots namespace", share.IntermediateStateRootsNamespace, blobtypes.ErrReservedNamespace},
+		{"pay for blob namespace", share.PayForBlobNamespace, blobtypes.ErrReservedNamespace},
+		{"primary reserved padding namespace", share.PrimaryReservedPaddingNamespace, blobtypes.ErrReservedNamespace},
+		{"secondary reserved namespace", share.MinSecondaryReservedNamespace, blobtypes.ErrReservedNamespace},
+		{"tail padding namespace", share.TailPaddingNamespace, blobtypes.ErrReservedNamespace},
+		{"parity shares namespace", share.ParitySharesNamespace, blobtypes.ErrReservedNamespace},
+	}
+	ranges := blobtypes.ReservedNamespaceRanges()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer := createSigner(t, kr, accs[0], encCfg.TxConfig, 1)
+			blob, err := share.NewV0Blob(share.RandomBlobNamespace(), bytes.Repeat([]byte{1}, 100))
+			require.NoError(t, err)
+			msg, err := blobtypes.NewMsgPayForBlobs(signer.Account(accs[0]).Address().String(), appconsts.LatestVersion, blob)
+			require.NoError(t, err)
+			// the constructor rejects reserved namespaces so the PFB is
+			// modified after it's built.
+			msg.Namespaces[0] = tt.namespace.Bytes()
+			rawTx, err := signer.CreateTx([]sdk.Msg{msg}, blobfactory.FeeTxOpts(1e9)...)
+			require.NoError(t, err)
+			blobTx, err := tx.MarshalBlobTx(rawTx, blob)
+			require.NoError(t, err)
+
+			resp := testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: blobTx})
+			assert.Equal(t, blobtypes.ModuleName, resp.Codespace, resp.Log)
+			assert.Equal(t, tt.wantErr.ABCICode(), resp.Code, resp.Log)
+			for _, r := range ranges {
+				if bytes.Compare(r.Min, tt.namespace.Bytes()) <= 0 && bytes.Compare(tt.namespace.Bytes(), r.Max) <= 0 {
+					assert.Equal(t, r.Code, resp.Code, "the code of the first reserved range that contains the namespace")
+					break
+				}
+			}
+		})
+	}
+}
+
+// TestDeliverTxNestedReservedNamespaceV3 checks that a PFB using a reserved
+// namespace nested in an authz MsgExec, which ProcessProposal doesn't inspect,
+// fails DeliverTx at app version 3 with the code of ErrReservedNamespace that
+// the blocks of earlier binaries have in their results.
+func TestDeliverTxNestedReservedNamespaceV3(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accs := []string{"a"}
+	cparams := app.DefaultConsensusParams()
+	cparams.Version.AppVersion = v3.Version
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(cparams, accs...)
+	testApp.Commit()
+
+	signer, err := user.NewSigner(kr, encCfg.TxConfig, testutil.ChainID, v3.Version, user.NewAccount(accs[0], 1, 0))
+	require.NoError(t, err)
+	addr := signer.Account(accs[0]).Address()
+
+	namespaces := []share.Namespace{share.TxNamespace, share.PayForBlobNamespace, share.PrimaryReservedPaddingNamespace, share.TailPaddingNamespace, share.ParitySharesNamespace}
+	height := testApp.LastBlockHeight() + 1
+	testApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+		ChainID: testutil.ChainID,
+		Height:  height,
+		Time:    time.Now(),
+		Version: tmversion.Consensus{App: v3.Version},
+	}})
+	for _, ns := range namespaces {
+		blob, err := share.NewV0Blob(share.RandomBlobNamespace(), bytes.Repeat([]byte{1}, 100))
+		require.NoError(t, err)
+		pfb, err := blobtypes.NewMsgPayForBlobs(addr.String(), v3.Version, blob)
+		require.NoError(t, err)
+		pfb.Namespaces[0] = ns.Bytes()
+		exec := authz.NewMsgExec(addr, []sdk.Msg{pfb})
+		rawTx, err := signer.CreateTx([]sdk.Msg{&exec}, blobfactory.FeeTxOpts(1e9)...)
+		require.NoError(t, err)
+		require.NoError(t, signer.IncrementSequence(accs[0]))
+
+		res := testApp.DeliverTx(abci.RequestDeliverTx{Tx: rawTx})
+		assert.Equal(t, blobtypes.ModuleName, res.Codespace, res.Log)
+		assert.Equal(t, blobtypes.ErrReservedNamespace.ABCICode(), res.Code, res.Log)
+	}
+	testApp.EndBlock(abci.RequestEndBlock{Height: height})
+	testApp.Commit()
+}
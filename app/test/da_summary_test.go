@@ -0,0 +1,53 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestQueryDASummary(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping DA summary test in short mode.")
+	}
+
+	accounts := testfactory.GenerateAccounts(1)
+	cfg := testnode.DefaultConfig().WithFundedAccounts(accounts...)
+	cctx, _, _ := testnode.NewNetwork(t, cfg)
+	require.NoError(t, cctx.WaitForNextBlock())
+
+	txClient, err := testnode.NewTxClientFromContext(cctx)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(cctx.GoContext(), time.Minute)
+	defer cancel()
+	blobs := blobfactory.ManyRandBlobs(tmrand.NewRand(), 1000, 10_000)
+	res, err := txClient.SubmitPayForBlob(ctx, blobs, user.SetGasLimitAndGasPrice(1e6, appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+
+	summary, err := da.QuerySummary(ctx, cctx.Client, res.Height)
+	require.NoError(t, err)
+
+	block, err := cctx.Client.Block(ctx, &res.Height)
+	require.NoError(t, err)
+	assert.Equal(t, res.Height, summary.Height)
+	assert.Equal(t, block.Block.DataHash, summary.DataRoot)
+	assert.Len(t, summary.RowRoots, 2*summary.SquareSize)
+	assert.Len(t, summary.ColumnRoots, 2*summary.SquareSize)
+	require.Len(t, summary.Namespaces, len(blobs))
+	for _, ns := range summary.Namespaces {
+		assert.Equal(t, 1, ns.Blobs)
+	}
+	assert.False(t, summary.Blobstream.Attested)
+	assert.NotEmpty(t, summary.Blobstream.Reason)
+}
@@ -1,14 +1,22 @@
 package app_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	coretypes "github.com/tendermint/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
 )
 
 func TestExportAppStateAndValidators(t *testing.T) {
@@ -54,3 +62,36 @@ func upgradeToV2(t *testing.T, testApp *app.App) {
 	testApp.Commit()
 	require.EqualValues(t, 3, testApp.LastBlockHeight())
 }
+
+func TestExportAtHalt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "export.json")
+	appOpts := appOptions{app.FlagExportAtHalt: path, server.FlagHaltHeight: 2}
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	testApp := app.New(log.NewNopLogger(), tmdb.NewMemDB(), nil, 0, encCfg, 0, 0, appOpts)
+	genesisState, _, _ := util.GenesisStateWithSingleValidator(testApp, "account")
+	testApp = util.InitialiseTestAppWithGenesis(testApp, app.DefaultConsensusParams(), genesisState)
+
+	for height := int64(1); height <= 2; height++ {
+		require.NoFileExists(t, path)
+		testApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+			ChainID: util.ChainID,
+			Height:  height,
+			Version: tmversion.Consensus{App: appconsts.LatestVersion},
+		}})
+		testApp.EndBlock(abci.RequestEndBlock{Height: height})
+		testApp.Commit()
+	}
+
+	doc, err := coretypes.GenesisDocFromFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, util.ChainID, doc.ChainID)
+	assert.Equal(t, int64(3), doc.InitialHeight)
+	assert.Len(t, doc.Validators, 1)
+	assert.Equal(t, appconsts.LatestVersion, doc.ConsensusParams.Version.AppVersion)
+}
+
+type appOptions map[string]interface{}
+
+func (o appOptions) Get(key string) interface{} {
+	return o[key]
+}
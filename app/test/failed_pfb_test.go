@@ -0,0 +1,173 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+// TestFailedPFBIsCharged verifies that a PFB whose fee payer spent its funds
+// earlier in the block is still charged for its blobs.
+func TestFailedPFBIsCharged(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := testfactory.GenerateAccounts(2)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	signer, err := user.NewSigner(kr, encCfg.TxConfig, testutil.ChainID, appconsts.LatestVersion, user.NewAccount(accounts[0], 1, 0))
+	require.NoError(t, err)
+	addr := testfactory.GetAddress(kr, accounts[0])
+	recipient := testfactory.GetAddress(kr, accounts[1])
+
+	// the sender keeps less than the fee of the PFB.
+	const left = 1000
+	sendFee := int64(1e5 * appconsts.DefaultMinGasPrice)
+	balance := testApp.BankKeeper.GetBalance(testApp.NewContext(true, tmproto.Header{}), addr, app.BondDenom)
+	send := banktypes.NewMsgSend(addr, recipient, sdk.NewCoins(sdk.NewCoin(app.BondDenom, balance.Amount.SubRaw(sendFee+left))))
+	sendTx, err := signer.CreateTx([]sdk.Msg{send}, user.SetGasLimitAndGasPrice(1e5, appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+	require.NoError(t, signer.IncrementSequence(accounts[0]))
+
+	blob, err := share.NewV0Blob(share.RandomBlobNamespace(), []byte("blob data"))
+	require.NoError(t, err)
+	rawTx, _, err := signer.CreatePayForBlobs(accounts[0], []*share.Blob{blob}, user.SetGasLimitAndGasPrice(1e6, appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+	blobTx, _, err := blobtx.UnmarshalBlobTx(rawTx)
+	require.NoError(t, err)
+
+	height := testApp.LastBlockHeight() + 1
+	testApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+		ChainID: testutil.ChainID,
+		Height:  height,
+		Time:    time.Now(),
+		Version: tmversion.Consensus{App: appconsts.LatestVersion},
+	}})
+	res := testApp.DeliverTx(abci.RequestDeliverTx{Tx: sendTx})
+	require.EqualValues(t, abci.CodeTypeOK, res.Code, res.Log)
+	res = testApp.DeliverTx(abci.RequestDeliverTx{Tx: blobTx.Tx})
+	assert.Equal(t, blobtypes.ErrFailedPFBCharged.Codespace(), res.Codespace)
+	assert.Equal(t, blobtypes.ErrFailedPFBCharged.ABCICode(), res.Code)
+	endBlock := testApp.EndBlock(abci.RequestEndBlock{Height: height})
+	testApp.Commit()
+
+	var events []*blobtypes.EventFailedPayForBlobs
+	for _, event := range endBlock.Events {
+		if event.Type != blobtypes.EventTypeFailedPayForBlobs {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		events = append(events, msg.(*blobtypes.EventFailedPayForBlobs))
+	}
+	require.Len(t, events, 1)
+	assert.Equal(t, addr.String(), events[0].FeePayer)
+	assert.Equal(t, sdk.NewInt64Coin(app.BondDenom, left).String(), events[0].Fee)
+	assert.Equal(t, sdkerrors.ErrInsufficientFunds.Codespace(), events[0].Codespace)
+	assert.Equal(t, sdkerrors.ErrInsufficientFunds.ABCICode(), events[0].Code)
+
+	ctx := testApp.NewContext(true, tmproto.Header{})
+	assert.True(t, testApp.BankKeeper.GetBalance(ctx, addr, app.BondDenom).IsZero())
+	// the PFB can't be included again.
+	assert.EqualValues(t, 2, testApp.AccountKeeper.GetAccount(ctx, addr).GetSequence())
+}
+
+// TestFailedPFBIsChargedToFeeGranter verifies that a failed PFB is charged to
+// its fee granter if the allowance covers the fee and to its fee payer
+// otherwise.
+func TestFailedPFBIsChargedToFeeGranter(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := testfactory.GenerateAccounts(3)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	signer, err := user.NewSigner(kr, encCfg.TxConfig, testutil.ChainID, appconsts.LatestVersion,
+		user.NewAccount(accounts[0], 1, 0), user.NewAccount(accounts[1], 2, 0))
+	require.NoError(t, err)
+	grantee := testfactory.GetAddress(kr, accounts[0])
+	granter := testfactory.GetAddress(kr, accounts[1])
+	recipient := testfactory.GetAddress(kr, accounts[2])
+
+	allowance, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{}, granter, grantee)
+	require.NoError(t, err)
+	grantTx, err := signer.CreateTx([]sdk.Msg{allowance}, user.SetGasLimitAndGasPrice(1e5, appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+	require.NoError(t, signer.IncrementSequence(accounts[1]))
+
+	// the granter keeps less than the fee of the PFB.
+	const left = 1000
+	sendFee := int64(1e5 * appconsts.DefaultMinGasPrice)
+	grantFee := int64(1e5 * appconsts.DefaultMinGasPrice)
+	balance := testApp.BankKeeper.GetBalance(testApp.NewContext(true, tmproto.Header{}), granter, app.BondDenom)
+	send := banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewCoin(app.BondDenom, balance.Amount.SubRaw(grantFee+sendFee+left))))
+	sendTx, err := signer.CreateTx([]sdk.Msg{send}, user.SetGasLimitAndGasPrice(1e5, appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+	require.NoError(t, signer.IncrementSequence(accounts[1]))
+
+	newPFB := func(opts ...user.TxOption) []byte {
+		blob, err := share.NewV0Blob(share.RandomBlobNamespace(), []byte("blob data"))
+		require.NoError(t, err)
+		rawTx, _, err := signer.CreatePayForBlobs(accounts[0], []*share.Blob{blob}, append(opts, user.SetGasLimitAndGasPrice(1e6, appconsts.DefaultMinGasPrice))...)
+		require.NoError(t, err)
+		require.NoError(t, signer.IncrementSequence(accounts[0]))
+		blobTx, _, err := blobtx.UnmarshalBlobTx(rawTx)
+		require.NoError(t, err)
+		return blobTx.Tx
+	}
+	grantedPFB := newPFB(user.SetFeeGranter(granter))
+	// recipient never granted an allowance, so the fee payer pays.
+	ungrantedPFB := newPFB(user.SetFeeGranter(recipient))
+
+	granteeBalance := testApp.BankKeeper.GetBalance(testApp.NewContext(true, tmproto.Header{}), grantee, app.BondDenom)
+	height := testApp.LastBlockHeight() + 1
+	testApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+		ChainID: testutil.ChainID,
+		Height:  height,
+		Time:    time.Now(),
+		Version: tmversion.Consensus{App: appconsts.LatestVersion},
+	}})
+	for _, tx := range [][]byte{grantTx, sendTx} {
+		res := testApp.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+		require.EqualValues(t, abci.CodeTypeOK, res.Code, res.Log)
+	}
+	for _, tx := range [][]byte{grantedPFB, ungrantedPFB} {
+		res := testApp.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+		require.Equal(t, blobtypes.ErrFailedPFBCharged.ABCICode(), res.Code, res.Log)
+	}
+	endBlock := testApp.EndBlock(abci.RequestEndBlock{Height: height})
+	testApp.Commit()
+
+	var events []*blobtypes.EventFailedPayForBlobs
+	for _, event := range endBlock.Events {
+		if event.Type != blobtypes.EventTypeFailedPayForBlobs {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		events = append(events, msg.(*blobtypes.EventFailedPayForBlobs))
+	}
+	require.Len(t, events, 2)
+	assert.Equal(t, granter.String(), events[0].FeePayer)
+	assert.Equal(t, sdk.NewInt64Coin(app.BondDenom, left).String(), events[0].Fee)
+	assert.Equal(t, grantee.String(), events[1].FeePayer)
+	fee := sdk.NewInt64Coin(app.BondDenom, int64(1e6*appconsts.DefaultMinGasPrice))
+	assert.Equal(t, fee.String(), events[1].Fee)
+
+	ctx := testApp.NewContext(true, tmproto.Header{})
+	assert.True(t, testApp.BankKeeper.GetBalance(ctx, granter, app.BondDenom).IsZero())
+	assert.Equal(t, granteeBalance.Sub(fee), testApp.BankKeeper.GetBalance(ctx, grantee, app.BondDenom))
+	assert.EqualValues(t, 2, testApp.AccountKeeper.GetAccount(ctx, grantee).GetSequence())
+}
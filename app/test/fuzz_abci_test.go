@@ -1,6 +1,7 @@
 package app_test
 
 import (
+	"math/rand"
 	"testing"
 	"time"
 
@@ -158,3 +159,86 @@ func TestPrepareProposalConsistency(t *testing.T) {
 		}
 	}
 }
+
+// FuzzPrepareProcessProposal checks the central invariant of the app that
+// every block that PrepareProposal produces is accepted by ProcessProposal
+// with random mixes of blob txs, send txs and undecodable txs. The signed txs
+// are generated once and every input picks a random subset of them in a random
+// order. Run it continuously with:
+//
+//	go test ./app/test -run FuzzPrepareProcessProposal -fuzz FuzzPrepareProcessProposal
+func FuzzPrepareProcessProposal(f *testing.F) {
+	if testing.Short() {
+		f.Skip("skipping FuzzPrepareProcessProposal in short mode.")
+	}
+	encConf := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := make([]string, 200) // 150 for creating blob txs, 50 for creating send txs
+	for i := range accounts {
+		accounts[i] = tmrand.Str(20)
+	}
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	blobTxs := testutil.RandBlobTxsWithAccounts(
+		f,
+		testApp,
+		encConf.TxConfig,
+		kr,
+		100_000,
+		3,
+		true,
+		testutil.ChainID,
+		accounts[:150],
+		user.SetGasLimitAndGasPrice(1_000_000_000, 0.1),
+	)
+	sendTxs := testutil.SendTxsWithAccounts(
+		f,
+		testApp,
+		encConf.TxConfig,
+		kr,
+		1000,
+		accounts[0],
+		accounts[150:],
+		testutil.ChainID,
+		user.SetGasLimitAndGasPrice(1_000_000, 0.1),
+	)
+
+	f.Add(int64(1), uint8(10), uint8(10), uint8(0))
+	f.Add(int64(2), uint8(150), uint8(50), uint8(5))
+	f.Add(int64(3), uint8(0), uint8(50), uint8(1))
+	f.Add(int64(4), uint8(100), uint8(0), uint8(0))
+
+	f.Fuzz(func(t *testing.T, seed int64, blobTxCount, sendTxCount, junkTxCount uint8) {
+		r := rand.New(rand.NewSource(seed))
+		txs := make([][]byte, 0)
+		for _, i := range r.Perm(len(blobTxs))[:min(int(blobTxCount), len(blobTxs))] {
+			txs = append(txs, blobTxs[i])
+		}
+		for _, i := range r.Perm(len(sendTxs))[:min(int(sendTxCount), len(sendTxs))] {
+			txs = append(txs, sendTxs[i])
+		}
+		for range junkTxCount {
+			junk := make([]byte, 1+r.Intn(1000))
+			_, _ = r.Read(junk)
+			txs = append(txs, junk)
+		}
+		r.Shuffle(len(txs), func(i, j int) { txs[i], txs[j] = txs[j], txs[i] })
+
+		blockTime := time.Now()
+		height := testApp.LastBlockHeight() + 1
+		resp := testApp.PrepareProposal(abci.RequestPrepareProposal{
+			BlockData: &core.Data{Txs: txs},
+			ChainId:   testutil.ChainID,
+			Time:      blockTime,
+			Height:    height,
+		})
+		res := testApp.ProcessProposal(abci.RequestProcessProposal{
+			BlockData: resp.BlockData,
+			Header: core.Header{
+				DataHash: resp.BlockData.Hash,
+				ChainID:  testutil.ChainID,
+				Version:  version.Consensus{App: appconsts.LatestVersion},
+				Height:   height,
+			},
+		})
+		require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Result)
+	})
+}
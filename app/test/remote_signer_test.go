@@ -0,0 +1,52 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// TestRemoteSigner verifies that a validator that signs votes and proposals
+// with a remote signer, e.g. tmkms, produces blocks that contain blob txs.
+func TestRemoteSigner(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping remote signer test in short mode.")
+	}
+
+	accounts := testfactory.GenerateAccounts(1)
+	cfg := testnode.DefaultConfig().
+		WithFundedAccounts(accounts...).
+		WithRemoteSigner()
+	cctx, _, _ := testnode.NewNetwork(t, cfg)
+	require.NoError(t, cctx.WaitForNextBlock())
+
+	txClient, err := testnode.NewTxClientFromContext(cctx)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(cctx.GoContext(), time.Minute)
+	defer cancel()
+	blobs := blobfactory.ManyRandBlobs(tmrand.NewRand(), 1000, 10_000)
+	res, err := txClient.SubmitPayForBlob(ctx, blobs, user.SetGasLimitAndGasPrice(1e6, appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, res.Code)
+
+	// The block that includes the blob tx must have been committed with a
+	// vote of the validator that was signed by the remote signer.
+	require.NoError(t, cctx.WaitForNextBlock())
+	commit, err := cctx.Client.Commit(ctx, &res.Height)
+	require.NoError(t, err)
+	validators, err := cctx.Client.Validators(ctx, &res.Height, nil, nil)
+	require.NoError(t, err)
+	valSet := tmtypes.NewValidatorSet(validators.Validators)
+	require.NoError(t, valSet.VerifyCommitLight(cctx.ChainID, commit.Commit.BlockID, res.Height, commit.Commit))
+}
@@ -0,0 +1,60 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestRunUpgradeChecks(t *testing.T) {
+	cparams := app.DefaultConsensusParams()
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(cparams)
+	appVersion := cparams.Version.AppVersion
+	newCtx := func(appVersion uint64) sdk.Context {
+		return testApp.NewContext(true, tmproto.Header{Height: testApp.LastBlockHeight() + 1, Version: version.Consensus{App: appVersion}})
+	}
+
+	failed := func(results []app.UpgradeCheckResult) map[string]error {
+		errs := make(map[string]error)
+		for _, result := range results {
+			if result.Err != nil {
+				errs[result.Name] = result.Err
+			}
+		}
+		return errs
+	}
+
+	ctx := newCtx(appVersion)
+	results := testApp.RunUpgradeChecks(ctx)
+	require.Len(t, results, 4)
+	assert.Empty(t, failed(results))
+
+	// a block of another app version than the one the app runs
+	assert.Contains(t, failed(testApp.RunUpgradeChecks(newCtx(appVersion-1))), "app_version")
+
+	// a gov max square size above the upper bound of the app version
+	subspace, ok := testApp.ParamsKeeper.GetSubspace(blobtypes.ModuleName)
+	require.True(t, ok)
+	subspace.Set(ctx, blobtypes.KeyGovMaxSquareSize, uint64(1024))
+	errs := failed(testApp.RunUpgradeChecks(ctx))
+	assert.ErrorContains(t, errs["versioned_constants"], "exceeds the square size upper bound")
+}
+
+func TestRunUpgradeChecksAllVersions(t *testing.T) {
+	for _, appVersion := range []uint64{1, 2, 3} {
+		cparams := app.DefaultConsensusParams()
+		cparams.Version.AppVersion = appVersion
+		testApp, _ := testutil.SetupTestAppWithGenesisValSet(cparams)
+		ctx := testApp.NewContext(true, tmproto.Header{Version: version.Consensus{App: appVersion}})
+		for _, result := range testApp.RunUpgradeChecks(ctx) {
+			assert.NoError(t, result.Err, "app version %d check %s", appVersion, result.Name)
+		}
+	}
+}
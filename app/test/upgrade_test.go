@@ -11,12 +11,17 @@ import (
 	v1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
 	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
 	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
 	"github.com/celestiaorg/celestia-app/v3/pkg/user"
 	"github.com/celestiaorg/celestia-app/v3/test/util"
 	"github.com/celestiaorg/celestia-app/v3/test/util/genesis"
 	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
 	blobstreamtypes "github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	"github.com/celestiaorg/celestia-app/v3/x/icahostfilter"
 	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	namespacetypes "github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	"github.com/celestiaorg/celestia-app/v3/x/ratelimit"
 	signaltypes "github.com/celestiaorg/celestia-app/v3/x/signal/types"
 	"github.com/celestiaorg/go-square/v2/share"
 	"github.com/celestiaorg/go-square/v2/tx"
@@ -24,7 +29,9 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v6/packetforward/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
 	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -41,86 +48,9 @@ func TestAppUpgradeV3(t *testing.T) {
 	testApp, genesis := SetupTestAppWithUpgradeHeight(t, 3)
 	upgradeFromV1ToV2(t, testApp)
 
-	ctx := testApp.NewContext(true, tmproto.Header{})
-	validators := testApp.StakingKeeper.GetAllValidators(ctx)
-	valAddr, err := sdk.ValAddressFromBech32(validators[0].OperatorAddress)
-	require.NoError(t, err)
-	record, err := genesis.Keyring().Key(testnode.DefaultValidatorAccountName)
-	require.NoError(t, err)
-	accAddr, err := record.GetAddress()
-	require.NoError(t, err)
-	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
-	resp, err := testApp.AccountKeeper.Account(ctx, &authtypes.QueryAccountRequest{
-		Address: accAddr.String(),
-	})
-	require.NoError(t, err)
-	var account authtypes.AccountI
-	err = encCfg.InterfaceRegistry.UnpackAny(resp.Account, &account)
-	require.NoError(t, err)
-
-	signer, err := user.NewSigner(
-		genesis.Keyring(), encCfg.TxConfig, testApp.GetChainID(), v3.Version,
-		user.NewAccount(testnode.DefaultValidatorAccountName, account.GetAccountNumber(), account.GetSequence()),
-	)
-	require.NoError(t, err)
-
-	upgradeTx, err := signer.CreateTx(
-		[]sdk.Msg{
-			signaltypes.NewMsgSignalVersion(valAddr, 3),
-			signaltypes.NewMsgTryUpgrade(accAddr),
-		},
-		user.SetGasLimitAndGasPrice(100_000, appconsts.DefaultMinGasPrice),
-	)
-	require.NoError(t, err)
-	testApp.BeginBlock(abci.RequestBeginBlock{
-		Header: tmproto.Header{
-			ChainID: genesis.ChainID,
-			Height:  3,
-			Version: tmversion.Consensus{App: 2},
-		},
-	})
-
-	deliverTxResp := testApp.DeliverTx(abci.RequestDeliverTx{
-		Tx: upgradeTx,
-	})
-	require.Equal(t, abci.CodeTypeOK, deliverTxResp.Code, deliverTxResp.Log)
-
-	endBlockResp := testApp.EndBlock(abci.RequestEndBlock{
-		Height: 3,
-	})
-	require.Equal(t, v2.Version, endBlockResp.ConsensusParamUpdates.Version.AppVersion)
-	require.Equal(t, appconsts.GetTimeoutCommit(v2.Version),
-		endBlockResp.Timeouts.TimeoutCommit)
-	require.Equal(t, appconsts.GetTimeoutPropose(v2.Version),
-		endBlockResp.Timeouts.TimeoutPropose)
-	testApp.Commit()
-	require.NoError(t, signer.IncrementSequence(testnode.DefaultValidatorAccountName))
-
-	ctx = testApp.NewContext(true, tmproto.Header{})
-	getUpgradeResp, err := testApp.SignalKeeper.GetUpgrade(ctx, &signaltypes.QueryGetUpgradeRequest{})
-	require.NoError(t, err)
-	require.Equal(t, v3.Version, getUpgradeResp.Upgrade.AppVersion)
-
-	initialHeight := int64(4)
-	for height := initialHeight; height < initialHeight+appconsts.UpgradeHeightDelay(testApp.GetChainID(), v2.Version); height++ {
-		appVersion := v2.Version
-		_ = testApp.BeginBlock(abci.RequestBeginBlock{
-			Header: tmproto.Header{
-				Height:  height,
-				Version: tmversion.Consensus{App: appVersion},
-			},
-		})
-
-		endBlockResp = testApp.EndBlock(abci.RequestEndBlock{
-			Height: 3 + appconsts.UpgradeHeightDelay(testApp.GetChainID(), v2.Version),
-		})
-
-		require.Equal(t, appconsts.GetTimeoutCommit(appVersion), endBlockResp.Timeouts.TimeoutCommit)
-		require.Equal(t, appconsts.GetTimeoutPropose(appVersion), endBlockResp.Timeouts.TimeoutPropose)
-
-		_ = testApp.Commit()
-	}
-	require.Equal(t, v3.Version, endBlockResp.ConsensusParamUpdates.Version.AppVersion)
+	signer, valAddr, accAddr := newValidatorSigner(t, testApp, genesis)
+	initialHeight := upgradeWithSignal(t, testApp, genesis.ChainID, signer, valAddr, accAddr, 3)
+	require.Equal(t, v3.Version, testApp.AppVersion())
 
 	// confirm that an authored blob tx works
 	blob, err := share.NewV1Blob(share.RandomBlobNamespace(), []byte("hello world"), accAddr.Bytes())
@@ -137,20 +67,79 @@ func TestAppUpgradeV3(t *testing.T) {
 	_ = testApp.BeginBlock(abci.RequestBeginBlock{
 		Header: tmproto.Header{
 			ChainID: genesis.ChainID,
-			Height:  initialHeight + appconsts.UpgradeHeightDelay(testApp.GetChainID(), v3.Version),
+			Height:  initialHeight,
 			Version: tmversion.Consensus{App: 3},
 		},
 	})
 
-	deliverTxResp = testApp.DeliverTx(abci.RequestDeliverTx{
+	deliverTxResp := testApp.DeliverTx(abci.RequestDeliverTx{
 		Tx: blobTx.Tx,
 	})
 	require.Equal(t, abci.CodeTypeOK, deliverTxResp.Code, deliverTxResp.Log)
 
-	respEndBlock := testApp.EndBlock(abci.
-		RequestEndBlock{Height: initialHeight + appconsts.UpgradeHeightDelay(testApp.GetChainID(), v3.Version)})
+	respEndBlock := testApp.EndBlock(abci.RequestEndBlock{Height: initialHeight})
 	require.Equal(t, appconsts.GetTimeoutCommit(v3.Version), respEndBlock.Timeouts.TimeoutCommit)
 	require.Equal(t, appconsts.GetTimeoutPropose(v3.Version), respEndBlock.Timeouts.TimeoutPropose)
+	testApp.Commit()
+}
+
+// TestAppUpgradeV4 verifies that the upgrade from v3 to v4 adds the modules
+// that were introduced in v4 and initializes their state.
+func TestAppUpgradeV4(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping TestAppUpgradeV4 in short mode")
+	}
+
+	// The chain starts at v3 so that no upgrade to v3 is recorded by the
+	// signal module.
+	testApp, genesis := setupTestAppWithAppVersion(t, 0, v3.Version)
+	signer, valAddr, accAddr := newValidatorSigner(t, testApp, genesis)
+
+	plan, err := testApp.MigrationPlan(v3.Version, v4.Version)
+	require.NoError(t, err)
+	added := make([]string, 0, len(plan))
+	migrated := make(map[string][2]uint64)
+	for _, migration := range plan {
+		if migration.FromVersion == 0 {
+			added = append(added, migration.Module)
+			continue
+		}
+		migrated[migration.Module] = [2]uint64{migration.FromVersion, migration.ToVersion}
+	}
+	require.ElementsMatch(t, []string{icahostfilter.ModuleName, namespacetypes.ModuleName, icacontrollertypes.SubModuleName, ratelimit.ModuleName, ibcfeetypes.ModuleName}, added)
+	// consensus version 3 of the blob module accepts MsgSetBlobFeeBudget
+	require.Equal(t, map[string][2]uint64{blobtypes.ModuleName: {2, 3}}, migrated)
+
+	// slashing params outside of the governance bounds of v4 are moved within
+	// them by the upgrade.
+	v3Ctx := sdk.NewContext(testApp.CommitMultiStore(), tmproto.Header{Version: tmversion.Consensus{App: v3.Version}}, false, log.NewNopLogger())
+	slashingParams := testApp.SlashingKeeper.GetParams(v3Ctx)
+	slashingParams.SignedBlocksWindow = 100
+	slashingParams.SlashFractionDowntime = sdk.NewDecWithPrec(5, 2)
+	testApp.SlashingKeeper.SetParams(v3Ctx, slashingParams)
+
+	upgradeWithSignal(t, testApp, genesis.ChainID, signer, valAddr, accAddr, 2)
+	require.Equal(t, v4.Version, testApp.AppVersion())
+
+	ctx := testApp.NewContext(true, tmproto.Header{Version: tmversion.Consensus{App: v4.Version}})
+	// confirm that the store of the namespace module is mounted and that its
+	// params are initialized
+	require.Equal(t, namespacetypes.DefaultParams(), testApp.NamespaceKeeper.GetParams(ctx))
+	require.Empty(t, testApp.NamespaceKeeper.GetAllRegistrations(ctx))
+	// confirm that the ICA controller is enabled
+	require.True(t, testApp.ICAControllerKeeper.IsControllerEnabled(ctx))
+	// confirm that the store of the ratelimit module is mounted and that no
+	// channel has a rate limit
+	require.Empty(t, testApp.RateLimitKeeper.GetFlows(ctx))
+	require.Empty(t, testApp.RateLimitKeeper.GetRateLimits(ctx))
+	// confirm that the store of the IBC fee module is mounted and that no
+	// channel is fee enabled
+	require.Empty(t, testApp.IBCFeeKeeper.GetAllFeeEnabledChannels(ctx))
+	require.False(t, testApp.IBCFeeKeeper.IsLocked(ctx))
+	slashingParams = testApp.SlashingKeeper.GetParams(ctx)
+	require.Equal(t, app.MinSignedBlocksWindow, slashingParams.SignedBlocksWindow)
+	require.Equal(t, app.MaxSlashFractionDowntime, slashingParams.SlashFractionDowntime)
+	require.Equal(t, app.DASlashingParams().MinSignedPerWindow, slashingParams.MinSignedPerWindow)
 }
 
 // TestAppUpgradeV2 verifies that the all module's params are overridden during an
@@ -250,14 +239,23 @@ func TestBlobstreamRemovedInV2(t *testing.T) {
 
 func SetupTestAppWithUpgradeHeight(t *testing.T, upgradeHeight int64) (*app.App, *genesis.Genesis) {
 	t.Helper()
+	return setupTestAppWithAppVersion(t, upgradeHeight, app.DefaultInitialConsensusParams().Version.AppVersion)
+}
+
+// setupTestAppWithAppVersion returns a test app whose chain starts with
+// appVersion.
+func setupTestAppWithAppVersion(t *testing.T, upgradeHeight int64, appVersion uint64) (*app.App, *genesis.Genesis) {
+	t.Helper()
 
 	db := dbm.NewMemDB()
 	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
 	testApp := app.New(log.NewNopLogger(), db, nil, 0, encCfg, upgradeHeight, 0, util.EmptyAppOptions{})
+	consensusParams := app.DefaultInitialConsensusParams()
+	consensusParams.Version.AppVersion = appVersion
 	genesis := genesis.NewDefaultGenesis().
 		WithChainID(appconsts.TestChainID).
 		WithValidators(genesis.NewDefaultValidator(testnode.DefaultValidatorAccountName)).
-		WithConsensusParams(app.DefaultInitialConsensusParams())
+		WithConsensusParams(consensusParams)
 	genDoc, err := genesis.Export()
 	require.NoError(t, err)
 	cp := genDoc.ConsensusParams
@@ -283,12 +281,11 @@ func SetupTestAppWithUpgradeHeight(t *testing.T, upgradeHeight int64) (*app.App,
 
 	// assert that the chain starts with version provided in genesis
 	infoResp := testApp.Info(abci.RequestInfo{})
-	appVersion := app.DefaultInitialConsensusParams().Version.AppVersion
 	require.EqualValues(t, appVersion, infoResp.AppVersion)
 	require.EqualValues(t, appconsts.GetTimeoutCommit(appVersion), infoResp.Timeouts.TimeoutCommit)
 	require.EqualValues(t, appconsts.GetTimeoutPropose(appVersion), infoResp.Timeouts.TimeoutPropose)
 
-	supportedVersions := []uint64{v1.Version, v2.Version, v3.Version}
+	supportedVersions := []uint64{v1.Version, v2.Version, v3.Version, v4.Version}
 	require.Equal(t, supportedVersions, testApp.SupportedVersions())
 
 	_ = testApp.Commit()
@@ -309,3 +306,102 @@ func upgradeFromV1ToV2(t *testing.T, testApp *app.App) {
 	testApp.Commit()
 	require.EqualValues(t, 2, testApp.AppVersion())
 }
+
+// upgradeWithSignal signals the next app version with the validator of
+// valAddr and tries the upgrade at height. It then runs blocks until the
+// upgrade takes effect and returns the height of the first block of the next
+// app version.
+func upgradeWithSignal(t *testing.T, testApp *app.App, chainID string, signer *user.Signer, valAddr sdk.ValAddress, accAddr sdk.AccAddress, height int64) int64 {
+	t.Helper()
+	fromVersion := testApp.AppVersion()
+	toVersion := fromVersion + 1
+
+	upgradeTx, err := signer.CreateTx(
+		[]sdk.Msg{
+			signaltypes.NewMsgSignalVersion(valAddr, toVersion),
+			signaltypes.NewMsgTryUpgrade(accAddr),
+		},
+		user.SetGasLimitAndGasPrice(100_000, appconsts.DefaultMinGasPrice),
+	)
+	require.NoError(t, err)
+	testApp.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			ChainID: chainID,
+			Height:  height,
+			Version: tmversion.Consensus{App: fromVersion},
+		},
+	})
+
+	deliverTxResp := testApp.DeliverTx(abci.RequestDeliverTx{
+		Tx: upgradeTx,
+	})
+	require.Equal(t, abci.CodeTypeOK, deliverTxResp.Code, deliverTxResp.Log)
+
+	endBlockResp := testApp.EndBlock(abci.RequestEndBlock{
+		Height: height,
+	})
+	require.Equal(t, fromVersion, endBlockResp.ConsensusParamUpdates.Version.AppVersion)
+	require.Equal(t, appconsts.GetTimeoutCommit(fromVersion),
+		endBlockResp.Timeouts.TimeoutCommit)
+	require.Equal(t, appconsts.GetTimeoutPropose(fromVersion),
+		endBlockResp.Timeouts.TimeoutPropose)
+	testApp.Commit()
+	require.NoError(t, signer.IncrementSequence(testnode.DefaultValidatorAccountName))
+
+	ctx := testApp.NewContext(true, tmproto.Header{})
+	getUpgradeResp, err := testApp.SignalKeeper.GetUpgrade(ctx, &signaltypes.QueryGetUpgradeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, toVersion, getUpgradeResp.Upgrade.AppVersion)
+
+	upgradeHeight := height + appconsts.UpgradeHeightDelay(chainID, fromVersion)
+	for h := height + 1; h <= upgradeHeight; h++ {
+		_ = testApp.BeginBlock(abci.RequestBeginBlock{
+			Header: tmproto.Header{
+				ChainID: chainID,
+				Height:  h,
+				Version: tmversion.Consensus{App: fromVersion},
+			},
+		})
+
+		endBlockResp = testApp.EndBlock(abci.RequestEndBlock{
+			Height: h,
+		})
+
+		require.Equal(t, appconsts.GetTimeoutCommit(fromVersion), endBlockResp.Timeouts.TimeoutCommit)
+		require.Equal(t, appconsts.GetTimeoutPropose(fromVersion), endBlockResp.Timeouts.TimeoutPropose)
+
+		_ = testApp.Commit()
+	}
+	require.Equal(t, toVersion, endBlockResp.ConsensusParamUpdates.Version.AppVersion)
+	return upgradeHeight + 1
+}
+
+// newValidatorSigner returns a signer of the account of the validator of
+// genesis with the address of the validator and of its account.
+func newValidatorSigner(t *testing.T, testApp *app.App, genesis *genesis.Genesis) (*user.Signer, sdk.ValAddress, sdk.AccAddress) {
+	t.Helper()
+	ctx := testApp.NewContext(true, tmproto.Header{})
+	validators := testApp.StakingKeeper.GetAllValidators(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].OperatorAddress)
+	require.NoError(t, err)
+	record, err := genesis.Keyring().Key(testnode.DefaultValidatorAccountName)
+	require.NoError(t, err)
+	accAddr, err := record.GetAddress()
+	require.NoError(t, err)
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	resp, err := testApp.AccountKeeper.Account(ctx, &authtypes.QueryAccountRequest{
+		Address: accAddr.String(),
+	})
+	require.NoError(t, err)
+	var account authtypes.AccountI
+	err = encCfg.InterfaceRegistry.UnpackAny(resp.Account, &account)
+	require.NoError(t, err)
+
+	signer, err := user.NewSigner(
+		genesis.Keyring(), encCfg.TxConfig, testApp.GetChainID(), v3.Version,
+		user.NewAccount(testnode.DefaultValidatorAccountName, account.GetAccountNumber(), account.GetSequence()),
+	)
+	require.NoError(t, err)
+
+	return signer, valAddr, accAddr
+}
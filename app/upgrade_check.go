@@ -0,0 +1,178 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// FlagUpgradeCheckBlocks is the flag to specify the number of blocks after an
+// upgrade during which the upgrade checks run. The checks are disabled if it
+// is 0.
+const FlagUpgradeCheckBlocks = "upgrade-check-blocks"
+
+// DefaultUpgradeCheckBlocks is the default of FlagUpgradeCheckBlocks.
+const DefaultUpgradeCheckBlocks = 100
+
+// UpgradeCheckResult is the result of one of the checks of RunUpgradeChecks.
+type UpgradeCheckResult struct {
+	Name string
+	// Err is nil if the check passed.
+	Err error
+}
+
+// RunUpgradeChecks runs lightweight self-checks of the state of the app
+// version of ctx that catch botched upgrades: that the app version and the
+// consensus params agree, that the stores of the app version are mounted,
+// that the params exist and are valid and that the versioned constants are
+// consistent with them. The checks only read state.
+func (app *App) RunUpgradeChecks(ctx sdk.Context) []UpgradeCheckResult {
+	checks := []struct {
+		name  string
+		check func(sdk.Context, uint64) error
+	}{
+		{"app_version", app.checkAppVersion},
+		{"stores", app.checkStores},
+		{"params", app.checkParams},
+		{"versioned_constants", app.checkVersionedConstants},
+	}
+	appVersion := ctx.BlockHeader().Version.App
+	results := make([]UpgradeCheckResult, len(checks))
+	for i, c := range checks {
+		results[i] = UpgradeCheckResult{Name: c.name, Err: runUpgradeCheck(ctx, appVersion, c.check)}
+	}
+	return results
+}
+
+// runUpgradeCheck converts a panic of check, e.g. because a param doesn't
+// exist, into an error so that a failed check never halts the node.
+func runUpgradeCheck(ctx sdk.Context, appVersion uint64, check func(sdk.Context, uint64) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return check(ctx, appVersion)
+}
+
+func (app *App) checkAppVersion(ctx sdk.Context, appVersion uint64) error {
+	if appVersion != app.AppVersion() {
+		return fmt.Errorf("block has app version %d but the app runs app version %d", appVersion, app.AppVersion())
+	}
+	if !slices.Contains(app.SupportedVersions(), appVersion) {
+		return fmt.Errorf("app version %d is not supported by this binary", appVersion)
+	}
+	// chains that started at v1 may not have the app version in the param
+	// store until they upgrade.
+	if stored := app.GetAppVersionFromParamStore(ctx); stored != appVersion && (appVersion != v1 || stored != 0) {
+		return fmt.Errorf("consensus params have app version %d but the app runs app version %d", stored, appVersion)
+	}
+	return nil
+}
+
+func (app *App) checkStores(_ sdk.Context, appVersion uint64) error {
+	for _, name := range app.keyVersions[appVersion] {
+		if app.CommitMultiStore().GetCommitKVStore(app.keys[name]) == nil {
+			return fmt.Errorf("store %s of app version %d is not mounted", name, appVersion)
+		}
+	}
+	return nil
+}
+
+func (app *App) checkParams(ctx sdk.Context, appVersion uint64) error {
+	if err := app.BlobKeeper.GetParams(ctx).Validate(); err != nil {
+		return fmt.Errorf("blob params: %w", err)
+	}
+	if appVersion > v1 {
+		subspace, exists := app.ParamsKeeper.GetSubspace(minfee.ModuleName)
+		if !exists || !subspace.Has(ctx, minfee.KeyNetworkMinGasPrice) {
+			return fmt.Errorf("network min gas price of app version %d is not set", appVersion)
+		}
+		var networkMinGasPrice sdk.Dec
+		subspace.Get(ctx, minfee.KeyNetworkMinGasPrice, &networkMinGasPrice)
+		if !networkMinGasPrice.IsPositive() {
+			return fmt.Errorf("network min gas price %s is not positive", networkMinGasPrice)
+		}
+	}
+	return nil
+}
+
+func (app *App) checkVersionedConstants(ctx sdk.Context, appVersion uint64) error {
+	upperBound := appconsts.SquareSizeUpperBound(appVersion)
+	if upperBound <= 0 || !square.IsPowerOfTwo(upperBound) {
+		return fmt.Errorf("square size upper bound %d of app version %d is not a power of two", upperBound, appVersion)
+	}
+	if govMaxSquareSize := app.BlobKeeper.GovMaxSquareSize(ctx); govMaxSquareSize > uint64(upperBound) {
+		return fmt.Errorf("gov max square size %d exceeds the square size upper bound %d of app version %d", govMaxSquareSize, upperBound, appVersion)
+	}
+	if appconsts.SubtreeRootThreshold(appVersion) <= 0 {
+		return fmt.Errorf("subtree root threshold of app version %d is not positive", appVersion)
+	}
+	if appconsts.MaxTxSize(appVersion) <= 0 {
+		return fmt.Errorf("max tx size of app version %d is not positive", appVersion)
+	}
+	if appconsts.GetTimeoutPropose(appVersion) <= 0 || appconsts.GetTimeoutCommit(appVersion) <= 0 {
+		return fmt.Errorf("timeouts of app version %d are not positive", appVersion)
+	}
+	return nil
+}
+
+// upgradeChecker runs the upgrade checks in BeginBlock for a number of blocks
+// after every upgrade, i.e. after the app version changes and after the node
+// starts since upgrading the binary is an upgrade too. Failures are logged
+// and counted in the upgrade_check_failures metric. They never affect
+// consensus.
+type upgradeChecker struct {
+	blocks int64
+	logger log.Logger
+	// appVersion is the app version of the last checked block.
+	appVersion uint64
+	// lastHeight is the last height of the current window, 0 before the
+	// first block.
+	lastHeight int64
+	// failed are the checks that already failed in the current window, so
+	// that each failure is only logged once.
+	failed map[string]bool
+}
+
+func newUpgradeChecker(blocks int64, logger log.Logger) *upgradeChecker {
+	return &upgradeChecker{blocks: blocks, logger: logger.With("module", "upgrade-check")}
+}
+
+// active returns whether the checks run at height with appVersion and opens
+// a new window of blocks if the app version changed.
+func (c *upgradeChecker) active(height int64, appVersion uint64) bool {
+	if c.lastHeight == 0 || appVersion != c.appVersion {
+		c.lastHeight = height + c.blocks - 1
+		c.failed = make(map[string]bool)
+		c.logger.Info("running upgrade checks", "app_version", appVersion, "from_height", height, "to_height", c.lastHeight)
+	}
+	c.appVersion = appVersion
+	return height <= c.lastHeight
+}
+
+func (c *upgradeChecker) beginBlock(app *App, ctx sdk.Context) {
+	if c == nil || !c.active(ctx.BlockHeight(), ctx.BlockHeader().Version.App) {
+		return
+	}
+	for _, result := range app.RunUpgradeChecks(ctx) {
+		if result.Err == nil {
+			continue
+		}
+		telemetry.IncrCounterWithLabels([]string{"upgrade_check_failures"}, 1, []metrics.Label{telemetry.NewLabel("check", result.Name)})
+		if !c.failed[result.Name] {
+			c.failed[result.Name] = true
+			c.logger.Error("upgrade check failed", "check", result.Name, "height", ctx.BlockHeight(), "app_version", c.appVersion, "err", result.Err)
+		}
+	}
+	if ctx.BlockHeight() == c.lastHeight && len(c.failed) == 0 {
+		c.logger.Info("upgrade checks passed", "app_version", c.appVersion, "blocks", c.blocks)
+	}
+}
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestUpgradeCheckerWindow(t *testing.T) {
+	c := newUpgradeChecker(3, log.NewNopLogger())
+	// the checks run for 3 blocks after the node starts
+	assert.True(t, c.active(10, 2))
+	assert.True(t, c.active(12, 2))
+	assert.False(t, c.active(13, 2))
+	// and for 3 blocks after the app version changes
+	assert.True(t, c.active(20, 3))
+	assert.True(t, c.active(22, 3))
+	assert.False(t, c.active(23, 3))
+	assert.False(t, c.active(100, 3))
+
+	var disabled *upgradeChecker
+	assert.NotPanics(t, func() { disabled.beginBlock(nil, sdk.Context{}) })
+}
@@ -0,0 +1,269 @@
+package cmd
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+const (
+	flagBenchProfile    = "profile"
+	flagBenchIterations = "iterations"
+	flagBenchOutput     = "output"
+
+	benchAccount = "bench"
+
+	workloadPrepareProposal      = "prepare_proposal"
+	workloadProcessProposal      = "process_proposal"
+	workloadCommitmentGeneration = "commitment_generation"
+)
+
+// benchProfile is a standardized workload: a block of blobTxs PFBs that each
+// pay for a blob of blobSize bytes in a square of at most squareSize.
+type benchProfile struct {
+	Name       string `json:"name"`
+	SquareSize int    `json:"square_size"`
+	BlobTxs    int    `json:"blob_txs"`
+	BlobSize   int    `json:"blob_size"`
+}
+
+var benchProfiles = map[string]benchProfile{
+	"small":      {Name: "small", SquareSize: 16, BlobTxs: 8, BlobSize: 10_000},
+	"default":    {Name: "default", SquareSize: appconsts.DefaultGovMaxSquareSize, BlobTxs: 25, BlobSize: 64_000},
+	"max-square": {Name: "max-square", SquareSize: appconsts.DefaultSquareSizeUpperBound, BlobTxs: 60, BlobSize: 120_000},
+}
+
+// BenchReport is the machine-readable report of the bench command.
+type BenchReport struct {
+	Version    string        `json:"version"`
+	Commit     string        `json:"commit"`
+	GoVersion  string        `json:"go_version"`
+	OS         string        `json:"os"`
+	Arch       string        `json:"arch"`
+	NumCPU     int           `json:"num_cpu"`
+	Profile    benchProfile  `json:"profile"`
+	SquareSize uint64        `json:"square_size"`
+	Workloads  []BenchResult `json:"workloads"`
+}
+
+// BenchResult is the duration of every iteration of a workload.
+type BenchResult struct {
+	Name       string  `json:"name"`
+	Iterations int     `json:"iterations"`
+	MeanMs     float64 `json:"mean_ms"`
+	MedianMs   float64 `json:"median_ms"`
+	MinMs      float64 `json:"min_ms"`
+	MaxMs      float64 `json:"max_ms"`
+}
+
+func benchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bench",
+		Short: "Run standardized workloads and print a performance report",
+		Long: "Run standardized workloads on the local machine and print a JSON performance report.\n" +
+			"The workloads are PrepareProposal of a block full of PFBs, ProcessProposal of that block and the generation of the share commitments of its blobs. " +
+			"They run against an in-memory app so the report reflects the CPU and memory of the machine, not its disk. " +
+			"Use it to size validator hardware and to compare releases.\n",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			profileName, err := cmd.Flags().GetString(flagBenchProfile)
+			if err != nil {
+				return err
+			}
+			profile, ok := benchProfiles[profileName]
+			if !ok {
+				return fmt.Errorf("unknown profile %q, expected one of %s", profileName, strings.Join(benchProfileNames(), ", "))
+			}
+			iterations, err := cmd.Flags().GetInt(flagBenchIterations)
+			if err != nil {
+				return err
+			}
+			if iterations <= 0 {
+				return fmt.Errorf("--%s must be positive, got %d", flagBenchIterations, iterations)
+			}
+			output, err := cmd.Flags().GetString(flagBenchOutput)
+			if err != nil {
+				return err
+			}
+
+			report, err := runBench(profile, iterations)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				cmd.Println(string(bz))
+				return nil
+			}
+			return os.WriteFile(output, bz, 0o644)
+		},
+	}
+	cmd.Flags().String(flagBenchProfile, "default", fmt.Sprintf("The workload profile, one of %s", strings.Join(benchProfileNames(), ", ")))
+	cmd.Flags().Int(flagBenchIterations, 5, "The number of times each workload is run")
+	cmd.Flags().String(flagBenchOutput, "", "File to write the report to. Defaults to stdout")
+	return cmd
+}
+
+func benchProfileNames() []string {
+	names := make([]string, 0, len(benchProfiles))
+	for name := range benchProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// runBench runs every workload of profile iterations times.
+func runBench(profile benchProfile, iterations int) (BenchReport, error) {
+	testutil.TestAppLogger = log.NewNopLogger()
+	cparams := app.DefaultConsensusParams()
+	testApp, kr := testutil.SetupTestAppWithGenesisValSetAndMaxSquareSize(cparams, profile.SquareSize, benchAccount)
+	appVersion := cparams.Version.AppVersion
+
+	addr := testfactory.GetAddress(kr, benchAccount)
+	acc := testutil.DirectQueryAccount(testApp, addr)
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer, err := user.NewSigner(kr, enc.TxConfig, testutil.ChainID, appVersion, user.NewAccount(benchAccount, acc.GetAccountNumber(), acc.GetSequence()))
+	if err != nil {
+		return BenchReport{}, err
+	}
+
+	blobs := make([]*share.Blob, profile.BlobTxs)
+	txs := make([][]byte, profile.BlobTxs)
+	for i := range txs {
+		data := make([]byte, profile.BlobSize)
+		if _, err := rand.Read(data); err != nil {
+			return BenchReport{}, err
+		}
+		if blobs[i], err = share.NewBlob(share.RandomBlobNamespace(), data, share.ShareVersionZero, nil); err != nil {
+			return BenchReport{}, err
+		}
+		if txs[i], _, err = signer.CreatePayForBlobs(benchAccount, []*share.Blob{blobs[i]}, user.SetGasLimit(uint64(profile.BlobSize)*20), user.SetFee(uint64(profile.BlobSize))); err != nil {
+			return BenchReport{}, err
+		}
+		if err := signer.IncrementSequence(benchAccount); err != nil {
+			return BenchReport{}, err
+		}
+	}
+
+	report := BenchReport{
+		Version:   version.Version,
+		Commit:    version.Commit,
+		GoVersion: runtime.Version(),
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+		NumCPU:    runtime.NumCPU(),
+		Profile:   profile,
+	}
+	height := testApp.LastBlockHeight() + 1
+
+	var prepared abci.ResponsePrepareProposal
+	prepareDurations, err := timeIterations(iterations, func() error {
+		prepared = testApp.PrepareProposal(abci.RequestPrepareProposal{
+			BlockData: &tmproto.Data{Txs: txs},
+			ChainId:   testutil.ChainID,
+			Height:    height,
+			Time:      time.Now(),
+		})
+		if len(prepared.BlockData.Txs) != len(txs) {
+			return fmt.Errorf("PrepareProposal included %d of %d txs", len(prepared.BlockData.Txs), len(txs))
+		}
+		return nil
+	})
+	if err != nil {
+		return BenchReport{}, err
+	}
+	report.SquareSize = prepared.BlockData.SquareSize
+
+	processDurations, err := timeIterations(iterations, func() error {
+		res := testApp.ProcessProposal(abci.RequestProcessProposal{
+			BlockData: prepared.BlockData,
+			Header: tmproto.Header{
+				Height:   height,
+				DataHash: prepared.BlockData.Hash,
+				ChainID:  testutil.ChainID,
+				Version:  tmversion.Consensus{App: appVersion},
+			},
+		})
+		if res.Result != abci.ResponseProcessProposal_ACCEPT {
+			return fmt.Errorf("ProcessProposal rejected the block")
+		}
+		return nil
+	})
+	if err != nil {
+		return BenchReport{}, err
+	}
+
+	commitmentDurations, err := timeIterations(iterations, func() error {
+		_, err := inclusion.CreateCommitments(blobs, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold(appVersion))
+		return err
+	})
+	if err != nil {
+		return BenchReport{}, err
+	}
+
+	report.Workloads = []BenchResult{
+		newBenchResult(workloadPrepareProposal, prepareDurations),
+		newBenchResult(workloadProcessProposal, processDurations),
+		newBenchResult(workloadCommitmentGeneration, commitmentDurations),
+	}
+	return report, nil
+}
+
+func timeIterations(iterations int, fn func() error) ([]time.Duration, error) {
+	durations := make([]time.Duration, iterations)
+	for i := range durations {
+		start := time.Now()
+		if err := fn(); err != nil {
+			return nil, err
+		}
+		durations[i] = time.Since(start)
+	}
+	return durations, nil
+}
+
+func newBenchResult(name string, durations []time.Duration) BenchResult {
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+	ms := func(d time.Duration) float64 { return float64(d) / float64(time.Millisecond) }
+	median := sorted[len(sorted)/2]
+	if len(sorted)%2 == 0 {
+		median = (sorted[len(sorted)/2-1] + sorted[len(sorted)/2]) / 2
+	}
+	return BenchResult{
+		Name:       name,
+		Iterations: len(sorted),
+		MeanMs:     ms(total / time.Duration(len(sorted))),
+		MedianMs:   ms(median),
+		MinMs:      ms(sorted[0]),
+		MaxMs:      ms(sorted[len(sorted)-1]),
+	}
+}
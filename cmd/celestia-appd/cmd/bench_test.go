@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_runBench(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping bench in short mode")
+	}
+	report, err := runBench(benchProfiles["small"], 2)
+	require.NoError(t, err)
+	assert.Equal(t, "small", report.Profile.Name)
+	assert.NotZero(t, report.SquareSize)
+	require.Len(t, report.Workloads, 3)
+	for _, workload := range report.Workloads {
+		assert.Equal(t, 2, workload.Iterations)
+		assert.Positive(t, workload.MeanMs, workload.Name)
+	}
+}
+
+func Test_newBenchResult(t *testing.T) {
+	result := newBenchResult("workload", []time.Duration{4 * time.Millisecond, time.Millisecond, 2 * time.Millisecond, 3 * time.Millisecond})
+	assert.Equal(t, BenchResult{
+		Name:       "workload",
+		Iterations: 4,
+		MeanMs:     2.5,
+		MedianMs:   2.5,
+		MinMs:      1,
+		MaxMs:      4,
+	}, result)
+}
+
+func Test_benchCommandUnknownProfile(t *testing.T) {
+	cmd := benchCommand()
+	cmd.SetArgs([]string{"--profile", "unknown"})
+	assert.ErrorContains(t, cmd.Execute(), "unknown profile")
+}
@@ -0,0 +1,412 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/test/util/genesis"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/rpc/client/local"
+	coretypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+const (
+	flagDevGenesis         = "genesis"
+	flagDevBlocksPerSecond = "blocks-per-second"
+	flagDevAccounts        = "accounts"
+	flagDevRPCAddress      = "rpc.laddr"
+	flagDevP2PAddress      = "p2p.laddr"
+	flagDevGRPCAddress     = "grpc.address"
+	flagDevAPIAddress      = "api.address"
+
+	// devValidatorName is the moniker of the local validator of a dev node.
+	devValidatorName = "dev-validator"
+)
+
+// devCommand returns a command with tooling for app developers.
+func devCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dev",
+		Short: "Tooling for app developers",
+	}
+	cmd.AddCommand(devRunCommand())
+	return cmd
+}
+
+func devRunCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Run an in-process single node chain from an exported state",
+		Long: `Boots an in-process single node chain from a genesis.json exported with "celestia-appd export" and
+produces blocks at the given rate until it is interrupted.
+
+The validator with the most voting power in the export signs with a new local key and all other validators
+are jailed, so that the node alone produces blocks. The state of the chain is otherwise the exported one. The
+--accounts test keys are funded in genesis and stored in the test keyring of the home directory.
+
+The state is kept in memory. Without --home, the home directory is a temporary directory that is removed on exit.`,
+		Example: "celestia-appd export > export.json && celestia-appd dev run --genesis export.json --blocks-per-second 10",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			genesisFile, err := cmd.Flags().GetString(flagDevGenesis)
+			if err != nil {
+				return err
+			}
+			blocksPerSecond, err := cmd.Flags().GetFloat64(flagDevBlocksPerSecond)
+			if err != nil {
+				return err
+			}
+			accounts, err := cmd.Flags().GetInt(flagDevAccounts)
+			if err != nil {
+				return err
+			}
+			home, err := cmd.Flags().GetString(flags.FlagHome)
+			if err != nil {
+				return err
+			}
+			cfg := devConfig{Accounts: accounts}
+			if cfg.RPCAddress, err = cmd.Flags().GetString(flagDevRPCAddress); err != nil {
+				return err
+			}
+			if cfg.P2PAddress, err = cmd.Flags().GetString(flagDevP2PAddress); err != nil {
+				return err
+			}
+			if cfg.GRPCAddress, err = cmd.Flags().GetString(flagDevGRPCAddress); err != nil {
+				return err
+			}
+			if cfg.APIAddress, err = cmd.Flags().GetString(flagDevAPIAddress); err != nil {
+				return err
+			}
+			if blocksPerSecond <= 0 {
+				return fmt.Errorf("--%s must be positive, got %v", flagDevBlocksPerSecond, blocksPerSecond)
+			}
+			cfg.BlockTime = time.Duration(float64(time.Second) / blocksPerSecond)
+			if accounts < 0 {
+				return fmt.Errorf("--%s must not be negative, got %d", flagDevAccounts, accounts)
+			}
+
+			if home == "" {
+				if home, err = os.MkdirTemp("", "celestia-dev-"); err != nil {
+					return err
+				}
+				defer os.RemoveAll(home)
+			}
+			genDoc, err := coretypes.GenesisDocFromFile(genesisFile)
+			if err != nil {
+				return fmt.Errorf("reading exported genesis: %w", err)
+			}
+
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			dev, err := startDevNode(ctx, home, genDoc, cfg)
+			if err != nil {
+				return err
+			}
+			defer dev.Stop()
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Running %s from height %d in %s with a block every %s\n", genDoc.ChainID, genDoc.InitialHeight, home, cfg.BlockTime)
+			fmt.Fprintf(out, "RPC: %s gRPC: %s API: %s\n", cfg.RPCAddress, cfg.GRPCAddress, cfg.APIAddress)
+			for i, addr := range dev.Accounts {
+				fmt.Fprintf(out, "%s: %s\n", devAccountName(i), addr)
+			}
+			if len(dev.Accounts) != 0 {
+				fmt.Fprintf(out, "Use the accounts with --keyring-backend test --home %s\n", home)
+			}
+
+			<-ctx.Done()
+			return nil
+		},
+	}
+	cmd.Flags().String(flagDevGenesis, "", "Path of the genesis.json exported with celestia-appd export")
+	cmd.Flags().Float64(flagDevBlocksPerSecond, 1, "Number of blocks to produce per second")
+	cmd.Flags().Int(flagDevAccounts, 10, "Number of funded test keys")
+	cmd.Flags().String(flags.FlagHome, "", "Home directory of the node. Must not exist or be empty. Defaults to a temporary directory")
+	cmd.Flags().String(flagDevRPCAddress, "tcp://127.0.0.1:26657", "RPC listen address")
+	cmd.Flags().String(flagDevP2PAddress, "tcp://127.0.0.1:26656", "P2P listen address")
+	cmd.Flags().String(flagDevGRPCAddress, "127.0.0.1:9090", "gRPC listen address")
+	cmd.Flags().String(flagDevAPIAddress, "tcp://127.0.0.1:1317", "API listen address")
+	_ = cmd.MarkFlagRequired(flagDevGenesis)
+	return cmd
+}
+
+// devConfig is the configuration of a node started by startDevNode.
+type devConfig struct {
+	// BlockTime is the time between blocks.
+	BlockTime time.Duration
+	// Accounts is the number of funded test keys.
+	Accounts    int
+	RPCAddress  string
+	P2PAddress  string
+	GRPCAddress string
+	APIAddress  string
+}
+
+func devAccountName(i int) string {
+	return fmt.Sprintf("dev-%d", i)
+}
+
+// devNode is a running node started by startDevNode.
+type devNode struct {
+	testnode.Context
+	// Accounts are the addresses of the funded test keys.
+	Accounts []sdk.AccAddress
+
+	cometNode *node.Node
+	stopGRPC  func() error
+	apiServer *api.Server
+}
+
+// startDevNode starts a single node chain from the exported genDoc in home.
+// The node keeps producing blocks until it is stopped.
+func startDevNode(ctx context.Context, home string, genDoc *coretypes.GenesisDoc, cfg devConfig) (*devNode, error) {
+	entries, err := os.ReadDir(home)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if len(entries) != 0 {
+		return nil, fmt.Errorf("home directory %s is not empty", home)
+	}
+
+	tmConfig := app.DefaultConsensusConfig()
+	tmConfig.SetRoot(home)
+	tmConfig.Moniker = devValidatorName
+	tmConfig.RPC.ListenAddress = cfg.RPCAddress
+	tmConfig.P2P.ListenAddress = cfg.P2PAddress
+	tmConfig.Consensus.TimeoutCommit = cfg.BlockTime
+	// index txs so that they can be queried by hash.
+	tmConfig.TxIndex.Indexer = "kv"
+	tmConfig.LogLevel = "error"
+	appConfig := app.DefaultAppConfig()
+	appConfig.GRPC.Address = cfg.GRPCAddress
+	appConfig.API.Enable = true
+	appConfig.API.Address = cfg.APIAddress
+
+	ecfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, home, nil, ecfg.Codec)
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]sdk.AccAddress, cfg.Accounts)
+	for i := range accounts {
+		record, _, err := kr.NewMnemonic(devAccountName(i), keyring.English, "", "", hd.Secp256k1)
+		if err != nil {
+			return nil, err
+		}
+		if accounts[i], err = record.GetAddress(); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, dir := range []string{filepath.Dir(tmConfig.GenesisFile()), filepath.Dir(tmConfig.PrivValidatorStateFile())} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+	consensusKey := ed25519.GenPrivKey()
+	privval.NewFilePV(consensusKey, tmConfig.PrivValidatorKeyFile(), tmConfig.PrivValidatorStateFile()).Save()
+	if err := devGenesis(ecfg.Codec, genDoc, consensusKey.PubKey(), accounts, genesis.DefaultInitialBalance); err != nil {
+		return nil, err
+	}
+	if err := genDoc.SaveAs(tmConfig.GenesisFile()); err != nil {
+		return nil, err
+	}
+
+	config := &testnode.UniversalTestingConfig{
+		TmConfig:   tmConfig,
+		AppConfig:  appConfig,
+		AppOptions: testnode.DefaultAppOptions(),
+		AppCreator: testnode.DefaultAppCreator(testnode.WithTimeoutCommit(cfg.BlockTime)),
+	}
+	cometNode, application, err := testnode.NewCometNode(home, config)
+	if err != nil {
+		return nil, err
+	}
+	if err := cometNode.Start(); err != nil {
+		return nil, err
+	}
+	dev := &devNode{
+		Context:   testnode.NewContext(ctx, kr, tmConfig, genDoc.ChainID, appConfig.API.Address),
+		Accounts:  accounts,
+		cometNode: cometNode,
+		stopGRPC:  func() error { return nil },
+	}
+	dev.Context.Context = dev.WithClient(local.New(cometNode))
+	if dev.Context, dev.stopGRPC, err = testnode.StartGRPCServer(application, appConfig, dev.Context); err != nil {
+		return nil, errors.Join(err, dev.Stop())
+	}
+	if dev.apiServer, err = testnode.StartAPIServer(application, *appConfig, dev.Context); err != nil {
+		return nil, errors.Join(err, dev.Stop())
+	}
+	return dev, nil
+}
+
+// Stop stops the node and its servers.
+func (d *devNode) Stop() error {
+	var errs []error
+	if d.apiServer != nil {
+		errs = append(errs, d.apiServer.Close())
+	}
+	errs = append(errs, d.stopGRPC())
+	if d.cometNode.IsRunning() {
+		errs = append(errs, d.cometNode.Stop())
+		d.cometNode.Wait()
+	}
+	return errors.Join(errs...)
+}
+
+// devGenesis modifies the exported genDoc so that the validator with
+// consensusPubKey can produce blocks on its own. The validator with the most
+// voting power in the export takes the consensus key and becomes the only
+// validator. All other validators are jailed so that they don't rejoin the
+// validator set. The accounts are funded with balance utia.
+func devGenesis(cdc codec.Codec, genDoc *coretypes.GenesisDoc, consensusPubKey crypto.PubKey, accounts []sdk.AccAddress, balance int64) error {
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+		return fmt.Errorf("failed to unmarshal app state: %w", err)
+	}
+	var stakingGenesis stakingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal staking genesis state: %w", err)
+	}
+	var bankGenesis banktypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[banktypes.ModuleName], &bankGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal bank genesis state: %w", err)
+	}
+	var authGenesis authtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[authtypes.ModuleName], &authGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal auth genesis state: %w", err)
+	}
+	var slashingGenesis slashingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[slashingtypes.ModuleName], &slashingGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal slashing genesis state: %w", err)
+	}
+
+	if len(stakingGenesis.LastValidatorPowers) == 0 {
+		return fmt.Errorf("genesis has no bonded validators, it must be exported with celestia-appd export")
+	}
+	top := stakingGenesis.LastValidatorPowers[0]
+	for _, lastPower := range stakingGenesis.LastValidatorPowers {
+		if lastPower.Power > top.Power {
+			top = lastPower
+		}
+	}
+	sdkPubKey, err := cryptocodec.FromTmPubKeyInterface(consensusPubKey)
+	if err != nil {
+		return err
+	}
+	pubKeyAny, err := codectypes.NewAnyWithValue(sdkPubKey)
+	if err != nil {
+		return err
+	}
+
+	// the tokens of the validators that are no longer bonded move from the
+	// bonded to the not bonded pool.
+	unbondedTokens := sdk.ZeroInt()
+	var moniker string
+	for i, val := range stakingGenesis.Validators {
+		if val.OperatorAddress == top.Address {
+			stakingGenesis.Validators[i].ConsensusPubkey = pubKeyAny
+			moniker = val.Description.Moniker
+			continue
+		}
+		if val.IsBonded() {
+			unbondedTokens = unbondedTokens.Add(val.Tokens)
+			stakingGenesis.Validators[i].Status = stakingtypes.Unbonded
+		}
+		stakingGenesis.Validators[i].Jailed = true
+	}
+	stakingGenesis.LastValidatorPowers = []stakingtypes.LastValidatorPower{top}
+	stakingGenesis.LastTotalPower = sdk.NewInt(top.Power)
+
+	bondDenom := stakingGenesis.Params.BondDenom
+	unbonded := sdk.NewCoin(bondDenom, unbondedTokens)
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String()
+	notBondedPool := authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String()
+	foundNotBondedPool := false
+	for i, b := range bankGenesis.Balances {
+		switch b.Address {
+		case bondedPool:
+			bankGenesis.Balances[i].Coins = b.Coins.Sub(unbonded)
+		case notBondedPool:
+			bankGenesis.Balances[i].Coins = b.Coins.Add(unbonded)
+			foundNotBondedPool = true
+		}
+	}
+	if !foundNotBondedPool && unbonded.IsPositive() {
+		bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{Address: notBondedPool, Coins: sdk.NewCoins(unbonded)})
+	}
+
+	funds := sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(balance)))
+	genAccounts := make(authtypes.GenesisAccounts, len(accounts))
+	for i, addr := range accounts {
+		genAccounts[i] = authtypes.NewBaseAccountWithAddress(addr)
+		bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{Address: addr.String(), Coins: funds})
+		bankGenesis.Supply = bankGenesis.Supply.Add(funds...)
+	}
+	packedAccounts, err := authtypes.PackAccounts(genAccounts)
+	if err != nil {
+		return err
+	}
+	authGenesis.Accounts = append(authGenesis.Accounts, packedAccounts...)
+
+	consAddr := sdk.ConsAddress(consensusPubKey.Address())
+	slashingGenesis.SigningInfos = append(slashingGenesis.SigningInfos, slashingtypes.SigningInfo{
+		Address:              consAddr.String(),
+		ValidatorSigningInfo: slashingtypes.NewValidatorSigningInfo(consAddr, genDoc.InitialHeight, 0, time.Unix(0, 0).UTC(), false, 0),
+	})
+
+	for name, msg := range map[string]codec.ProtoMarshaler{
+		stakingtypes.ModuleName:  &stakingGenesis,
+		banktypes.ModuleName:     &bankGenesis,
+		authtypes.ModuleName:     &authGenesis,
+		slashingtypes.ModuleName: &slashingGenesis,
+	} {
+		if appState[name], err = cdc.MarshalJSON(msg); err != nil {
+			return err
+		}
+	}
+	if genDoc.AppState, err = json.Marshal(appState); err != nil {
+		return err
+	}
+
+	genDoc.Validators = []coretypes.GenesisValidator{{
+		Address: consensusPubKey.Address(),
+		PubKey:  consensusPubKey,
+		Power:   top.Power,
+		Name:    moniker,
+	}}
+	genDoc.GenesisTime = tmtime.Now()
+	if genDoc.ConsensusParams != nil {
+		// let the block times follow the wall clock at any block rate.
+		genDoc.ConsensusParams.Block.TimeIotaMs = 1
+	}
+	return genDoc.ValidateAndComplete()
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/test/util/genesis"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestDevRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping dev run test in short mode.")
+	}
+	genDoc := exportedGenesis(t)
+
+	tmConfig := testnode.DefaultTendermintConfig()
+	appConfig := testnode.DefaultAppConfig()
+	cfg := devConfig{
+		BlockTime:   50 * time.Millisecond,
+		Accounts:    2,
+		RPCAddress:  tmConfig.RPC.ListenAddress,
+		P2PAddress:  tmConfig.P2P.ListenAddress,
+		GRPCAddress: appConfig.GRPC.Address,
+		APIAddress:  appConfig.API.Address,
+	}
+	dev, err := startDevNode(context.Background(), filepath.Join(t.TempDir(), "dev"), genDoc, cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, dev.Stop()) })
+
+	_, err = dev.WaitForHeight(genDoc.InitialHeight + 3)
+	require.NoError(t, err)
+	require.Len(t, dev.Accounts, 2)
+
+	bankClient := banktypes.NewQueryClient(dev.GRPCClient)
+	resp, err := bankClient.Balance(context.Background(), &banktypes.QueryBalanceRequest{Address: dev.Accounts[1].String(), Denom: app.BondDenom})
+	require.NoError(t, err)
+	assert.EqualValues(t, genesis.DefaultInitialBalance, resp.Balance.Amount.Int64())
+
+	stakingClient := stakingtypes.NewQueryClient(dev.GRPCClient)
+	vals, err := stakingClient.Validators(context.Background(), &stakingtypes.QueryValidatorsRequest{Status: stakingtypes.Bonded.String()})
+	require.NoError(t, err)
+	assert.Len(t, vals.Validators, 1)
+}
+
+func TestDevGenesis(t *testing.T) {
+	genDoc := exportedGenesis(t)
+	require.Len(t, genDoc.Validators, 2)
+	pubKey := ed25519.GenPrivKey().PubKey()
+	account := sdk.AccAddress("dev")
+
+	cdc := encoding.MakeConfig(app.ModuleEncodingRegisters...).Codec
+	require.NoError(t, devGenesis(cdc, genDoc, pubKey, []sdk.AccAddress{account}, 100))
+	require.Len(t, genDoc.Validators, 1)
+	assert.Equal(t, pubKey, genDoc.Validators[0].PubKey)
+
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(genDoc.AppState, &appState))
+	var stakingGenesis stakingtypes.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenesis))
+	var bankGenesis banktypes.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[banktypes.ModuleName], &bankGenesis))
+	balances := make(map[string]sdk.Coins)
+	for _, b := range bankGenesis.Balances {
+		balances[b.Address] = b.Coins
+	}
+
+	require.Len(t, stakingGenesis.LastValidatorPowers, 1)
+	bonded, notBonded := sdk.ZeroInt(), sdk.ZeroInt()
+	for _, val := range stakingGenesis.Validators {
+		if val.OperatorAddress == stakingGenesis.LastValidatorPowers[0].Address {
+			assert.True(t, val.IsBonded())
+			assert.False(t, val.Jailed)
+			consPubKey, err := val.ConsPubKey()
+			require.NoError(t, err)
+			assert.Equal(t, pubKey.Bytes(), consPubKey.Bytes())
+			bonded = bonded.Add(val.Tokens)
+		} else {
+			assert.True(t, val.IsUnbonded())
+			assert.True(t, val.Jailed)
+			notBonded = notBonded.Add(val.Tokens)
+		}
+	}
+	bondDenom := stakingGenesis.Params.BondDenom
+	assert.Equal(t, bonded, balances[authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String()].AmountOf(bondDenom))
+	assert.Equal(t, notBonded, balances[authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String()].AmountOf(bondDenom))
+	assert.EqualValues(t, 100, balances[account.String()].AmountOf(bondDenom).Int64())
+}
+
+// exportedGenesis returns the genesis that celestia-appd export writes for a
+// chain of two validators after its first block.
+func exportedGenesis(t *testing.T) *coretypes.GenesisDoc {
+	t.Helper()
+	g := genesis.NewDefaultGenesis().
+		WithChainID("dev-test").
+		WithValidators(genesis.NewDefaultValidator("validator-0"), genesis.NewDefaultValidator("validator-1"))
+	genDoc, err := g.Export()
+	require.NoError(t, err)
+
+	testApp := testutil.NewTestApp()
+	cparams := genDoc.ConsensusParams
+	testApp.Info(abci.RequestInfo{})
+	testApp.InitChain(abci.RequestInitChain{
+		Time:    genDoc.GenesisTime,
+		ChainId: genDoc.ChainID,
+		ConsensusParams: &abci.ConsensusParams{
+			Block:     &abci.BlockParams{MaxBytes: cparams.Block.MaxBytes, MaxGas: cparams.Block.MaxGas},
+			Evidence:  &cparams.Evidence,
+			Validator: &cparams.Validator,
+			Version:   &cparams.Version,
+		},
+		AppStateBytes: genDoc.AppState,
+	})
+	testApp.Commit()
+
+	exported, err := testApp.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+	exportedDoc := &coretypes.GenesisDoc{
+		GenesisTime:   genDoc.GenesisTime,
+		ChainID:       genDoc.ChainID,
+		InitialHeight: exported.Height,
+		AppState:      exported.AppState,
+		Validators:    exported.Validators,
+		ConsensusParams: &tmproto.ConsensusParams{
+			Block:     tmproto.BlockParams{MaxBytes: exported.ConsensusParams.Block.MaxBytes, MaxGas: exported.ConsensusParams.Block.MaxGas, TimeIotaMs: cparams.Block.TimeIotaMs},
+			Evidence:  *exported.ConsensusParams.Evidence,
+			Validator: *exported.ConsensusParams.Validator,
+			Version:   *exported.ConsensusParams.Version,
+		},
+	}
+	require.NoError(t, exportedDoc.ValidateAndComplete())
+	return exportedDoc
+}
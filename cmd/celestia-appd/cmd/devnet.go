@@ -0,0 +1,304 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/test/util/genesis"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/p2p"
+)
+
+const (
+	flagDevnetValidators = "validators"
+	flagDevnetAccounts   = "accounts"
+	flagDevnetMaxSquare  = "max-square"
+	flagDevnetChainID    = "chain-id"
+	flagDevnetOutput     = "output"
+	flagDevnetHosts      = "hosts"
+	flagDevnetImage      = "docker-image"
+	flagDevnetBinary     = "binary"
+	flagDevnetNodeHome   = "node-home"
+
+	// devnetP2PPort is the p2p port of every node of the devnet.
+	devnetP2PPort = 26656
+	// devnetRPCPort is the RPC port that the first node of the devnet
+	// publishes on the docker host. Every following node publishes the port
+	// 100 above that of the previous node.
+	devnetRPCPort = 26657
+)
+
+// devnetCommand returns a command that generates the configuration of local
+// and remote multi-node devnets.
+func devnetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "devnet",
+		Short: "Generate the configuration of multi-node devnets",
+	}
+	cmd.AddCommand(devnetInitCommand())
+	return cmd
+}
+
+func devnetInitCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Generate the genesis, keys and node configs of a multi-node devnet",
+		Long: `Generates a complete devnet in the output directory:
+
+  keyring-test/                  test keyring with the keys of the validators and of the funded accounts
+  validator-<i>/                 home directory of the i-th node, with its genesis, keys, config.toml and app.toml
+  docker-compose.yml             runs every node in a container of the docker image
+  systemd/validator-<i>.service  runs the i-th node from --node-home on its host
+
+The nodes are persistent peers of each other. By default they reach each other by the service names of docker-compose.yml. Pass --hosts to run them on separate machines instead.`,
+		Example: "celestia-appd devnet init --validators 4 --accounts 10 --max-square 128 --output ./devnet",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			validators, err := cmd.Flags().GetInt(flagDevnetValidators)
+			if err != nil {
+				return err
+			}
+			accounts, err := cmd.Flags().GetInt(flagDevnetAccounts)
+			if err != nil {
+				return err
+			}
+			maxSquare, err := cmd.Flags().GetUint64(flagDevnetMaxSquare)
+			if err != nil {
+				return err
+			}
+			chainID, err := cmd.Flags().GetString(flagDevnetChainID)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagDevnetOutput)
+			if err != nil {
+				return err
+			}
+			hosts, err := cmd.Flags().GetStringSlice(flagDevnetHosts)
+			if err != nil {
+				return err
+			}
+			image, err := cmd.Flags().GetString(flagDevnetImage)
+			if err != nil {
+				return err
+			}
+			binary, err := cmd.Flags().GetString(flagDevnetBinary)
+			if err != nil {
+				return err
+			}
+			nodeHome, err := cmd.Flags().GetString(flagDevnetNodeHome)
+			if err != nil {
+				return err
+			}
+			if chainID == "" {
+				chainID = "devnet-" + strings.ToLower(tmrand.Str(6))
+			}
+
+			cfg := devnetConfig{
+				Validators: validators,
+				Accounts:   accounts,
+				MaxSquare:  maxSquare,
+				ChainID:    chainID,
+				Hosts:      hosts,
+				Image:      image,
+				Binary:     binary,
+				NodeHome:   nodeHome,
+			}
+			if err := cfg.ValidateBasic(); err != nil {
+				return err
+			}
+			if err := initDevnet(output, cfg); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Generated devnet %s with %d validators in %s\n", chainID, validators, output)
+			return nil
+		},
+	}
+	cmd.Flags().Int(flagDevnetValidators, 4, "Number of validators")
+	cmd.Flags().Int(flagDevnetAccounts, 0, "Number of funded accounts besides the validators")
+	cmd.Flags().Uint64(flagDevnetMaxSquare, appconsts.DefaultGovMaxSquareSize, "Governance max square size of the devnet")
+	cmd.Flags().String(flagDevnetChainID, "", "Chain ID of the devnet. Defaults to a random devnet-<suffix>")
+	cmd.Flags().String(flagDevnetOutput, "./devnet", "Directory to write the devnet to. Must not exist or be empty")
+	cmd.Flags().StringSlice(flagDevnetHosts, nil, "Hosts of the validators in order, for devnets that don't run on docker-compose. Defaults to the service names of docker-compose.yml")
+	cmd.Flags().String(flagDevnetImage, "ghcr.io/celestiaorg/celestia-app:latest", "Docker image of the nodes of docker-compose.yml")
+	cmd.Flags().String(flagDevnetBinary, "/usr/local/bin/celestia-appd", "Path of the celestia-appd binary on the hosts of the systemd units")
+	cmd.Flags().String(flagDevnetNodeHome, "/home/celestia/.celestia-app", "Path that the home directory of the node is copied to on the hosts of the systemd units")
+	return cmd
+}
+
+// devnetConfig is the configuration of a devnet generated by initDevnet.
+type devnetConfig struct {
+	Validators int
+	Accounts   int
+	MaxSquare  uint64
+	ChainID    string
+	// Hosts are the hosts of the validators. If empty, the validators use the
+	// service names of docker-compose.yml.
+	Hosts    []string
+	Image    string
+	Binary   string
+	NodeHome string
+}
+
+func (c devnetConfig) ValidateBasic() error {
+	if c.Validators <= 0 {
+		return fmt.Errorf("number of validators %d must be positive", c.Validators)
+	}
+	if c.Accounts < 0 {
+		return fmt.Errorf("number of accounts %d must not be negative", c.Accounts)
+	}
+	upperBound := uint64(appconsts.SquareSizeUpperBound(appconsts.LatestVersion))
+	if c.MaxSquare == 0 || c.MaxSquare&(c.MaxSquare-1) != 0 || c.MaxSquare > upperBound {
+		return fmt.Errorf("max square size %d must be a power of two of at most %d", c.MaxSquare, upperBound)
+	}
+	if c.ChainID == "" {
+		return fmt.Errorf("chain ID cannot be empty")
+	}
+	if len(c.Hosts) != 0 && len(c.Hosts) != c.Validators {
+		return fmt.Errorf("got %d hosts for %d validators", len(c.Hosts), c.Validators)
+	}
+	return nil
+}
+
+// host returns the host that the other nodes reach the i-th validator at.
+func (c devnetConfig) host(i int) string {
+	if len(c.Hosts) == 0 {
+		return devnetNodeName(i)
+	}
+	return c.Hosts[i]
+}
+
+func devnetNodeName(i int) string {
+	return fmt.Sprintf("validator-%d", i)
+}
+
+// initDevnet writes the devnet of cfg to output.
+func initDevnet(output string, cfg devnetConfig) error {
+	entries, err := os.ReadDir(output)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(entries) != 0 {
+		return fmt.Errorf("output directory %s is not empty", output)
+	}
+
+	gen := genesis.NewDefaultGenesis().WithChainID(cfg.ChainID)
+	codec := gen.EncodingConfig().Codec
+	// the keys are written to the output so that the accounts can be used.
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, output, nil, codec)
+	if err != nil {
+		return err
+	}
+	blobParams := blobtypes.DefaultParams()
+	blobParams.GovMaxSquareSize = cfg.MaxSquare
+	gen = gen.WithKeyring(kr).WithModifiers(genesis.SetBlobParams(codec, blobParams))
+	for i := range cfg.Validators {
+		gen = gen.WithValidators(genesis.NewDefaultValidator(devnetNodeName(i)))
+	}
+	for i := range cfg.Accounts {
+		gen = gen.WithKeyringAccounts(genesis.NewKeyringAccounts(genesis.DefaultInitialBalance, fmt.Sprintf("account-%d", i))...)
+	}
+
+	peers := make([]string, cfg.Validators)
+	for i, val := range gen.Validators() {
+		nodeID := p2p.PubKeyToID(val.NetworkKey.PubKey())
+		peers[i] = p2p.IDAddressString(nodeID, fmt.Sprintf("%s:%d", cfg.host(i), devnetP2PPort))
+	}
+
+	for i := range cfg.Validators {
+		tmConfig := app.DefaultConsensusConfig()
+		tmConfig.Moniker = devnetNodeName(i)
+		tmConfig.RPC.ListenAddress = fmt.Sprintf("tcp://0.0.0.0:%d", devnetRPCPort)
+		tmConfig.P2P.ListenAddress = fmt.Sprintf("tcp://0.0.0.0:%d", devnetP2PPort)
+		tmConfig.P2P.PersistentPeers = strings.Join(append(append([]string{}, peers[:i]...), peers[i+1:]...), ",")
+		// the nodes of a devnet usually share a host or a private network.
+		tmConfig.P2P.AddrBookStrict = false
+		tmConfig.P2P.AllowDuplicateIP = true
+		if err := genesis.InitFiles(filepath.Join(output, devnetNodeName(i)), tmConfig, app.DefaultAppConfig(), gen, i); err != nil {
+			return fmt.Errorf("initializing %s: %w", devnetNodeName(i), err)
+		}
+	}
+
+	if err := writeDevnetTemplate(filepath.Join(output, "docker-compose.yml"), devnetComposeTemplate, cfg); err != nil {
+		return err
+	}
+	for i := range cfg.Validators {
+		unit := filepath.Join(output, "systemd", devnetNodeName(i)+".service")
+		if err := writeDevnetTemplate(unit, devnetSystemdTemplate, devnetUnit{devnetConfig: cfg, Name: devnetNodeName(i)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// devnetUnit is the data of the systemd unit of a validator.
+type devnetUnit struct {
+	devnetConfig
+	Name string
+}
+
+// devnetNode is the data of the docker-compose service of a validator.
+type devnetNode struct {
+	Name    string
+	RPCPort int
+}
+
+// Nodes returns the docker-compose services of the validators.
+func (c devnetConfig) Nodes() []devnetNode {
+	nodes := make([]devnetNode, c.Validators)
+	for i := range nodes {
+		nodes[i] = devnetNode{Name: devnetNodeName(i), RPCPort: devnetRPCPort + i*100}
+	}
+	return nodes
+}
+
+var devnetComposeTemplate = template.Must(template.New("docker-compose").Parse(`# Generated by celestia-appd devnet init for {{ .ChainID }}.
+services:
+{{- range .Nodes }}
+  {{ .Name }}:
+    image: {{ $.Image }}
+    command: ["start", "--home", "/home/celestia"]
+    volumes:
+      - ./{{ .Name }}:/home/celestia
+    ports:
+      - "{{ .RPCPort }}:26657"
+{{- end }}
+`))
+
+var devnetSystemdTemplate = template.Must(template.New("systemd").Parse(`# Generated by celestia-appd devnet init for {{ .ChainID }}.
+# Copy {{ .Name }}/ to {{ .NodeHome }} on the host of {{ .Name }}.
+[Unit]
+Description=celestia-appd {{ .Name }} of {{ .ChainID }}
+After=network-online.target
+Wants=network-online.target
+
+[Service]
+ExecStart={{ .Binary }} start --home {{ .NodeHome }}
+Restart=on-failure
+RestartSec=5
+LimitNOFILE=65535
+
+[Install]
+WantedBy=multi-user.target
+`))
+
+func writeDevnetTemplate(path string, tmpl *template.Template, data any) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return tmpl.Execute(file, data)
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/p2p"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestDevnetInit(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "devnet")
+	_, err := executeCmd(devnetCommand(), "init", "--validators", "3", "--accounts", "2", "--max-square", "32", "--chain-id", "test-devnet", "--output", output)
+	require.NoError(t, err)
+
+	var genesisDoc *coretypes.GenesisDoc
+	nodeIDs := make([]string, 3)
+	for i := range 3 {
+		home := filepath.Join(output, devnetNodeName(i))
+		doc, err := coretypes.GenesisDocFromFile(filepath.Join(home, "config", "genesis.json"))
+		require.NoError(t, err)
+		assert.Equal(t, "test-devnet", doc.ChainID)
+		if genesisDoc != nil {
+			assert.Equal(t, genesisDoc.AppState, doc.AppState, "every node must have the same genesis")
+		}
+		genesisDoc = doc
+
+		nodeKey, err := p2p.LoadNodeKey(filepath.Join(home, "config", "node_key.json"))
+		require.NoError(t, err)
+		nodeIDs[i] = string(nodeKey.ID())
+		assert.FileExists(t, filepath.Join(home, "config", "priv_validator_key.json"))
+		assert.FileExists(t, filepath.Join(home, "config", "app.toml"))
+		assert.FileExists(t, filepath.Join(output, "systemd", devnetNodeName(i)+".service"))
+	}
+
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(genesisDoc.AppState, &appState))
+	var blobGenesis blobtypes.GenesisState
+	encoding.MakeConfig(app.ModuleEncodingRegisters...).Codec.MustUnmarshalJSON(appState[blobtypes.ModuleName], &blobGenesis)
+	assert.EqualValues(t, 32, blobGenesis.Params.GovMaxSquareSize)
+
+	for i := range 3 {
+		config, err := os.ReadFile(filepath.Join(output, devnetNodeName(i), "config", "config.toml"))
+		require.NoError(t, err)
+		for j, nodeID := range nodeIDs {
+			peer := nodeID + "@" + devnetNodeName(j) + ":26656"
+			assert.Equal(t, i != j, strings.Contains(string(config), peer), "node %d peer %d", i, j)
+		}
+	}
+
+	compose, err := os.ReadFile(filepath.Join(output, "docker-compose.yml"))
+	require.NoError(t, err)
+	for i := range 3 {
+		assert.Contains(t, string(compose), "./"+devnetNodeName(i)+":/home/celestia")
+	}
+
+	records, err := os.ReadDir(filepath.Join(output, "keyring-test"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, records)
+
+	t.Run("rejects a non-empty output directory", func(t *testing.T) {
+		_, err := executeCmd(devnetCommand(), "init", "--output", output)
+		assert.ErrorContains(t, err, "not empty")
+	})
+	t.Run("rejects an invalid max square size", func(t *testing.T) {
+		_, err := executeCmd(devnetCommand(), "init", "--max-square", "100", "--output", t.TempDir())
+		assert.ErrorContains(t, err, "power of two")
+	})
+	t.Run("rejects a host per validator mismatch", func(t *testing.T) {
+		_, err := executeCmd(devnetCommand(), "init", "--validators", "2", "--hosts", "10.0.0.1", "--output", t.TempDir())
+		assert.ErrorContains(t, err, "hosts")
+	})
+}
@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	flagDoctorSamples = "samples"
+
+	defaultDoctorSamples = 16
+)
+
+func doctorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Health checks of a node",
+	}
+	cmd.AddCommand(doctorDACommand())
+	return cmd
+}
+
+func doctorDACommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "da",
+		Short: "Check that the data of a block is available and matches its header",
+		Long: "Check that the data of a block is available and matches its header.\n" +
+			"Fetches the block from the node, reconstructs and extends its data square, recomputes the data root and compares it against the header, " +
+			"then verifies the inclusion proofs of a random sample of shares against the data root. The result of each stage is printed.\n",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetInt64(flags.FlagHeight)
+			if err != nil {
+				return err
+			}
+			samples, err := cmd.Flags().GetInt(flagDoctorSamples)
+			if err != nil {
+				return err
+			}
+			if samples <= 0 {
+				return fmt.Errorf("--%s must be positive, got %d", flagDoctorSamples, samples)
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			var heightPtr *int64
+			if height != 0 {
+				heightPtr = &height
+			}
+			res, err := node.Block(cmd.Context(), heightPtr)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Checking the data of height %d\n", res.Block.Height)
+			results := checkDataAvailability(res.Block, samples, rand.New(rand.NewSource(time.Now().UnixNano())))
+			failed := false
+			for _, result := range results {
+				switch {
+				case result.skipped:
+					cmd.Printf("SKIP  %s\n", result.stage)
+				case result.err != nil:
+					failed = true
+					cmd.Printf("FAIL  %s: %s\n", result.stage, result.err)
+				default:
+					cmd.Printf("PASS  %s\n", result.stage)
+				}
+			}
+			if failed {
+				return fmt.Errorf("data availability check of height %d failed", res.Block.Height)
+			}
+			return nil
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	// the query flags already define the height flag.
+	cmd.Flags().Lookup(flags.FlagHeight).Usage = "The height of the block to check. Defaults to the latest height"
+	cmd.Flags().Int(flagDoctorSamples, defaultDoctorSamples, "The number of shares whose inclusion proofs are verified")
+	return cmd
+}
+
+// stageResult is the outcome of a stage of the data availability check. A
+// stage is skipped if a stage that it depends on failed.
+type stageResult struct {
+	stage   string
+	err     error
+	skipped bool
+}
+
+const (
+	stageReconstructSquare = "reconstruct square"
+	stageExtendSquare      = "extend square"
+	stageDataRoot          = "data root matches header"
+	stageSampleProofs      = "verify sampled share proofs"
+)
+
+// checkDataAvailability runs the stages of the data availability check of
+// block. The inclusion proofs of up to samples shares, chosen with rng, are
+// verified against the data root of the header.
+func checkDataAvailability(block *coretypes.Block, samples int, rng *rand.Rand) []stageResult {
+	stages := []string{stageReconstructSquare, stageExtendSquare, stageDataRoot, stageSampleProofs}
+	results := make([]stageResult, 0, len(stages))
+	// fail records the error of the current stage and skips the remaining
+	// ones.
+	fail := func(err error) []stageResult {
+		results = append(results, stageResult{stage: stages[len(results)], err: err})
+		for _, stage := range stages[len(results):] {
+			results = append(results, stageResult{stage: stage, skipped: true})
+		}
+		return results
+	}
+	pass := func() {
+		results = append(results, stageResult{stage: stages[len(results)]})
+	}
+
+	appVersion := block.Version.App
+	dataSquare, err := square.Construct(block.Data.Txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion))
+	if err != nil {
+		return fail(err)
+	}
+	if uint64(dataSquare.Size()) != block.Data.SquareSize {
+		return fail(fmt.Errorf("reconstructed square size %d differs from the square size %d of the block", dataSquare.Size(), block.Data.SquareSize))
+	}
+	pass()
+
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	if err != nil {
+		return fail(err)
+	}
+	pass()
+
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return fail(err)
+	}
+	if !bytes.Equal(dah.Hash(), block.DataHash) {
+		return fail(fmt.Errorf("recomputed data root %X differs from the data root %X of the header", dah.Hash(), block.DataHash))
+	}
+	pass()
+
+	if err := verifySampledProofs(eds, dataSquare, block.DataHash, samples, rng); err != nil {
+		return fail(err)
+	}
+	pass()
+	return results
+}
+
+// verifySampledProofs verifies the inclusion proofs of up to samples distinct
+// shares of the original data square against dataRoot.
+func verifySampledProofs(eds *rsmt2d.ExtendedDataSquare, dataSquare square.Square, dataRoot []byte, samples int, rng *rand.Rand) error {
+	samples = min(samples, len(dataSquare))
+	for _, index := range rng.Perm(len(dataSquare))[:samples] {
+		sp, err := proof.NewShareInclusionProofFromEDS(eds, dataSquare[index].Namespace(), share.NewRange(index, index+1))
+		if err != nil {
+			return fmt.Errorf("share %d: %w", index, err)
+		}
+		if err := sp.Validate(dataRoot); err != nil {
+			return fmt.Errorf("share %d: %w", index, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func Test_checkDataAvailability(t *testing.T) {
+	newBlock := func(t *testing.T) *coretypes.Block {
+		txs := make(coretypes.Txs, 20)
+		for i := range txs {
+			txs[i] = tmrand.Bytes(1000)
+		}
+		dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.DefaultSquareSizeUpperBound, appconsts.DefaultSubtreeRootThreshold)
+		require.NoError(t, err)
+		eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+		require.NoError(t, err)
+		dah, err := da.NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+
+		return &coretypes.Block{
+			Header: coretypes.Header{
+				Version:  version.Consensus{App: appconsts.LatestVersion},
+				DataHash: dah.Hash(),
+			},
+			Data: coretypes.Data{Txs: txs, SquareSize: uint64(dataSquare.Size())},
+		}
+	}
+	stages := []string{stageReconstructSquare, stageExtendSquare, stageDataRoot, stageSampleProofs}
+
+	type testCase struct {
+		name   string
+		modify func(*coretypes.Block)
+		// failed is the index of the stage that is expected to fail or -1
+		// if all stages pass.
+		failed int
+	}
+	testCases := []testCase{
+		{
+			name:   "available block",
+			modify: func(*coretypes.Block) {},
+			failed: -1,
+		},
+		{
+			name:   "wrong square size",
+			modify: func(b *coretypes.Block) { b.Data.SquareSize *= 2 },
+			failed: 0,
+		},
+		{
+			name:   "wrong data root",
+			modify: func(b *coretypes.Block) { b.DataHash = tmrand.Bytes(32) },
+			failed: 2,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			block := newBlock(t)
+			tc.modify(block)
+
+			results := checkDataAvailability(block, defaultDoctorSamples, rand.New(rand.NewSource(1)))
+			require.Len(t, results, len(stages))
+			for i, result := range results {
+				assert.Equal(t, stages[i], result.stage)
+				switch {
+				case tc.failed == -1 || i < tc.failed:
+					assert.NoError(t, result.err)
+					assert.False(t, result.skipped)
+				case i == tc.failed:
+					assert.Error(t, result.err)
+				default:
+					assert.True(t, result.skipped)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/app/forensics"
+	"github.com/spf13/cobra"
+)
+
+func forensicsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forensics",
+		Short: "Tools to investigate app hash mismatches",
+		Long: "Tools to investigate app hash mismatches.\n" +
+			fmt.Sprintf("Nodes started with --%s write a forensic dump of the store writes, square layout and tx results of every committed block to the provided directory.\n", forensics.FlagDir),
+	}
+	cmd.AddCommand(forensicsDiffCommand())
+	return cmd
+}
+
+func forensicsDiffCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff [dir-a] [dir-b]",
+		Short: "Compare the forensic dumps of two nodes",
+		Long: "Compare the forensic dumps of two nodes.\n" +
+			"The dumps of the heights present in both directories are compared in ascending order and the differences of the first height at which the nodes diverged are printed.\n",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, diffs, err := forensics.DiffDirs(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			if len(diffs) == 0 {
+				cmd.Println("No differences found")
+				return nil
+			}
+			cmd.Printf("Dumps diverge at height %d:\n", height)
+			for _, diff := range diffs {
+				cmd.Println(diff)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	namespacetypes "github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagGenesisRestricted       = "restricted"
+	flagGenesisAllowedSigners   = "allowed-signers"
+	flagGenesisGasPerBlobByte   = "gas-per-blob-byte"
+	flagGenesisGovMaxSquareSize = "gov-max-square-size"
+	flagGenesisMaxBlobsPerPFB   = "max-blobs-per-pfb"
+	flagGenesisMaxPFBsPerBlock  = "max-pfbs-per-block"
+	flagGenesisMaxTotalBlobSize = "max-total-blob-size-per-pfb"
+)
+
+// addGenesisNamespaceCommand returns a command that registers a namespace in
+// the genesis of a network so that it launches with the registration instead
+// of a MsgRegisterNamespace in one of its first blocks.
+func addGenesisNamespaceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-namespace <namespace> <owner>",
+		Short: "Register a namespace to an owner in genesis.json",
+		Long: `Registers the hex encoded namespace, including its version, to the bech32 encoded owner address
+in the namespace module genesis state of genesis.json. Pass --restricted to only let the owner and the
+--allowed-signers pay for blobs in the namespace.`,
+		Example: "celestia-appd add-genesis-namespace 0x000000000000000000000000000000000000000102030405060708090a celestia1grvklux2yjsln7ztk6slv538396qatckqhs86z --restricted --allowed-signers celestia1...",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+			if err != nil {
+				return fmt.Errorf("failed to decode hex namespace: %w", err)
+			}
+			restricted, err := cmd.Flags().GetBool(flagGenesisRestricted)
+			if err != nil {
+				return err
+			}
+			allowedSigners, err := cmd.Flags().GetStringSlice(flagGenesisAllowedSigners)
+			if err != nil {
+				return err
+			}
+			registration := namespacetypes.Registration{
+				Namespace:      namespace,
+				Owner:          args[1],
+				Restricted:     restricted,
+				AllowedSigners: allowedSigners,
+			}
+
+			return updateGenesis(cmd, func(cdc codec.Codec, appState map[string]json.RawMessage) error {
+				var genState namespacetypes.GenesisState
+				if err := cdc.UnmarshalJSON(appState[namespacetypes.ModuleName], &genState); err != nil {
+					return fmt.Errorf("failed to unmarshal namespace genesis state: %w", err)
+				}
+				genState.Registrations = append(genState.Registrations, registration)
+				if err := genState.Validate(); err != nil {
+					return err
+				}
+				appState[namespacetypes.ModuleName], err = cdc.MarshalJSON(&genState)
+				return err
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().Bool(flagGenesisRestricted, false, "Only let the owner and the allowed signers pay for blobs in the namespace")
+	cmd.Flags().StringSlice(flagGenesisAllowedSigners, nil, "Comma separated bech32 encoded addresses other than the owner that can pay for blobs in the restricted namespace")
+	return cmd
+}
+
+// setGenesisBlobParamsCommand returns a command that sets the params of the
+// blob module in the genesis of a network.
+func setGenesisBlobParamsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-genesis-blob-params",
+		Short: "Set the blob module params in genesis.json",
+		Long: `Sets the blob module params of genesis.json that are passed as flags and keeps the others.
+Pass --allowed-signers to only let these accounts submit PFBs, e.g. on a private network.`,
+		Example: "celestia-appd set-genesis-blob-params --gov-max-square-size 128 --allowed-signers celestia1...,celestia1...",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return updateGenesis(cmd, func(cdc codec.Codec, appState map[string]json.RawMessage) error {
+				var genState blobtypes.GenesisState
+				if err := cdc.UnmarshalJSON(appState[blobtypes.ModuleName], &genState); err != nil {
+					return fmt.Errorf("failed to unmarshal blob genesis state: %w", err)
+				}
+				if err := setBlobParamsFromFlags(cmd, &genState.Params); err != nil {
+					return err
+				}
+				if err := genState.Validate(); err != nil {
+					return err
+				}
+				var err error
+				appState[blobtypes.ModuleName], err = cdc.MarshalJSON(&genState)
+				return err
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().Uint32(flagGenesisGasPerBlobByte, 0, "Gas consumed per byte of blob data")
+	cmd.Flags().Uint64(flagGenesisGovMaxSquareSize, 0, "Governance max size of the original data square")
+	cmd.Flags().Uint32(flagGenesisMaxBlobsPerPFB, 0, "Max number of blobs of a PFB, 0 means no limit")
+	cmd.Flags().Uint32(flagGenesisMaxPFBsPerBlock, 0, "Max number of PFBs of a block, 0 means no limit")
+	cmd.Flags().Uint32(flagGenesisMaxTotalBlobSize, 0, "Max total size of the blobs of a PFB in bytes, 0 means no limit")
+	cmd.Flags().StringSlice(flagGenesisAllowedSigners, nil, "Comma separated bech32 encoded addresses that can sign PFBs. Any address can if it is empty")
+	return cmd
+}
+
+// setBlobParamsFromFlags sets the params that are passed as flags of cmd.
+func setBlobParamsFromFlags(cmd *cobra.Command, params *blobtypes.Params) error {
+	var err error
+	if cmd.Flags().Changed(flagGenesisGasPerBlobByte) {
+		if params.GasPerBlobByte, err = cmd.Flags().GetUint32(flagGenesisGasPerBlobByte); err != nil {
+			return err
+		}
+	}
+	if cmd.Flags().Changed(flagGenesisGovMaxSquareSize) {
+		if params.GovMaxSquareSize, err = cmd.Flags().GetUint64(flagGenesisGovMaxSquareSize); err != nil {
+			return err
+		}
+	}
+	if cmd.Flags().Changed(flagGenesisMaxBlobsPerPFB) {
+		if params.MaxBlobsPerPfb, err = cmd.Flags().GetUint32(flagGenesisMaxBlobsPerPFB); err != nil {
+			return err
+		}
+	}
+	if cmd.Flags().Changed(flagGenesisMaxPFBsPerBlock) {
+		if params.MaxPfbsPerBlock, err = cmd.Flags().GetUint32(flagGenesisMaxPFBsPerBlock); err != nil {
+			return err
+		}
+	}
+	if cmd.Flags().Changed(flagGenesisMaxTotalBlobSize) {
+		if params.MaxTotalBlobSizePerPfb, err = cmd.Flags().GetUint32(flagGenesisMaxTotalBlobSize); err != nil {
+			return err
+		}
+	}
+	if cmd.Flags().Changed(flagGenesisAllowedSigners) {
+		if params.AllowedSigners, err = cmd.Flags().GetStringSlice(flagGenesisAllowedSigners); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateGenesis applies update to the app state of the genesis.json of the
+// home directory of cmd and writes it back.
+func updateGenesis(cmd *cobra.Command, update func(cdc codec.Codec, appState map[string]json.RawMessage) error) error {
+	home, err := cmd.Flags().GetString(flags.FlagHome)
+	if err != nil {
+		return err
+	}
+	genFile := filepath.Join(home, "config", "genesis.json")
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	if err := update(encoding.MakeConfig(app.ModuleEncodingRegisters...).Codec, appState); err != nil {
+		return err
+	}
+
+	genDoc.AppState, err = json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+	return genutil.ExportGenesisFile(genDoc, genFile)
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	namespacetypes "github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestGenesisBlobCommands(t *testing.T) {
+	cdc := encoding.MakeConfig(app.ModuleEncodingRegisters...).Codec
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	appState, err := json.Marshal(app.ModuleBasics.DefaultGenesis(cdc))
+	require.NoError(t, err)
+	genDoc := &coretypes.GenesisDoc{ChainID: "private", AppState: appState}
+	require.NoError(t, genDoc.SaveAs(filepath.Join(home, "config", "genesis.json")))
+
+	owner := sdk.AccAddress("owner").String()
+	signer := sdk.AccAddress("signer").String()
+	namespace := hex.EncodeToString(share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize)).Bytes())
+
+	_, err = executeCmd(addGenesisNamespaceCommand(), namespace, owner, "--restricted", "--allowed-signers", signer, "--home", home)
+	require.NoError(t, err)
+	_, err = executeCmd(setGenesisBlobParamsCommand(), "--gov-max-square-size", "32", "--max-total-blob-size-per-pfb", "1000000", "--allowed-signers", owner+","+signer, "--home", home)
+	require.NoError(t, err)
+
+	genState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+	var namespaceGenState namespacetypes.GenesisState
+	cdc.MustUnmarshalJSON(genState[namespacetypes.ModuleName], &namespaceGenState)
+	require.Len(t, namespaceGenState.Registrations, 1)
+	assert.Equal(t, owner, namespaceGenState.Registrations[0].Owner)
+	assert.True(t, namespaceGenState.Registrations[0].Restricted)
+	assert.Equal(t, []string{signer}, namespaceGenState.Registrations[0].AllowedSigners)
+
+	var blobGenState blobtypes.GenesisState
+	cdc.MustUnmarshalJSON(genState[blobtypes.ModuleName], &blobGenState)
+	assert.EqualValues(t, 32, blobGenState.Params.GovMaxSquareSize)
+	assert.EqualValues(t, 1_000_000, blobGenState.Params.MaxTotalBlobSizePerPfb)
+	assert.Equal(t, []string{owner, signer}, blobGenState.Params.AllowedSigners)
+	// the params that aren't passed are kept.
+	assert.Equal(t, blobtypes.DefaultParams().GasPerBlobByte, blobGenState.Params.GasPerBlobByte)
+
+	t.Run("rejects a namespace that is already registered", func(t *testing.T) {
+		_, err := executeCmd(addGenesisNamespaceCommand(), namespace, signer, "--home", home)
+		assert.ErrorContains(t, err, "duplicate registration")
+	})
+	t.Run("rejects a reserved namespace", func(t *testing.T) {
+		_, err := executeCmd(addGenesisNamespaceCommand(), hex.EncodeToString(share.TxNamespace.Bytes()), owner, "--home", home)
+		assert.ErrorIs(t, err, namespacetypes.ErrInvalidNamespace)
+	})
+	t.Run("rejects invalid params", func(t *testing.T) {
+		_, err := executeCmd(setGenesisBlobParamsCommand(), "--gas-per-blob-byte", "0", "--home", home)
+		assert.Error(t, err)
+		_, err = executeCmd(setGenesisBlobParamsCommand(), "--allowed-signers", "celestia1xxxxxxxxxxxx", "--home", home)
+		assert.Error(t, err)
+	})
+}
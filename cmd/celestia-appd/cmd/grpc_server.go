@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/guard"
+	"github.com/cosmos/cosmos-sdk/client"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	srvrtypes "github.com/cosmos/cosmos-sdk/server/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/spf13/cast"
+	"google.golang.org/grpc"
+)
+
+// guardConfig returns the config of the gRPC guard from the app options.
+func guardConfig(appOpts srvrtypes.AppOptions) guard.Config {
+	return guard.Config{
+		AuthTokens:      cast.ToStringSlice(appOpts.Get(guard.FlagAuthTokens)),
+		RateLimit:       cast.ToFloat64(appOpts.Get(guard.FlagRateLimit)),
+		RateBurst:       cast.ToInt(appOpts.Get(guard.FlagRateBurst)),
+		MaxRequestBytes: cast.ToInt(appOpts.Get(guard.FlagMaxRequestBytes)),
+		ExemptLoopback:  cast.ToBool(appOpts.Get(guard.FlagExemptLoopback)),
+	}
+}
+
+// startGRPCServer starts the gRPC server of the SDK with the services of app
+// guarded according to guardCfg.
+func startGRPCServer(clientCtx client.Context, app srvrtypes.Application, cfg serverconfig.GRPCConfig, guardCfg guard.Config) (*grpc.Server, error) {
+	if guardCfg.MaxRequestBytes > 0 {
+		cfg.MaxRecvMsgSize = guardCfg.MaxRequestBytes
+	}
+	return servergrpc.StartGRPCServer(clientCtx, guardedApp{Application: app, guard: guard.New(guardCfg)}, cfg)
+}
+
+// guardedApp registers the gRPC services of an app behind a guard.
+type guardedApp struct {
+	srvrtypes.Application
+	guard *guard.Guard
+}
+
+func (a guardedApp) RegisterGRPCServer(server gogogrpc.Server) {
+	a.Application.RegisterGRPCServer(a.guard.Server(server))
+}
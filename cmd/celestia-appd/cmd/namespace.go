@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/namespace"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+const flagFullNamespace = "full"
+
+// namespaceCommand returns a command that derives blob namespaces from labels
+// and account addresses.
+func namespaceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "namespace",
+		Short: "Derive blob namespaces from labels and account addresses",
+		Long: `Derives a version 0 namespace deterministically from a label or an account address.
+By default the hex encoded user specifiable portion of the namespace ID is printed, which can be passed to pay-for-blob as is.`,
+	}
+	cmd.AddCommand(namespaceFromLabelCommand(), namespaceFromAddressCommand())
+	cmd.PersistentFlags().Bool(flagFullNamespace, false, "Print the whole hex encoded namespace instead of the user specifiable portion of its ID")
+	return cmd
+}
+
+func namespaceFromLabelCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "from-label [label]",
+		Short:   "Derive a namespace from a label",
+		Example: "celestia-appd namespace from-label my-rollup",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ns, err := namespace.FromLabel(args[0])
+			if err != nil {
+				return err
+			}
+			return printNamespace(cmd, ns)
+		},
+	}
+}
+
+func namespaceFromAddressCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "from-address [celestia address]",
+		Short:   "Derive a namespace from an account address",
+		Example: "celestia-appd namespace from-address celestia1grvklux2yjsln7ztk6slv538396qatckqhs86z",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid address: %s", args[0])
+			}
+			ns, err := namespace.FromAddress(addr)
+			if err != nil {
+				return err
+			}
+			return printNamespace(cmd, ns)
+		},
+	}
+}
+
+func printNamespace(cmd *cobra.Command, ns share.Namespace) error {
+	full, err := cmd.Flags().GetBool(flagFullNamespace)
+	if err != nil {
+		return err
+	}
+	encoded := hex.EncodeToString(ns.ID()[share.NamespaceVersionZeroPrefixSize:])
+	if full {
+		encoded = hex.EncodeToString(ns.Bytes())
+	}
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), encoded)
+	return err
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/namespace"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceCommand(t *testing.T) {
+	t.Run("derives a namespace from a label", func(t *testing.T) {
+		ns, err := namespace.FromLabel("my-rollup")
+		require.NoError(t, err)
+		output, err := executeCmd(namespaceCommand(), "from-label", "my-rollup")
+		require.NoError(t, err)
+		assert.Equal(t, hex.EncodeToString(ns.ID()[share.NamespaceVersionZeroPrefixSize:])+"\n", output)
+		assert.Len(t, strings.TrimSpace(output), 2*share.NamespaceVersionZeroIDSize)
+	})
+	t.Run("prints the full namespace", func(t *testing.T) {
+		ns, err := namespace.FromLabel("my-rollup")
+		require.NoError(t, err)
+		output, err := executeCmd(namespaceCommand(), "from-label", "my-rollup", "--full")
+		require.NoError(t, err)
+		assert.Equal(t, hex.EncodeToString(ns.Bytes())+"\n", output)
+	})
+	t.Run("derives a namespace from an address", func(t *testing.T) {
+		addr := "celestia1grvklux2yjsln7ztk6slv538396qatckqhs86z"
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		require.NoError(t, err)
+		ns, err := namespace.FromAddress(accAddr)
+		require.NoError(t, err)
+		output, err := executeCmd(namespaceCommand(), "from-address", addr)
+		require.NoError(t, err)
+		assert.Equal(t, hex.EncodeToString(ns.ID()[share.NamespaceVersionZeroPrefixSize:])+"\n", output)
+	})
+	t.Run("returns an error for an invalid address", func(t *testing.T) {
+		_, err := executeCmd(namespaceCommand(), "from-address", "celestia1xxxxxxxxxxxx")
+		assert.ErrorContains(t, err, "invalid address")
+	})
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/app/module"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	flagUpgradeHeight     = "height"
+	flagUpgradeAppVersion = "app-version"
+	flagUpgradeExportFile = "export-file"
+)
+
+var (
+	haltHeightRegexp   = regexp.MustCompile(`(?m)^halt-height\s*=.*$`)
+	exportAtHaltRegexp = regexp.MustCompile(`(?m)^` + app.FlagExportAtHalt + `\s*=.*$`)
+)
+
+func prepareUpgradeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prepare-upgrade",
+		Short: "Configure the node to halt for a coordinated upgrade",
+		Long: "Configure the node to halt for a coordinated upgrade.\n" +
+			"Verifies that this binary contains the state migrations of the upgrade to the provided app version and prints them. " +
+			"Then sets the halt height in app.toml so that the node shuts down after committing the provided height. " +
+			fmt.Sprintf("If --%s is set, the state of the halt height is additionally exported to the file as a genesis file before the node shuts down.\n", flagUpgradeExportFile) +
+			"The node must be restarted for the configuration to take effect.\n",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			height, err := cmd.Flags().GetInt64(flagUpgradeHeight)
+			if err != nil {
+				return err
+			}
+			if height <= 0 {
+				return fmt.Errorf("--%s must be positive", flagUpgradeHeight)
+			}
+			appVersion, err := cmd.Flags().GetUint64(flagUpgradeAppVersion)
+			if err != nil {
+				return err
+			}
+			exportFile, err := cmd.Flags().GetString(flagUpgradeExportFile)
+			if err != nil {
+				return err
+			}
+			if exportFile != "" {
+				if exportFile, err = filepath.Abs(exportFile); err != nil {
+					return err
+				}
+			}
+
+			plan, err := migrationPlan(appVersion)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Upgrade from app version %d to %d:\n", appVersion-1, appVersion)
+			if len(plan) == 0 {
+				cmd.Println("  no module migrations")
+			}
+			for _, migration := range plan {
+				cmd.Println("  " + describeMigration(migration))
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			appConfigPath := filepath.Join(serverCtx.Config.RootDir, "config", "app.toml")
+			if err := setHaltConfig(appConfigPath, height, exportFile); err != nil {
+				return err
+			}
+			cmd.Printf("Set halt-height = %d in %s\n", height, appConfigPath)
+			if exportFile != "" {
+				cmd.Printf("The state at height %d will be exported to %s\n", height, exportFile)
+			}
+			cmd.Println("Restart the node for the changes to take effect")
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagUpgradeHeight, 0, "Height after which the node halts for the upgrade")
+	cmd.Flags().Uint64(flagUpgradeAppVersion, appconsts.LatestVersion, "App version that the chain upgrades to")
+	cmd.Flags().String(flagUpgradeExportFile, "", "File to export the state at the halt height to. No state is exported if empty")
+	return cmd
+}
+
+// migrationPlan returns the module migrations of the upgrade to appVersion
+// that this binary performs. It returns an error if the binary doesn't
+// support the upgrade.
+func migrationPlan(appVersion uint64) ([]module.ModuleMigration, error) {
+	if appVersion <= 1 {
+		return nil, fmt.Errorf("app version %d is not an upgrade", appVersion)
+	}
+	config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	// The app is constructed with empty options so that none of the services
+	// configured for the node, e.g. the blob index, are started.
+	application := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, 0, config, 0, 0, emptyAppOptions{})
+	plan, err := application.MigrationPlan(appVersion-1, appVersion)
+	if err != nil {
+		return nil, fmt.Errorf("this binary doesn't support the upgrade to app version %d: %w", appVersion, err)
+	}
+	return plan, nil
+}
+
+func describeMigration(migration module.ModuleMigration) string {
+	if migration.FromVersion == 0 {
+		return fmt.Sprintf("%s: added at consensus version %d", migration.Module, migration.ToVersion)
+	}
+	return fmt.Sprintf("%s: consensus version %d -> %d, migrations from versions %v",
+		migration.Module, migration.FromVersion, migration.ToVersion, migration.Steps)
+}
+
+// setHaltConfig sets the halt height and the export file in the app config at
+// path. The rest of the file is left untouched so that comments and custom
+// settings are preserved.
+func setHaltConfig(path string, haltHeight int64, exportFile string) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if !haltHeightRegexp.Match(bz) {
+		return fmt.Errorf("halt-height not found in %s", path)
+	}
+	haltHeightLine := []byte("halt-height = " + strconv.FormatInt(haltHeight, 10))
+	exportLine := []byte(app.FlagExportAtHalt + " = " + strconv.Quote(exportFile))
+
+	bz = haltHeightRegexp.ReplaceAllLiteral(bz, haltHeightLine)
+	if exportAtHaltRegexp.Match(bz) {
+		bz = exportAtHaltRegexp.ReplaceAllLiteral(bz, exportLine)
+	} else if exportFile != "" {
+		// halt-height is a top level key so the export file is added right
+		// after it to remain outside of any table.
+		bz = haltHeightRegexp.ReplaceAllLiteral(bz, append(append(haltHeightLine, '\n'), exportLine...))
+	}
+	return os.WriteFile(path, bz, 0o644)
+}
+
+// emptyAppOptions is an implementation of servertypes.AppOptions without any
+// options set.
+type emptyAppOptions struct{}
+
+func (emptyAppOptions) Get(string) interface{} {
+	return nil
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_setHaltConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.toml")
+	config := "minimum-gas-prices = \"0.002utia\"\nhalt-height = 0\nhalt-time = 0\n\n[api]\nenable = false\n"
+	require.NoError(t, os.WriteFile(path, []byte(config), 0o644))
+
+	require.NoError(t, setHaltConfig(path, 100, ""))
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "minimum-gas-prices = \"0.002utia\"\nhalt-height = 100\nhalt-time = 0\n\n[api]\nenable = false\n", string(bz))
+
+	require.NoError(t, setHaltConfig(path, 200, "/tmp/export.json"))
+	bz, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "minimum-gas-prices = \"0.002utia\"\nhalt-height = 200\nexport-at-halt = \"/tmp/export.json\"\nhalt-time = 0\n\n[api]\nenable = false\n", string(bz))
+
+	require.NoError(t, setHaltConfig(path, 300, ""))
+	bz, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "minimum-gas-prices = \"0.002utia\"\nhalt-height = 300\nexport-at-halt = \"\"\nhalt-time = 0\n\n[api]\nenable = false\n", string(bz))
+
+	require.NoError(t, os.WriteFile(path, []byte("[api]\nenable = false\n"), 0o644))
+	require.Error(t, setHaltConfig(path, 100, ""))
+}
+
+func Test_migrationPlan(t *testing.T) {
+	_, err := migrationPlan(appconsts.LatestVersion)
+	require.NoError(t, err)
+
+	_, err = migrationPlan(1)
+	require.Error(t, err)
+
+	_, err = migrationPlan(appconsts.LatestVersion + 1)
+	require.Error(t, err)
+}
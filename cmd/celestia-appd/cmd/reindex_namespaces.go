@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/blobindex"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/store"
+)
+
+const (
+	flagReindexFrom = "from"
+	flagReindexTo   = "to"
+
+	// reindexLogInterval is the number of heights between progress logs.
+	reindexLogInterval = 1000
+)
+
+func reindexNamespacesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reindex-namespaces",
+		Short: "Rebuild the blob index from the stored blocks",
+		Long: "Rebuild the blob index from the stored blocks.\n" +
+			"Walks the blocks in the block store and indexes the namespaces and share ranges of their txs and blobs. " +
+			fmt.Sprintf("Use it to backfill the index after enabling --%s on an existing node. ", blobindex.FlagEnable) +
+			"The node must be stopped while reindexing.\n",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+			blockStore := store.NewBlockStore(blockStoreDB)
+
+			from, err := cmd.Flags().GetInt64(flagReindexFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagReindexTo)
+			if err != nil {
+				return err
+			}
+			base := blockStore.Base()
+			if from == 0 {
+				from = base
+			}
+			if to == 0 {
+				to = blockStore.Height()
+			}
+			if from < base || to > blockStore.Height() || from > to {
+				return fmt.Errorf("invalid height range [%d, %d]: the block store contains the blob data of heights [%d, %d]", from, to, base, blockStore.Height())
+			}
+
+			indexDB, err := blobindex.OpenDB(config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer indexDB.Close()
+			index := blobindex.New(indexDB)
+
+			for height := from; height <= to; height++ {
+				block := blockStore.LoadBlock(height)
+				if block == nil {
+					return fmt.Errorf("block at height %d not found", height)
+				}
+				if err := index.IndexBlock(height, block.Version.App, block.Data.Txs.ToSliceOfBytes()); err != nil {
+					return err
+				}
+				if (height-from+1)%reindexLogInterval == 0 {
+					serverCtx.Logger.Info("reindexed blocks", "height", height, "to", to)
+				}
+			}
+			cmd.Printf("Reindexed heights %d to %d\n", from, to)
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagReindexFrom, 0, "First height to reindex. Defaults to the lowest height in the block store")
+	cmd.Flags().Int64(flagReindexTo, 0, "Last height to reindex. Defaults to the latest height in the block store")
+	return cmd
+}
@@ -5,10 +5,14 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v3/app"
 	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/app/forensics"
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/guard"
+	"github.com/celestiaorg/celestia-app/v3/pkg/blobindex"
+	"github.com/celestiaorg/celestia-app/v3/pkg/feestats"
+	"github.com/celestiaorg/celestia-app/v3/pkg/telemetrypush"
 	blobstreamclient "github.com/celestiaorg/celestia-app/v3/x/blobstream/client"
 	"github.com/cosmos/cosmos-sdk/client"
 	clientconfig "github.com/cosmos/cosmos-sdk/client/config"
-	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
@@ -74,7 +78,7 @@ func NewRootCmd() *cobra.Command {
 				return err
 			}
 
-			appTemplate := serverconfig.DefaultConfigTemplate
+			appTemplate := serverconfig.DefaultConfigTemplate + guard.ConfigTemplate
 			appConfig := app.DefaultAppConfig()
 			tmConfig := app.DefaultConsensusConfig()
 
@@ -110,21 +114,31 @@ func initRootCommand(rootCommand *cobra.Command, encodingConfig encoding.Config)
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
 		genutilcli.MigrateGenesisCmd(),
 		simdcmd.AddGenesisAccountCmd(app.DefaultNodeHome),
+		addGenesisNamespaceCommand(),
+		setGenesisBlobParamsCommand(),
 		genutilcli.GenTxCmd(app.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(app.ModuleBasics),
 		tmcli.NewCompletionCmd(rootCommand, true),
-		debug.Cmd(),
+		debugCommand(),
 		clientconfig.Cmd(),
 		commands.CompactGoLevelDBCmd,
 		addrbookCommand(),
 		downloadGenesisCommand(),
 		addrConversionCmd(),
+		namespaceCommand(),
 		rpc.StatusCommand(),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(app.DefaultNodeHome),
 		blobstreamclient.VerifyCmd(),
 		snapshot.Cmd(NewAppServer),
+		forensicsCommand(),
+		reindexNamespacesCommand(),
+		prepareUpgradeCommand(),
+		doctorCommand(),
+		benchCommand(),
+		devnetCommand(),
+		devCommand(),
 	)
 
 	// Add the following commands to the rootCommand: start, tendermint, export, version, and rollback.
@@ -144,6 +158,22 @@ func addStartFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 	startCmd.Flags().Int64(UpgradeHeightFlag, 0, "Upgrade height to switch from v1 to v2. Must be coordinated amongst all validators")
 	startCmd.Flags().Duration(TimeoutCommitFlag, 0, "Override the application configured timeout_commit. Note: only for testing purposes.")
+	startCmd.Flags().String(forensics.FlagDir, "", "Directory to write forensic dumps of the store writes, square layout and tx results of every committed block to. Used to investigate app hash mismatches. Disabled if empty")
+	startCmd.Flags().Int64(forensics.FlagRetainHeights, forensics.DefaultRetainHeights, "Number of most recent heights to keep forensic dumps for. All dumps are kept if 0")
+	startCmd.Flags().Bool(blobindex.FlagEnable, false, "Index the namespaces and share ranges of the txs and blobs of committed blocks")
+	startCmd.Flags().Int(feestats.FlagWindow, 0, "Number of most recent heights to compute the per-namespace fee statistics over. Disabled if 0")
+	startCmd.Flags().StringSlice(guard.FlagAuthTokens, nil, "Tokens that gRPC requests must present in the x-api-key metadata or as a bearer token. Authentication is disabled if empty")
+	startCmd.Flags().Float64(guard.FlagRateLimit, 0, "Number of gRPC requests per second that each IP can send. Disabled if 0")
+	startCmd.Flags().Int(guard.FlagRateBurst, 0, "Number of gRPC requests that each IP can send in a burst above the rate limit. Defaults to the rate limit if 0")
+	startCmd.Flags().Int(guard.FlagMaxRequestBytes, 0, "Max size of a gRPC request in bytes. Defaults to grpc.max-recv-msg-size if 0")
+	startCmd.Flags().Bool(guard.FlagExemptLoopback, false, "Exempt gRPC requests from loopback addresses, e.g. from the gRPC gateway of the API server, from authentication and rate limiting")
+	startCmd.Flags().String(app.FlagExportAtHalt, "", "File to export the state to as a genesis file when the node reaches the halt height. Disabled if empty")
+	startCmd.Flags().Duration(app.FlagProposalTimeout, 0, "Duration after which PrepareProposal proposes an empty block if building and erasure coding the square of the block isn't done. Disabled if 0")
+	startCmd.Flags().Bool(app.FlagBlobEvents, false, "Emit an event that locates every blob of a block in its data square at the end of the block, for indexers and blob subscriptions. Reconstructs the square of every block")
+	startCmd.Flags().Bool(app.FlagNamespaceFairness, false, "Allocate the shares of proposed blocks across namespaces in proportion to the fees they pay when blob txs don't all fit, instead of in pure priority order")
+	startCmd.Flags().String(telemetrypush.FlagEndpoint, "", "URL to periodically post a report of anonymized mempool, block fullness and ProcessProposal latency metrics to. Disabled if empty")
+	startCmd.Flags().Duration(telemetrypush.FlagInterval, telemetrypush.DefaultInterval, "Interval between two telemetry reports")
+	startCmd.Flags().Int64(app.FlagUpgradeCheckBlocks, app.DefaultUpgradeCheckBlocks, "Number of blocks after the node starts and after every upgrade during which self-checks of the upgraded state run. Disabled if 0")
 }
 
 // replaceLogger optionally replaces the logger with a file logger if the flag
@@ -0,0 +1,13 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewRootCmd verifies that the flags of the commands don't conflict, which
+// panics when the command tree is built.
+func TestNewRootCmd(t *testing.T) {
+	assert.NotPanics(t, func() { NewRootCmd() })
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/square"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	flagSquareLayoutFormat = "format"
+
+	squareLayoutFormatJSON = "json"
+	squareLayoutFormatHTML = "html"
+)
+
+// debugCommand returns the debug command of the Cosmos SDK with the debug
+// tools of the app added to it.
+func debugCommand() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(squareLayoutCommand())
+	return cmd
+}
+
+func squareLayoutCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "square-layout",
+		Short: "Print a map of the data square of a block",
+		Long: "Print a map of the data square of a block.\n" +
+			"Fetches the block from the node, constructs its data square and prints which shares hold txs, PFBs, the blobs of each namespace and padding, " +
+			"along with the share of the square that is padding. Use it to diagnose why the square of a block is larger than expected.\n",
+		Example: "celestia-appd debug square-layout --height 100 --format html > square.html",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetInt64(flags.FlagHeight)
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString(flagSquareLayoutFormat)
+			if err != nil {
+				return err
+			}
+			if format != squareLayoutFormatJSON && format != squareLayoutFormatHTML {
+				return fmt.Errorf("--%s must be %s or %s, got %s", flagSquareLayoutFormat, squareLayoutFormatJSON, squareLayoutFormatHTML, format)
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			var heightPtr *int64
+			if height != 0 {
+				heightPtr = &height
+			}
+			res, err := node.Block(cmd.Context(), heightPtr)
+			if err != nil {
+				return err
+			}
+
+			layout, err := blockSquareLayout(res.Block)
+			if err != nil {
+				return err
+			}
+			return writeSquareLayout(cmd.OutOrStdout(), layout, format)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	// the query flags already define the height flag.
+	cmd.Flags().Lookup(flags.FlagHeight).Usage = "The height of the block. Defaults to the latest height"
+	cmd.Flags().String(flagSquareLayoutFormat, squareLayoutFormatJSON, fmt.Sprintf("The format of the map, %s or %s", squareLayoutFormatJSON, squareLayoutFormatHTML))
+	return cmd
+}
+
+// blockSquareLayout constructs the data square of block with the square
+// construction of its app version and returns its layout.
+func blockSquareLayout(block *coretypes.Block) (square.Layout, error) {
+	appVersion := block.Version.App
+	dataSquare, err := square.Construct(appVersion, block.Data.Txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appVersion))
+	if err != nil {
+		return square.Layout{}, fmt.Errorf("constructing the square of height %d: %w", block.Height, err)
+	}
+	return dataSquare.Layout()
+}
+
+func writeSquareLayout(w io.Writer, layout square.Layout, format string) error {
+	if format == squareLayoutFormatHTML {
+		return layout.WriteHTML(w)
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(layout)
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/square"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func Test_blockSquareLayout(t *testing.T) {
+	txs := make(coretypes.Txs, 20)
+	for i := range txs {
+		txs[i] = tmrand.Bytes(1000)
+	}
+	for _, appVersion := range []uint64{1, 2, appconsts.LatestVersion} {
+		block := &coretypes.Block{
+			Header: coretypes.Header{Version: version.Consensus{App: appVersion}},
+			Data:   coretypes.Data{Txs: txs},
+		}
+		layout, err := blockSquareLayout(block)
+		require.NoError(t, err, appVersion)
+		assert.Equal(t, 8, layout.Size, appVersion)
+		require.Len(t, layout.Ranges, 2, appVersion)
+		assert.Equal(t, square.KindTx, layout.Ranges[0].Kind, appVersion)
+		assert.Equal(t, square.KindTailPadding, layout.Ranges[1].Kind, appVersion)
+		assert.Equal(t, 64-layout.Ranges[0].End, layout.PaddingShares, appVersion)
+
+		var out bytes.Buffer
+		require.NoError(t, writeSquareLayout(&out, layout, squareLayoutFormatJSON))
+		var decoded square.Layout
+		require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+		assert.Equal(t, layout, decoded, appVersion)
+	}
+
+	_, err := blockSquareLayout(&coretypes.Block{Data: coretypes.Data{Txs: txs}})
+	assert.ErrorContains(t, err, "unsupported app version")
+}
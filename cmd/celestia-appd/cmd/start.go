@@ -4,6 +4,7 @@ package cmd
 // start command flag.
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net"
@@ -14,7 +15,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/retention"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/blobindex"
+	"github.com/celestiaorg/celestia-app/v3/pkg/telemetrypush"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -32,12 +36,14 @@ import (
 	"github.com/spf13/cobra"
 	tmserver "github.com/tendermint/tendermint/abci/server"
 	cmtcmd "github.com/tendermint/tendermint/cmd/cometbft/commands"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
 	"github.com/tendermint/tendermint/rpc/client/local"
+	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -53,6 +59,14 @@ const (
 
 	FlagForceNoBBR = "force-no-bbr"
 
+	// FlagProofRetainHeights is the flag to specify the number of most recent
+	// heights for which the node must be able to serve share and blob proofs.
+	FlagProofRetainHeights = retention.FlagProofRetainHeights
+
+	// FlagReadOnly is the flag to start a node that serves queries, proofs
+	// and indexes but never participates in consensus.
+	FlagReadOnly = "read-only"
+
 	// gRPC-related flags
 	flagGRPCOnly       = "grpc-only"
 	flagGRPCEnable     = "grpc.enable"
@@ -113,6 +127,14 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 			}
 
 			serverCtx := server.GetServerContextFromCmd(cmd)
+			if err := retention.NewConfig(serverCtx.Viper).Validate(); err != nil {
+				return err
+			}
+			if serverCtx.Viper.GetBool(FlagReadOnly) {
+				if err := configureReadOnly(serverCtx); err != nil {
+					return err
+				}
+			}
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
@@ -176,6 +198,8 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint(server.FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(server.FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
 	cmd.Flags().Bool(FlagForceNoBBR, false, "bypass the requirement to use bbr locally")
+	cmd.Flags().Bool(FlagReadOnly, false, "Start a node that serves queries, proofs and indexes and follows the chain with block sync but never signs blocks or votes, e.g. for RPC providers. Enables the gRPC server and, unless set otherwise, the blob index")
+	cmd.Flags().Uint64(FlagProofRetainHeights, 0, "Number of most recent heights for which the node must retain the blocks needed to serve share and blob proofs. The node refuses to start if its pruning settings would prune them. 0 means no guarantee")
 
 	cmd.Flags().Bool(server.FlagAPIEnable, false, "Define if the API server should be enabled")
 	cmd.Flags().Bool(server.FlagAPISwagger, false, "Define if swagger documentation should automatically be registered (Note: the API must also be enabled)")
@@ -301,9 +325,18 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, appCreator sr
 	} else {
 		ctx.Logger.Info("starting node with ABCI Tendermint in-process")
 
+		var privValidator tmtypes.PrivValidator
+		if ctx.Viper.GetBool(FlagReadOnly) {
+			// a key that is never part of the validator set so the node can't
+			// sign, even if its key file belongs to a validator. It is not
+			// persisted so it needs no state file either.
+			privValidator = privval.NewFilePV(ed25519.GenPrivKey(), "", "")
+		} else {
+			privValidator = privval.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
+		}
 		tmNode, err = node.NewNode(
 			cfg,
-			privval.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
+			privValidator,
 			nodeKey,
 			proxy.NewLocalClientCreator(app),
 			genDocProvider,
@@ -410,7 +443,7 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, appCreator sr
 	)
 
 	if config.GRPC.Enable {
-		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC)
+		grpcSrv, err = startGRPCServer(clientCtx, app, config.GRPC, guardConfig(ctx.Viper))
 		if err != nil {
 			return err
 		}
@@ -436,6 +469,31 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, appCreator sr
 		return server.WaitForQuitSignals()
 	}
 
+	if endpoint := ctx.Viper.GetString(telemetrypush.FlagEndpoint); endpoint != "" {
+		if a, ok := app.(interface {
+			TelemetryCollector() *telemetrypush.Collector
+		}); ok && a.TelemetryCollector() != nil {
+			reporterID, err := telemetrypush.LoadOrGenReporterID(filepath.Join(cfg.DBDir(), telemetrypush.ReporterIDFile))
+			if err != nil {
+				return err
+			}
+			pusher := telemetrypush.NewPusher(
+				endpoint,
+				ctx.Viper.GetDuration(telemetrypush.FlagInterval),
+				a.TelemetryCollector(),
+				tmNode.Mempool(),
+				reporterID,
+				tmNode.GenesisDoc().ChainID,
+				version.Version,
+				ctx.Logger,
+			)
+			pushCtx, cancelPush := context.WithCancel(context.Background())
+			defer cancelPush()
+			go pusher.Run(pushCtx)
+			ctx.Logger.Info("pushing telemetry reports", "endpoint", endpoint)
+		}
+	}
+
 	var rosettaSrv crgserver.Server
 	if config.Rosetta.Enable {
 		offlineMode := config.Rosetta.Offline
@@ -628,6 +686,29 @@ If you need to bypass this check use the --force-no-bbr flag.
 	return nil
 }
 
+// configureReadOnly configures a node started with --read-only. A read-only
+// node follows the chain with Tendermint so it can serve proofs from its
+// blocks, but it signs with a throwaway key instead of the key of the node or a
+// remote signer, see startInProcess. It always serves gRPC queries and indexes
+// blobs unless the blob index is explicitly disabled.
+func configureReadOnly(ctx *server.Context) error {
+	if ctx.Viper.GetBool(flagGRPCOnly) {
+		return fmt.Errorf("--%s can't be combined with --%s: a read-only node follows the chain with Tendermint", FlagReadOnly, flagGRPCOnly)
+	}
+	if !ctx.Viper.GetBool(flagWithTendermint) {
+		return fmt.Errorf("--%s requires --%s: a read-only node follows the chain with Tendermint", FlagReadOnly, flagWithTendermint)
+	}
+
+	ctx.Viper.Set(flagGRPCEnable, true)
+	if !ctx.Viper.IsSet(blobindex.FlagEnable) {
+		ctx.Viper.Set(blobindex.FlagEnable, true)
+	}
+	ctx.Config.FastSyncMode = true
+	ctx.Config.PrivValidatorListenAddr = ""
+	ctx.Logger.Info("starting node in read-only mode; the node doesn't participate in consensus")
+	return nil
+}
+
 func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("application", backendType, dataDir)
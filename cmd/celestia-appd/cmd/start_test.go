@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/blobindex"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_configureReadOnly(t *testing.T) {
+	newContext := func() *server.Context {
+		ctx := server.NewDefaultContext()
+		ctx.Viper.Set(flagWithTendermint, true)
+		ctx.Viper.Set(flagGRPCEnable, false)
+		ctx.Config.FastSyncMode = false
+		ctx.Config.PrivValidatorListenAddr = "tcp://127.0.0.1:26659"
+		return ctx
+	}
+
+	ctx := newContext()
+	require.NoError(t, configureReadOnly(ctx))
+	assert.True(t, ctx.Viper.GetBool(flagGRPCEnable))
+	assert.True(t, ctx.Viper.GetBool(blobindex.FlagEnable))
+	assert.True(t, ctx.Config.FastSyncMode)
+	assert.Empty(t, ctx.Config.PrivValidatorListenAddr)
+
+	// the blob index can still be disabled explicitly.
+	ctx = newContext()
+	ctx.Viper.Set(blobindex.FlagEnable, false)
+	require.NoError(t, configureReadOnly(ctx))
+	assert.False(t, ctx.Viper.GetBool(blobindex.FlagEnable))
+
+	ctx = newContext()
+	ctx.Viper.Set(flagGRPCOnly, true)
+	assert.ErrorContains(t, configureReadOnly(ctx), flagGRPCOnly)
+
+	ctx = newContext()
+	ctx.Viper.Set(flagWithTendermint, false)
+	assert.ErrorContains(t, configureReadOnly(ctx), flagWithTendermint)
+}
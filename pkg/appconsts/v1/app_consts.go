@@ -6,6 +6,8 @@ const (
 	Version              uint64 = 1
 	SquareSizeUpperBound int    = 128
 	SubtreeRootThreshold int    = 64
+	ShareSize            int    = 512
+	NamespaceSize        int    = 29
 	// TimeoutPropose is deprecated because it was not a constant
 	// in v1, it was the default for a user-configurable timeout.
 	TimeoutPropose = time.Second * 10
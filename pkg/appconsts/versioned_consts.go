@@ -1,16 +1,22 @@
 package appconsts
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
 	"strconv"
 	"time"
 
 	v1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
 	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
 	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	"github.com/celestiaorg/go-square/v2/share"
 )
 
 const (
-	LatestVersion = v3.Version
+	LatestVersion = v4.Version
 )
 
 // SubtreeRootThreshold works as a target upper bound for the number of subtree
@@ -21,12 +27,23 @@ const (
 // SubtreeRootThreshold.
 //
 // The rationale for this value is described in more detail in ADR-013.
-func SubtreeRootThreshold(_ uint64) int {
-	return v3.SubtreeRootThreshold
+func SubtreeRootThreshold(v uint64) int {
+	switch v {
+	case v1.Version:
+		return v1.SubtreeRootThreshold
+	case v2.Version:
+		return v2.SubtreeRootThreshold
+	case v3.Version:
+		return v3.SubtreeRootThreshold
+	default:
+		return v4.SubtreeRootThreshold
+	}
 }
 
 // SquareSizeUpperBound imposes an upper bound on the max effective square size.
-func SquareSizeUpperBound(_ uint64) int {
+// It is the max square size of the app version, the governance max square size
+// can only lower it.
+func SquareSizeUpperBound(v uint64) int {
 	if OverrideSquareSizeUpperBoundStr != "" {
 		parsedValue, err := strconv.Atoi(OverrideSquareSizeUpperBoundStr)
 		if err != nil {
@@ -34,19 +51,71 @@ func SquareSizeUpperBound(_ uint64) int {
 		}
 		return parsedValue
 	}
-	return v3.SquareSizeUpperBound
+	switch v {
+	case v1.Version:
+		return v1.SquareSizeUpperBound
+	case v2.Version:
+		return v2.SquareSizeUpperBound
+	case v3.Version:
+		return v3.SquareSizeUpperBound
+	default:
+		return v4.SquareSizeUpperBound
+	}
+}
+
+// ShareSize returns the size of a share in bytes.
+func ShareSize(v uint64) int {
+	switch v {
+	case v1.Version:
+		return v1.ShareSize
+	case v2.Version:
+		return v2.ShareSize
+	case v3.Version:
+		return v3.ShareSize
+	default:
+		return v4.ShareSize
+	}
+}
+
+// NamespaceSize returns the size of a namespace in bytes.
+func NamespaceSize(v uint64) int {
+	switch v {
+	case v1.Version:
+		return v1.NamespaceSize
+	case v2.Version:
+		return v2.NamespaceSize
+	case v3.Version:
+		return v3.NamespaceSize
+	default:
+		return v4.NamespaceSize
+	}
 }
 
 func TxSizeCostPerByte(_ uint64) uint64 {
-	return v3.TxSizeCostPerByte
+	return v4.TxSizeCostPerByte
 }
 
 func GasPerBlobByte(_ uint64) uint32 {
-	return v3.GasPerBlobByte
+	return v4.GasPerBlobByte
 }
 
 func MaxTxSize(_ uint64) int {
-	return v3.MaxTxSize
+	return v4.MaxTxSize
+}
+
+// ShareVersions returns the share versions that blobs can use. Share version 1,
+// which adds the signer to the blob, is supported from v3 onwards.
+func ShareVersions(v uint64) []uint8 {
+	if v < v3.Version {
+		return []uint8{share.ShareVersionZero}
+	}
+	return SupportedShareVersions
+}
+
+// IsSupportedShareVersion returns whether blobs can use the share version in
+// the app version.
+func IsSupportedShareVersion(v uint64, shareVersion uint8) bool {
+	return slices.Contains(ShareVersions(v), shareVersion)
 }
 
 var (
@@ -62,8 +131,10 @@ func GetTimeoutPropose(v uint64) time.Duration {
 		return v1.TimeoutPropose
 	case v2.Version:
 		return v2.TimeoutPropose
-	default:
+	case v3.Version:
 		return v3.TimeoutPropose
+	default:
+		return v4.TimeoutPropose
 	}
 }
 
@@ -73,8 +144,10 @@ func GetTimeoutCommit(v uint64) time.Duration {
 		return v1.TimeoutCommit
 	case v2.Version:
 		return v2.TimeoutCommit
-	default:
+	case v3.Version:
 		return v3.TimeoutCommit
+	default:
+		return v4.TimeoutCommit
 	}
 }
 
@@ -94,7 +167,22 @@ func UpgradeHeightDelay(chainID string, v uint64) int64 {
 			return v3.UpgradeHeightDelay
 		}
 		return v2.UpgradeHeightDelay
-	default:
+	case v3.Version:
 		return v3.UpgradeHeightDelay
+	default:
+		return v4.UpgradeHeightDelay
 	}
 }
+
+// ConstantsDigest returns a hex encoded digest of the versioned constants for
+// the provided app version. Clients can compare it with the digest reported by
+// a node to detect that the node runs with constants that differ from the ones
+// compiled into the client.
+func ConstantsDigest(v uint64) string {
+	consts := fmt.Sprintf(
+		"version=%d,subtree_root_threshold=%d,square_size_upper_bound=%d,tx_size_cost_per_byte=%d,gas_per_blob_byte=%d,max_tx_size=%d,namespace_size=%d,share_size=%d",
+		v, SubtreeRootThreshold(v), SquareSizeUpperBound(v), TxSizeCostPerByte(v), GasPerBlobByte(v), MaxTxSize(v), NamespaceSize(v), ShareSize(v),
+	)
+	digest := sha256.Sum256([]byte(consts))
+	return hex.EncodeToString(digest[:])
+}
@@ -9,6 +9,8 @@ import (
 	v1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
 	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
 	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	"github.com/celestiaorg/go-square/v2/share"
 )
 
 func TestVersionedConsts(t *testing.T) {
@@ -36,6 +38,12 @@ func TestVersionedConsts(t *testing.T) {
 			expectedConstant: v3.SubtreeRootThreshold,
 			got:              appconsts.SubtreeRootThreshold(v3.Version),
 		},
+		{
+			name:             "SubtreeRootThreshold v4",
+			version:          v4.Version,
+			expectedConstant: v4.SubtreeRootThreshold,
+			got:              appconsts.SubtreeRootThreshold(v4.Version),
+		},
 		{
 			name:             "SquareSizeUpperBound v1",
 			version:          v1.Version,
@@ -54,6 +62,12 @@ func TestVersionedConsts(t *testing.T) {
 			expectedConstant: v3.SquareSizeUpperBound,
 			got:              appconsts.SquareSizeUpperBound(v3.Version),
 		},
+		{
+			name:             "SquareSizeUpperBound v4",
+			version:          v4.Version,
+			expectedConstant: v4.SquareSizeUpperBound,
+			got:              appconsts.SquareSizeUpperBound(v4.Version),
+		},
 		{
 			name:             "TxSizeCostPerByte v3",
 			version:          v3.Version,
@@ -72,6 +86,42 @@ func TestVersionedConsts(t *testing.T) {
 			expectedConstant: v3.MaxTxSize,
 			got:              appconsts.MaxTxSize(v3.Version),
 		},
+		{
+			name:             "ShareSize v1",
+			version:          v1.Version,
+			expectedConstant: v1.ShareSize,
+			got:              appconsts.ShareSize(v1.Version),
+		},
+		{
+			name:             "NamespaceSize v1",
+			version:          v1.Version,
+			expectedConstant: v1.NamespaceSize,
+			got:              appconsts.NamespaceSize(v1.Version),
+		},
+		{
+			name:             "ShareSize v2",
+			version:          v2.Version,
+			expectedConstant: v2.ShareSize,
+			got:              appconsts.ShareSize(v2.Version),
+		},
+		{
+			name:             "NamespaceSize v2",
+			version:          v2.Version,
+			expectedConstant: v2.NamespaceSize,
+			got:              appconsts.NamespaceSize(v2.Version),
+		},
+		{
+			name:             "ShareSize v3",
+			version:          v3.Version,
+			expectedConstant: v3.ShareSize,
+			got:              appconsts.ShareSize(v3.Version),
+		},
+		{
+			name:             "NamespaceSize v3",
+			version:          v3.Version,
+			expectedConstant: v3.NamespaceSize,
+			got:              appconsts.NamespaceSize(v3.Version),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -81,6 +131,22 @@ func TestVersionedConsts(t *testing.T) {
 	}
 }
 
+// TestVersionedShareLayout checks that the share layout of every app version
+// matches the one of go-square, which lays out the shares of the square.
+func TestVersionedShareLayout(t *testing.T) {
+	for _, v := range []uint64{v1.Version, v2.Version, v3.Version} {
+		require.Equal(t, share.ShareSize, appconsts.ShareSize(v))
+		require.Equal(t, share.NamespaceSize, appconsts.NamespaceSize(v))
+	}
+}
+
+func TestIsSupportedShareVersion(t *testing.T) {
+	require.True(t, appconsts.IsSupportedShareVersion(v1.Version, share.ShareVersionZero))
+	require.False(t, appconsts.IsSupportedShareVersion(v2.Version, share.ShareVersionOne))
+	require.True(t, appconsts.IsSupportedShareVersion(v3.Version, share.ShareVersionOne))
+	require.False(t, appconsts.IsSupportedShareVersion(v3.Version, share.MaxShareVersion+1))
+}
+
 func TestUpgradeHeightDelay(t *testing.T) {
 	tests := []struct {
 		name                       string
@@ -139,3 +205,9 @@ func TestUpgradeHeightDelay(t *testing.T) {
 		})
 	}
 }
+
+func TestConstantsDigest(t *testing.T) {
+	require.Equal(t, appconsts.ConstantsDigest(v3.Version), appconsts.ConstantsDigest(v3.Version))
+	require.Len(t, appconsts.ConstantsDigest(v3.Version), 64)
+	require.NotEqual(t, appconsts.ConstantsDigest(v2.Version), appconsts.ConstantsDigest(v3.Version))
+}
@@ -0,0 +1,158 @@
+// Package blobindex maintains an index of the namespaces and share ranges of
+// the txs and blobs of committed blocks. It allows finding the heights at
+// which a namespace has blobs and the shares occupied by a tx without
+// reconstructing the data square of every block.
+package blobindex
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	coretypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	// FlagEnable is the flag to enable indexing of committed blocks.
+	FlagEnable = "blob-index"
+	// DBName is the name of the database of the index in the data directory
+	// of the node.
+	DBName = "blob_index"
+)
+
+var (
+	namespacePrefix = []byte{0x01}
+	txPrefix        = []byte{0x02}
+
+	// ErrNotFound is returned if a tx is not indexed.
+	ErrNotFound = errors.New("not found in blob index")
+)
+
+// TxLocation is the location of a tx in the original data square of the
+// block that included it. Ranges are end exclusive.
+type TxLocation struct {
+	Height  int64       `json:"height"`
+	TxRange share.Range `json:"tx_range"`
+	// BlobRanges are the ranges of the blobs of a blob tx in the order of the
+	// blobs in the tx.
+	BlobRanges []share.Range `json:"blob_ranges,omitempty"`
+}
+
+// Index maps namespaces to the heights that include blobs of them and tx
+// hashes to the shares they occupy.
+type Index struct {
+	db dbm.DB
+}
+
+// New returns an index that is stored in db.
+func New(db dbm.DB) *Index {
+	return &Index{db: db}
+}
+
+// OpenDB opens the database of the index in the data directory of home.
+func OpenDB(home string, backend dbm.BackendType) (dbm.DB, error) {
+	return dbm.NewDB(DBName, backend, filepath.Join(home, "data"))
+}
+
+// IndexBlock indexes the txs of the block at height. The txs must include the
+// blobs of blob txs. Indexing a block more than once has no further effect.
+func (idx *Index) IndexBlock(height int64, appVersion uint64, txs [][]byte) error {
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion), txs...)
+	if err != nil {
+		return fmt.Errorf("constructing square of height %d: %w", height, err)
+	}
+
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+	for i, rawTx := range txs {
+		txRange, err := builder.FindTxShareRange(i)
+		if err != nil {
+			return fmt.Errorf("finding share range of tx %d at height %d: %w", i, height, err)
+		}
+		location := TxLocation{Height: height, TxRange: txRange}
+
+		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+		if isBlobTx && err == nil {
+			location.BlobRanges = make([]share.Range, len(blobTx.Blobs))
+			for j, blob := range blobTx.Blobs {
+				start, err := builder.FindBlobStartingIndex(i, j)
+				if err != nil {
+					return fmt.Errorf("finding start of blob %d of tx %d at height %d: %w", j, i, height, err)
+				}
+				length, err := builder.BlobShareLength(i, j)
+				if err != nil {
+					return fmt.Errorf("finding length of blob %d of tx %d at height %d: %w", j, i, height, err)
+				}
+				location.BlobRanges[j] = share.NewRange(start, start+length)
+				if err := batch.Set(namespaceKey(blob.Namespace(), height), []byte{}); err != nil {
+					return err
+				}
+			}
+		}
+
+		bz, err := json.Marshal(location)
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(txKey(coretypes.Tx(rawTx).Hash()), bz); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// Heights returns the heights in [from, to] that include blobs of namespace in
+// ascending order.
+func (idx *Index) Heights(namespace share.Namespace, from, to int64) ([]int64, error) {
+	if from > to {
+		return nil, fmt.Errorf("from height %d is greater than to height %d", from, to)
+	}
+	it, err := idx.db.Iterator(namespaceKey(namespace, from), namespaceKey(namespace, to+1))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var heights []int64
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		heights = append(heights, int64(binary.BigEndian.Uint64(key[len(key)-8:])))
+	}
+	return heights, it.Error()
+}
+
+// TxLocation returns the location of the tx with the provided hash. The hash
+// of a blob tx is the hash of the tx without its blobs, as reported by
+// Tendermint.
+func (idx *Index) TxLocation(hash []byte) (TxLocation, error) {
+	bz, err := idx.db.Get(txKey(hash))
+	if err != nil {
+		return TxLocation{}, err
+	}
+	if bz == nil {
+		return TxLocation{}, fmt.Errorf("tx %X: %w", hash, ErrNotFound)
+	}
+	var location TxLocation
+	if err := json.Unmarshal(bz, &location); err != nil {
+		return TxLocation{}, err
+	}
+	return location, nil
+}
+
+func namespaceKey(namespace share.Namespace, height int64) []byte {
+	key := make([]byte, 0, len(namespacePrefix)+share.NamespaceSize+8)
+	key = append(key, namespacePrefix...)
+	key = append(key, namespace.Bytes()...)
+	return binary.BigEndian.AppendUint64(key, uint64(height))
+}
+
+func txKey(hash []byte) []byte {
+	return append(append([]byte{}, txPrefix...), hash...)
+}
@@ -0,0 +1,127 @@
+package blobindex_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/blobindex"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	coretypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var (
+	namespaceA = share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	namespaceB = share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+)
+
+// blockTxs returns the txs of a block with a send tx and two blob txs. The
+// first blob tx has blobs of both namespaces while the second only has a blob
+// of namespaceB.
+func blockTxs(t *testing.T) [][]byte {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(enc.Codec, testfactory.TestAccName)
+	signer, err := user.NewSigner(kr, enc.TxConfig, testutil.ChainID, appconsts.LatestVersion, user.NewAccount(testfactory.TestAccName, 0, 0))
+	require.NoError(t, err)
+
+	sendTx := blobfactory.GenerateRawSendTx(signer, 100)
+	require.NoError(t, signer.IncrementSequence(testfactory.TestAccName))
+	blobTxA, _, err := signer.CreatePayForBlobs(testfactory.TestAccName, blobfactory.ManyBlobs(tmrand.NewRand(), []share.Namespace{namespaceA, namespaceB}, []int{100, 10_000}), blobfactory.DefaultTxOpts()...)
+	require.NoError(t, err)
+	require.NoError(t, signer.IncrementSequence(testfactory.TestAccName))
+	blobTxB, _, err := signer.CreatePayForBlobs(testfactory.TestAccName, blobfactory.ManyBlobs(tmrand.NewRand(), []share.Namespace{namespaceB}, []int{1_000}), blobfactory.DefaultTxOpts()...)
+	require.NoError(t, err)
+	return [][]byte{sendTx, blobTxA, blobTxB}
+}
+
+func TestIndexBlock(t *testing.T) {
+	index := blobindex.New(dbm.NewMemDB())
+	txs := blockTxs(t)
+	maxSquareSize := appconsts.SquareSizeUpperBound(appconsts.LatestVersion)
+	threshold := appconsts.SubtreeRootThreshold(appconsts.LatestVersion)
+
+	require.NoError(t, index.IndexBlock(5, appconsts.LatestVersion, txs))
+	require.NoError(t, index.IndexBlock(7, appconsts.LatestVersion, txs[2:]))
+	// reindexing a block is a no-op
+	require.NoError(t, index.IndexBlock(5, appconsts.LatestVersion, txs))
+
+	heights, err := index.Heights(namespaceA, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{5}, heights)
+	heights, err = index.Heights(namespaceB, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{5, 7}, heights)
+	heights, err = index.Heights(namespaceB, 6, 7)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{7}, heights)
+	heights, err = index.Heights(share.RandomBlobNamespace(), 1, 10)
+	require.NoError(t, err)
+	assert.Empty(t, heights)
+	_, err = index.Heights(namespaceA, 10, 1)
+	require.Error(t, err)
+
+	for i, tx := range txs {
+		location, err := index.TxLocation(coretypes.Tx(tx).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), location.Height)
+		txRange, err := square.TxShareRange(txs, i, maxSquareSize, threshold)
+		require.NoError(t, err)
+		assert.Equal(t, txRange, location.TxRange)
+		for j, blobRange := range location.BlobRanges {
+			want, err := square.BlobShareRange(txs, i, j, maxSquareSize, threshold)
+			require.NoError(t, err)
+			assert.Equal(t, want, blobRange)
+		}
+	}
+	location, err := index.TxLocation(coretypes.Tx(txs[1]).Hash())
+	require.NoError(t, err)
+	assert.Len(t, location.BlobRanges, 2)
+
+	_, err = index.TxLocation([]byte("unknown"))
+	require.True(t, errors.Is(err, blobindex.ErrNotFound))
+}
+
+func TestListener(t *testing.T) {
+	index := blobindex.New(dbm.NewMemDB())
+	listener := blobindex.NewListener(index, log.NewNopLogger())
+	txs := blockTxs(t)
+	ctx := context.Background()
+
+	commit := func(height int64, dataHash []byte) {
+		header := tmproto.Header{Height: height, DataHash: dataHash, Version: tmversion.Consensus{App: appconsts.LatestVersion}}
+		require.NoError(t, listener.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: header}, abci.ResponseBeginBlock{}))
+		require.NoError(t, listener.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+		require.NoError(t, listener.ListenCommit(ctx, abci.ResponseCommit{}))
+	}
+
+	// only the proposal with the committed data hash is indexed
+	listener.ListenProcessProposal(tmproto.Header{Height: 1, DataHash: []byte("other")}, txs[:2])
+	listener.ListenProcessProposal(tmproto.Header{Height: 1, DataHash: []byte("committed")}, txs[2:])
+	commit(1, []byte("committed"))
+	// blocks that weren't processed as a proposal are skipped
+	commit(2, []byte("committed"))
+
+	heights, err := index.Heights(namespaceB, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, heights)
+	heights, err = index.Heights(namespaceA, 1, 2)
+	require.NoError(t, err)
+	assert.Empty(t, heights)
+}
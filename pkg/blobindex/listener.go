@@ -0,0 +1,76 @@
+package blobindex
+
+import (
+	"context"
+	"sync"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/streaming"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+var _ baseapp.StreamingService = (*Listener)(nil)
+
+// Listener is a streaming service that indexes every committed block. Blobs
+// are stripped from blob txs before DeliverTx so the txs of a block are taken
+// from the accepted proposal with the same data hash. Blocks that were not
+// processed as a proposal, e.g. during block sync, are not indexed and can be
+// backfilled from the block store with the reindex-namespaces command.
+// Blocks that fail to be indexed are logged and can be backfilled the same
+// way.
+type Listener struct {
+	streaming.BaseService
+
+	index  *Index
+	logger log.Logger
+
+	header tmproto.Header
+	txs    [][]byte
+
+	mtx sync.Mutex
+	// proposals are the txs of the accepted proposals of the next height by
+	// data hash.
+	proposals map[string][][]byte
+}
+
+// NewListener returns a streaming service that adds committed blocks to index.
+func NewListener(index *Index, logger log.Logger) *Listener {
+	return &Listener{
+		index:     index,
+		logger:    logger.With("module", "blobindex"),
+		proposals: make(map[string][][]byte),
+	}
+}
+
+// ListenProcessProposal implements the ProposalListener interface of the app.
+func (l *Listener) ListenProcessProposal(header tmproto.Header, txs [][]byte) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.proposals[string(header.DataHash)] = txs
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (l *Listener) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.header = req.Header
+	l.txs = l.proposals[string(req.Header.DataHash)]
+	l.proposals = make(map[string][][]byte)
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener.
+func (l *Listener) ListenCommit(context.Context, abci.ResponseCommit) error {
+	header, txs := l.header, l.txs
+	l.header, l.txs = tmproto.Header{}, nil
+	if txs == nil {
+		l.logger.Debug("skipping indexing of block that wasn't processed as a proposal", "height", header.Height)
+		return nil
+	}
+	if err := l.index.IndexBlock(header.Height, header.Version.App, txs); err != nil {
+		l.logger.Error("failed to index block", "height", header.Height, "err", err)
+	}
+	return nil
+}
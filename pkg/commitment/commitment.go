@@ -0,0 +1,35 @@
+// Package commitment computes the share commitments of blobs and proves them
+// against the data root of a block. The share commitment of a blob is the root
+// of a merkle mountain range over the roots of the subtrees of its shares, see
+// ADR-013 and specs/src/specs/data_square_layout.md. Because of the
+// non-interactive default rules, the same subtree roots are inner nodes of the
+// row roots of the square that contains the blob, so a client can check that
+// the commitment of a PFB corresponds to the shares posted in a block with a
+// Proof of the subtree roots to the row roots and of the row roots to the data
+// root.
+package commitment
+
+import (
+	"github.com/celestiaorg/celestia-app/v3/pkg/shares"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// SubtreeRoots returns the roots of the subtrees of the shares of blob that
+// its share commitment is made of, in the order of the shares.
+func SubtreeRoots(blob *share.Blob, subtreeRootThreshold int) ([][]byte, error) {
+	return inclusion.GenerateSubtreeRoots(blob, subtreeRootThreshold)
+}
+
+// Create returns the share commitment of blob in appVersion, i.e. the
+// commitment that a MsgPayForBlobs for the blob has to contain. It is
+// shares.Commitment, which is the canonical implementation.
+func Create(appVersion uint64, blob *share.Blob) ([]byte, error) {
+	return shares.Commitment(appVersion, blob)
+}
+
+// FromSubtreeRoots returns the share commitment that is made of subtreeRoots.
+func FromSubtreeRoots(subtreeRoots [][]byte) []byte {
+	return merkle.HashFromByteSlices(subtreeRoots)
+}
@@ -0,0 +1,40 @@
+package commitment_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/commitment"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreate checks that the commitments match those of a MsgPayForBlobs and
+// are the merkle root of the subtree roots.
+func TestCreate(t *testing.T) {
+	threshold := appconsts.SubtreeRootThreshold(appconsts.LatestVersion)
+	sizes := []int{1, 1000, 4 * threshold * share.ContinuationSparseShareContentSize}
+	blobs := make([]*share.Blob, len(sizes))
+	for i, size := range sizes {
+		ns := share.MustNewV0Namespace(bytes.Repeat([]byte{byte(i + 1)}, share.NamespaceVersionZeroIDSize))
+		var err error
+		blobs[i], err = share.NewV0Blob(ns, bytes.Repeat([]byte{1}, size))
+		require.NoError(t, err)
+	}
+	msg, err := blobtypes.NewMsgPayForBlobs(sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String(), appconsts.LatestVersion, blobs...)
+	require.NoError(t, err)
+
+	for i, blob := range blobs {
+		got, err := commitment.Create(appconsts.LatestVersion, blob)
+		require.NoError(t, err)
+		assert.Equal(t, msg.ShareCommitments[i], got)
+
+		subtreeRoots, err := commitment.SubtreeRoots(blob, threshold)
+		require.NoError(t, err)
+		assert.Equal(t, got, commitment.FromSubtreeRoots(subtreeRoots))
+	}
+}
@@ -0,0 +1,131 @@
+package commitment
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// Proof proves that a share commitment is made of the subtree roots of shares
+// of a data square. The subtree roots are proven to the row roots of the rows
+// of the shares, and the row roots to the data root.
+type Proof struct {
+	// SubtreeRoots are the subtree roots of the shares in the order of the
+	// shares. The share commitment is their merkle root.
+	SubtreeRoots [][]byte
+	// SubtreeRootProofs are the NMT proofs of the subtree roots of every row of
+	// the shares to the row root, in the order of the rows. The range of a
+	// proof is the range of the shares in the row.
+	SubtreeRootProofs []*proof.NMTProof
+	// RowProof proves the row roots of the shares to the data root.
+	RowProof proof.RowProof
+}
+
+// NewProof returns the proof of the share commitment of the blob whose shares
+// are shareRange of the original data square of eds, which must follow the
+// non-interactive default rules of subtreeRootThreshold.
+func NewProof(eds *rsmt2d.ExtendedDataSquare, shareRange share.Range, subtreeRootThreshold int) (Proof, error) {
+	squareSize := int(eds.Width() / 2)
+	if shareRange.Start < 0 || shareRange.Start >= shareRange.End || shareRange.End > squareSize*squareSize {
+		return Proof{}, fmt.Errorf("share range [%d, %d) is not in a square of size %d", shareRange.Start, shareRange.End, squareSize)
+	}
+	first, err := share.NewShare(eds.GetCell(uint(shareRange.Start/squareSize), uint(shareRange.Start%squareSize)))
+	if err != nil {
+		return Proof{}, err
+	}
+	shareProof, err := proof.NewShareInclusionProofFromEDS(eds, first.Namespace(), shareRange)
+	if err != nil {
+		return Proof{}, err
+	}
+
+	subtreeWidth := inclusion.SubTreeWidth(shareRange.End-shareRange.Start, subtreeRootThreshold)
+	var subtreeRoots [][]byte
+	cursor := 0
+	for _, rowProof := range shareProof.ShareProofs {
+		ranges, err := nmt.ToLeafRanges(int(rowProof.Start), int(rowProof.End), subtreeWidth)
+		if err != nil {
+			return Proof{}, err
+		}
+		for _, r := range ranges {
+			root, err := subtreeRoot(shareProof.Data[cursor : cursor+r.End-r.Start])
+			if err != nil {
+				return Proof{}, err
+			}
+			subtreeRoots = append(subtreeRoots, root)
+			cursor += r.End - r.Start
+		}
+	}
+	return Proof{
+		SubtreeRoots:      subtreeRoots,
+		SubtreeRootProofs: shareProof.ShareProofs,
+		RowProof:          *shareProof.RowProof,
+	}, nil
+}
+
+// subtreeRoot returns the root of the subtree of the row NMT whose leaves are
+// shares, as the NMT wrapper of the row computes it.
+func subtreeRoot(shares [][]byte) ([]byte, error) {
+	tree := nmt.New(appconsts.NewBaseHashFunc(), nmt.NamespaceIDSize(share.NamespaceSize), nmt.IgnoreMaxNamespace(true))
+	for _, sh := range shares {
+		leaf := make([]byte, 0, share.NamespaceSize+len(sh))
+		leaf = append(leaf, sh[:share.NamespaceSize]...)
+		leaf = append(leaf, sh...)
+		if err := tree.Push(leaf); err != nil {
+			return nil, err
+		}
+	}
+	return tree.Root()
+}
+
+// Verify checks that p proves commitment to dataRoot for a square that
+// follows the non-interactive default rules of subtreeRootThreshold.
+func (p Proof) Verify(dataRoot, commitment []byte, subtreeRootThreshold int) error {
+	if !bytes.Equal(FromSubtreeRoots(p.SubtreeRoots), commitment) {
+		return errors.New("the subtree roots don't make up the commitment")
+	}
+	if err := p.RowProof.Validate(dataRoot); err != nil {
+		return err
+	}
+	if len(p.SubtreeRootProofs) != len(p.RowProof.RowRoots) {
+		return fmt.Errorf("the number of subtree root proofs %d must equal the number of row roots %d", len(p.SubtreeRootProofs), len(p.RowProof.RowRoots))
+	}
+
+	// the subtree width depends on the number of shares of the blob, which
+	// the proofs cover.
+	shareCount := 0
+	for _, rowProof := range p.SubtreeRootProofs {
+		shareCount += int(rowProof.End - rowProof.Start)
+	}
+	subtreeWidth := inclusion.SubTreeWidth(shareCount, subtreeRootThreshold)
+	hasher := nmt.NewNmtHasher(appconsts.NewBaseHashFunc(), share.NamespaceSize, true)
+	cursor := 0
+	for i, rowProof := range p.SubtreeRootProofs {
+		ranges, err := nmt.ToLeafRanges(int(rowProof.Start), int(rowProof.End), subtreeWidth)
+		if err != nil {
+			return err
+		}
+		if cursor+len(ranges) > len(p.SubtreeRoots) {
+			return fmt.Errorf("row %d needs %d subtree roots but only %d are left", i, len(ranges), len(p.SubtreeRoots)-cursor)
+		}
+		nmtProof := nmt.NewInclusionProof(int(rowProof.Start), int(rowProof.End), rowProof.Nodes, true)
+		valid, err := nmtProof.VerifySubtreeRootInclusion(hasher, p.SubtreeRoots[cursor:cursor+len(ranges)], subtreeWidth, p.RowProof.RowRoots[i])
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return fmt.Errorf("the subtree roots of row %d aren't included in its row root", i)
+		}
+		cursor += len(ranges)
+	}
+	if cursor != len(p.SubtreeRoots) {
+		return fmt.Errorf("%d subtree roots aren't covered by the proofs", len(p.SubtreeRoots)-cursor)
+	}
+	return nil
+}
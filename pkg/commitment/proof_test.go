@@ -0,0 +1,77 @@
+package commitment_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/commitment"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestProof(t *testing.T) {
+	threshold := appconsts.SubtreeRootThreshold(appconsts.LatestVersion)
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	rand := tmrand.NewRand()
+
+	// blobs of a single share, of a single row and of many rows with subtrees
+	// wider than a share.
+	sizes := []int{100, 5000, 100_000}
+	blobs := make([]*share.Blob, len(sizes))
+	for i, size := range sizes {
+		ns := share.MustNewV0Namespace(bytes.Repeat([]byte{byte(i + 1)}, share.NamespaceVersionZeroIDSize))
+		blobs[i], err = share.NewV0Blob(ns, rand.Bytes(size))
+		require.NoError(t, err)
+	}
+	blobTx, _, err := signer.CreatePayForBlobs(testfactory.TestAccName, blobs, blobfactory.DefaultTxOpts()...)
+	require.NoError(t, err)
+	txs := append(testfactory.GenerateRandomTxs(5, 300).ToSliceOfBytes(), blobTx)
+
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appconsts.LatestVersion), threshold, txs...)
+	require.NoError(t, err)
+	dataSquare, err := builder.Export()
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	for i, blob := range blobs {
+		start, err := builder.FindBlobStartingIndex(len(txs)-1, i)
+		require.NoError(t, err)
+		length, err := builder.BlobShareLength(len(txs)-1, i)
+		require.NoError(t, err)
+		blobCommitment, err := commitment.Create(appconsts.LatestVersion, blob)
+		require.NoError(t, err)
+		subtreeRoots, err := commitment.SubtreeRoots(blob, threshold)
+		require.NoError(t, err)
+
+		p, err := commitment.NewProof(eds, share.NewRange(start, start+length), threshold)
+		require.NoError(t, err)
+		assert.Equal(t, subtreeRoots, p.SubtreeRoots, "blob %d", i)
+		require.NoError(t, p.Verify(dah.Hash(), blobCommitment, threshold), "blob %d", i)
+
+		assert.Error(t, p.Verify(dah.Hash(), bytes.Repeat([]byte{1}, len(blobCommitment)), threshold))
+		assert.Error(t, p.Verify(bytes.Repeat([]byte{1}, len(dah.Hash())), blobCommitment, threshold))
+
+		// a proof of other subtree roots with the same commitment doesn't
+		// verify.
+		tampered := p
+		tampered.SubtreeRoots = append([][]byte{}, p.SubtreeRoots...)
+		tampered.SubtreeRoots[0] = append([]byte{}, p.SubtreeRoots[0]...)
+		tampered.SubtreeRoots[0][len(tampered.SubtreeRoots[0])-1] ^= 1
+		assert.Error(t, tampered.Verify(dah.Hash(), commitment.FromSubtreeRoots(tampered.SubtreeRoots), threshold))
+	}
+
+	_, err = commitment.NewProof(eds, share.NewRange(0, len(dataSquare)+1), threshold)
+	assert.Error(t, err)
+}
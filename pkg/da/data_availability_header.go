@@ -75,6 +75,17 @@ func ExtendShares(s [][]byte) (*rsmt2d.ExtendedDataSquare, error) {
 	return rsmt2d.ComputeExtendedDataSquare(s, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(squareSize)))
 }
 
+// ExtendSharesWithRootCache extends the shares like ExtendShares but takes the
+// row and column roots of the extended data square from cache if possible.
+func ExtendSharesWithRootCache(s [][]byte, cache *wrapper.RootCache) (*rsmt2d.ExtendedDataSquare, error) {
+	// Check that the length of the square is a power of 2.
+	if !square.IsPowerOfTwo(len(s)) {
+		return nil, fmt.Errorf("number of shares is not a power of 2: got %d", len(s))
+	}
+	squareSize := SquareSize(len(s))
+	return rsmt2d.ComputeExtendedDataSquare(s, appconsts.DefaultCodec(), cache.NewConstructor(uint64(squareSize)))
+}
+
 // String returns hex representation of merkle hash of the DAHeader.
 func (dah *DataAvailabilityHeader) String() string {
 	if dah == nil {
@@ -2,11 +2,14 @@ package da
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"sort"
 	"strings"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
 	sh "github.com/celestiaorg/go-square/v2/share"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -239,6 +242,29 @@ func TestSquareSize(t *testing.T) {
 	}
 }
 
+// BenchmarkExtendAndComputeDAH benchmarks the erasure coding of a data square
+// and the computation of its row and column roots. rsmt2d encodes the rows
+// and columns and computes their roots in parallel, so run it with -cpu to
+// measure how it scales with the number of cores, e.g.
+//
+//	go test ./pkg/da -run=^$ -bench=ExtendAndComputeDAH -cpu=1,4,8
+func BenchmarkExtendAndComputeDAH(b *testing.B) {
+	for _, squareSize := range []int{32, 64, 128} {
+		shares := generateShares(squareSize * squareSize)
+		b.Run(fmt.Sprintf("square size %d", squareSize), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				eds, err := ExtendShares(shares)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if _, err := NewDataAvailabilityHeader(eds); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
 // generateShares generates count number of shares with a constant namespace and
 // share contents.
 func generateShares(count int) (shares [][]byte) {
@@ -278,3 +304,32 @@ func maxDataAvailabilityHeader(t *testing.T) (dah DataAvailabilityHeader) {
 
 	return dah
 }
+
+func TestExtendSharesContext(t *testing.T) {
+	shares := generateShares(16)
+	eds, err := ExtendShares(shares)
+	require.NoError(t, err)
+	want, err := NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	for _, cache := range []*wrapper.RootCache{nil, wrapper.NewRootCache(16)} {
+		eds, err := ExtendSharesContext(context.Background(), shares, cache)
+		require.NoError(t, err)
+		got, err := NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+		require.Equal(t, want.Hash(), got.Hash())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = ExtendSharesContext(ctx, shares, nil)
+	require.ErrorIs(t, err, context.Canceled)
+
+	// the roots aren't computed once ctx is done.
+	ctx, cancel = context.WithCancel(context.Background())
+	eds, err = ExtendSharesContext(ctx, shares, nil)
+	require.NoError(t, err)
+	cancel()
+	_, err = NewDataAvailabilityHeader(eds)
+	require.ErrorIs(t, err, context.Canceled)
+}
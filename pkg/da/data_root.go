@@ -0,0 +1,53 @@
+package da
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/square"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+)
+
+// DataRootFromBlobs returns the data root that a block of appVersion with txs
+// would have. blobs[i] are the blobs of txs[i], which must be the signed PFB
+// that pays for them, and are nil for a normal tx. blobs can be nil if none of
+// the txs have blobs.
+//
+// The txs must be in block order, i.e. the txs with blobs after the normal
+// txs, and fit in a square of the square size upper bound of appVersion. The
+// square is laid out, erasure coded and committed to exactly like
+// ProcessProposal does, but the validity of the txs isn't checked, so the data
+// root only matches that of a block if the block has the same txs.
+func DataRootFromBlobs(txs [][]byte, blobs [][]*share.Blob, appVersion uint64) ([]byte, error) {
+	if blobs != nil && len(blobs) != len(txs) {
+		return nil, fmt.Errorf("got blobs for %d txs, want blobs for each of the %d txs", len(blobs), len(txs))
+	}
+
+	rawTxs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		if blobs == nil || len(blobs[i]) == 0 {
+			rawTxs[i] = tx
+			continue
+		}
+		rawTx, err := blobtx.MarshalBlobTx(tx, blobs[i]...)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling blob tx at index %d: %w", i, err)
+		}
+		rawTxs[i] = rawTx
+	}
+
+	dataSquare, err := square.Construct(appVersion, rawTxs, appconsts.SquareSizeUpperBound(appVersion))
+	if err != nil {
+		return nil, err
+	}
+	eds, err := ExtendShares(dataSquare)
+	if err != nil {
+		return nil, err
+	}
+	dah, err := NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, err
+	}
+	return dah.Hash(), nil
+}
@@ -0,0 +1,72 @@
+package da
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	sh "github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataRootFromBlobs(t *testing.T) {
+	ns1 := sh.MustNewV0Namespace(bytes.Repeat([]byte{1}, sh.NamespaceVersionZeroIDSize))
+	ns2 := sh.MustNewV0Namespace(bytes.Repeat([]byte{2}, sh.NamespaceVersionZeroIDSize))
+	newBlob := func(ns sh.Namespace, size int) *sh.Blob {
+		blob, err := sh.NewV0Blob(ns, bytes.Repeat([]byte{0xff}, size))
+		require.NoError(t, err)
+		return blob
+	}
+	txs := [][]byte{[]byte("send"), []byte("pfb1"), []byte("pfb2")}
+	blobs := [][]*sh.Blob{
+		nil,
+		{newBlob(ns2, 1000), newBlob(ns1, 100)},
+		{newBlob(ns2, 10)},
+	}
+
+	blockTxs := [][]byte{txs[0]}
+	for i := 1; i < len(txs); i++ {
+		rawTx, err := blobtx.MarshalBlobTx(txs[i], blobs[i]...)
+		require.NoError(t, err)
+		blockTxs = append(blockTxs, rawTx)
+	}
+	want := dataAvailabilityHeader(t, blockTxs)
+
+	got, err := DataRootFromBlobs(txs, blobs, appconsts.LatestVersion)
+	require.NoError(t, err)
+	assert.Equal(t, want.Hash(), got)
+
+	t.Run("data root of an empty block is that of the min DAH", func(t *testing.T) {
+		minDAH := MinDataAvailabilityHeader()
+		for _, appVersion := range []uint64{v2.Version, appconsts.LatestVersion} {
+			got, err := DataRootFromBlobs(nil, nil, appVersion)
+			require.NoError(t, err)
+			assert.Equal(t, minDAH.Hash(), got)
+		}
+	})
+
+	t.Run("normal txs without blobs", func(t *testing.T) {
+		got, err := DataRootFromBlobs(txs[:1], nil, appconsts.LatestVersion)
+		require.NoError(t, err)
+		want := dataAvailabilityHeader(t, txs[:1])
+		assert.Equal(t, want.Hash(), got)
+	})
+
+	t.Run("blobs for a subset of the txs", func(t *testing.T) {
+		_, err := DataRootFromBlobs(txs, blobs[:2], appconsts.LatestVersion)
+		assert.Error(t, err)
+	})
+
+	t.Run("normal tx after a tx with blobs", func(t *testing.T) {
+		_, err := DataRootFromBlobs(append(txs, []byte("send")), append(blobs, nil), appconsts.LatestVersion)
+		assert.Error(t, err)
+	})
+
+	t.Run("unsupported app version", func(t *testing.T) {
+		_, err := DataRootFromBlobs(txs, blobs, appconsts.LatestVersion+1)
+		assert.Error(t, err)
+	})
+}
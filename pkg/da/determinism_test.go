@@ -0,0 +1,97 @@
+package da_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/determinism"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func determinismSquare(t *testing.T) square.Square {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	rand := tmrand.NewRand()
+	rand.Seed(1)
+	txs := blobfactory.RandBlobTxs(signer, rand, 8, 2, 1000).ToSliceOfBytes()
+	dataSquare, err := square.Construct(txs, appconsts.DefaultSquareSizeUpperBound, appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	return dataSquare
+}
+
+// TestErasureCodingDeterminism verifies that extending the same square
+// concurrently always results in the same data root.
+func TestErasureCodingDeterminism(t *testing.T) {
+	shares := share.ToBytes(determinismSquare(t))
+	determinism.Require(t, func(p *determinism.Perturber) (any, error) {
+		roots := make([][]byte, 4)
+		errs := make([]error, len(roots))
+		var wg sync.WaitGroup
+		for i := range roots {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				p.Yield()
+				eds, err := da.ExtendShares(shares)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				dah, err := da.NewDataAvailabilityHeader(eds)
+				errs[i] = err
+				roots[i] = dah.Hash()
+			}()
+		}
+		wg.Wait()
+		for _, err := range errs {
+			if err != nil {
+				return nil, err
+			}
+		}
+		return roots, nil
+	})
+}
+
+// TestShareParsingDeterminism verifies that parsing the txs and blobs of the
+// same square concurrently always results in the same output.
+func TestShareParsingDeterminism(t *testing.T) {
+	dataSquare := determinismSquare(t)
+	determinism.Require(t, func(p *determinism.Perturber) (any, error) {
+		var (
+			wg           sync.WaitGroup
+			txs          [][]byte
+			blobs        []*share.Blob
+			txErr, blErr error
+		)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			p.Yield()
+			txs, txErr = share.ParseTxs(dataSquare)
+		}()
+		go func() {
+			defer wg.Done()
+			p.Yield()
+			blobs, blErr = share.ParseBlobs(dataSquare)
+		}()
+		wg.Wait()
+		if txErr != nil {
+			return nil, txErr
+		}
+		if blErr != nil {
+			return nil, blErr
+		}
+		data := make([][]byte, len(blobs))
+		for i, blob := range blobs {
+			data[i] = blob.Data()
+		}
+		return [][][]byte{txs, data}, nil
+	})
+}
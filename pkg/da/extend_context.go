@@ -0,0 +1,67 @@
+package da
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+)
+
+// ExtendSharesContext extends the shares like ExtendSharesWithRootCache but
+// stops erasure coding the rows and columns of the square and computing their
+// roots once ctx is done, returning the error of ctx. The roots are computed
+// lazily, e.g. by NewDataAvailabilityHeader, so the extended data square must
+// only be used while ctx isn't done. The cache may be nil.
+func ExtendSharesContext(ctx context.Context, s [][]byte, cache *wrapper.RootCache) (*rsmt2d.ExtendedDataSquare, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	// Check that the length of the square is a power of 2.
+	if !square.IsPowerOfTwo(len(s)) {
+		return nil, fmt.Errorf("number of shares is not a power of 2: got %d", len(s))
+	}
+	squareSize := SquareSize(len(s))
+	newTree := wrapper.NewConstructor(uint64(squareSize))
+	if cache != nil {
+		newTree = cache.NewConstructor(uint64(squareSize))
+	}
+	return rsmt2d.ComputeExtendedDataSquare(
+		s,
+		contextCodec{Codec: appconsts.DefaultCodec(), ctx: ctx},
+		func(axis rsmt2d.Axis, index uint) rsmt2d.Tree {
+			return contextTree{Tree: newTree(axis, index), ctx: ctx}
+		},
+	)
+}
+
+// contextCodec fails to encode the rows and columns of a square once ctx is
+// done.
+type contextCodec struct {
+	rsmt2d.Codec
+	ctx context.Context
+}
+
+func (c contextCodec) Encode(data [][]byte) ([][]byte, error) {
+	if err := c.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return c.Codec.Encode(data)
+}
+
+// contextTree fails to compute its root once ctx is done. The root is where
+// the leaves of the tree are hashed.
+type contextTree struct {
+	rsmt2d.Tree
+	ctx context.Context
+}
+
+func (t contextTree) Root() ([]byte, error) {
+	if err := t.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return t.Tree.Root()
+}
@@ -0,0 +1,84 @@
+package da
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/shares"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// LayoutDataRoot returns the data root of a data square of layout.
+func LayoutDataRoot(layout shares.ShareLayout, rawShares [][]byte) ([]byte, error) {
+	if layout == shares.LegacyShareLayout {
+		return LegacyDataRoot(rawShares)
+	}
+	eds, err := ExtendShares(rawShares)
+	if err != nil {
+		return nil, err
+	}
+	dah, err := NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, err
+	}
+	return dah.Hash(), nil
+}
+
+// LegacyDataRoot returns the data root of a data square of the legacy share
+// layout. The rows and columns of its extended data square are committed to
+// by namespaced Merkle trees of the 8 byte legacy namespaces.
+func LegacyDataRoot(rawShares [][]byte) ([]byte, error) {
+	if !square.IsPowerOfTwo(len(rawShares)) {
+		return nil, fmt.Errorf("number of shares is not a power of 2: got %d", len(rawShares))
+	}
+	squareSize := uint64(SquareSize(len(rawShares)))
+	eds, err := rsmt2d.ComputeExtendedDataSquare(rawShares, appconsts.DefaultCodec(), func(_ rsmt2d.Axis, axisIndex uint) rsmt2d.Tree {
+		return &legacyTree{
+			tree:       nmt.New(sha256.New(), nmt.NamespaceIDSize(shares.LegacyNamespaceSize), nmt.IgnoreMaxNamespace(true)),
+			squareSize: squareSize,
+			axisIndex:  uint64(axisIndex),
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	dah, err := NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, err
+	}
+	return dah.Hash(), nil
+}
+
+// legacyTree is the equivalent of wrapper.ErasuredNamespacedMerkleTree for the
+// legacy namespace size.
+type legacyTree struct {
+	tree       *nmt.NamespacedMerkleTree
+	squareSize uint64
+	axisIndex  uint64
+	shareIndex uint64
+}
+
+// Push implements rsmt2d.Tree.
+func (t *legacyTree) Push(data []byte) error {
+	if len(data) < shares.LegacyNamespaceSize {
+		return errors.New("data is too short to contain namespace ID")
+	}
+	leaf := make([]byte, shares.LegacyNamespaceSize+len(data))
+	copy(leaf[shares.LegacyNamespaceSize:], data)
+	if t.shareIndex < t.squareSize && t.axisIndex < t.squareSize {
+		copy(leaf, data[:shares.LegacyNamespaceSize])
+	} else {
+		copy(leaf, shares.LegacyParityNamespace)
+	}
+	t.shareIndex++
+	return t.tree.Push(leaf)
+}
+
+// Root implements rsmt2d.Tree.
+func (t *legacyTree) Root() ([]byte, error) {
+	return t.tree.Root()
+}
@@ -0,0 +1,58 @@
+package da
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/shares"
+	"github.com/celestiaorg/go-square/v2"
+	sh "github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// legacyTxShares returns count shares of the legacy tx namespace.
+func legacyTxShares(count int) [][]byte {
+	rawShares := make([][]byte, count)
+	for i := range rawShares {
+		rawShares[i] = append(append([]byte{}, shares.LegacyTxNamespace...), bytes.Repeat([]byte{byte(i)}, shares.LegacyShareSize-shares.LegacyNamespaceSize)...)
+	}
+	return rawShares
+}
+
+func TestLegacyDataRoot(t *testing.T) {
+	rawShares := legacyTxShares(16)
+	root, err := LegacyDataRoot(rawShares)
+	require.NoError(t, err)
+
+	modified := append([][]byte{}, rawShares...)
+	modified[0] = append([]byte{}, rawShares[0]...)
+	modified[0][shares.LegacyShareSize-1] ^= 1
+	modifiedRoot, err := LegacyDataRoot(modified)
+	require.NoError(t, err)
+	assert.NotEqual(t, root, modifiedRoot)
+
+	_, err = LegacyDataRoot(rawShares[:3])
+	assert.Error(t, err)
+}
+
+func TestLayoutDataRoot(t *testing.T) {
+	txs := [][]byte{bytes.Repeat([]byte{1}, 100)}
+	dataSquare, err := square.Construct(txs, appconsts.DefaultSquareSizeUpperBound, appconsts.DefaultSubtreeRootThreshold)
+	require.NoError(t, err)
+	root, err := LayoutDataRoot(shares.BlobShareLayout, sh.ToBytes(dataSquare))
+	require.NoError(t, err)
+	eds, err := ExtendShares(sh.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	assert.Equal(t, dah.Hash(), root)
+
+	legacyShares := legacyTxShares(16)
+	legacyRoot, err := LayoutDataRoot(shares.LegacyShareLayout, legacyShares)
+	require.NoError(t, err)
+	wantLegacyRoot, err := LegacyDataRoot(legacyShares)
+	require.NoError(t, err)
+	assert.Equal(t, wantLegacyRoot, legacyRoot)
+}
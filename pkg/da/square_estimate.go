@@ -0,0 +1,74 @@
+package da
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+)
+
+// SquareLayoutEstimate is the result of a dry-run layout of a set of txs.
+type SquareLayoutEstimate struct {
+	// CompactShares are the shares allocated to the txs and the PFBs. Like
+	// go-square, the PFBs are counted with the largest possible share
+	// indexes of their blobs.
+	CompactShares int
+	BlobShares    int
+	// PaddingShares are the namespace padding shares that the
+	// non-interactive default rules require between the blobs.
+	PaddingShares int
+	// SquareSize is the smallest square size that fits the layout.
+	SquareSize int
+	// WorstCaseSquareSize is the square size of the square that go-square
+	// constructs from the txs. It assumes the worst case padding for every
+	// blob so it can be larger than SquareSize.
+	WorstCaseSquareSize int
+}
+
+// EstimateSquareLayout lays out txs the way go-square does, without writing
+// any shares, and returns the number of shares that they actually occupy.
+// The layout is deterministic and doesn't depend on the square size: the
+// blobs are ordered by namespace and each one starts at the first index that
+// complies with the non-interactive default rules after the previous one.
+//
+// Squares are built with go-square, whose square size is
+// WorstCaseSquareSize, so SquareSize is only an estimate of how small the
+// square could be. It returns an error if txs don't fit in a square of
+// maxSquareSize.
+func EstimateSquareLayout(txs [][]byte, maxSquareSize, subtreeRootThreshold int) (SquareLayoutEstimate, error) {
+	builder, err := square.NewBuilder(maxSquareSize, subtreeRootThreshold, txs...)
+	if err != nil {
+		return SquareLayoutEstimate{}, err
+	}
+
+	estimate := SquareLayoutEstimate{
+		CompactShares: builder.TxCounter.Size() + builder.PfbCounter.Size(),
+	}
+	worstCaseShares := estimate.CompactShares
+	blobs := append([]*square.Element(nil), builder.Blobs...)
+	sort.SliceStable(blobs, func(i, j int) bool {
+		return bytes.Compare(blobs[i].Blob.Namespace().Bytes(), blobs[j].Blob.Namespace().Bytes()) < 0
+	})
+	cursor := estimate.CompactShares
+	for _, blob := range blobs {
+		start := inclusion.NextShareIndex(cursor, blob.NumShares, subtreeRootThreshold)
+		estimate.PaddingShares += start - cursor
+		estimate.BlobShares += blob.NumShares
+		worstCaseShares += blob.NumShares + blob.MaxPadding
+		cursor = start + blob.NumShares
+	}
+
+	estimate.SquareSize = minSquareSize(cursor)
+	estimate.WorstCaseSquareSize = minSquareSize(worstCaseShares)
+	return estimate, nil
+}
+
+// minSquareSize returns the smallest square size that fits shares, which is
+// 1 for an empty square.
+func minSquareSize(shares int) int {
+	if shares == 0 {
+		return 1
+	}
+	return inclusion.BlobMinSquareSize(shares)
+}
@@ -0,0 +1,56 @@
+package da_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestEstimateSquareLayout(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	rand := tmrand.NewRand()
+	rand.Seed(1)
+	threshold := appconsts.SubtreeRootThreshold(appconsts.LatestVersion)
+	maxSquareSize := appconsts.DefaultSquareSizeUpperBound
+
+	for i := 0; i < 20; i++ {
+		txs := blobfactory.RandBlobTxs(signer, rand, 1+rand.Intn(20), 1+rand.Intn(3), 1+rand.Intn(20_000)).ToSliceOfBytes()
+		estimate, err := da.EstimateSquareLayout(txs, maxSquareSize, threshold)
+		require.NoError(t, err)
+
+		dataSquare, err := square.Construct(txs, maxSquareSize, threshold)
+		require.NoError(t, err)
+		assert.Equal(t, int(dataSquare.Size()), estimate.WorstCaseSquareSize)
+		assert.LessOrEqual(t, estimate.SquareSize, estimate.WorstCaseSquareSize)
+
+		// the dry-run layout occupies exactly the shares before the tail
+		// padding of the constructed square.
+		used := len(dataSquare)
+		for used > 0 && dataSquare[used-1].Namespace().IsTailPadding() {
+			used--
+		}
+		assert.Equal(t, used, estimate.CompactShares+estimate.PaddingShares+estimate.BlobShares)
+	}
+
+	// the worst case padding of 5 blobs of 204 shares bumps the square to
+	// the next square size although they fit in 32x32 shares.
+	rand.Seed(1)
+	txs := blobfactory.RandBlobTxs(signer, rand, 5, 1, 98_000).ToSliceOfBytes()
+	estimate, err := da.EstimateSquareLayout(txs, maxSquareSize, threshold)
+	require.NoError(t, err)
+	assert.Equal(t, 32, estimate.SquareSize)
+	assert.Equal(t, 64, estimate.WorstCaseSquareSize)
+	assert.LessOrEqual(t, estimate.CompactShares+estimate.PaddingShares+estimate.BlobShares, 32*32)
+
+	estimate, err = da.EstimateSquareLayout(nil, maxSquareSize, threshold)
+	require.NoError(t, err)
+	assert.Equal(t, da.SquareLayoutEstimate{SquareSize: 1, WorstCaseSquareSize: 1}, estimate)
+}
@@ -0,0 +1,153 @@
+package da
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+// SummaryQueryPath is the path of the custom query that returns the Summary of
+// a block. The protobuf encoded block must be passed as the data of the query.
+//
+// example path: custom/daSummary
+const SummaryQueryPath = "daSummary"
+
+// Summary describes the data availability of a block: the roots that light
+// nodes sample against, the namespaces it contains blobs of and whether its
+// data root has been attested to by Blobstream.
+type Summary struct {
+	Height   int64            `json:"height"`
+	DataRoot tmbytes.HexBytes `json:"data_root"`
+	// SquareSize is the width of the original data square.
+	SquareSize  int                `json:"square_size"`
+	RowRoots    []tmbytes.HexBytes `json:"row_roots"`
+	ColumnRoots []tmbytes.HexBytes `json:"column_roots"`
+	// Namespaces are the namespaces that the block contains blobs of, in
+	// ascending order.
+	Namespaces []NamespaceBlobs `json:"namespaces"`
+	Blobstream BlobstreamStatus `json:"blobstream"`
+}
+
+// NamespaceBlobs is the number of blobs of a namespace in a block.
+type NamespaceBlobs struct {
+	Namespace tmbytes.HexBytes `json:"namespace"`
+	Blobs     int              `json:"blobs"`
+}
+
+// BlobstreamStatus describes the Blobstream data commitment that covers a
+// height.
+type BlobstreamStatus struct {
+	// Attested is true if a data commitment covering the height exists.
+	Attested bool `json:"attested"`
+	// Nonce is the attestation nonce of the data commitment. BeginBlock and
+	// EndBlock are the height range that it covers.
+	Nonce      uint64 `json:"nonce,omitempty"`
+	BeginBlock uint64 `json:"begin_block,omitempty"`
+	EndBlock   uint64 `json:"end_block,omitempty"`
+	// Reason explains why the height isn't attested.
+	Reason string `json:"reason,omitempty"`
+}
+
+// NewSummary constructs the data square of block and returns its summary
+// without the Blobstream status. It returns an error if the data root of the
+// square doesn't match the data hash of the block header.
+func NewSummary(block *tmproto.Block) (Summary, error) {
+	appVersion := block.Header.Version.App
+	dataSquare, err := square.Construct(block.Data.Txs, appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion))
+	if err != nil {
+		return Summary{}, err
+	}
+	eds, err := ExtendShares(share.ToBytes(dataSquare))
+	if err != nil {
+		return Summary{}, err
+	}
+	dah, err := NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return Summary{}, err
+	}
+	if !bytes.Equal(dah.Hash(), block.Header.DataHash) {
+		return Summary{}, fmt.Errorf("data root %X of height %d doesn't match the data hash %X of the header", dah.Hash(), block.Header.Height, block.Header.DataHash)
+	}
+
+	return Summary{
+		Height:      block.Header.Height,
+		DataRoot:    dah.Hash(),
+		SquareSize:  dataSquare.Size(),
+		RowRoots:    toHexBytes(dah.RowRoots),
+		ColumnRoots: toHexBytes(dah.ColumnRoots),
+		Namespaces:  namespaceBlobs(block.Data.Txs),
+	}, nil
+}
+
+// QuerySummary returns the summary of the block at height. The block is
+// fetched from the node and passed to the custom query of the app.
+func QuerySummary(ctx context.Context, client rpcclient.Client, height int64) (Summary, error) {
+	res, err := client.Block(ctx, &height)
+	if err != nil {
+		return Summary{}, err
+	}
+	pbb, err := res.Block.ToProto()
+	if err != nil {
+		return Summary{}, err
+	}
+	rawBlock, err := pbb.Marshal()
+	if err != nil {
+		return Summary{}, err
+	}
+
+	queryRes, err := client.ABCIQuery(ctx, "custom/"+SummaryQueryPath, rawBlock)
+	if err != nil {
+		return Summary{}, err
+	}
+	if queryRes.Response.Value == nil && queryRes.Response.Log != "" {
+		// custom queries don't set a code on failure, the error is logged
+		// instead.
+		return Summary{}, errors.New(queryRes.Response.Log)
+	}
+	var summary Summary
+	if err := json.Unmarshal(queryRes.Response.Value, &summary); err != nil {
+		return Summary{}, err
+	}
+	return summary, nil
+}
+
+func namespaceBlobs(txs [][]byte) []NamespaceBlobs {
+	counts := make(map[string]int)
+	for _, rawTx := range txs {
+		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlobTx || err != nil {
+			continue
+		}
+		for _, blob := range blobTx.Blobs {
+			counts[string(blob.Namespace().Bytes())]++
+		}
+	}
+
+	namespaces := make([]NamespaceBlobs, 0, len(counts))
+	for ns, count := range counts {
+		namespaces = append(namespaces, NamespaceBlobs{Namespace: []byte(ns), Blobs: count})
+	}
+	sort.Slice(namespaces, func(i, j int) bool {
+		return bytes.Compare(namespaces[i].Namespace, namespaces[j].Namespace) < 0
+	})
+	return namespaces
+}
+
+func toHexBytes(bzs [][]byte) []tmbytes.HexBytes {
+	hexBytes := make([]tmbytes.HexBytes, len(bzs))
+	for i, bz := range bzs {
+		hexBytes[i] = bz
+	}
+	return hexBytes
+}
@@ -0,0 +1,69 @@
+package da
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2"
+	sh "github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestNewSummary(t *testing.T) {
+	ns1 := sh.MustNewV0Namespace(bytes.Repeat([]byte{1}, sh.NamespaceVersionZeroIDSize))
+	ns2 := sh.MustNewV0Namespace(bytes.Repeat([]byte{2}, sh.NamespaceVersionZeroIDSize))
+	newBlob := func(ns sh.Namespace, size int) *sh.Blob {
+		blob, err := sh.NewV0Blob(ns, bytes.Repeat([]byte{0xff}, size))
+		require.NoError(t, err)
+		return blob
+	}
+	newBlobTx := func(blobs ...*sh.Blob) []byte {
+		tx, err := blobtx.MarshalBlobTx([]byte("pfb"), blobs...)
+		require.NoError(t, err)
+		return tx
+	}
+	txs := [][]byte{
+		[]byte("send"),
+		newBlobTx(newBlob(ns2, 1000), newBlob(ns1, 100)),
+		newBlobTx(newBlob(ns2, 10)),
+	}
+	block := &tmproto.Block{
+		Header: tmproto.Header{Height: 10, Version: tmversion.Consensus{App: appconsts.LatestVersion}},
+		Data:   tmproto.Data{Txs: txs},
+	}
+	wantDAH := dataAvailabilityHeader(t, txs)
+	block.Header.DataHash = wantDAH.Hash()
+
+	summary, err := NewSummary(block)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), summary.Height)
+	assert.Equal(t, wantDAH.Hash(), []byte(summary.DataRoot))
+	assert.Equal(t, wantDAH.SquareSize(), summary.SquareSize)
+	assert.Equal(t, toHexBytes(wantDAH.RowRoots), summary.RowRoots)
+	assert.Equal(t, toHexBytes(wantDAH.ColumnRoots), summary.ColumnRoots)
+	assert.Equal(t, []NamespaceBlobs{
+		{Namespace: ns1.Bytes(), Blobs: 1},
+		{Namespace: ns2.Bytes(), Blobs: 2},
+	}, summary.Namespaces)
+	assert.Zero(t, summary.Blobstream)
+
+	minDAH := MinDataAvailabilityHeader()
+	block.Header.DataHash = minDAH.Hash()
+	_, err = NewSummary(block)
+	require.Error(t, err)
+}
+
+func dataAvailabilityHeader(t *testing.T, txs [][]byte) DataAvailabilityHeader {
+	dataSquare, err := square.Construct(txs, appconsts.DefaultSquareSizeUpperBound, appconsts.DefaultSubtreeRootThreshold)
+	require.NoError(t, err)
+	eds, err := ExtendShares(sh.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	return dah
+}
@@ -0,0 +1,296 @@
+// Package feestats tracks rolling statistics of the fees that are paid for
+// the blobs of each namespace. It allows DA marketplaces and rollups to price
+// blob space against the observed demand without indexing every block
+// themselves.
+package feestats
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/streaming"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+const (
+	// FlagWindow is the flag of the number of most recent heights that the
+	// statistics are computed over.
+	FlagWindow = "fee-stats-window"
+	// QueryPath is the path of the custom query that returns the Stats. The
+	// data of the query is an optional namespace to return the statistics of.
+	//
+	// example path: custom/feeStats
+	QueryPath = "feeStats"
+)
+
+var _ baseapp.StreamingService = (*Tracker)(nil)
+
+// Stats are the fee statistics of the namespaces that had blobs paid for
+// between FromHeight and ToHeight, inclusive.
+type Stats struct {
+	FromHeight int64 `json:"from_height"`
+	ToHeight   int64 `json:"to_height"`
+	// Namespaces are in ascending order.
+	Namespaces []NamespaceStats `json:"namespaces"`
+}
+
+// NamespaceStats are the fee statistics of a namespace. The fee of a PFB is
+// attributed to its namespaces in proportion to the size of their blobs. Fees
+// are denominated in utia.
+type NamespaceStats struct {
+	Namespace tmbytes.HexBytes `json:"namespace"`
+	PFBs      int              `json:"pfbs"`
+	Blobs     int              `json:"blobs"`
+	// Bytes is the volume of blob data of the namespace.
+	Bytes uint64 `json:"bytes"`
+	Fees  uint64 `json:"fees"`
+	// AvgFeePerByte is Fees divided by Bytes. P50FeePerByte and P95FeePerByte
+	// are percentiles of the fee per byte of the PFBs of the namespace.
+	AvgFeePerByte float64 `json:"avg_fee_per_byte"`
+	P50FeePerByte float64 `json:"p50_fee_per_byte"`
+	P95FeePerByte float64 `json:"p95_fee_per_byte"`
+}
+
+// payment is the part of a successful PFB that pays for the blobs of a
+// namespace.
+type payment struct {
+	namespace string
+	blobs     int
+	bytes     uint64
+	fee       uint64
+}
+
+type block struct {
+	height   int64
+	payments []payment
+}
+
+// Tracker is a streaming service that records the fees of the successful PFBs
+// of every committed block and updates the statistics over the window of most
+// recent heights at Commit. The statistics are kept in memory only and start
+// empty when the node restarts. Txs that can't be decoded and PFBs whose fee
+// can't be attributed are skipped, so the statistics are best effort.
+type Tracker struct {
+	streaming.BaseService
+
+	window    int
+	txDecoder sdk.TxDecoder
+	logger    log.Logger
+
+	// current are the payments of the block that is being executed.
+	current block
+	// blocks are the payments of the committed blocks in the window, oldest
+	// first.
+	blocks []block
+
+	mtx   sync.RWMutex
+	stats Stats
+}
+
+// NewTracker returns a tracker of the statistics over the last window
+// heights.
+func NewTracker(window int, txDecoder sdk.TxDecoder, logger log.Logger) *Tracker {
+	return &Tracker{
+		window:    window,
+		txDecoder: txDecoder,
+		logger:    logger.With("module", "feestats"),
+	}
+}
+
+// Stats returns the statistics as of the last committed height.
+func (t *Tracker) Stats() Stats {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+	return t.stats
+}
+
+// Query handles the custom query of the statistics. If the data of the query
+// is set, only the statistics of that namespace are returned.
+func (t *Tracker) Query(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+	if len(path) != 0 {
+		return nil, fmt.Errorf("expected query path length: 0 actual: %d ", len(path))
+	}
+	stats := t.Stats()
+	if len(req.Data) != 0 {
+		filtered := stats
+		filtered.Namespaces = nil
+		for _, ns := range stats.Namespaces {
+			if bytes.Equal(ns.Namespace, req.Data) {
+				filtered.Namespaces = append(filtered.Namespaces, ns)
+			}
+		}
+		stats = filtered
+	}
+	return json.Marshal(stats)
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (t *Tracker) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	t.current = block{height: req.Header.Height}
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener. The blobs are stripped
+// from blob txs before DeliverTx but the sizes and namespaces of the blobs
+// are part of the MsgPayForBlobs.
+func (t *Tracker) ListenDeliverTx(_ context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if res.Code != abci.CodeTypeOK {
+		return nil
+	}
+	sdkTx, err := t.txDecoder(req.Tx)
+	if err != nil {
+		return nil
+	}
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return nil
+	}
+	var pfbs []*blobtypes.MsgPayForBlobs
+	for _, msg := range sdkTx.GetMsgs() {
+		if pfb, ok := msg.(*blobtypes.MsgPayForBlobs); ok {
+			pfbs = append(pfbs, pfb)
+		}
+	}
+	if len(pfbs) == 0 {
+		return nil
+	}
+	fee := feeTx.GetFee().AmountOf(appconsts.BondDenom)
+	if !fee.IsUint64() {
+		t.logger.Error("skipping PFB with fee that overflows uint64", "height", t.current.height, "fee", fee)
+		return nil
+	}
+	t.current.payments = append(t.current.payments, payments(pfbs, fee.Uint64())...)
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener.
+func (t *Tracker) ListenCommit(context.Context, abci.ResponseCommit) error {
+	t.blocks = append(t.blocks, t.current)
+	if len(t.blocks) > t.window {
+		t.blocks = t.blocks[len(t.blocks)-t.window:]
+	}
+	t.current = block{}
+
+	stats := computeStats(t.blocks)
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.stats = stats
+	return nil
+}
+
+// QueryStats returns the statistics of the node. If namespace is not empty,
+// only the statistics of that namespace are returned.
+func QueryStats(ctx context.Context, client rpcclient.Client, namespace []byte) (Stats, error) {
+	res, err := client.ABCIQuery(ctx, "custom/"+QueryPath, namespace)
+	if err != nil {
+		return Stats{}, err
+	}
+	if res.Response.Value == nil && res.Response.Log != "" {
+		// custom queries don't set a code on failure, the error is logged
+		// instead.
+		return Stats{}, errors.New(res.Response.Log)
+	}
+	var stats Stats
+	if err := json.Unmarshal(res.Response.Value, &stats); err != nil {
+		return Stats{}, err
+	}
+	return stats, nil
+}
+
+// payments splits fee across the namespaces of pfbs in proportion to the size
+// of their blobs.
+func payments(pfbs []*blobtypes.MsgPayForBlobs, fee uint64) []payment {
+	var total uint64
+	byNamespace := make(map[string]*payment)
+	var order []string
+	for _, pfb := range pfbs {
+		for i, ns := range pfb.Namespaces {
+			if i >= len(pfb.BlobSizes) {
+				break
+			}
+			p, ok := byNamespace[string(ns)]
+			if !ok {
+				p = &payment{namespace: string(ns)}
+				byNamespace[string(ns)] = p
+				order = append(order, string(ns))
+			}
+			p.blobs++
+			p.bytes += uint64(pfb.BlobSizes[i])
+			total += uint64(pfb.BlobSizes[i])
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+
+	result := make([]payment, len(order))
+	for i, ns := range order {
+		p := byNamespace[ns]
+		p.fee = uint64(float64(fee) * float64(p.bytes) / float64(total))
+		result[i] = *p
+	}
+	return result
+}
+
+func computeStats(blocks []block) Stats {
+	if len(blocks) == 0 {
+		return Stats{}
+	}
+	stats := Stats{FromHeight: blocks[0].height, ToHeight: blocks[len(blocks)-1].height}
+
+	byNamespace := make(map[string]*NamespaceStats)
+	feesPerByte := make(map[string][]float64)
+	for _, b := range blocks {
+		for _, p := range b.payments {
+			ns, ok := byNamespace[p.namespace]
+			if !ok {
+				ns = &NamespaceStats{Namespace: []byte(p.namespace)}
+				byNamespace[p.namespace] = ns
+			}
+			ns.PFBs++
+			ns.Blobs += p.blobs
+			ns.Bytes += p.bytes
+			ns.Fees += p.fee
+			feesPerByte[p.namespace] = append(feesPerByte[p.namespace], float64(p.fee)/float64(p.bytes))
+		}
+	}
+
+	stats.Namespaces = make([]NamespaceStats, 0, len(byNamespace))
+	for key, ns := range byNamespace {
+		ns.AvgFeePerByte = float64(ns.Fees) / float64(ns.Bytes)
+		values := feesPerByte[key]
+		sort.Float64s(values)
+		ns.P50FeePerByte = percentile(values, 50)
+		ns.P95FeePerByte = percentile(values, 95)
+		stats.Namespaces = append(stats.Namespaces, *ns)
+	}
+	sort.Slice(stats.Namespaces, func(i, j int) bool {
+		return bytes.Compare(stats.Namespaces[i].Namespace, stats.Namespaces[j].Namespace) < 0
+	})
+	return stats
+}
+
+// percentile returns the nearest-rank percentile p of the sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
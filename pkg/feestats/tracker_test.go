@@ -0,0 +1,100 @@
+package feestats_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/feestats"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestTracker(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	nsA := share.MustNewV0Namespace([]byte("a"))
+	nsB := share.MustNewV0Namespace([]byte("b"))
+
+	newTx := func(fee int64, msgs ...sdk.Msg) []byte {
+		builder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, fee)))
+		rawTx, err := encCfg.TxConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return rawTx
+	}
+	// pfb returns a PFB with a blob of sizeA in nsA and of sizeB in nsB. A
+	// size of 0 omits the blob.
+	pfb := func(sizeA, sizeB uint32) *blobtypes.MsgPayForBlobs {
+		msg := &blobtypes.MsgPayForBlobs{}
+		for i, size := range []uint32{sizeA, sizeB} {
+			if size > 0 {
+				msg.Namespaces = append(msg.Namespaces, []share.Namespace{nsA, nsB}[i].Bytes())
+				msg.BlobSizes = append(msg.BlobSizes, size)
+			}
+		}
+		return msg
+	}
+
+	tracker := feestats.NewTracker(2, encCfg.TxConfig.TxDecoder(), log.NewNopLogger())
+	commit := func(height int64, txs ...abci.RequestDeliverTx) {
+		ctx := context.Background()
+		require.NoError(t, tracker.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+		for i, tx := range txs {
+			code := abci.CodeTypeOK
+			// the last tx of every block fails
+			if i == len(txs)-1 {
+				code = 1
+			}
+			require.NoError(t, tracker.ListenDeliverTx(ctx, tx, abci.ResponseDeliverTx{Code: code}))
+		}
+		require.NoError(t, tracker.ListenCommit(ctx, abci.ResponseCommit{}))
+	}
+
+	failed := abci.RequestDeliverTx{Tx: newTx(1e6, pfb(1, 0))}
+	commit(1,
+		abci.RequestDeliverTx{Tx: newTx(1000, pfb(100, 0))},
+		abci.RequestDeliverTx{Tx: newTx(100, &banktypes.MsgSend{})},
+		failed,
+	)
+	commit(2,
+		// the fee of a PFB is split by blob size
+		abci.RequestDeliverTx{Tx: newTx(3000, pfb(100, 200))},
+		failed,
+	)
+
+	stats := tracker.Stats()
+	require.Equal(t, int64(1), stats.FromHeight)
+	require.Equal(t, int64(2), stats.ToHeight)
+	require.Equal(t, []feestats.NamespaceStats{
+		{Namespace: nsA.Bytes(), PFBs: 2, Blobs: 2, Bytes: 200, Fees: 2000, AvgFeePerByte: 10, P50FeePerByte: 10, P95FeePerByte: 10},
+		{Namespace: nsB.Bytes(), PFBs: 1, Blobs: 1, Bytes: 200, Fees: 2000, AvgFeePerByte: 10, P50FeePerByte: 10, P95FeePerByte: 10},
+	}, stats.Namespaces)
+
+	// height 1 drops out of the window
+	commit(3,
+		abci.RequestDeliverTx{Tx: newTx(4000, pfb(100, 0))},
+		failed,
+	)
+	stats = tracker.Stats()
+	require.Equal(t, int64(2), stats.FromHeight)
+	require.Equal(t, int64(3), stats.ToHeight)
+	require.Len(t, stats.Namespaces, 2)
+	require.Equal(t, feestats.NamespaceStats{
+		Namespace: nsA.Bytes(), PFBs: 2, Blobs: 2, Bytes: 200, Fees: 5000, AvgFeePerByte: 25, P50FeePerByte: 10, P95FeePerByte: 40,
+	}, stats.Namespaces[0])
+
+	res, err := tracker.Query(sdk.Context{}, nil, abci.RequestQuery{Data: nsB.Bytes()})
+	require.NoError(t, err)
+	require.Contains(t, string(res), fmt.Sprintf("%X", nsB.Bytes()))
+	require.NotContains(t, string(res), fmt.Sprintf("%X", nsA.Bytes()))
+}
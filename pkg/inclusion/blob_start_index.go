@@ -0,0 +1,35 @@
+package inclusion
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/shares"
+)
+
+// BlobStartIndex returns the index of the first share of a blob that is
+// blobShareLen shares long and is placed after cursor in a square of
+// squareSize following the non-interactive default rules of appVersion. The
+// cursor is the index after the end of the previous blob.
+//
+// It performs the same computation as the square builder of the proposer so
+// clients can use it to determine where a blob will land before submitting it.
+// The rule itself is shares.NextShareIndex, which is canonical; BlobStartIndex
+// validates its arguments on top of it and returns an error if the blob
+// doesn't fit in the square.
+func BlobStartIndex(appVersion uint64, cursor, blobShareLen, squareSize int) (int, error) {
+	if cursor < 0 {
+		return 0, fmt.Errorf("cursor %d must not be negative", cursor)
+	}
+	if blobShareLen <= 0 {
+		return 0, fmt.Errorf("blob share length %d must be positive", blobShareLen)
+	}
+	if squareSize <= 0 || squareSize&(squareSize-1) != 0 {
+		return 0, fmt.Errorf("square size %d must be a positive power of two", squareSize)
+	}
+
+	start := shares.NextShareIndex(appVersion, cursor, blobShareLen)
+	if end := start + blobShareLen; end > squareSize*squareSize {
+		return 0, fmt.Errorf("blob of %d shares starting at %d doesn't fit in a square of size %d", blobShareLen, start, squareSize)
+	}
+	return start, nil
+}
@@ -0,0 +1,136 @@
+package inclusion_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/inclusion"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobStartIndex(t *testing.T) {
+	appVersion := appconsts.LatestVersion
+	threshold := appconsts.SubtreeRootThreshold(appVersion)
+
+	type testCase struct {
+		name                             string
+		cursor, blobShareLen, squareSize int
+		want                             int
+		wantErr                          bool
+	}
+	testCases := []testCase{
+		{name: "single share at start", cursor: 0, blobShareLen: 1, squareSize: 1, want: 0},
+		{name: "single share after cursor", cursor: 7, blobShareLen: 1, squareSize: 4, want: 7},
+		{name: "blob below threshold is not aligned", cursor: 3, blobShareLen: threshold, squareSize: 128, want: 3},
+		{name: "blob above threshold is aligned to 2", cursor: 3, blobShareLen: threshold + 1, squareSize: 128, want: 4},
+		{name: "aligned cursor is kept", cursor: 4, blobShareLen: threshold + 1, squareSize: 128, want: 4},
+		{name: "blob of 4 thresholds is aligned to 4", cursor: 5, blobShareLen: 4 * threshold, squareSize: 128, want: 8},
+		{name: "blob just over 4 thresholds is aligned to 8", cursor: 5, blobShareLen: 4*threshold + 1, squareSize: 128, want: 8},
+		{name: "width is bounded by the blob min square size", cursor: 1, blobShareLen: 256 * threshold, squareSize: 256, want: 128},
+		{name: "blob fills the square", cursor: 0, blobShareLen: 16, squareSize: 4, want: 0},
+		{name: "blob fills the rest of the square", cursor: 12, blobShareLen: 4, squareSize: 4, want: 12},
+		{name: "blob doesn't fit after alignment", cursor: 191, blobShareLen: threshold + 1, squareSize: 16, wantErr: true},
+		{name: "blob larger than square", cursor: 0, blobShareLen: 17, squareSize: 4, wantErr: true},
+		{name: "negative cursor", cursor: -1, blobShareLen: 1, squareSize: 4, wantErr: true},
+		{name: "empty blob", cursor: 0, blobShareLen: 0, squareSize: 4, wantErr: true},
+		{name: "square size not a power of two", cursor: 0, blobShareLen: 1, squareSize: 3, wantErr: true},
+		{name: "zero square size", cursor: 0, blobShareLen: 1, squareSize: 0, wantErr: true},
+	}
+	// exhaustively check that every start index is the first index at or after
+	// the cursor that is aligned to the subtree width.
+	for _, squareSize := range []int{1, 2, 4, 8, 16, 32} {
+		for blobShareLen := 1; blobShareLen <= squareSize*squareSize; blobShareLen++ {
+			width := subtreeWidth(blobShareLen, threshold)
+			for cursor := 0; cursor+blobShareLen <= squareSize*squareSize; cursor++ {
+				want := (cursor + width - 1) / width * width
+				start, err := inclusion.BlobStartIndex(appVersion, cursor, blobShareLen, squareSize)
+				if want+blobShareLen > squareSize*squareSize {
+					require.Error(t, err)
+					continue
+				}
+				require.NoError(t, err)
+				require.Equal(t, want, start, "cursor %d blob share len %d", cursor, blobShareLen)
+			}
+		}
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := inclusion.BlobStartIndex(appVersion, tc.cursor, tc.blobShareLen, tc.squareSize)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+// subtreeWidth returns the subtree width of a blob, which is the smallest
+// power of two that is at least the number of thresholds that the blob spans,
+// bounded by the width of the smallest square that can hold the blob.
+func subtreeWidth(blobShareLen, threshold int) int {
+	width := 1
+	for width*threshold < blobShareLen {
+		width *= 2
+	}
+	minWidth := 1
+	for minWidth*minWidth < blobShareLen {
+		minWidth *= 2
+	}
+	return min(width, minWidth)
+}
+
+// TestBlobStartIndexMatchesSquareBuilder checks that the start indexes
+// computed by BlobStartIndex are the ones chosen by the square builder.
+func TestBlobStartIndexMatchesSquareBuilder(t *testing.T) {
+	threshold := appconsts.SubtreeRootThreshold(appconsts.LatestVersion)
+	maxSquareSize := appconsts.SquareSizeUpperBound(appconsts.LatestVersion)
+	sizes := []int{1, 1000, threshold * share.ContinuationSparseShareContentSize, 5000, 100_000, 10, 300_000}
+
+	account := "test"
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(enc.Codec, account)
+	signer, err := user.NewSigner(kr, enc.TxConfig, testutil.ChainID, appconsts.LatestVersion, user.NewAccount(account, 0, 0))
+	require.NoError(t, err)
+
+	blobs := make([]*share.Blob, len(sizes))
+	for i, size := range sizes {
+		// the namespaces are increasing so the blobs keep their order in the
+		// square.
+		ns := share.MustNewV0Namespace(bytes.Repeat([]byte{byte(i + 1)}, share.NamespaceVersionZeroIDSize))
+		blobs[i], err = share.NewBlob(ns, bytes.Repeat([]byte{1}, size), share.ShareVersionZero, nil)
+		require.NoError(t, err)
+	}
+	blobTx, _, err := signer.CreatePayForBlobs(account, blobs, user.SetGasLimit(1_000_000_000), user.SetFee(100_000))
+	require.NoError(t, err)
+	txs := [][]byte{blobTx}
+
+	dataSquare, err := square.Construct(txs, maxSquareSize, threshold)
+	require.NoError(t, err)
+
+	var cursor int
+	for i, blob := range blobs {
+		shareRange, err := square.BlobShareRange(txs, 0, i, maxSquareSize, threshold)
+		require.NoError(t, err)
+		blobShareLen := share.SparseSharesNeeded(uint32(len(blob.Data())))
+		require.Equal(t, blobShareLen, shareRange.End-shareRange.Start)
+		if i > 0 {
+			start, err := inclusion.BlobStartIndex(appconsts.LatestVersion, cursor, blobShareLen, dataSquare.Size())
+			require.NoError(t, err)
+			require.Equal(t, shareRange.Start, start, fmt.Sprintf("blob %d", i))
+		}
+		cursor = shareRange.End
+	}
+}
@@ -0,0 +1,130 @@
+package inclusion
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+const (
+	// namespacedHashSize is the size of the nodes of the NMTs of the rows.
+	namespacedHashSize = 2*share.NamespaceSize + tmhash.Size
+	// hashSize is the size of the nodes of the Merkle tree of the data root.
+	hashSize = tmhash.Size
+)
+
+// ProofEstimate is the size and the verification cost of the inclusion proof
+// of a range of shares to the data root, as created by
+// proof.NewShareInclusionProof.
+type ProofEstimate struct {
+	// Shares is the number of shares that are proven.
+	Shares int
+	// Rows is the number of rows that the shares span.
+	Rows int
+	// NMTNodes is the number of nodes of the NMT range proofs of the shares to
+	// their row roots.
+	NMTNodes int
+	// RowProofAunts is the number of aunts of the Merkle proofs of the row
+	// roots to the data root.
+	RowProofAunts int
+	// DataBytes is the size of the proven shares.
+	DataBytes int
+	// ProofBytes is the size of the hashes of the proof: the NMT nodes, the
+	// row roots and the Merkle proofs of the row roots. It excludes the
+	// protobuf encoding overhead.
+	ProofBytes int
+	// HashCount is the number of hashes that verifying the proof computes.
+	HashCount int
+}
+
+// EstimateShareRangeProof returns the estimate of the inclusion proof of the
+// shares in the end-exclusive range [start, end) of a square of squareSize.
+func EstimateShareRangeProof(squareSize, start, end int) (ProofEstimate, error) {
+	if squareSize <= 0 || squareSize&(squareSize-1) != 0 {
+		return ProofEstimate{}, fmt.Errorf("square size %d must be a power of two", squareSize)
+	}
+	if start < 0 || end <= start || end > squareSize*squareSize {
+		return ProofEstimate{}, fmt.Errorf("invalid share range [%d, %d) for square size %d", start, end, squareSize)
+	}
+
+	startRow, endRow := start/squareSize, (end-1)/squareSize
+	// the data root is the root of the Merkle tree of the row and column
+	// roots of the extended square.
+	aunts := bits.Len(uint(4*squareSize)) - 1
+	estimate := ProofEstimate{
+		Shares:        end - start,
+		Rows:          endRow - startRow + 1,
+		DataBytes:     (end - start) * share.ShareSize,
+		RowProofAunts: (endRow - startRow + 1) * aunts,
+	}
+	for row := startRow; row <= endRow; row++ {
+		rowStart, rowEnd := 0, squareSize
+		if row == startRow {
+			rowStart = start % squareSize
+		}
+		if row == endRow {
+			rowEnd = (end-1)%squareSize + 1
+		}
+		// the rows of the extended square have 2*squareSize leaves.
+		nodes, hashes := rangeProofCost(0, 2*squareSize, rowStart, rowEnd)
+		estimate.NMTNodes += nodes
+		estimate.HashCount += hashes
+	}
+	// a Merkle proof verifies with the hash of the leaf and one hash per aunt.
+	estimate.HashCount += estimate.Rows + estimate.RowProofAunts
+	estimate.ProofBytes = estimate.NMTNodes*namespacedHashSize +
+		estimate.Rows*namespacedHashSize +
+		(estimate.Rows+estimate.RowProofAunts)*hashSize
+	return estimate, nil
+}
+
+// rangeProofCost returns the number of nodes of the range proof of the leaves
+// [start, end) of the subtree of the leaves [lo, hi) and the number of hashes
+// that verifying it computes for the subtree.
+func rangeProofCost(lo, hi, start, end int) (nodes int, hashes int) {
+	if end <= lo || hi <= start {
+		// the subtree is outside of the range so its root is a proof node.
+		return 1, 0
+	}
+	if hi-lo == 1 {
+		return 0, 1
+	}
+	mid := (lo + hi) / 2
+	leftNodes, leftHashes := rangeProofCost(lo, mid, start, end)
+	rightNodes, rightHashes := rangeProofCost(mid, hi, start, end)
+	return leftNodes + rightNodes, leftHashes + rightHashes + 1
+}
+
+// EstimateBlobProof returns an upper bound of the inclusion proof of a blob
+// with blobSize bytes of data in a square of squareSize. The blob can start
+// at any index that the non-interactive default rules allow, so every field
+// is the maximum over all of them.
+func EstimateBlobProof(blobSize, squareSize, subtreeRootThreshold int) (ProofEstimate, error) {
+	if blobSize <= 0 {
+		return ProofEstimate{}, fmt.Errorf("blob size %d must be positive", blobSize)
+	}
+	shares := share.SparseSharesNeeded(uint32(blobSize))
+	if shares > squareSize*squareSize {
+		return ProofEstimate{}, fmt.Errorf("blob of %d shares doesn't fit in a square of size %d", shares, squareSize)
+	}
+	width := inclusion.SubTreeWidth(shares, subtreeRootThreshold)
+
+	var worst ProofEstimate
+	for start := 0; start+shares <= squareSize*squareSize; start += width {
+		estimate, err := EstimateShareRangeProof(squareSize, start, start+shares)
+		if err != nil {
+			return ProofEstimate{}, err
+		}
+		worst.Shares = estimate.Shares
+		worst.DataBytes = estimate.DataBytes
+		worst.Rows = max(worst.Rows, estimate.Rows)
+		worst.NMTNodes = max(worst.NMTNodes, estimate.NMTNodes)
+		worst.RowProofAunts = max(worst.RowProofAunts, estimate.RowProofAunts)
+		worst.ProofBytes = max(worst.ProofBytes, estimate.ProofBytes)
+		worst.HashCount = max(worst.HashCount, estimate.HashCount)
+	}
+	return worst, nil
+}
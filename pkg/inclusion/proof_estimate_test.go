@@ -0,0 +1,88 @@
+package inclusion_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/inclusion"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateShareRangeProof(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	for _, squareSize := range []int{1, 2, 4} {
+		padding, err := share.NamespacePaddingShare(ns, share.ShareVersionZero)
+		require.NoError(t, err)
+		dataSquare := make([]share.Share, squareSize*squareSize)
+		for i := range dataSquare {
+			dataSquare[i] = padding
+		}
+		for start := 0; start < squareSize*squareSize; start++ {
+			for end := start + 1; end <= squareSize*squareSize; end++ {
+				shareProof, err := proof.NewShareInclusionProof(dataSquare, ns, share.NewRange(start, end))
+				require.NoError(t, err)
+				got, err := inclusion.EstimateShareRangeProof(squareSize, start, end)
+				require.NoError(t, err)
+
+				want := inclusion.ProofEstimate{
+					Shares:    end - start,
+					Rows:      len(shareProof.RowProof.RowRoots),
+					DataBytes: len(bytes.Join(shareProof.Data, nil)),
+				}
+				for _, nmtProof := range shareProof.ShareProofs {
+					want.NMTNodes += len(nmtProof.Nodes)
+					want.ProofBytes += len(bytes.Join(nmtProof.Nodes, nil))
+				}
+				for i, rowProof := range shareProof.RowProof.Proofs {
+					want.RowProofAunts += len(rowProof.Aunts)
+					want.ProofBytes += len(shareProof.RowProof.RowRoots[i]) + len(rowProof.LeafHash) + len(bytes.Join(rowProof.Aunts, nil))
+				}
+				want.HashCount = got.HashCount
+				assert.Equal(t, want, got, "square size %d range [%d, %d)", squareSize, start, end)
+			}
+		}
+	}
+}
+
+func TestEstimateShareRangeProofHashCount(t *testing.T) {
+	// one share of a square of size 1: the leaf and the root of the NMT of
+	// the extended row of 2 leaves, and the leaf and 2 aunts of the Merkle
+	// proof over the 4 row and column roots.
+	got, err := inclusion.EstimateShareRangeProof(1, 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 5, got.HashCount)
+
+	// a whole row of a square of size 4: 4 leaves and 3 inner nodes of the
+	// left half, the root of the NMT, and the leaf and 4 aunts of the
+	// Merkle proof over the 16 row and column roots.
+	got, err = inclusion.EstimateShareRangeProof(4, 4, 8)
+	require.NoError(t, err)
+	assert.Equal(t, 13, got.HashCount)
+}
+
+func TestEstimateBlobProof(t *testing.T) {
+	threshold := appconsts.DefaultSubtreeRootThreshold
+	got, err := inclusion.EstimateBlobProof(100_000, 64, threshold)
+	require.NoError(t, err)
+	shares := share.SparseSharesNeeded(100_000)
+	assert.Equal(t, shares, got.Shares)
+	assert.Equal(t, shares*share.ShareSize, got.DataBytes)
+
+	// the upper bound is at least the estimate of the first index.
+	first, err := inclusion.EstimateShareRangeProof(64, 0, shares)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, got.ProofBytes, first.ProofBytes)
+	assert.GreaterOrEqual(t, got.HashCount, first.HashCount)
+	assert.GreaterOrEqual(t, got.Rows, first.Rows)
+
+	_, err = inclusion.EstimateBlobProof(0, 64, threshold)
+	assert.Error(t, err)
+	_, err = inclusion.EstimateBlobProof(100_000, 2, threshold)
+	assert.Error(t, err)
+	_, err = inclusion.EstimateShareRangeProof(3, 0, 1)
+	assert.Error(t, err)
+}
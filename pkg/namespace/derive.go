@@ -0,0 +1,62 @@
+// Package namespace derives blob namespaces deterministically from labels and
+// account addresses so that applications don't need to invent their own
+// schemes to get namespaces that are unlikely to collide with each other.
+package namespace
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// labelDomain and addressDomain separate the hashes of labels from the
+	// hashes of addresses so that a label can't derive the namespace of an
+	// address with the same bytes.
+	labelDomain   = "celestia/namespace/label/v0"
+	addressDomain = "celestia/namespace/address/v0"
+)
+
+// FromLabel derives a version 0 namespace from a human readable label, e.g.
+// the name of an application. The user specifiable portion of the namespace
+// ID is the first share.NamespaceVersionZeroIDSize bytes of the SHA-256 hash
+// of the label. The label is hashed as is so labels that differ only in case
+// or whitespace derive different namespaces.
+func FromLabel(label string) (share.Namespace, error) {
+	if label == "" {
+		return share.Namespace{}, errors.New("label can not be empty")
+	}
+	return derive(labelDomain, []byte(label))
+}
+
+// FromAddress derives a version 0 namespace from an account address, like
+// FromLabel does from a label.
+func FromAddress(addr sdk.AccAddress) (share.Namespace, error) {
+	if len(addr) == 0 {
+		return share.Namespace{}, errors.New("address can not be empty")
+	}
+	if err := sdk.VerifyAddressFormat(addr); err != nil {
+		return share.Namespace{}, err
+	}
+	return derive(addressDomain, addr)
+}
+
+func derive(domain string, data []byte) (share.Namespace, error) {
+	hash := sha256.New()
+	hash.Write([]byte(domain))
+	hash.Write(data)
+	ns, err := share.NewV0Namespace(hash.Sum(nil)[:share.NamespaceVersionZeroIDSize])
+	if err != nil {
+		return share.Namespace{}, err
+	}
+	// a hash in the reserved ranges is astronomically unlikely but it would
+	// make the namespace unusable for blobs, so it is rejected rather than
+	// silently returned.
+	if err := ns.ValidateForBlob(); err != nil {
+		return share.Namespace{}, fmt.Errorf("derived namespace %x is not usable for blobs: %w", ns.Bytes(), err)
+	}
+	return ns, nil
+}
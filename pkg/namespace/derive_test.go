@@ -0,0 +1,49 @@
+package namespace_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/namespace"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromLabel(t *testing.T) {
+	ns, err := namespace.FromLabel("my-rollup")
+	require.NoError(t, err)
+	assert.Equal(t, share.NamespaceVersionZero, ns.Version())
+	assert.NoError(t, ns.ValidateForBlob())
+
+	again, err := namespace.FromLabel("my-rollup")
+	require.NoError(t, err)
+	assert.Equal(t, ns, again)
+
+	other, err := namespace.FromLabel("My-rollup")
+	require.NoError(t, err)
+	assert.NotEqual(t, ns, other)
+
+	_, err = namespace.FromLabel("")
+	assert.Error(t, err)
+}
+
+func TestFromAddress(t *testing.T) {
+	addr := sdk.AccAddress([]byte("-----address-20-----"))
+	ns, err := namespace.FromAddress(addr)
+	require.NoError(t, err)
+	assert.Equal(t, share.NamespaceVersionZero, ns.Version())
+	assert.NoError(t, ns.ValidateForBlob())
+
+	again, err := namespace.FromAddress(addr)
+	require.NoError(t, err)
+	assert.Equal(t, ns, again)
+
+	// the same bytes derive different namespaces as a label and an address
+	label, err := namespace.FromLabel(string(addr))
+	require.NoError(t, err)
+	assert.NotEqual(t, ns, label)
+
+	_, err = namespace.FromAddress(nil)
+	assert.Error(t, err)
+}
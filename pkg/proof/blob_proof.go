@@ -0,0 +1,81 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// ErrBlobNotFound is returned by NewBlobInclusionProof if the txs don't
+// contain a blob with the namespace and commitment.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// NewBlobInclusionProof returns the inclusion proof of the shares of the blob
+// of txs with namespace and commitment to the data root of the square of
+// txs, as well as the end-exclusive range of its shares in the square. txs
+// must be the txs of a block with appVersion. If several blobs match, the
+// proof is for the first one.
+func NewBlobInclusionProof(txs [][]byte, namespace share.Namespace, commitment []byte, appVersion uint64) (ShareProof, share.Range, error) {
+	pfbIndex, blobIndex, err := findBlob(txs, namespace, commitment, appconsts.SubtreeRootThreshold(appVersion))
+	if err != nil {
+		return ShareProof{}, share.Range{}, err
+	}
+
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion), txs...)
+	if err != nil {
+		return ShareProof{}, share.Range{}, err
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return ShareProof{}, share.Range{}, err
+	}
+	start, err := builder.FindBlobStartingIndex(pfbIndex, blobIndex)
+	if err != nil {
+		return ShareProof{}, share.Range{}, err
+	}
+	length, err := builder.BlobShareLength(pfbIndex, blobIndex)
+	if err != nil {
+		return ShareProof{}, share.Range{}, err
+	}
+
+	shareRange := share.NewRange(start, start+length)
+	shareProof, err := NewShareInclusionProof(dataSquare, namespace, shareRange)
+	if err != nil {
+		return ShareProof{}, share.Range{}, err
+	}
+	return shareProof, shareRange, nil
+}
+
+// findBlob returns the index of the blob tx in txs and the index of the blob
+// in the blob tx of the first blob with namespace and commitment. Only the
+// commitments of the blobs with namespace are computed.
+func findBlob(txs [][]byte, namespace share.Namespace, commitment []byte, subtreeRootThreshold int) (int, int, error) {
+	for txIndex, rawTx := range txs {
+		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlobTx {
+			continue
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		for blobIndex, blob := range blobTx.Blobs {
+			if !blob.Namespace().Equals(namespace) {
+				continue
+			}
+			blobCommitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, subtreeRootThreshold)
+			if err != nil {
+				return 0, 0, err
+			}
+			if bytes.Equal(blobCommitment, commitment) {
+				return txIndex, blobIndex, nil
+			}
+		}
+	}
+	return 0, 0, ErrBlobNotFound
+}
@@ -0,0 +1,64 @@
+package proof_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestNewBlobInclusionProof(t *testing.T) {
+	appVersion := appconsts.LatestVersion
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blockTxs := testfactory.GenerateRandomTxs(10, 500).ToSliceOfBytes()
+	blockTxs = append(blockTxs, blobfactory.RandBlobTxs(signer, tmrand.NewRand(), 10, 2, 2000).ToSliceOfBytes()...)
+
+	dataSquare, err := square.Construct(blockTxs, appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(blockTxs[15])
+	require.NoError(t, err)
+	require.True(t, isBlobTx)
+	blob := blobTx.Blobs[1]
+	commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold(appVersion))
+	require.NoError(t, err)
+
+	t.Run("proves the shares of the blob", func(t *testing.T) {
+		shareProof, shareRange, err := proof.NewBlobInclusionProof(blockTxs, blob.Namespace(), commitment, appVersion)
+		require.NoError(t, err)
+		require.NoError(t, shareProof.Validate(dah.Hash()))
+
+		blobShares, err := blob.ToShares()
+		require.NoError(t, err)
+		assert.Equal(t, len(blobShares), shareRange.End-shareRange.Start)
+		assert.Equal(t, share.ToBytes(blobShares), shareProof.Data)
+		assert.Equal(t, share.ToBytes(dataSquare[shareRange.Start:shareRange.End]), shareProof.Data)
+	})
+
+	t.Run("returns an error if the commitment doesn't match", func(t *testing.T) {
+		_, _, err := proof.NewBlobInclusionProof(blockTxs, blob.Namespace(), commitment[1:], appVersion)
+		assert.ErrorIs(t, err, proof.ErrBlobNotFound)
+	})
+
+	t.Run("returns an error if the namespace doesn't match", func(t *testing.T) {
+		_, _, err := proof.NewBlobInclusionProof(blockTxs, share.RandomBlobNamespace(), commitment, appVersion)
+		assert.ErrorIs(t, err, proof.ErrBlobNotFound)
+	})
+}
@@ -0,0 +1,382 @@
+package proof
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+// compactEncodingVersion is the first byte of a compact share proof so that
+// the encoding can change without breaking decoders of older proofs.
+const compactEncodingVersion = 1
+
+const (
+	// nodeTagSplit marks an NMT node that is encoded as references to its
+	// minimum namespace, maximum namespace and digest.
+	nodeTagSplit byte = iota
+	// nodeTagRaw marks a node that isn't an NMT node and is encoded as is.
+	nodeTagRaw
+)
+
+const (
+	// shareTagStripped marks a share of which the namespace of the proof was
+	// stripped.
+	shareTagStripped byte = iota
+	// shareTagRaw marks a share that is encoded as is.
+	shareTagRaw
+)
+
+// nmtNodeSize is the size of an NMT node: the minimum and maximum namespace
+// of its leaves followed by a sha256 digest.
+const nmtNodeSize = 2*share.NamespaceSize + 32
+
+// MarshalCompact returns the compact encoding of the share proof. Proofs of
+// large blobs are dominated by redundant bytes: every share starts with the
+// namespace of the proof, most NMT nodes repeat that namespace as their
+// minimum and maximum namespace, and the row proofs of adjacent rows share
+// most of their aunts. The compact encoding stores every distinct namespace
+// and hash once and refers to them by index. The encoding is deterministic:
+// the same proof always results in the same bytes.
+func (sp ShareProof) MarshalCompact() []byte {
+	e := newCompactEncoder()
+	namespace := append([]byte{uint8(sp.NamespaceVersion)}, sp.NamespaceId...)
+
+	e.writeUvarint(uint64(len(sp.Data)))
+	for _, data := range sp.Data {
+		if bytes.HasPrefix(data, namespace) {
+			e.body.WriteByte(shareTagStripped)
+			e.writeBytes(data[len(namespace):])
+		} else {
+			e.body.WriteByte(shareTagRaw)
+			e.writeBytes(data)
+		}
+	}
+
+	e.writeUvarint(uint64(len(sp.ShareProofs)))
+	for _, proof := range sp.ShareProofs {
+		e.writeVarint(int64(proof.Start))
+		e.writeVarint(int64(proof.End))
+		e.writeNodes(proof.Nodes)
+		e.writeHash(proof.LeafHash)
+	}
+
+	if sp.RowProof == nil {
+		e.body.WriteByte(0)
+	} else {
+		e.body.WriteByte(1)
+		e.writeNodes(sp.RowProof.RowRoots)
+		e.writeUvarint(uint64(len(sp.RowProof.Proofs)))
+		for _, proof := range sp.RowProof.Proofs {
+			e.writeVarint(proof.Total)
+			e.writeVarint(proof.Index)
+			e.writeHash(proof.LeafHash)
+			e.writeUvarint(uint64(len(proof.Aunts)))
+			for _, aunt := range proof.Aunts {
+				e.writeHash(aunt)
+			}
+		}
+		e.writeHash(sp.RowProof.Root)
+		e.writeUvarint(uint64(sp.RowProof.StartRow))
+		e.writeUvarint(uint64(sp.RowProof.EndRow))
+	}
+
+	return e.bytes(sp.NamespaceVersion, sp.NamespaceId)
+}
+
+// UnmarshalCompactShareProof decodes a share proof encoded with
+// ShareProof.MarshalCompact.
+func UnmarshalCompactShareProof(data []byte) (ShareProof, error) {
+	d := &compactDecoder{data: data}
+	version := d.readByte()
+	if d.err == nil && version != compactEncodingVersion {
+		return ShareProof{}, fmt.Errorf("unsupported compact share proof encoding version %d", version)
+	}
+
+	sp := ShareProof{}
+	namespaceVersion := d.readUvarint()
+	if namespaceVersion > 255 {
+		d.fail(fmt.Errorf("namespace version %d exceeds 255", namespaceVersion))
+	}
+	sp.NamespaceVersion = uint32(namespaceVersion)
+	sp.NamespaceId = d.readBytes()
+	d.namespaces = d.readTable()
+	d.hashes = d.readTable()
+	namespace := append([]byte{uint8(sp.NamespaceVersion)}, sp.NamespaceId...)
+
+	if n := d.readCount(); n > 0 {
+		sp.Data = make([][]byte, n)
+		for i := range sp.Data {
+			switch tag := d.readByte(); tag {
+			case shareTagStripped:
+				sp.Data[i] = append(append([]byte{}, namespace...), d.readBytes()...)
+			case shareTagRaw:
+				sp.Data[i] = d.readBytes()
+			default:
+				d.fail(fmt.Errorf("invalid share tag %d", tag))
+			}
+		}
+	}
+
+	if n := d.readCount(); n > 0 {
+		sp.ShareProofs = make([]*NMTProof, n)
+		for i := range sp.ShareProofs {
+			sp.ShareProofs[i] = &NMTProof{
+				Start:    int32(d.readVarint()),
+				End:      int32(d.readVarint()),
+				Nodes:    d.readNodes(),
+				LeafHash: d.readHash(),
+			}
+		}
+	}
+
+	if d.readByte() == 1 {
+		rowProof := &RowProof{RowRoots: d.readNodes()}
+		if n := d.readCount(); n > 0 {
+			rowProof.Proofs = make([]*Proof, n)
+			for i := range rowProof.Proofs {
+				proof := &Proof{
+					Total:    d.readVarint(),
+					Index:    d.readVarint(),
+					LeafHash: d.readHash(),
+				}
+				if n := d.readCount(); n > 0 {
+					proof.Aunts = make([][]byte, n)
+					for j := range proof.Aunts {
+						proof.Aunts[j] = d.readHash()
+					}
+				}
+				rowProof.Proofs[i] = proof
+			}
+		}
+		rowProof.Root = d.readHash()
+		rowProof.StartRow = uint32(d.readUvarint())
+		rowProof.EndRow = uint32(d.readUvarint())
+		sp.RowProof = rowProof
+	}
+
+	if d.err == nil && len(d.data) != 0 {
+		d.fail(fmt.Errorf("%d trailing bytes", len(d.data)))
+	}
+	if d.err != nil {
+		return ShareProof{}, fmt.Errorf("invalid compact share proof: %w", d.err)
+	}
+	return sp, nil
+}
+
+// compactTable deduplicates byte strings in the order they are first added.
+type compactTable struct {
+	index  map[string]uint64
+	values [][]byte
+}
+
+func (t *compactTable) add(value []byte) uint64 {
+	if i, ok := t.index[string(value)]; ok {
+		return i
+	}
+	i := uint64(len(t.values))
+	t.index[string(value)] = i
+	t.values = append(t.values, value)
+	return i
+}
+
+// compactEncoder writes the body of a compact share proof while it collects
+// the namespaces and hashes that the body refers to.
+type compactEncoder struct {
+	namespaces compactTable
+	hashes     compactTable
+	body       bytes.Buffer
+}
+
+func newCompactEncoder() *compactEncoder {
+	return &compactEncoder{
+		namespaces: compactTable{index: make(map[string]uint64)},
+		hashes:     compactTable{index: make(map[string]uint64)},
+	}
+}
+
+func (e *compactEncoder) writeUvarint(v uint64) {
+	e.body.Write(binary.AppendUvarint(nil, v))
+}
+
+func (e *compactEncoder) writeVarint(v int64) {
+	e.body.Write(binary.AppendVarint(nil, v))
+}
+
+func (e *compactEncoder) writeBytes(b []byte) {
+	e.writeUvarint(uint64(len(b)))
+	e.body.Write(b)
+}
+
+func (e *compactEncoder) writeHash(hash []byte) {
+	e.writeUvarint(e.hashes.add(hash))
+}
+
+func (e *compactEncoder) writeNodes(nodes [][]byte) {
+	e.writeUvarint(uint64(len(nodes)))
+	for _, node := range nodes {
+		if len(node) != nmtNodeSize {
+			e.body.WriteByte(nodeTagRaw)
+			e.writeBytes(node)
+			continue
+		}
+		e.body.WriteByte(nodeTagSplit)
+		e.writeUvarint(e.namespaces.add(node[:share.NamespaceSize]))
+		e.writeUvarint(e.namespaces.add(node[share.NamespaceSize : 2*share.NamespaceSize]))
+		e.writeHash(node[2*share.NamespaceSize:])
+	}
+}
+
+// bytes returns the header, the tables and the body of the compact share
+// proof.
+func (e *compactEncoder) bytes(namespaceVersion uint32, namespaceID []byte) []byte {
+	out := []byte{compactEncodingVersion}
+	out = binary.AppendUvarint(out, uint64(namespaceVersion))
+	out = appendBytes(out, namespaceID)
+	for _, table := range []compactTable{e.namespaces, e.hashes} {
+		out = binary.AppendUvarint(out, uint64(len(table.values)))
+		for _, value := range table.values {
+			out = appendBytes(out, value)
+		}
+	}
+	return append(out, e.body.Bytes()...)
+}
+
+func appendBytes(out []byte, b []byte) []byte {
+	out = binary.AppendUvarint(out, uint64(len(b)))
+	return append(out, b...)
+}
+
+// compactDecoder reads a compact share proof. After the first error every
+// read returns a zero value so that the caller only has to check err once.
+type compactDecoder struct {
+	data       []byte
+	namespaces [][]byte
+	hashes     [][]byte
+	err        error
+}
+
+var errTruncated = errors.New("unexpected end of data")
+
+func (d *compactDecoder) fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+func (d *compactDecoder) readByte() byte {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.data) == 0 {
+		d.fail(errTruncated)
+		return 0
+	}
+	b := d.data[0]
+	d.data = d.data[1:]
+	return b
+}
+
+func (d *compactDecoder) readUvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.fail(errTruncated)
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *compactDecoder) readVarint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.data)
+	if n <= 0 {
+		d.fail(errTruncated)
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+// readCount reads the number of elements of a list. Every element takes at
+// least one byte so a count larger than the remaining data is invalid, which
+// prevents large allocations for malicious input.
+func (d *compactDecoder) readCount() int {
+	n := d.readUvarint()
+	if n > uint64(len(d.data)) {
+		d.fail(fmt.Errorf("count %d exceeds the remaining %d bytes", n, len(d.data)))
+		return 0
+	}
+	return int(n)
+}
+
+func (d *compactDecoder) readBytes() []byte {
+	n := d.readUvarint()
+	if n > uint64(len(d.data)) {
+		d.fail(errTruncated)
+		return nil
+	}
+	if n == 0 {
+		return nil
+	}
+	b := append([]byte{}, d.data[:n]...)
+	d.data = d.data[n:]
+	return b
+}
+
+func (d *compactDecoder) readTable() [][]byte {
+	table := make([][]byte, d.readCount())
+	for i := range table {
+		table[i] = d.readBytes()
+	}
+	return table
+}
+
+func (d *compactDecoder) readRef(table [][]byte) []byte {
+	i := d.readUvarint()
+	if d.err != nil {
+		return nil
+	}
+	if i >= uint64(len(table)) {
+		d.fail(fmt.Errorf("reference %d is out of range of a table of %d values", i, len(table)))
+		return nil
+	}
+	return table[i]
+}
+
+func (d *compactDecoder) readHash() []byte {
+	hash := d.readRef(d.hashes)
+	if len(hash) == 0 {
+		return nil
+	}
+	return append([]byte{}, hash...)
+}
+
+func (d *compactDecoder) readNodes() [][]byte {
+	n := d.readCount()
+	if n == 0 {
+		return nil
+	}
+	nodes := make([][]byte, n)
+	for i := range nodes {
+		switch tag := d.readByte(); tag {
+		case nodeTagSplit:
+			node := make([]byte, 0, nmtNodeSize)
+			node = append(node, d.readRef(d.namespaces)...)
+			node = append(node, d.readRef(d.namespaces)...)
+			nodes[i] = append(node, d.readRef(d.hashes)...)
+		case nodeTagRaw:
+			nodes[i] = d.readBytes()
+		default:
+			d.fail(fmt.Errorf("invalid node tag %d", tag))
+		}
+	}
+	return nodes
+}
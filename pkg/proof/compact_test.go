@@ -0,0 +1,133 @@
+package proof_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestShareProofMarshalCompact(t *testing.T) {
+	shareProof, dataRoot := blobShareProof(t, 100_000)
+
+	compact := shareProof.MarshalCompact()
+	full, err := shareProof.Marshal()
+	require.NoError(t, err)
+	assert.Less(t, len(compact), len(full))
+	assert.Equal(t, compact, shareProof.MarshalCompact(), "the encoding must be deterministic")
+
+	decoded, err := proof.UnmarshalCompactShareProof(compact)
+	require.NoError(t, err)
+	require.NoError(t, decoded.Validate(dataRoot))
+	decodedFull, err := decoded.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, full, decodedFull)
+}
+
+func TestShareProofMarshalCompactRoundTrip(t *testing.T) {
+	small, _ := blobShareProof(t, 500)
+	testCases := []proof.ShareProof{
+		{},
+		small,
+		{
+			// a share of another namespace and a node that isn't an NMT node.
+			Data:             [][]byte{[]byte("not a share of the namespace")},
+			ShareProofs:      []*proof.NMTProof{{Start: 0, End: 1, Nodes: [][]byte{[]byte("node")}}},
+			NamespaceId:      bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize),
+			NamespaceVersion: 0,
+		},
+	}
+	for i, tc := range testCases {
+		decoded, err := proof.UnmarshalCompactShareProof(tc.MarshalCompact())
+		require.NoError(t, err, i)
+		want, err := tc.Marshal()
+		require.NoError(t, err)
+		got, err := decoded.Marshal()
+		require.NoError(t, err)
+		assert.Equal(t, want, got, i)
+	}
+}
+
+func TestUnmarshalCompactShareProofErrors(t *testing.T) {
+	shareProof, _ := blobShareProof(t, 2_000)
+	compact := shareProof.MarshalCompact()
+
+	type testCase struct {
+		name    string
+		data    []byte
+		wantErr string
+	}
+	testCases := []testCase{
+		{name: "empty", data: nil, wantErr: "unexpected end of data"},
+		{name: "unsupported version", data: append([]byte{2}, compact[1:]...), wantErr: "unsupported compact share proof encoding version 2"},
+		{name: "truncated", data: compact[:len(compact)-1], wantErr: "unexpected end of data"},
+		{name: "trailing bytes", data: append(append([]byte{}, compact...), 0), wantErr: "1 trailing bytes"},
+		{name: "huge count", data: []byte{1, 0, 0, 0xff, 0xff, 0xff, 0xff, 0x0f}, wantErr: "exceeds the remaining"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := proof.UnmarshalCompactShareProof(tc.data)
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+// BenchmarkShareProofMarshalCompact reports the size of the proofs of blobs of
+// different sizes in the protobuf and the compact encoding.
+func BenchmarkShareProofMarshalCompact(b *testing.B) {
+	for _, size := range []int{1_000, 100_000, 1_000_000} {
+		shareProof, _ := blobShareProof(b, size)
+		full, err := shareProof.Marshal()
+		require.NoError(b, err)
+
+		b.Run(fmt.Sprintf("blob size %d", size), func(b *testing.B) {
+			var compact []byte
+			for i := 0; i < b.N; i++ {
+				compact = shareProof.MarshalCompact()
+			}
+			b.ReportMetric(float64(len(full)), "proto_bytes")
+			b.ReportMetric(float64(len(compact)), "compact_bytes")
+		})
+	}
+}
+
+// blobShareProof returns the share proof of a blob of size bytes in a block
+// and the data root of that block.
+func blobShareProof(t testing.TB, size int) (proof.ShareProof, []byte) {
+	appVersion := appconsts.LatestVersion
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	namespace := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	blockTxs := coretypes.Txs(blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{namespace}, []int{size})).ToSliceOfBytes()
+
+	dataSquare, err := square.Construct(blockTxs, appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(blockTxs[0])
+	require.NoError(t, err)
+	require.True(t, isBlobTx)
+	blob := blobTx.Blobs[0]
+	commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold(appVersion))
+	require.NoError(t, err)
+
+	shareProof, _, err := proof.NewBlobInclusionProof(blockTxs, blob.Namespace(), commitment, appVersion)
+	require.NoError(t, err)
+	return shareProof, dah.Hash()
+}
@@ -0,0 +1,53 @@
+package proof
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+)
+
+// BlobLocation identifies the shares occupied by a blob in the original data
+// square of a block.
+type BlobLocation struct {
+	Height int64
+	// Range is the end exclusive range of the shares occupied by the blob.
+	Range     share.Range
+	Namespace share.Namespace
+}
+
+// NewBlobLocation returns the location of the blob at blobIndex of the blob
+// transaction at txIndex in the provided block txs. The square is constructed
+// using the upper bound square size of the app version as we don't have access
+// to the square size dictated by governance.
+func NewBlobLocation(height int64, appVersion uint64, txs [][]byte, txIndex, blobIndex int) (BlobLocation, error) {
+	if txIndex < 0 || txIndex >= len(txs) {
+		return BlobLocation{}, fmt.Errorf("tx index %d out of range [0, %d)", txIndex, len(txs))
+	}
+	blobTx, isBlobTx, err := tx.UnmarshalBlobTx(txs[txIndex])
+	if !isBlobTx {
+		return BlobLocation{}, fmt.Errorf("tx at index %d is not a blob tx", txIndex)
+	}
+	if err != nil {
+		return BlobLocation{}, err
+	}
+	if blobIndex < 0 || blobIndex >= len(blobTx.Blobs) {
+		return BlobLocation{}, fmt.Errorf("blob index %d out of range [0, %d)", blobIndex, len(blobTx.Blobs))
+	}
+
+	shareRange, err := square.BlobShareRange(txs, txIndex, blobIndex, appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion))
+	if err != nil {
+		return BlobLocation{}, err
+	}
+	return BlobLocation{
+		Height:    height,
+		Range:     shareRange,
+		Namespace: blobTx.Blobs[blobIndex].Namespace(),
+	}, nil
+}
+
+func (l BlobLocation) String() string {
+	return fmt.Sprintf("height %d shares [%d, %d) namespace %x", l.Height, l.Range.Start, l.Range.End, l.Namespace.Bytes())
+}
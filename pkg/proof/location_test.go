@@ -0,0 +1,52 @@
+package proof_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestNewBlobLocation(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+
+	blockTxs := testfactory.GenerateRandomTxs(5, 200).ToSliceOfBytes()
+	blockTxs = append(blockTxs, blobfactory.RandBlobTxs(signer, tmrand.NewRand(), 3, 2, 1000).ToSliceOfBytes()...)
+
+	dataSquare, err := square.Construct(blockTxs, appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+
+	for txIndex := 5; txIndex < len(blockTxs); txIndex++ {
+		blobTx, isBlobTx, err := tx.UnmarshalBlobTx(blockTxs[txIndex])
+		require.True(t, isBlobTx)
+		require.NoError(t, err)
+		for blobIndex, blob := range blobTx.Blobs {
+			location, err := proof.NewBlobLocation(10, appconsts.LatestVersion, blockTxs, txIndex, blobIndex)
+			require.NoError(t, err)
+			require.Equal(t, int64(10), location.Height)
+			require.Equal(t, blob.Namespace(), location.Namespace)
+
+			// the range is end exclusive and only contains shares of the blob's
+			// namespace
+			ns, err := proof.ParseNamespace(dataSquare, location.Range)
+			require.NoError(t, err)
+			require.Equal(t, blob.Namespace(), ns)
+			require.Equal(t, blob.Namespace(), dataSquare[location.Range.Start].Namespace())
+		}
+	}
+
+	_, err = proof.NewBlobLocation(10, appconsts.LatestVersion, blockTxs, 0, 0)
+	require.Error(t, err, "tx at index 0 is not a blob tx")
+	_, err = proof.NewBlobLocation(10, appconsts.LatestVersion, blockTxs, 5, 2)
+	require.Error(t, err, "blob index out of range")
+	_, err = proof.NewBlobLocation(10, appconsts.LatestVersion, blockTxs, len(blockTxs), 0)
+	require.Error(t, err, "tx index out of range")
+}
@@ -216,7 +216,7 @@ func TestNewShareInclusionProof(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			actualNID, err := proof.ParseNamespace(dataSquare, tt.startingShare, tt.endingShare)
+			actualNID, err := proof.ParseNamespace(dataSquare, share.NewRange(tt.startingShare, tt.endingShare))
 			if tt.expectErr {
 				require.Error(t, err)
 				return
@@ -254,7 +254,7 @@ func TestAllSharesInclusionProof(t *testing.T) {
 	require.NoError(t, err)
 	dataRoot := dah.Hash()
 
-	actualNamespace, err := proof.ParseNamespace(dataSquare, 0, 256)
+	actualNamespace, err := proof.ParseNamespace(dataSquare, share.NewRange(0, 256))
 	require.NoError(t, err)
 	require.Equal(t, share.TxNamespace, actualNamespace)
 	proof, err := proof.NewShareInclusionProof(
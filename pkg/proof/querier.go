@@ -107,12 +107,12 @@ func QueryShareInclusionProof(_ sdk.Context, path []string, req abci.RequestQuer
 		return nil, err
 	}
 
-	nID, err := ParseNamespace(dataSquare, begin, end)
+	shareRange := share.NewRange(begin, end)
+	nID, err := ParseNamespace(dataSquare, shareRange)
 	if err != nil {
 		return nil, err
 	}
 
-	shareRange := share.NewRange(begin, end)
 	// create and marshal the share inclusion proof, which we return in the form of []byte
 	shareProof, err := NewShareInclusionProof(dataSquare, nID, shareRange)
 	if err != nil {
@@ -129,26 +129,26 @@ func QueryShareInclusionProof(_ sdk.Context, path []string, req abci.RequestQuer
 
 // ParseNamespace validates the share range, checks if it only contains one namespace and returns
 // that namespace ID.
-// The provided range, defined by startShare and endShare, is end-exclusive.
-func ParseNamespace(rawShares []share.Share, startShare int, endShare int) (share.Namespace, error) {
-	if startShare < 0 {
-		return share.Namespace{}, fmt.Errorf("start share %d should be positive", startShare)
+// The provided share range is end-exclusive.
+func ParseNamespace(rawShares []share.Share, shareRange share.Range) (share.Namespace, error) {
+	if shareRange.Start < 0 {
+		return share.Namespace{}, fmt.Errorf("start share %d should be positive", shareRange.Start)
 	}
 
-	if endShare < 0 {
-		return share.Namespace{}, fmt.Errorf("end share %d should be positive", endShare)
+	if shareRange.End < 0 {
+		return share.Namespace{}, fmt.Errorf("end share %d should be positive", shareRange.End)
 	}
 
-	if endShare <= startShare {
-		return share.Namespace{}, fmt.Errorf("end share %d cannot be lower or equal to the starting share %d", endShare, startShare)
+	if shareRange.End <= shareRange.Start {
+		return share.Namespace{}, fmt.Errorf("end share %d cannot be lower or equal to the starting share %d", shareRange.End, shareRange.Start)
 	}
 
-	if endShare > len(rawShares) {
-		return share.Namespace{}, fmt.Errorf("end share %d is higher than block shares %d", endShare, len(rawShares))
+	if shareRange.End > len(rawShares) {
+		return share.Namespace{}, fmt.Errorf("end share %d is higher than block shares %d", shareRange.End, len(rawShares))
 	}
 
-	startShareNs := rawShares[startShare].Namespace()
-	for i, sh := range rawShares[startShare:endShare] {
+	startShareNs := rawShares[shareRange.Start].Namespace()
+	for i, sh := range rawShares[shareRange.Start:shareRange.End] {
 		ns := sh.Namespace()
 		if !bytes.Equal(startShareNs.Bytes(), ns.Bytes()) {
 			return share.Namespace{}, fmt.Errorf("shares range contain different namespaces at index %d: %v and %v ", i, startShareNs, ns)
@@ -0,0 +1,79 @@
+package shares
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+// ErrSequenceLenOverflow is returned for a blob whose data is longer than the
+// sequence length of its first share, a uint32, can represent. go-square
+// would otherwise truncate the sequence length of such a blob.
+var ErrSequenceLenOverflow = errors.New("blob data exceeds the max sequence length")
+
+// BlobTooLargeError is returned for a blob whose shares don't fit in a data
+// square of SquareSize.
+type BlobTooLargeError struct {
+	// Size is the size of the data of the blob in bytes.
+	Size int
+	// MaxSize is the max size of the data of a blob, with the share version
+	// of the blob, that fits in the square.
+	MaxSize    int
+	SquareSize int
+}
+
+func (e *BlobTooLargeError) Error() string {
+	return fmt.Sprintf("blob of %d bytes exceeds the max blob size of %d bytes of a square of size %d", e.Size, e.MaxSize, e.SquareSize)
+}
+
+// MaxBlobSize returns the max size in bytes of the data of a blob of share
+// version 0 that fits in a data square of squareSize. The blob shares the
+// square with at least one share of the PFB that pays for it so it has at most
+// squareSize*squareSize-1 shares. The max size of a blob of share version 1
+// is share.SignerSize bytes less since its first share holds the signer.
+func MaxBlobSize(squareSize int) int {
+	if squareSize <= 0 {
+		return 0
+	}
+	maxSize := share.AvailableBytesFromSparseShares(squareSize*squareSize - 1)
+	return min(maxSize, math.MaxUint32)
+}
+
+// ValidateBlobSize returns ErrSequenceLenOverflow if the sequence length of
+// the blob can't represent the size of its data and a *BlobTooLargeError if
+// the blob doesn't fit in a data square of squareSize.
+func ValidateBlobSize(blob *share.Blob, squareSize int) error {
+	return validateBlobSize(blob.DataLen(), len(blob.Signer()), squareSize)
+}
+
+// validateBlobSize is ValidateBlobSize for a blob of size bytes with a signer
+// of signerSize bytes. The square capacity isn't checked if squareSize is 0.
+func validateBlobSize(size, signerSize, squareSize int) error {
+	if uint64(size) > math.MaxUint32 {
+		return fmt.Errorf("%w: %d bytes", ErrSequenceLenOverflow, size)
+	}
+	if squareSize <= 0 {
+		return nil
+	}
+	if blobSharesNeeded(size, signerSize) > squareSize*squareSize-1 {
+		return &BlobTooLargeError{
+			Size:       size,
+			MaxSize:    max(MaxBlobSize(squareSize)-signerSize, 0),
+			SquareSize: squareSize,
+		}
+	}
+	return nil
+}
+
+// blobSharesNeeded returns the number of sparse shares of a blob of size bytes
+// with a signer of signerSize bytes.
+func blobSharesNeeded(size, signerSize int) int {
+	first := share.FirstSparseShareContentSize - signerSize
+	if size <= first {
+		return 1
+	}
+	continuation := share.ContinuationSparseShareContentSize
+	return 1 + (size-first+continuation-1)/continuation
+}
@@ -0,0 +1,81 @@
+package shares
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxBlobSize(t *testing.T) {
+	assert.Equal(t, 0, MaxBlobSize(0))
+	assert.Equal(t, 0, MaxBlobSize(1))
+	for _, squareSize := range []int{2, 8, 64, 512} {
+		maxSize := MaxBlobSize(squareSize)
+		assert.Equal(t, squareSize*squareSize-1, share.SparseSharesNeeded(uint32(maxSize)), squareSize)
+		assert.Equal(t, squareSize*squareSize, share.SparseSharesNeeded(uint32(maxSize+1)), squareSize)
+	}
+}
+
+func TestValidateBlobSize(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	signer := bytes.Repeat([]byte{1}, share.SignerSize)
+	squareSize := 4
+	maxSize := MaxBlobSize(squareSize)
+
+	newV0Blob := func(size int) *share.Blob {
+		blob, err := share.NewV0Blob(ns, bytes.Repeat([]byte{1}, size))
+		require.NoError(t, err)
+		return blob
+	}
+	newV1Blob := func(size int) *share.Blob {
+		blob, err := share.NewV1Blob(ns, bytes.Repeat([]byte{1}, size), signer)
+		require.NoError(t, err)
+		return blob
+	}
+
+	testCases := []struct {
+		name    string
+		blob    *share.Blob
+		maxSize int
+	}{
+		{name: "max size v0 blob", blob: newV0Blob(maxSize)},
+		{name: "too large v0 blob", blob: newV0Blob(maxSize + 1), maxSize: maxSize},
+		{name: "max size v1 blob", blob: newV1Blob(maxSize - share.SignerSize)},
+		{name: "too large v1 blob", blob: newV1Blob(maxSize - share.SignerSize + 1), maxSize: maxSize - share.SignerSize},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateBlobSize(tc.blob, squareSize)
+			splitter := NewSparseShareSplitter(SplitterHooks{}).WithMaxSquareSize(squareSize)
+			writeErr := splitter.Write(tc.blob)
+			streaming := NewStreamingSparseShareSplitter(func(share.Share) error { return nil }).WithMaxSquareSize(squareSize)
+			streamingErr := streaming.WriteBlobFrom(bytes.NewReader(tc.blob.Data()), ns, tc.blob.ShareVersion(), tc.blob.Signer(), uint32(tc.blob.DataLen()))
+			if tc.maxSize == 0 {
+				require.NoError(t, err)
+				require.NoError(t, writeErr)
+				require.NoError(t, streamingErr)
+				assert.Equal(t, squareSize*squareSize-1, splitter.Count())
+				assert.Equal(t, squareSize*squareSize-1, streaming.Count())
+				return
+			}
+
+			var tooLarge *BlobTooLargeError
+			require.ErrorAs(t, err, &tooLarge)
+			assert.Equal(t, &BlobTooLargeError{Size: tc.blob.DataLen(), MaxSize: tc.maxSize, SquareSize: squareSize}, tooLarge)
+			assert.Equal(t, err, writeErr)
+			assert.Equal(t, err, streamingErr)
+			assert.Zero(t, splitter.Count())
+			assert.Zero(t, streaming.Count())
+		})
+	}
+
+	// the square capacity isn't checked without a max square size
+	require.NoError(t, NewSparseShareSplitter(SplitterHooks{}).Write(newV0Blob(maxSize+1)))
+	assert.ErrorIs(t, validateBlobSize(math.MaxUint32+1, 0, 0), ErrSequenceLenOverflow)
+	assert.NoError(t, validateBlobSize(math.MaxUint32, 0, 0))
+}
@@ -0,0 +1,143 @@
+package shares
+
+import (
+	"bytes"
+	"testing"
+
+	v1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksumSharesRoundTrip(t *testing.T) {
+	ns := share.RandomBlobNamespace()
+	sizes := []int{
+		1,
+		firstChecksumShareContentSize,
+		firstChecksumShareContentSize + 1,
+		firstChecksumShareContentSize + continuationChecksumShareContentSize,
+		10_000,
+	}
+	for _, size := range sizes {
+		data := bytes.Repeat([]byte{0xab}, size)
+		shares, err := SplitChecksumShares(ns, data)
+		require.NoError(t, err, size)
+		require.Len(t, shares, ChecksumSharesNeeded(uint32(size)), size)
+		for _, s := range shares {
+			assert.Equal(t, ChecksumShareVersion, s.Version(), size)
+			assert.True(t, s.Namespace().Equals(ns), size)
+		}
+
+		got, err := ParseChecksumShares(shares)
+		require.NoError(t, err, size)
+		assert.Equal(t, data, got, size)
+	}
+
+	_, err := SplitChecksumShares(ns, nil)
+	assert.Error(t, err)
+}
+
+func TestVerifyShareChecksum(t *testing.T) {
+	shares, err := SplitChecksumShares(share.RandomBlobNamespace(), bytes.Repeat([]byte{1}, 1000))
+	require.NoError(t, err)
+
+	// a flipped bit anywhere after the info byte is detected
+	for _, index := range []int{payloadStart, payloadStart + share.SequenceLenBytes, 300, checksumStart - 1, share.ShareSize - 1} {
+		raw := append([]byte(nil), shares[0].ToBytes()...)
+		raw[index] ^= 1
+		corrupted, err := share.NewShare(raw)
+		require.NoError(t, err)
+		assert.ErrorIs(t, VerifyShareChecksum(*corrupted), ErrChecksumMismatch, index)
+
+		blobShares := append([]share.Share{*corrupted}, shares[1:]...)
+		_, err = ParseChecksumShares(blobShares)
+		assert.Error(t, err, index)
+	}
+
+	raw := append([]byte(nil), shares[1].ToBytes()...)
+	raw[400] ^= 0xff
+	corrupted, err := share.NewShare(raw)
+	require.NoError(t, err)
+	_, err = ParseChecksumShares([]share.Share{shares[0], *corrupted, shares[2]})
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+	assert.ErrorContains(t, err, "share 1")
+
+	v0Shares := share.TailPaddingShares(1)
+	assert.Error(t, VerifyShareChecksum(v0Shares[0]))
+}
+
+func TestParseChecksumSharesSequence(t *testing.T) {
+	ns := share.RandomBlobNamespace()
+	shares, err := SplitChecksumShares(ns, bytes.Repeat([]byte{1}, 1000))
+	require.NoError(t, err)
+	other, err := SplitChecksumShares(share.RandomBlobNamespace(), bytes.Repeat([]byte{1}, 1000))
+	require.NoError(t, err)
+
+	_, err = ParseChecksumShares(nil)
+	assert.Error(t, err)
+	_, err = ParseChecksumShares(shares[1:])
+	assert.ErrorContains(t, err, "doesn't start a sequence")
+	_, err = ParseChecksumShares(shares[:2])
+	assert.ErrorContains(t, err, "needs 3 shares, got 2")
+	_, err = ParseChecksumShares([]share.Share{shares[0], other[1], shares[2]})
+	assert.ErrorContains(t, err, "share 1 doesn't continue the sequence")
+}
+
+// TestChecksumSharesOverhead measures the extra shares that the checksums
+// cost compared to share version 0.
+func TestChecksumSharesOverhead(t *testing.T) {
+	for _, size := range []uint32{1, 1_000, 100_000, 1_000_000, 8_000_000} {
+		v0 := share.SparseSharesNeeded(size)
+		checksum := ChecksumSharesNeeded(size)
+		assert.GreaterOrEqual(t, checksum, v0, size)
+		// 482 / 478 bytes of data per continuation share, rounded up
+		assert.LessOrEqual(t, float64(checksum), float64(v0)*1.0084+1, size)
+	}
+}
+
+func TestChecksumSharesSupported(t *testing.T) {
+	for _, v := range []uint64{v1.Version, v2.Version, v3.Version} {
+		assert.False(t, ChecksumSharesSupported(v), v)
+	}
+}
+
+func BenchmarkSplitSparseShares(b *testing.B) {
+	blob, err := share.NewV0Blob(share.RandomBlobNamespace(), bytes.Repeat([]byte{1}, 1_000_000))
+	require.NoError(b, err)
+	b.SetBytes(1_000_000)
+	b.ResetTimer()
+	for range b.N {
+		if _, err := blob.ToShares(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSplitChecksumShares(b *testing.B) {
+	ns := share.RandomBlobNamespace()
+	data := bytes.Repeat([]byte{1}, 1_000_000)
+	b.SetBytes(1_000_000)
+	b.ResetTimer()
+	for range b.N {
+		if _, err := SplitChecksumShares(ns, data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkVerifyShareChecksum(b *testing.B) {
+	shares, err := SplitChecksumShares(share.RandomBlobNamespace(), bytes.Repeat([]byte{1}, 1_000_000))
+	require.NoError(b, err)
+	b.SetBytes(int64(len(shares) * share.ShareSize))
+	b.ResetTimer()
+	for range b.N {
+		for _, s := range shares {
+			if err := VerifyShareChecksum(s); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
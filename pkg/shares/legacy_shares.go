@@ -0,0 +1,201 @@
+package shares
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// The legacy share layout is the layout of the data squares produced before
+// blobs were introduced, when blobs were called messages and evidence was
+// part of the square. A legacy share consists of:
+//
+//   - an 8 byte namespace ID
+//   - an info byte whose 7 most significant bits are the share version and
+//     whose least significant bit is set if the share starts a sequence
+//   - the varint encoded sequence length if the share starts a sequence
+//   - for compact shares, i.e. shares of a reserved namespace, a reserved
+//     byte that is the index of the first unit that starts in the share or 0
+//   - the data of the sequence
+//
+// The units of compact sequences, e.g. txs, are prefixed with their varint
+// encoded length.
+const (
+	LegacyShareSize         = 256
+	LegacyNamespaceSize     = 8
+	legacyShareInfoBytes    = 1
+	legacyShareReservedByte = 1
+)
+
+var (
+	LegacyTxNamespace                     = legacyNamespace(1)
+	LegacyIntermediateStateRootsNamespace = legacyNamespace(2)
+	LegacyEvidenceNamespace               = legacyNamespace(3)
+	LegacyPayForMessageNamespace          = legacyNamespace(4)
+	// LegacyMaxReservedNamespace is the highest namespace reserved for
+	// compact shares.
+	LegacyMaxReservedNamespace = legacyNamespace(255)
+	LegacyTailPaddingNamespace = []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE}
+	LegacyParityNamespace      = bytes.Repeat([]byte{0xFF}, LegacyNamespaceSize)
+)
+
+func legacyNamespace(id byte) []byte {
+	ns := make([]byte, LegacyNamespaceSize)
+	ns[LegacyNamespaceSize-1] = id
+	return ns
+}
+
+// LegacySquare is the content of a data square of the legacy share layout.
+type LegacySquare struct {
+	Txs                    [][]byte
+	IntermediateStateRoots [][]byte
+	Evidence               [][]byte
+	// PayForMessages are the txs that paid for Messages.
+	PayForMessages [][]byte
+	Messages       []LegacyMessage
+}
+
+// LegacyMessage is the predecessor of a blob.
+type LegacyMessage struct {
+	NamespaceID []byte
+	Data        []byte
+}
+
+// legacySequence is a sequence of consecutive legacy shares of a namespace.
+type legacySequence struct {
+	namespace []byte
+	length    uint64
+	data      []byte
+	compact   bool
+}
+
+// ParseLegacyShares parses the shares of a data square of the legacy share
+// layout. It returns an error if the shares don't follow the layout, e.g. if
+// their namespaces aren't ordered or a sequence is shorter than its length.
+// Tail padding and namespace padding are skipped.
+func ParseLegacyShares(shares [][]byte) (LegacySquare, error) {
+	sequences, err := parseLegacySequences(shares)
+	if err != nil {
+		return LegacySquare{}, err
+	}
+
+	var legacySquare LegacySquare
+	for _, seq := range sequences {
+		if !seq.compact {
+			legacySquare.Messages = append(legacySquare.Messages, LegacyMessage{NamespaceID: seq.namespace, Data: seq.data})
+			continue
+		}
+		units, err := parseLegacyUnits(seq.data)
+		if err != nil {
+			return LegacySquare{}, fmt.Errorf("namespace %X: %w", seq.namespace, err)
+		}
+		switch {
+		case bytes.Equal(seq.namespace, LegacyTxNamespace):
+			legacySquare.Txs = append(legacySquare.Txs, units...)
+		case bytes.Equal(seq.namespace, LegacyIntermediateStateRootsNamespace):
+			legacySquare.IntermediateStateRoots = append(legacySquare.IntermediateStateRoots, units...)
+		case bytes.Equal(seq.namespace, LegacyEvidenceNamespace):
+			legacySquare.Evidence = append(legacySquare.Evidence, units...)
+		case bytes.Equal(seq.namespace, LegacyPayForMessageNamespace):
+			legacySquare.PayForMessages = append(legacySquare.PayForMessages, units...)
+		default:
+			return LegacySquare{}, fmt.Errorf("unknown reserved namespace %X", seq.namespace)
+		}
+	}
+	return legacySquare, nil
+}
+
+func parseLegacySequences(shares [][]byte) ([]legacySequence, error) {
+	var (
+		sequences []legacySequence
+		current   *legacySequence
+		prevNs    []byte
+	)
+	// finish verifies that the current sequence is complete and truncates
+	// its data to its length.
+	finish := func() error {
+		if current == nil {
+			return nil
+		}
+		if uint64(len(current.data)) < current.length {
+			return fmt.Errorf("sequence of namespace %X has %d bytes but a length of %d", current.namespace, len(current.data), current.length)
+		}
+		current.data = current.data[:current.length]
+		// sequences of length 0 are namespace padding
+		if current.length > 0 {
+			sequences = append(sequences, *current)
+		}
+		current = nil
+		return nil
+	}
+
+	for i, s := range shares {
+		if len(s) != LegacyShareSize {
+			return nil, fmt.Errorf("share %d has %d bytes, expected %d", i, len(s), LegacyShareSize)
+		}
+		ns := s[:LegacyNamespaceSize]
+		if prevNs != nil && bytes.Compare(ns, prevNs) < 0 {
+			return nil, fmt.Errorf("share %d of namespace %X follows namespace %X", i, ns, prevNs)
+		}
+		prevNs = ns
+		if bytes.Equal(ns, LegacyTailPaddingNamespace) {
+			continue
+		}
+		if bytes.Equal(ns, LegacyParityNamespace) {
+			return nil, fmt.Errorf("share %d has the parity namespace", i)
+		}
+
+		info := s[LegacyNamespaceSize]
+		if version := info >> 1; version != 0 {
+			return nil, fmt.Errorf("share %d has unsupported share version %d", i, version)
+		}
+		rest := s[LegacyNamespaceSize+legacyShareInfoBytes:]
+		if info&1 == 1 {
+			if err := finish(); err != nil {
+				return nil, err
+			}
+			length, n := binary.Uvarint(rest)
+			if n <= 0 {
+				return nil, fmt.Errorf("share %d has an invalid sequence length", i)
+			}
+			rest = rest[n:]
+			current = &legacySequence{
+				namespace: append([]byte(nil), ns...),
+				length:    length,
+				compact:   bytes.Compare(ns, LegacyMaxReservedNamespace) <= 0,
+			}
+		} else if current == nil || !bytes.Equal(current.namespace, ns) {
+			return nil, fmt.Errorf("share %d continues a sequence of namespace %X that didn't start", i, ns)
+		}
+		if current.compact {
+			reservedByte := int(rest[0])
+			if reservedByte != 0 && (reservedByte < LegacyShareSize-len(rest)+legacyShareReservedByte || reservedByte >= LegacyShareSize) {
+				return nil, fmt.Errorf("share %d has reserved byte %d outside of its data", i, reservedByte)
+			}
+			rest = rest[legacyShareReservedByte:]
+		}
+		current.data = append(current.data, rest...)
+	}
+	if err := finish(); err != nil {
+		return nil, err
+	}
+	return sequences, nil
+}
+
+func parseLegacyUnits(data []byte) ([][]byte, error) {
+	var units [][]byte
+	for len(data) > 0 {
+		length, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, errors.New("invalid unit length delimiter")
+		}
+		data = data[n:]
+		if uint64(len(data)) < length {
+			return nil, fmt.Errorf("unit of length %d exceeds the remaining %d bytes", length, len(data))
+		}
+		units = append(units, data[:length])
+		data = data[length:]
+	}
+	return units, nil
+}
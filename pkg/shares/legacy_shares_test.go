@@ -0,0 +1,137 @@
+package shares
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// legacySequenceShares splits data into legacy shares of ns. If units is
+// non-nil, data is a compact sequence of units and units are the offsets in
+// data at which they start.
+func legacySequenceShares(ns []byte, data []byte, units []int) [][]byte {
+	compact := units != nil
+	var shares [][]byte
+	for offset, first := 0, true; first || offset < len(data); first = false {
+		s := make([]byte, 0, LegacyShareSize)
+		s = append(s, ns...)
+		if first {
+			s = append(s, 1)
+			s = binary.AppendUvarint(s, uint64(len(data)))
+		} else {
+			s = append(s, 0)
+		}
+		if compact {
+			s = append(s, 0)
+		}
+		end := min(offset+LegacyShareSize-len(s), len(data))
+		if compact {
+			for _, unit := range units {
+				if unit >= offset && unit < end {
+					s[len(s)-1] = byte(len(s) + unit - offset)
+					break
+				}
+			}
+		}
+		s = append(s, data[offset:end]...)
+		shares = append(shares, append(s, make([]byte, LegacyShareSize-len(s))...))
+		offset = end
+	}
+	return shares
+}
+
+func legacyCompactShares(ns []byte, units ...[]byte) [][]byte {
+	var data []byte
+	starts := make([]int, len(units))
+	for i, unit := range units {
+		starts[i] = len(data)
+		data = binary.AppendUvarint(data, uint64(len(unit)))
+		data = append(data, unit...)
+	}
+	return legacySequenceShares(ns, data, starts)
+}
+
+func legacyTestSquare(t *testing.T) ([][]byte, LegacySquare) {
+	messageNs := []byte{0, 0, 0, 0, 0, 0, 1, 0}
+	want := LegacySquare{
+		Txs:            [][]byte{bytes.Repeat([]byte{1}, 300), bytes.Repeat([]byte{2}, 10)},
+		Evidence:       [][]byte{bytes.Repeat([]byte{3}, 50)},
+		PayForMessages: [][]byte{bytes.Repeat([]byte{4}, 120)},
+		Messages:       []LegacyMessage{{NamespaceID: messageNs, Data: bytes.Repeat([]byte{5}, 600)}},
+	}
+	var shares [][]byte
+	shares = append(shares, legacyCompactShares(LegacyTxNamespace, want.Txs...)...)
+	shares = append(shares, legacyCompactShares(LegacyEvidenceNamespace, want.Evidence...)...)
+	shares = append(shares, legacyCompactShares(LegacyPayForMessageNamespace, want.PayForMessages...)...)
+	shares = append(shares, legacySequenceShares(messageNs, want.Messages[0].Data, nil)...)
+	for len(shares) < 16 {
+		shares = append(shares, legacySequenceShares(LegacyTailPaddingNamespace, nil, nil)...)
+	}
+	require.Len(t, shares, 16)
+	return shares, want
+}
+
+func TestParseLegacyShares(t *testing.T) {
+	shares, want := legacyTestSquare(t)
+	got, err := ParseLegacyShares(shares)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestParseLegacySharesInvalid(t *testing.T) {
+	shares, _ := legacyTestSquare(t)
+
+	t.Run("unordered namespaces", func(t *testing.T) {
+		invalid := append([][]byte{}, shares...)
+		invalid[0], invalid[3] = invalid[3], invalid[0]
+		_, err := ParseLegacyShares(invalid)
+		assert.ErrorContains(t, err, "follows namespace")
+	})
+	t.Run("truncated sequence", func(t *testing.T) {
+		_, err := ParseLegacyShares(shares[1:])
+		assert.ErrorContains(t, err, "didn't start")
+	})
+	t.Run("sequence shorter than its length", func(t *testing.T) {
+		invalid := append([][]byte{}, shares...)
+		// drop the continuation share of the txs
+		invalid = append(invalid[:1], invalid[2:]...)
+		_, err := ParseLegacyShares(invalid)
+		assert.ErrorContains(t, err, "length")
+	})
+	t.Run("wrong share size", func(t *testing.T) {
+		_, err := ParseLegacyShares([][]byte{shares[0][:100]})
+		assert.ErrorContains(t, err, "bytes, expected")
+	})
+}
+
+func TestShareLayoutSchedule(t *testing.T) {
+	schedule := ShareLayoutSchedule{BlobLayoutHeight: 10}
+	assert.Equal(t, LegacyShareLayout, schedule.Layout(9))
+	assert.Equal(t, BlobShareLayout, schedule.Layout(10))
+	assert.Equal(t, BlobShareLayout, ShareLayoutSchedule{}.Layout(1))
+
+	legacyShares, legacySquare := legacyTestSquare(t)
+	parsed, err := schedule.ParseSquare(9, legacyShares)
+	require.NoError(t, err)
+	assert.Equal(t, LegacyShareLayout, parsed.Layout)
+	assert.Equal(t, append(legacySquare.Txs, legacySquare.PayForMessages...), parsed.Txs)
+	assert.Equal(t, []ParsedBlob{{Namespace: legacySquare.Messages[0].NamespaceID, Data: legacySquare.Messages[0].Data}}, parsed.Blobs)
+
+	// legacy shares can't be parsed as blob shares
+	_, err = schedule.ParseSquare(10, legacyShares)
+	assert.Error(t, err)
+
+	txs := [][]byte{bytes.Repeat([]byte{1}, 100)}
+	dataSquare, err := square.Construct(txs, appconsts.DefaultSquareSizeUpperBound, appconsts.DefaultSubtreeRootThreshold)
+	require.NoError(t, err)
+	parsed, err = schedule.ParseSquare(10, share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	assert.Equal(t, BlobShareLayout, parsed.Layout)
+	assert.Equal(t, txs, parsed.Txs)
+}
@@ -0,0 +1,111 @@
+package shares
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+// ShareError describes shares that ParseSharesLenient couldn't parse into a
+// sequence.
+type ShareError struct {
+	// Range is the range of the indexes of the shares, in the shares passed to
+	// ParseSharesLenient, that the error applies to.
+	Range share.Range
+	Err   error
+}
+
+func (e ShareError) Error() string {
+	if e.Range.End-e.Range.Start == 1 {
+		return fmt.Sprintf("share %d: %v", e.Range.Start, e.Err)
+	}
+	return fmt.Sprintf("shares [%d, %d): %v", e.Range.Start, e.Range.End, e.Err)
+}
+
+func (e ShareError) Unwrap() error {
+	return e.Err
+}
+
+// ParseSharesLenient parses shares into sequences like share.ParseShares but
+// doesn't stop at the first malformed share. It returns every sequence that
+// could be parsed and an error for each share, or range of shares, that
+// couldn't, so explorers and recovery tooling can show what is left of
+// possibly corrupted data:
+//   - a share of the wrong size or an unsupported share version,
+//   - a continuation share that doesn't continue a sequence of its namespace,
+//   - a sequence of which the number of shares doesn't match its sequence
+//     length, e.g. because one of its shares is malformed.
+//
+// If ignorePadding is true, padding sequences are omitted from the result.
+// For valid shares, the sequences are the same as those of share.ParseShares.
+func ParseSharesLenient(rawShares [][]byte, ignorePadding bool) ([]share.Sequence, []ShareError) {
+	var (
+		sequences []share.Sequence
+		errs      []ShareError
+		current   share.Sequence
+		start     int
+	)
+	finish := func(end int) {
+		if len(current.Shares) == 0 {
+			return
+		}
+		if err := validSequenceLen(current); err != nil {
+			errs = append(errs, ShareError{Range: share.NewRange(start, end), Err: err})
+		} else if !ignorePadding || !isPaddingSequence(current) {
+			sequences = append(sequences, current)
+		}
+		current = share.Sequence{}
+	}
+
+	for i, raw := range rawShares {
+		s, err := share.NewShare(raw)
+		if err == nil {
+			err = s.CheckVersionSupported()
+		}
+		if err != nil {
+			// the sequence continues after the malformed share so that it
+			// is reported as a whole once it ends.
+			errs = append(errs, ShareError{Range: share.NewRange(i, i+1), Err: err})
+			continue
+		}
+
+		if s.IsSequenceStart() {
+			finish(i)
+			current = share.Sequence{Namespace: s.Namespace(), Shares: []share.Share{*s}}
+			start = i
+			continue
+		}
+		if len(current.Shares) == 0 || !bytes.Equal(current.Namespace.Bytes(), s.Namespace().Bytes()) {
+			finish(i)
+			errs = append(errs, ShareError{Range: share.NewRange(i, i+1), Err: fmt.Errorf("continuation share of namespace %x doesn't continue a sequence", s.Namespace().Bytes())})
+			continue
+		}
+		current.Shares = append(current.Shares, *s)
+	}
+	finish(len(rawShares))
+
+	return sequences, errs
+}
+
+// validSequenceLen returns an error if the number of shares of seq doesn't
+// match the number of shares needed for the sequence length in its first
+// share.
+func validSequenceLen(seq share.Sequence) error {
+	if isPaddingSequence(seq) {
+		return nil
+	}
+	first := seq.Shares[0]
+	sharesNeeded := share.SparseSharesNeeded(first.SequenceLen())
+	if first.IsCompactShare() {
+		sharesNeeded = share.CompactSharesNeeded(first.SequenceLen())
+	}
+	if len(seq.Shares) != sharesNeeded {
+		return fmt.Errorf("share sequence has %d shares but needed %d shares", len(seq.Shares), sharesNeeded)
+	}
+	return nil
+}
+
+func isPaddingSequence(seq share.Sequence) bool {
+	return len(seq.Shares) == 1 && seq.Shares[0].IsPadding()
+}
@@ -0,0 +1,81 @@
+package shares
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSharesLenient(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	compact := share.NewCompactShareSplitter(share.TxNamespace, share.ShareVersionZero)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, compact.WriteTx(bytes.Repeat([]byte{byte(i)}, 200)))
+	}
+	txShares, err := compact.Export()
+	require.NoError(t, err)
+	splitter := share.NewSparseShareSplitter()
+	for _, size := range []int{100, 2000, 100} {
+		blob, err := share.NewV0Blob(ns, bytes.Repeat([]byte{byte(size)}, size))
+		require.NoError(t, err)
+		require.NoError(t, splitter.Write(blob))
+	}
+	padding, err := share.NamespacePaddingShare(ns, share.ShareVersionZero)
+	require.NoError(t, err)
+	// 5 tx shares, a blob of 1 share, a blob of 5 shares, a blob of 1 share
+	// and a padding share.
+	shares := append(append(txShares, splitter.Export()...), padding)
+	validShares := share.ToBytes(shares)
+	require.Len(t, validShares, 13)
+
+	t.Run("valid shares", func(t *testing.T) {
+		for _, ignorePadding := range []bool{true, false} {
+			want, err := share.ParseShares(shares, ignorePadding)
+			require.NoError(t, err)
+			got, errs := ParseSharesLenient(validShares, ignorePadding)
+			assert.Empty(t, errs)
+			assert.Equal(t, want, got)
+		}
+	})
+
+	t.Run("malformed share in a sequence", func(t *testing.T) {
+		rawShares := append([][]byte{}, validShares...)
+		rawShares[8] = rawShares[8][:100]
+		sequences, errs := ParseSharesLenient(rawShares, true)
+		require.Len(t, errs, 2)
+		assert.Equal(t, share.NewRange(8, 9), errs[0].Range)
+		assert.ErrorContains(t, errs[0], "share 8: share data must be 512 bytes, got 100")
+		assert.Equal(t, share.NewRange(6, 11), errs[1].Range)
+		assert.ErrorContains(t, errs[1], "shares [6, 11): share sequence has 4 shares but needed 5 shares")
+		// the txs and the blobs before and after the corrupted blob.
+		require.Len(t, sequences, 3)
+		assert.Equal(t, share.TxNamespace, sequences[0].Namespace)
+		assert.Equal(t, shares[5:6], sequences[1].Shares)
+		assert.Equal(t, shares[11:12], sequences[2].Shares)
+	})
+
+	t.Run("unsupported share version", func(t *testing.T) {
+		rawShares := append([][]byte{}, validShares...)
+		rawShares[5] = append([]byte{}, rawShares[5]...)
+		rawShares[5][share.NamespaceSize] = 0xfe
+		sequences, errs := ParseSharesLenient(rawShares, true)
+		require.Len(t, errs, 1)
+		assert.Equal(t, share.NewRange(5, 6), errs[0].Range)
+		assert.ErrorContains(t, errs[0], "unsupported share version")
+		assert.Len(t, sequences, 3)
+	})
+
+	t.Run("continuation share without a sequence start", func(t *testing.T) {
+		sequences, errs := ParseSharesLenient(validShares[7:], true)
+		require.Len(t, errs, 4)
+		for i, err := range errs {
+			assert.Equal(t, share.NewRange(i, i+1), err.Range)
+			assert.ErrorContains(t, err, "doesn't continue a sequence")
+		}
+		require.Len(t, sequences, 1)
+		assert.Equal(t, shares[11:12], sequences[0].Shares)
+	})
+}
@@ -0,0 +1,79 @@
+// Package shares exposes the non-interactive default rules that determine
+// where the blobs of a PFB are placed in the data square and how their share
+// commitments are computed. The rules are those of ADR-013, see
+// specs/src/specs/data_square_layout.md, with the subtree root threshold of an
+// app version. Client libraries can use them to pre-compute the commitments
+// and share indexes of their blobs with the same code as the square builder
+// of the app. It also maps the txs of compact shares to the shares they
+// occupy, and recovers the txs of a partial subset of compact shares.
+//
+// Beyond go-square, it holds the share formats of the app: the splitters that
+// validate blob sizes and report the shares they write, the checksum shares,
+// the legacy share layout, and the lenient and raw data parsers.
+package shares
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// BlobShareLen returns the number of shares that a blob of blobSize bytes
+// occupies with the share version. Blobs of share version 1 also hold the
+// address of their signer in their first share.
+func BlobShareLen(blobSize int, shareVersion uint8) (int, error) {
+	if blobSize <= 0 {
+		return 0, fmt.Errorf("blob size %d must be positive", blobSize)
+	}
+	switch shareVersion {
+	case share.ShareVersionZero:
+		return share.SparseSharesNeeded(uint32(blobSize)), nil
+	case share.ShareVersionOne:
+		return share.SparseSharesNeeded(uint32(blobSize + share.SignerSize)), nil
+	default:
+		return 0, fmt.Errorf("unsupported share version %d", shareVersion)
+	}
+}
+
+// SubtreeWidth returns the number of leaves of the subtrees that the share
+// commitment of a blob of blobShareLen shares is made of in appVersion. It is
+// also the alignment of the first share of the blob in the square.
+func SubtreeWidth(appVersion uint64, blobShareLen int) int {
+	return inclusion.SubTreeWidth(blobShareLen, appconsts.SubtreeRootThreshold(appVersion))
+}
+
+// NextShareIndex returns the index of the first share of a blob of
+// blobShareLen shares that is placed after cursor in appVersion, where cursor
+// is the index after the end of the previous blob. It assumes that the blob
+// fits in the square. It is the canonical implementation of the rule;
+// inclusion.BlobStartIndex builds on it and checks that the blob fits.
+func NextShareIndex(appVersion uint64, cursor, blobShareLen int) int {
+	return inclusion.NextShareIndex(cursor, blobShareLen, appconsts.SubtreeRootThreshold(appVersion))
+}
+
+// PaddingShares returns the number of padding shares that are placed between
+// cursor and the first share of a blob of blobShareLen shares in appVersion.
+func PaddingShares(appVersion uint64, cursor, blobShareLen int) int {
+	return NextShareIndex(appVersion, cursor, blobShareLen) - cursor
+}
+
+// BlobIndexes returns the index of the first share of every blob of a PFB,
+// given the number of shares of each blob in the order in which the square
+// builder places them, when the first blob is placed after cursor in
+// appVersion. It also returns the number of shares from cursor to the end of
+// the last blob, which includes the padding in between the blobs.
+func BlobIndexes(appVersion uint64, cursor int, blobShareLens ...int) (indexes []uint32, sharesUsed int) {
+	sharesUsed, indexes = inclusion.BlobSharesUsedNonInteractiveDefaults(cursor, appconsts.SubtreeRootThreshold(appVersion), blobShareLens...)
+	return indexes, sharesUsed
+}
+
+// Commitment returns the share commitment of blob in appVersion, i.e. the
+// commitment that a MsgPayForBlobs for the blob has to contain. It is the
+// canonical entry point for computing share commitments, which
+// commitment.Create calls.
+func Commitment(appVersion uint64, blob *share.Blob) ([]byte, error) {
+	return inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold(appVersion))
+}
@@ -0,0 +1,101 @@
+package shares_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/shares"
+	"github.com/celestiaorg/celestia-app/v3/pkg/square"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobShareLen(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	signer := bytes.Repeat([]byte{2}, share.SignerSize)
+	for _, size := range []int{1, share.FirstSparseShareContentSize - share.SignerSize, share.FirstSparseShareContentSize, 10_000} {
+		blob, err := share.NewV0Blob(ns, bytes.Repeat([]byte{3}, size))
+		require.NoError(t, err)
+		blobShares, err := blob.ToShares()
+		require.NoError(t, err)
+		got, err := shares.BlobShareLen(size, share.ShareVersionZero)
+		require.NoError(t, err)
+		assert.Equal(t, len(blobShares), got, size)
+
+		blob, err = share.NewV1Blob(ns, bytes.Repeat([]byte{3}, size), signer)
+		require.NoError(t, err)
+		blobShares, err = blob.ToShares()
+		require.NoError(t, err)
+		got, err = shares.BlobShareLen(size, share.ShareVersionOne)
+		require.NoError(t, err)
+		assert.Equal(t, len(blobShares), got, size)
+	}
+
+	_, err := shares.BlobShareLen(0, share.ShareVersionZero)
+	assert.Error(t, err)
+	_, err = shares.BlobShareLen(1, 2)
+	assert.Error(t, err)
+}
+
+func TestPaddingShares(t *testing.T) {
+	threshold := appconsts.SubtreeRootThreshold(appconsts.LatestVersion)
+	// blobs of up to threshold shares aren't aligned.
+	assert.Equal(t, 1, shares.SubtreeWidth(appconsts.LatestVersion, threshold))
+	assert.Equal(t, 0, shares.PaddingShares(appconsts.LatestVersion, 3, threshold))
+	// a blob of 4 thresholds is aligned to 4 shares.
+	assert.Equal(t, 4, shares.SubtreeWidth(appconsts.LatestVersion, 4*threshold))
+	assert.Equal(t, 8, shares.NextShareIndex(appconsts.LatestVersion, 5, 4*threshold))
+	assert.Equal(t, 3, shares.PaddingShares(appconsts.LatestVersion, 5, 4*threshold))
+}
+
+// TestBlobIndexes checks that the blob indexes and commitments computed with
+// the rules match those of the square builder and of a MsgPayForBlobs.
+func TestBlobIndexes(t *testing.T) {
+	threshold := appconsts.SubtreeRootThreshold(appconsts.LatestVersion)
+	sizes := []int{100, 4 * threshold * share.ContinuationSparseShareContentSize, 1000, 2 * threshold * share.ContinuationSparseShareContentSize}
+	blobs := make([]*share.Blob, len(sizes))
+	blobShareLens := make([]int, len(sizes))
+	for i, size := range sizes {
+		// the namespaces are ascending so that the square builder keeps the
+		// order of the blobs.
+		ns := share.MustNewV0Namespace(bytes.Repeat([]byte{byte(i + 1)}, share.NamespaceVersionZeroIDSize))
+		var err error
+		blobs[i], err = share.NewV0Blob(ns, bytes.Repeat([]byte{1}, size))
+		require.NoError(t, err)
+		blobShareLens[i], err = shares.BlobShareLen(size, share.ShareVersionZero)
+		require.NoError(t, err)
+	}
+
+	msg, err := blobtypes.NewMsgPayForBlobs(sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String(), appconsts.LatestVersion, blobs...)
+	require.NoError(t, err)
+	for i, blob := range blobs {
+		commitment, err := shares.Commitment(appconsts.LatestVersion, blob)
+		require.NoError(t, err)
+		assert.Equal(t, msg.ShareCommitments[i], commitment)
+	}
+
+	blobTx, err := tx.MarshalBlobTx([]byte("pfb"), blobs...)
+	require.NoError(t, err)
+	dataSquare, err := square.Construct(appconsts.LatestVersion, [][]byte{blobTx}, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+	layout, err := dataSquare.Layout()
+	require.NoError(t, err)
+
+	// the first blob is placed after the PFB share.
+	indexes, sharesUsed := shares.BlobIndexes(appconsts.LatestVersion, 1, blobShareLens...)
+	var want []uint32
+	wantEnd := 0
+	for _, r := range layout.Ranges {
+		if r.Kind == square.KindBlob {
+			want = append(want, uint32(r.Start))
+			wantEnd = r.End
+		}
+	}
+	assert.Equal(t, want, indexes)
+	assert.Equal(t, wantEnd-1, sharesUsed)
+}
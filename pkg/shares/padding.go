@@ -0,0 +1,83 @@
+package shares
+
+import (
+	"errors"
+
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+var (
+	// reservedPaddingShare and tailPaddingShare are the templates of the
+	// padding shares of the reserved padding namespaces, which are the same
+	// in every square.
+	reservedPaddingShare = share.ReservedPaddingShare()
+	tailPaddingShare     = share.TailPaddingShare()
+)
+
+// NamespacePaddingShares returns n namespace padding shares of ns and
+// shareVersion. They are the same as those of share.NamespacePaddingShares,
+// but they are copies of a single padding share in one buffer rather than n
+// shares that are built and allocated one by one.
+func NamespacePaddingShares(ns share.Namespace, shareVersion uint8, n int) ([]share.Share, error) {
+	if n < 0 {
+		return nil, errors.New("n must be positive")
+	}
+	template, err := share.NamespacePaddingShare(ns, shareVersion)
+	if err != nil {
+		return nil, err
+	}
+	return repeatShare(template, n), nil
+}
+
+// ReservedPaddingShares returns n reserved padding shares, see
+// NamespacePaddingShares.
+func ReservedPaddingShares(n int) []share.Share {
+	return repeatShare(reservedPaddingShare, n)
+}
+
+// TailPaddingShares returns n tail padding shares, see
+// NamespacePaddingShares.
+func TailPaddingShares(n int) []share.Share {
+	return repeatShare(tailPaddingShare, n)
+}
+
+// repeatShare returns n copies of s that are slices of one buffer.
+func repeatShare(s share.Share, n int) []share.Share {
+	buf := make([]byte, n*share.ShareSize)
+	shares := make([]share.Share, n)
+	for i := range shares {
+		data := buf[i*share.ShareSize : (i+1)*share.ShareSize : (i+1)*share.ShareSize]
+		copy(data, s.ToBytes())
+		// data has the size of a share so it is always valid
+		sh, _ := share.NewShare(data)
+		shares[i] = *sh
+	}
+	return shares
+}
+
+// paddingTemplates caches the namespace padding share of every namespace and
+// share version that a splitter writes padding for, so that it is built once
+// per namespace rather than for every padding share.
+type paddingTemplates map[paddingKey]share.Share
+
+type paddingKey struct {
+	ns           string
+	shareVersion uint8
+}
+
+// get returns the namespace padding share of ns and shareVersion.
+func (t *paddingTemplates) get(ns share.Namespace, shareVersion uint8) (share.Share, error) {
+	key := paddingKey{ns: string(ns.Bytes()), shareVersion: shareVersion}
+	if template, ok := (*t)[key]; ok {
+		return template, nil
+	}
+	template, err := share.NamespacePaddingShare(ns, shareVersion)
+	if err != nil {
+		return share.Share{}, err
+	}
+	if *t == nil {
+		*t = make(paddingTemplates)
+	}
+	(*t)[key] = template
+	return template, nil
+}
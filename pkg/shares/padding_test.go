@@ -0,0 +1,58 @@
+package shares
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaddingShares(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+
+	got, err := NamespacePaddingShares(ns, share.ShareVersionOne, 3)
+	require.NoError(t, err)
+	want, err := share.NamespacePaddingShares(ns, share.ShareVersionOne, 3)
+	require.NoError(t, err)
+	assert.Equal(t, share.ToBytes(want), share.ToBytes(got))
+
+	assert.Equal(t, share.ToBytes(share.ReservedPaddingShares(3)), share.ToBytes(ReservedPaddingShares(3)))
+	assert.Equal(t, share.ToBytes(share.TailPaddingShares(3)), share.ToBytes(TailPaddingShares(3)))
+	assert.Empty(t, TailPaddingShares(0))
+
+	_, err = NamespacePaddingShares(ns, share.ShareVersionZero, -1)
+	assert.Error(t, err)
+}
+
+func TestPaddingSharesAreCopies(t *testing.T) {
+	padding := TailPaddingShares(2)
+	padding[0].ToBytes()[share.ShareSize-1] = 1
+	want := share.TailPaddingShare()
+	assert.Equal(t, want.ToBytes(), padding[1].ToBytes())
+	assert.Equal(t, want.ToBytes(), TailPaddingShares(1)[0].ToBytes())
+}
+
+func TestPaddingTemplates(t *testing.T) {
+	nsA := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	nsB := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+
+	var templates paddingTemplates
+	for _, tc := range []struct {
+		ns           share.Namespace
+		shareVersion uint8
+	}{
+		{nsA, share.ShareVersionZero},
+		{nsB, share.ShareVersionZero},
+		{nsA, share.ShareVersionOne},
+		{nsA, share.ShareVersionZero},
+	} {
+		got, err := templates.get(tc.ns, tc.shareVersion)
+		require.NoError(t, err)
+		want, err := share.NamespacePaddingShare(tc.ns, tc.shareVersion)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+	assert.Len(t, templates, 3)
+}
@@ -0,0 +1,137 @@
+package shares
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+// Tx is a tx of compact shares with the range of the shares that it
+// occupies.
+type Tx struct {
+	Data  []byte
+	Range share.Range
+}
+
+// ParsePartialTxs returns the txs that a contiguous subset of the compact
+// shares of a data square contains completely, in the order of the square.
+// offset is the index of the first share of the subset in the square, which
+// the ranges of the txs are relative to.
+//
+// Unlike TxShareRanges, the subset can start and end in the middle of a
+// sequence, e.g. it can be the first K shares of the tx namespace. If the
+// subset starts in the middle of a sequence, the txs are parsed from the first
+// unit that starts in its shares, which the reserved bytes of the shares
+// locate. truncated reports whether the subset ends in the middle of a tx
+// that starts in its shares, i.e. whether the tx that follows the returned txs
+// needs shares that follow the subset.
+func ParsePartialTxs(compactShares []share.Share, offset int) (txs []Tx, truncated bool, err error) {
+	start := 0
+	for start < len(compactShares) {
+		end := start + 1
+		for end < len(compactShares) && !compactShares[end].IsSequenceStart() {
+			end++
+		}
+		sequenceTxs, sequenceTruncated, err := partialSequenceTxs(compactShares[start:end], offset+start, end == len(compactShares))
+		if err != nil {
+			return nil, false, err
+		}
+		txs = append(txs, sequenceTxs...)
+		truncated = sequenceTruncated
+		start = end
+	}
+	return txs, truncated, nil
+}
+
+// partialSequenceTxs returns the txs of the shares of a compact share
+// sequence that start at share offset. The shares can start in the middle of
+// the sequence and, if they are the last shares of the subset, end before the
+// end of the sequence.
+func partialSequenceTxs(sequence []share.Share, offset int, last bool) ([]Tx, bool, error) {
+	for i, sh := range sequence {
+		if !sh.IsCompactShare() {
+			return nil, false, fmt.Errorf("share %d of namespace %x is not a compact share", offset+i, sh.Namespace().Bytes())
+		}
+		if sh.Version() != share.ShareVersionZero {
+			return nil, false, fmt.Errorf("unsupported share version for compact shares %d", sh.Version())
+		}
+		if i > 0 && !bytes.Equal(sh.Namespace().Bytes(), sequence[0].Namespace().Bytes()) {
+			return nil, false, fmt.Errorf("share %d of namespace %x continues a sequence of namespace %x", offset+i, sh.Namespace().Bytes(), sequence[0].Namespace().Bytes())
+		}
+	}
+
+	// first is the index of the first share in which a unit starts.
+	first := 0
+	var rawData []byte
+	if sequence[0].IsSequenceStart() {
+		rawData = append(rawData, sequence[0].RawData()...)
+	} else {
+		for ; first < len(sequence); first++ {
+			data, err := unitStartData(sequence[first])
+			if err != nil {
+				return nil, false, fmt.Errorf("share %d: %w", offset+first, err)
+			}
+			if data != nil {
+				rawData = append(rawData, data...)
+				break
+			}
+		}
+		// no unit starts in the shares.
+		if first == len(sequence) {
+			return nil, false, nil
+		}
+	}
+	shareEnds := make([]int, len(sequence)-first)
+	shareEnds[0] = len(rawData)
+	for i, sh := range sequence[first+1:] {
+		rawData = append(rawData, sh.RawData()...)
+		shareEnds[i+1] = len(rawData)
+	}
+
+	// complete reports whether the shares contain the end of the sequence.
+	// Only the last shares of the subset can end before it.
+	complete := !last
+	if sequence[0].IsSequenceStart() {
+		sequenceLen := int(sequence[0].SequenceLen())
+		switch {
+		case sequenceLen <= len(rawData):
+			rawData = rawData[:sequenceLen]
+			complete = true
+		case complete:
+			return nil, false, fmt.Errorf("sequence length %d of share %d exceeds the %d bytes of raw data of its shares", sequenceLen, offset, len(rawData))
+		}
+	}
+
+	units, truncated, err := parseUnits(rawData, shareEnds)
+	if err != nil {
+		return nil, false, fmt.Errorf("%w in the sequence of share %d", err, offset)
+	}
+	if truncated && complete {
+		return nil, false, fmt.Errorf("the last unit exceeds the sequence of share %d", offset)
+	}
+	txs := make([]Tx, len(units))
+	for i, u := range units {
+		txs[i] = Tx{Data: u.data, Range: share.NewRange(offset+first+u.first, offset+first+u.last+1)}
+	}
+	return txs, truncated, nil
+}
+
+// unitStartData returns the raw data of a compact share from the first unit
+// that starts in it, as located by its reserved bytes, or nil if no unit
+// starts in it.
+func unitStartData(sh share.Share) ([]byte, error) {
+	data := sh.ToBytes()
+	rawDataStart := len(data) - len(sh.RawData())
+	index, err := share.ParseReservedBytes(data[rawDataStart-share.ShareReservedBytes : rawDataStart])
+	if err != nil {
+		return nil, err
+	}
+	if index == 0 {
+		return nil, nil
+	}
+	if int(index) < rawDataStart {
+		return nil, fmt.Errorf("reserved bytes locate a unit at byte %d before the raw data at byte %d", index, rawDataStart)
+	}
+	return data[index:], nil
+}
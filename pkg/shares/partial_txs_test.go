@@ -0,0 +1,80 @@
+package shares_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/shares"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParsePartialTxs checks that every contiguous subset of compact shares
+// yields exactly the txs whose shares it contains.
+func TestParsePartialTxs(t *testing.T) {
+	txSplitter := share.NewCompactShareSplitter(share.TxNamespace, share.ShareVersionZero)
+	pfbSplitter := share.NewCompactShareSplitter(share.PayForBlobNamespace, share.ShareVersionZero)
+	var txs [][]byte
+	for i, size := range []int{10, 200, share.FirstCompactShareContentSize - 2, 1000, 50, 5000, 30} {
+		tx := bytes.Repeat([]byte{byte(i)}, size)
+		require.NoError(t, txSplitter.WriteTx(tx))
+		txs = append(txs, tx)
+	}
+	for i, size := range []int{300, 800} {
+		tx := bytes.Repeat([]byte{byte(100 + i)}, size)
+		require.NoError(t, pfbSplitter.WriteTx(tx))
+		txs = append(txs, tx)
+	}
+	txShares, err := txSplitter.Export()
+	require.NoError(t, err)
+	pfbShares, err := pfbSplitter.Export()
+	require.NoError(t, err)
+	compactShares := append(append([]share.Share{}, txShares...), pfbShares...)
+	ranges, err := shares.TxShareRanges(compactShares)
+	require.NoError(t, err)
+
+	for start := 0; start <= len(compactShares); start++ {
+		for end := start; end <= len(compactShares); end++ {
+			var want []shares.Tx
+			wantTruncated := false
+			for _, tx := range txs {
+				r := ranges[sha256.Sum256(tx)]
+				if r.Start >= start && r.End <= end {
+					want = append(want, shares.Tx{Data: tx, Range: r})
+				}
+				if r.Start >= start && r.Start < end && r.End > end {
+					wantTruncated = true
+				}
+			}
+			got, truncated, err := shares.ParsePartialTxs(compactShares[start:end], start)
+			require.NoError(t, err, "shares [%d, %d)", start, end)
+			assert.Equal(t, want, got, "shares [%d, %d)", start, end)
+			assert.Equal(t, wantTruncated, truncated, "shares [%d, %d)", start, end)
+		}
+	}
+}
+
+func TestParsePartialTxsErrors(t *testing.T) {
+	splitter := share.NewCompactShareSplitter(share.TxNamespace, share.ShareVersionZero)
+	require.NoError(t, splitter.WriteTx(bytes.Repeat([]byte{1}, 1000)))
+	txShares, err := splitter.Export()
+	require.NoError(t, err)
+	pfbSplitter := share.NewCompactShareSplitter(share.PayForBlobNamespace, share.ShareVersionZero)
+	require.NoError(t, pfbSplitter.WriteTx(bytes.Repeat([]byte{2}, 1000)))
+	pfbShares, err := pfbSplitter.Export()
+	require.NoError(t, err)
+	blob, err := share.NewV0Blob(share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize)), []byte("data"))
+	require.NoError(t, err)
+	sparse, err := blob.ToShares()
+	require.NoError(t, err)
+
+	_, _, err = shares.ParsePartialTxs(append(append([]share.Share{}, txShares...), sparse...), 0)
+	assert.ErrorContains(t, err, "is not a compact share")
+	_, _, err = shares.ParsePartialTxs(append(append([]share.Share{}, txShares[:1]...), pfbShares[1:]...), 0)
+	assert.ErrorContains(t, err, "continues a sequence of namespace")
+	// the tx sequence ends before the PFB sequence starts.
+	_, _, err = shares.ParsePartialTxs(append(append([]share.Share{}, txShares[:1]...), pfbShares...), 0)
+	assert.ErrorContains(t, err, "exceeds the")
+}
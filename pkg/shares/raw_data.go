@@ -0,0 +1,92 @@
+package shares
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+// Framing determines how WriteRawData delimits the raw data of a sequence so
+// that the raw data of many sequences can be written to the same stream.
+type Framing uint8
+
+const (
+	// NoFraming writes the raw data without a prefix.
+	NoFraming Framing = iota
+	// UvarintFraming prefixes the raw data with its varint encoded length,
+	// like the units of compact shares.
+	UvarintFraming
+	// Uint32Framing prefixes the raw data with its length as a 4 byte big
+	// endian integer, like the sequence length of the first share of a
+	// sequence.
+	Uint32Framing
+)
+
+func (f Framing) String() string {
+	switch f {
+	case NoFraming:
+		return "none"
+	case UvarintFraming:
+		return "uvarint"
+	case Uint32Framing:
+		return "uint32"
+	default:
+		return fmt.Sprintf("unknown framing %d", uint8(f))
+	}
+}
+
+// WriteRawData writes the raw data of seq to w, prefixed according to
+// framing. The raw data is the same as the one returned by seq.RawData() but
+// it is written share by share instead of being concatenated into one slice
+// first, so reassembling large blobs doesn't need a copy of their data. It
+// returns the number of bytes written.
+func WriteRawData(w io.Writer, seq share.Sequence, framing Framing) (int64, error) {
+	sequenceLen, err := seq.SequenceLen()
+	if err != nil {
+		return 0, err
+	}
+
+	var prefix []byte
+	switch framing {
+	case NoFraming:
+	case UvarintFraming:
+		prefix = binary.AppendUvarint(nil, uint64(sequenceLen))
+	case Uint32Framing:
+		prefix = binary.BigEndian.AppendUint32(nil, sequenceLen)
+	default:
+		return 0, fmt.Errorf("unsupported framing %s", framing)
+	}
+
+	// verify that the shares contain the whole sequence before writing
+	// anything so that w doesn't receive a truncated sequence.
+	available := 0
+	for i := range seq.Shares {
+		available += len(seq.Shares[i].RawData())
+	}
+	if available < int(sequenceLen) {
+		return 0, fmt.Errorf("sequence length %d exceeds the %d bytes of raw data of its %d shares", sequenceLen, available, len(seq.Shares))
+	}
+
+	var total int64
+	if len(prefix) > 0 {
+		written, err := w.Write(prefix)
+		total += int64(written)
+		if err != nil {
+			return total, err
+		}
+	}
+	remaining := int(sequenceLen)
+	for i := 0; remaining > 0; i++ {
+		data := seq.Shares[i].RawData()
+		data = data[:min(len(data), remaining)]
+		written, err := w.Write(data)
+		total += int64(written)
+		if err != nil {
+			return total, err
+		}
+		remaining -= len(data)
+	}
+	return total, nil
+}
@@ -0,0 +1,80 @@
+package shares
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteRawData(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	splitter := share.NewSparseShareSplitter()
+	for _, size := range []int{1, 478, 479, 5000} {
+		blob, err := share.NewV0Blob(ns, bytes.Repeat([]byte{byte(size)}, size))
+		require.NoError(t, err)
+		require.NoError(t, splitter.Write(blob))
+	}
+	compact := share.NewCompactShareSplitter(share.TxNamespace, share.ShareVersionZero)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, compact.WriteTx(bytes.Repeat([]byte{byte(i)}, 200)))
+	}
+	txShares, err := compact.Export()
+	require.NoError(t, err)
+	sequences, err := share.ParseShares(append(txShares, splitter.Export()...), true)
+	require.NoError(t, err)
+	require.Len(t, sequences, 5)
+
+	for _, seq := range sequences {
+		want, err := seq.RawData()
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		n, err := WriteRawData(&buf, seq, NoFraming)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(want)), n)
+		assert.Equal(t, want, buf.Bytes())
+
+		buf.Reset()
+		n, err = WriteRawData(&buf, seq, UvarintFraming)
+		require.NoError(t, err)
+		assert.Equal(t, int64(buf.Len()), n)
+		length, err := binary.ReadUvarint(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(len(want)), length)
+		assert.Equal(t, want, buf.Bytes())
+
+		buf.Reset()
+		_, err = WriteRawData(&buf, seq, Uint32Framing)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(len(want)), binary.BigEndian.Uint32(buf.Next(4)))
+		assert.Equal(t, want, buf.Bytes())
+	}
+
+	t.Run("truncated sequence", func(t *testing.T) {
+		seq := sequences[4]
+		seq.Shares = seq.Shares[:len(seq.Shares)-1]
+		var buf bytes.Buffer
+		_, err := WriteRawData(&buf, seq, NoFraming)
+		assert.ErrorContains(t, err, "exceeds")
+		assert.Zero(t, buf.Len())
+	})
+	t.Run("unsupported framing", func(t *testing.T) {
+		_, err := WriteRawData(&bytes.Buffer{}, sequences[0], Framing(9))
+		assert.ErrorContains(t, err, "unknown framing 9")
+	})
+	t.Run("writer error", func(t *testing.T) {
+		_, err := WriteRawData(failingWriter{}, sequences[0], NoFraming)
+		assert.ErrorIs(t, err, errWrite)
+	})
+}
+
+var errWrite = errors.New("write failed")
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errWrite }
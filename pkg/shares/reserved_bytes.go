@@ -0,0 +1,124 @@
+package shares
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+// ReservedBytes returns the reserved bytes of the compact share s: the index
+// of the byte in the share at which the first unit, i.e. tx, that starts in
+// the share begins. It is 0 if no unit starts in the share.
+func ReservedBytes(s share.Share) (uint32, error) {
+	start, err := reservedBytesStart(s)
+	if err != nil {
+		return 0, err
+	}
+	return share.ParseReservedBytes(s.ToBytes()[start : start+share.ShareReservedBytes])
+}
+
+// SetReservedBytes returns a copy of the compact share s with its reserved
+// bytes set to byteIndex.
+func SetReservedBytes(s share.Share, byteIndex uint32) (share.Share, error) {
+	start, err := reservedBytesStart(s)
+	if err != nil {
+		return share.Share{}, err
+	}
+	reservedBytes, err := share.NewReservedBytes(byteIndex)
+	if err != nil {
+		return share.Share{}, err
+	}
+	data := append([]byte(nil), s.ToBytes()...)
+	copy(data[start:], reservedBytes)
+	updated, err := share.NewShare(data)
+	if err != nil {
+		return share.Share{}, err
+	}
+	return *updated, nil
+}
+
+// ValidateReservedBytes verifies that the reserved bytes of every compact
+// share in shares point to the first unit that starts in the share, as
+// determined by the unit length delimiters of its sequence. Shares that
+// aren't compact are ignored.
+func ValidateReservedBytes(shares []share.Share) error {
+	for i := 0; i < len(shares); {
+		if !shares[i].IsCompactShare() {
+			i++
+			continue
+		}
+		if !shares[i].IsSequenceStart() {
+			return fmt.Errorf("compact share %d continues a sequence that didn't start", i)
+		}
+		end := i + 1
+		for end < len(shares) && shares[end].IsCompactShare() && !shares[end].IsSequenceStart() &&
+			shares[end].Namespace().Equals(shares[i].Namespace()) {
+			end++
+		}
+		expected, err := expectedReservedBytes(shares[i:end])
+		if err != nil {
+			return fmt.Errorf("compact share sequence starting at share %d: %w", i, err)
+		}
+		for j, want := range expected {
+			got, err := ReservedBytes(shares[i+j])
+			if err != nil {
+				return fmt.Errorf("share %d: %w", i+j, err)
+			}
+			if got != want {
+				return fmt.Errorf("share %d has reserved bytes %d but its first unit starts at byte %d", i+j, got, want)
+			}
+		}
+		i = end
+	}
+	return nil
+}
+
+// expectedReservedBytes returns the reserved bytes of each share of the
+// compact share sequence by walking the unit length delimiters of its data.
+func expectedReservedBytes(sequence []share.Share) ([]uint32, error) {
+	var data []byte
+	// contentStart is the index in data at which the content of each share
+	// begins.
+	contentStart := make([]int, len(sequence))
+	for i := range sequence {
+		contentStart[i] = len(data)
+		data = append(data, sequence[i].RawData()...)
+	}
+	sequenceLen := int(sequence[0].SequenceLen())
+	if sequenceLen > len(data) {
+		return nil, fmt.Errorf("sequence length %d exceeds the %d bytes of its shares", sequenceLen, len(data))
+	}
+
+	expected := make([]uint32, len(sequence))
+	shareIndex := 0
+	for pos := 0; pos < sequenceLen; {
+		for shareIndex+1 < len(sequence) && contentStart[shareIndex+1] <= pos {
+			shareIndex++
+		}
+		if expected[shareIndex] == 0 {
+			rawDataStart := share.ShareSize - len(sequence[shareIndex].RawData())
+			expected[shareIndex] = uint32(rawDataStart + pos - contentStart[shareIndex])
+		}
+		unitLen, n := binary.Uvarint(data[pos:sequenceLen])
+		if n <= 0 {
+			return nil, fmt.Errorf("invalid unit length delimiter at byte %d of the sequence", pos)
+		}
+		pos += n + int(unitLen)
+	}
+	return expected, nil
+}
+
+func reservedBytesStart(s share.Share) (int, error) {
+	if !s.IsCompactShare() {
+		return 0, fmt.Errorf("share of namespace %x is not a compact share", s.Namespace().Bytes())
+	}
+	if s.Version() != share.ShareVersionZero {
+		return 0, fmt.Errorf("unsupported share version for compact shares %d", s.Version())
+	}
+	start := share.NamespaceSize + share.ShareInfoBytes
+	if s.IsSequenceStart() {
+		start += share.SequenceLenBytes
+	}
+	return start, nil
+}
@@ -0,0 +1,60 @@
+package shares_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/shares"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateReservedBytes(t *testing.T) {
+	// txs that span multiple compact shares so that units start at different
+	// positions of continuation shares
+	txs := [][]byte{
+		bytes.Repeat([]byte{1}, 100),
+		bytes.Repeat([]byte{2}, 700),
+		bytes.Repeat([]byte{3}, 20),
+		bytes.Repeat([]byte{4}, 1200),
+	}
+	dataSquare, err := square.Construct(txs, appconsts.DefaultSquareSizeUpperBound, appconsts.DefaultSubtreeRootThreshold)
+	require.NoError(t, err)
+	dataShares := []share.Share(dataSquare)
+	require.NoError(t, shares.ValidateReservedBytes(dataShares))
+
+	// The first unit of the first share starts right after the reserved bytes.
+	first, err := shares.ReservedBytes(dataShares[0])
+	require.NoError(t, err)
+	require.Equal(t, uint32(share.ShareSize-share.FirstCompactShareContentSize), first)
+
+	// The tx of 1200 bytes spans the last shares so the last share doesn't
+	// contain the start of a unit.
+	last := 0
+	for i, s := range dataShares {
+		if s.Namespace().Equals(share.TxNamespace) {
+			last = i
+		}
+	}
+	lastReserved, err := shares.ReservedBytes(dataShares[last])
+	require.NoError(t, err)
+	require.Zero(t, lastReserved)
+
+	tampered, err := shares.SetReservedBytes(dataShares[1], 100)
+	require.NoError(t, err)
+	got, err := shares.ReservedBytes(tampered)
+	require.NoError(t, err)
+	require.Equal(t, uint32(100), got)
+	tamperedShares := append([]share.Share(nil), dataShares...)
+	tamperedShares[1] = tampered
+	require.Error(t, shares.ValidateReservedBytes(tamperedShares))
+
+	// Reserved bytes only exist in compact shares.
+	blobShare := share.TailPaddingShare()
+	_, err = shares.ReservedBytes(blobShare)
+	require.Error(t, err)
+	_, err = shares.SetReservedBytes(blobShare, 0)
+	require.Error(t, err)
+}
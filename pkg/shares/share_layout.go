@@ -0,0 +1,105 @@
+package shares
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+// ShareLayout is the layout of the shares of a data square.
+type ShareLayout uint8
+
+const (
+	// BlobShareLayout is the share layout of go-square.
+	BlobShareLayout ShareLayout = iota
+	// LegacyShareLayout is the share layout that predates blobs. See
+	// ParseLegacyShares.
+	LegacyShareLayout
+)
+
+func (l ShareLayout) String() string {
+	switch l {
+	case BlobShareLayout:
+		return "blob"
+	case LegacyShareLayout:
+		return "legacy"
+	default:
+		return fmt.Sprintf("unknown share layout %d", uint8(l))
+	}
+}
+
+// ShareLayoutSchedule determines the share layout of each height of a chain
+// so that archive nodes that upgraded in place can parse and validate the
+// heights that were produced before the blob share layout.
+type ShareLayoutSchedule struct {
+	// BlobLayoutHeight is the first height that uses the blob share layout.
+	// Heights before it use the legacy share layout. If it is 0 or 1, all
+	// heights use the blob share layout.
+	BlobLayoutHeight int64
+}
+
+// Layout returns the share layout of height.
+func (s ShareLayoutSchedule) Layout(height int64) ShareLayout {
+	if height < s.BlobLayoutHeight {
+		return LegacyShareLayout
+	}
+	return BlobShareLayout
+}
+
+// ParsedSquare is the content of a data square independent of its share
+// layout.
+type ParsedSquare struct {
+	Layout ShareLayout
+	// Txs are the txs of the square, including the txs that pay for blobs.
+	Txs   [][]byte
+	Blobs []ParsedBlob
+}
+
+// ParsedBlob is a blob, or a message of the legacy share layout.
+type ParsedBlob struct {
+	Namespace []byte
+	Data      []byte
+}
+
+// ParseSquare parses the shares of the data square of height with the parser
+// of its share layout.
+func (s ShareLayoutSchedule) ParseSquare(height int64, shares [][]byte) (ParsedSquare, error) {
+	layout := s.Layout(height)
+	if layout == LegacyShareLayout {
+		legacySquare, err := ParseLegacyShares(shares)
+		if err != nil {
+			return ParsedSquare{}, fmt.Errorf("parsing legacy shares of height %d: %w", height, err)
+		}
+		parsed := ParsedSquare{
+			Layout: layout,
+			Txs:    append(append([][]byte{}, legacySquare.Txs...), legacySquare.PayForMessages...),
+			Blobs:  make([]ParsedBlob, len(legacySquare.Messages)),
+		}
+		for i, msg := range legacySquare.Messages {
+			parsed.Blobs[i] = ParsedBlob{Namespace: msg.NamespaceID, Data: msg.Data}
+		}
+		return parsed, nil
+	}
+
+	squareShares, err := share.FromBytes(shares)
+	if err != nil {
+		return ParsedSquare{}, fmt.Errorf("parsing shares of height %d: %w", height, err)
+	}
+	txs, err := share.ParseTxs(squareShares)
+	if err != nil {
+		return ParsedSquare{}, fmt.Errorf("parsing txs of height %d: %w", height, err)
+	}
+	blobs, err := share.ParseBlobs(squareShares)
+	if err != nil {
+		return ParsedSquare{}, fmt.Errorf("parsing blobs of height %d: %w", height, err)
+	}
+	parsed := ParsedSquare{
+		Layout: layout,
+		Txs:    txs,
+		Blobs:  make([]ParsedBlob, len(blobs)),
+	}
+	for i, blob := range blobs {
+		parsed.Blobs[i] = ParsedBlob{Namespace: blob.Namespace().Bytes(), Data: blob.Data()}
+	}
+	return parsed, nil
+}
@@ -0,0 +1,70 @@
+package shares
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+// writeBlobShares reads the size bytes of the data of a blob from r and calls
+// emit with every share of the blob, in order. The number of shares is known
+// from the sequence length, so the shares are built in buffers of up to
+// batchShares shares that are allocated at once instead of one allocation per
+// share. Every share is a separate slice of the buffer so emit owns it. ns,
+// shareVersion and signer must be valid for a blob, see share.NewBlob.
+func writeBlobShares(r io.Reader, ns share.Namespace, shareVersion uint8, signer []byte, size uint32, batchShares int, emit func(share.Share) error) error {
+	shareLen, err := BlobShareLen(int(size), shareVersion)
+	if err != nil {
+		return err
+	}
+	infoByte, err := share.NewInfoByte(shareVersion, true)
+	if err != nil {
+		return err
+	}
+	continuationInfoByte, err := share.NewInfoByte(shareVersion, false)
+	if err != nil {
+		return err
+	}
+
+	var buf []byte
+	remaining := size
+	for i := range shareLen {
+		if len(buf) == 0 {
+			buf = make([]byte, min(shareLen-i, batchShares)*share.ShareSize)
+		}
+		data := buf[:0:share.ShareSize]
+		buf = buf[share.ShareSize:]
+
+		data = append(data, ns.Bytes()...)
+		if i == 0 {
+			data = append(data, byte(infoByte))
+			data = binary.BigEndian.AppendUint32(data, size)
+			if shareVersion == share.ShareVersionOne {
+				data = append(data, signer...)
+			}
+		} else {
+			data = append(data, byte(continuationInfoByte))
+		}
+
+		n := min(uint32(share.ShareSize-len(data)), remaining)
+		start := len(data)
+		// the bytes after the blob data are zero padding since make zeroes
+		// the whole buffer.
+		data = data[:share.ShareSize]
+		if _, err := io.ReadFull(r, data[start:start+int(n)]); err != nil {
+			return fmt.Errorf("reading %d bytes of blob data at offset %d: %w", n, size-remaining, err)
+		}
+		remaining -= n
+
+		s, err := share.NewShare(data)
+		if err != nil {
+			return err
+		}
+		if err := emit(*s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
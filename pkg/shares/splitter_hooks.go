@@ -0,0 +1,160 @@
+package shares
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+// SplitterHooks are optional callbacks that observe the shares written by a
+// SparseShareSplitter or CompactShareSplitter, e.g. for fraud provers or
+// debuggers that need to follow how a layout is constructed. Nil hooks are
+// skipped and a splitter without hooks writes the same shares as the
+// go-square splitter.
+type SplitterHooks struct {
+	// OnShareWritten is called with every share that is written, in order.
+	OnShareWritten func(s share.Share)
+	// OnSequenceComplete is called once all shares of a sequence, i.e. a blob
+	// or all compact shares of a namespace, are written. shareRange is
+	// relative to the first share that the splitter wrote.
+	OnSequenceComplete func(ns share.Namespace, shareRange share.Range)
+	// OnPaddingInserted is called when count namespace padding shares are
+	// written after the shares of a blob.
+	OnPaddingInserted func(ns share.Namespace, count int)
+}
+
+func (h SplitterHooks) empty() bool {
+	return h.OnShareWritten == nil && h.OnSequenceComplete == nil && h.OnPaddingInserted == nil
+}
+
+// written calls the hooks of the shares that were written. If sequence is
+// true, they form a complete sequence, otherwise they are padding.
+func (h SplitterHooks) written(shares []share.Share, offset int, sequence bool) {
+	if len(shares) == 0 {
+		return
+	}
+	if h.OnShareWritten != nil {
+		for _, s := range shares {
+			h.OnShareWritten(s)
+		}
+	}
+	ns := shares[0].Namespace()
+	switch {
+	case sequence && h.OnSequenceComplete != nil:
+		h.OnSequenceComplete(ns, share.NewRange(offset, offset+len(shares)))
+	case !sequence && h.OnPaddingInserted != nil:
+		h.OnPaddingInserted(ns, len(shares))
+	}
+}
+
+// SparseShareSplitter splits blobs into the same shares as a
+// share.SparseShareSplitter and calls hooks as blobs and namespace padding
+// shares are written. It allocates the shares of a blob at once from its
+// sequence length, which avoids growing its buffers on every share when many
+// small blobs are written, and copies the namespace padding shares from a
+// template per namespace. It rejects the blobs whose size the sequence length
+// can't represent and, if a max square size is set, the blobs that don't fit
+// in a square of that size, see ValidateBlobSize.
+type SparseShareSplitter struct {
+	shares        []share.Share
+	padding       paddingTemplates
+	hooks         SplitterHooks
+	maxSquareSize int
+}
+
+func NewSparseShareSplitter(hooks SplitterHooks) *SparseShareSplitter {
+	return &SparseShareSplitter{hooks: hooks}
+}
+
+// WithMaxSquareSize sets the size of the square that every blob written to the
+// splitter must fit in.
+func (sss *SparseShareSplitter) WithMaxSquareSize(squareSize int) *SparseShareSplitter {
+	sss.maxSquareSize = squareSize
+	return sss
+}
+
+// Write writes blob to the splitter.
+func (sss *SparseShareSplitter) Write(blob *share.Blob) error {
+	if err := validateBlobSize(blob.DataLen(), len(blob.Signer()), sss.maxSquareSize); err != nil {
+		return err
+	}
+	shareLen, err := BlobShareLen(blob.DataLen(), blob.ShareVersion())
+	if err != nil {
+		return err
+	}
+	start := len(sss.shares)
+	sss.shares = slices.Grow(sss.shares, shareLen)
+	err = writeBlobShares(bytes.NewReader(blob.Data()), blob.Namespace(), blob.ShareVersion(), blob.Signer(), uint32(blob.DataLen()), shareLen, func(s share.Share) error {
+		sss.shares = append(sss.shares, s)
+		return nil
+	})
+	if err != nil {
+		sss.shares = sss.shares[:start]
+		return err
+	}
+	sss.hooks.written(sss.shares[start:], start, true)
+	return nil
+}
+
+// WriteNamespacePaddingShares writes count padding shares with the namespace
+// of the last written share.
+func (sss *SparseShareSplitter) WriteNamespacePaddingShares(count int) error {
+	if count < 0 {
+		return errors.New("cannot write negative namespaced shares")
+	}
+	if count == 0 {
+		return nil
+	}
+	if len(sss.shares) == 0 {
+		return errors.New("cannot write namespace padding shares on an empty SparseShareSplitter")
+	}
+	last := sss.shares[len(sss.shares)-1]
+	template, err := sss.padding.get(last.Namespace(), last.InfoByte().Version())
+	if err != nil {
+		return err
+	}
+	start := len(sss.shares)
+	sss.shares = append(sss.shares, repeatShare(template, count)...)
+	sss.hooks.written(sss.shares[start:], start, false)
+	return nil
+}
+
+// Export returns the shares written so far.
+func (sss *SparseShareSplitter) Export() []share.Share {
+	return sss.shares
+}
+
+// Count returns the number of shares written so far.
+func (sss *SparseShareSplitter) Count() int {
+	return len(sss.shares)
+}
+
+// CompactShareSplitter is a share.CompactShareSplitter that calls hooks when
+// its shares are exported. The shares of compact sequences are only final, in
+// particular the sequence length of the first one, once the sequence is
+// exported so the hooks of all shares are called by the first Export.
+type CompactShareSplitter struct {
+	*share.CompactShareSplitter
+	hooks    SplitterHooks
+	exported bool
+}
+
+func NewCompactShareSplitter(ns share.Namespace, shareVersion uint8, hooks SplitterHooks) *CompactShareSplitter {
+	return &CompactShareSplitter{
+		CompactShareSplitter: share.NewCompactShareSplitter(ns, shareVersion),
+		hooks:                hooks,
+	}
+}
+
+// Export returns the compact shares of the splitter.
+func (css *CompactShareSplitter) Export() ([]share.Share, error) {
+	shares, err := css.CompactShareSplitter.Export()
+	if err != nil || css.exported || css.hooks.empty() {
+		return shares, err
+	}
+	css.exported = true
+	css.hooks.written(shares, 0, true)
+	return shares, nil
+}
@@ -0,0 +1,115 @@
+package shares
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder records the calls of the hooks it returns.
+type recorder struct {
+	written   []share.Share
+	sequences []share.Range
+	padding   []int
+}
+
+func (r *recorder) hooks() SplitterHooks {
+	return SplitterHooks{
+		OnShareWritten: func(s share.Share) { r.written = append(r.written, s) },
+		OnSequenceComplete: func(_ share.Namespace, shareRange share.Range) {
+			r.sequences = append(r.sequences, shareRange)
+		},
+		OnPaddingInserted: func(_ share.Namespace, count int) { r.padding = append(r.padding, count) },
+	}
+}
+
+func TestSparseShareSplitterHooks(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	blobA, err := share.NewV0Blob(ns, bytes.Repeat([]byte{1}, 1000))
+	require.NoError(t, err)
+	blobB, err := share.NewV0Blob(ns, bytes.Repeat([]byte{2}, 100))
+	require.NoError(t, err)
+
+	r := &recorder{}
+	splitter := NewSparseShareSplitter(r.hooks())
+	require.NoError(t, splitter.Write(blobA))
+	require.NoError(t, splitter.WriteNamespacePaddingShares(2))
+	require.NoError(t, splitter.Write(blobB))
+
+	plain := share.NewSparseShareSplitter()
+	require.NoError(t, plain.Write(blobA))
+	require.NoError(t, plain.WriteNamespacePaddingShares(2))
+	require.NoError(t, plain.Write(blobB))
+
+	assert.Equal(t, plain.Export(), splitter.Export())
+	assert.Equal(t, splitter.Export(), r.written)
+	assert.Equal(t, []share.Range{share.NewRange(0, 3), share.NewRange(5, 6)}, r.sequences)
+	assert.Equal(t, []int{2}, r.padding)
+}
+
+func TestSparseShareSplitter(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	signer := bytes.Repeat([]byte{2}, share.SignerSize)
+
+	splitter := NewSparseShareSplitter(SplitterHooks{})
+	want := share.NewSparseShareSplitter()
+	for _, tc := range []struct {
+		size         int
+		shareVersion uint8
+		signer       []byte
+	}{
+		{size: 1, shareVersion: share.ShareVersionZero},
+		{size: share.FirstSparseShareContentSize, shareVersion: share.ShareVersionZero},
+		{size: share.FirstSparseShareContentSize + 1, shareVersion: share.ShareVersionZero},
+		{size: 100_000, shareVersion: share.ShareVersionZero},
+		{size: share.FirstSparseShareContentSize - share.SignerSize, shareVersion: share.ShareVersionOne, signer: signer},
+		{size: 5_000, shareVersion: share.ShareVersionOne, signer: signer},
+	} {
+		blob, err := share.NewBlob(ns, bytes.Repeat([]byte{byte(tc.size)}, tc.size), tc.shareVersion, tc.signer)
+		require.NoError(t, err)
+		require.NoError(t, want.Write(blob))
+		require.NoError(t, splitter.Write(blob))
+	}
+	require.NoError(t, want.WriteNamespacePaddingShares(2))
+	require.NoError(t, splitter.WriteNamespacePaddingShares(2))
+
+	assert.Equal(t, want.Count(), splitter.Count())
+	assert.Equal(t, share.ToBytes(want.Export()), share.ToBytes(splitter.Export()))
+}
+
+func TestCompactShareSplitterHooks(t *testing.T) {
+	r := &recorder{}
+	splitter := NewCompactShareSplitter(share.TxNamespace, share.ShareVersionZero, r.hooks())
+	for i := 0; i < 3; i++ {
+		require.NoError(t, splitter.WriteTx(bytes.Repeat([]byte{byte(i)}, 400)))
+	}
+	shares, err := splitter.Export()
+	require.NoError(t, err)
+	// The hooks are only called by the first Export.
+	_, err = splitter.Export()
+	require.NoError(t, err)
+
+	assert.Equal(t, shares, r.written)
+	assert.Equal(t, []share.Range{share.NewRange(0, len(shares))}, r.sequences)
+	assert.Empty(t, r.padding)
+}
+
+func TestSplittersWithoutHooks(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	blob, err := share.NewV0Blob(ns, bytes.Repeat([]byte{1}, 1000))
+	require.NoError(t, err)
+
+	sparse := NewSparseShareSplitter(SplitterHooks{})
+	require.NoError(t, sparse.Write(blob))
+	require.NoError(t, sparse.WriteNamespacePaddingShares(1))
+	assert.Equal(t, 4, sparse.Count())
+
+	compact := NewCompactShareSplitter(share.TxNamespace, share.ShareVersionZero, SplitterHooks{})
+	require.NoError(t, compact.WriteTx([]byte{1}))
+	shares, err := compact.Export()
+	require.NoError(t, err)
+	assert.Len(t, shares, 1)
+}
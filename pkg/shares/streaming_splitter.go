@@ -0,0 +1,104 @@
+package shares
+
+import (
+	"errors"
+	"io"
+
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+// streamingBatchShares is the max number of shares of a blob that a
+// StreamingSparseShareSplitter allocates at once.
+const streamingBatchShares = 64
+
+// StreamingSparseShareSplitter splits blobs into the same shares as a
+// share.SparseShareSplitter but reads the data of every blob from an
+// io.Reader and passes each share to a callback as soon as it is built. It
+// allocates the shares of a blob in batches of streamingBatchShares shares so
+// multi-megabyte blobs can be split without holding their data and all of
+// their shares at once.
+// If a max square size is set, it rejects the blobs that don't fit in a square
+// of that size before reading their data, see ValidateBlobSize.
+type StreamingSparseShareSplitter struct {
+	emit          func(share.Share) error
+	count         int
+	maxSquareSize int
+	// lastNamespace and lastShareVersion are the ones of the last blob, used
+	// by WriteNamespacePaddingShares.
+	lastNamespace    share.Namespace
+	lastShareVersion uint8
+	padding          paddingTemplates
+}
+
+// NewStreamingSparseShareSplitter returns a splitter that calls emit with
+// every share that it writes, in order. The splitter stops at the first error
+// returned by emit. emit owns the shares it is called with.
+func NewStreamingSparseShareSplitter(emit func(share.Share) error) *StreamingSparseShareSplitter {
+	return &StreamingSparseShareSplitter{emit: emit}
+}
+
+// WithMaxSquareSize sets the size of the square that every blob written to the
+// splitter must fit in.
+func (sss *StreamingSparseShareSplitter) WithMaxSquareSize(squareSize int) *StreamingSparseShareSplitter {
+	sss.maxSquareSize = squareSize
+	return sss
+}
+
+// WriteBlobFrom reads the size bytes of the data of a blob from r and writes
+// the shares of the blob. ns, shareVersion and signer must be valid for a
+// blob, see share.NewBlob. It returns an error if r has less than size bytes.
+func (sss *StreamingSparseShareSplitter) WriteBlobFrom(r io.Reader, ns share.Namespace, shareVersion uint8, signer []byte, size uint32) error {
+	if size == 0 {
+		return errors.New("data can not be empty")
+	}
+	// validate the blob like go-square does without holding its data
+	if _, err := share.NewBlob(ns, []byte{0}, shareVersion, signer); err != nil {
+		return err
+	}
+	if err := validateBlobSize(int(size), len(signer), sss.maxSquareSize); err != nil {
+		return err
+	}
+	if err := writeBlobShares(r, ns, shareVersion, signer, size, streamingBatchShares, sss.write); err != nil {
+		return err
+	}
+	sss.lastNamespace = ns
+	sss.lastShareVersion = shareVersion
+	return nil
+}
+
+// WriteNamespacePaddingShares writes count padding shares with the namespace
+// of the last written blob.
+func (sss *StreamingSparseShareSplitter) WriteNamespacePaddingShares(count int) error {
+	if count < 0 {
+		return errors.New("cannot write negative namespaced shares")
+	}
+	if count == 0 {
+		return nil
+	}
+	if sss.count == 0 {
+		return errors.New("cannot write namespace padding shares on an empty StreamingSparseShareSplitter")
+	}
+	template, err := sss.padding.get(sss.lastNamespace, sss.lastShareVersion)
+	if err != nil {
+		return err
+	}
+	for _, padding := range repeatShare(template, count) {
+		if err := sss.write(padding); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (sss *StreamingSparseShareSplitter) write(s share.Share) error {
+	if err := sss.emit(s); err != nil {
+		return err
+	}
+	sss.count++
+	return nil
+}
+
+// Count returns the number of shares written so far.
+func (sss *StreamingSparseShareSplitter) Count() int {
+	return sss.count
+}
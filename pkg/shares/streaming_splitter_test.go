@@ -0,0 +1,81 @@
+package shares
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamingSparseShareSplitter(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	signer := bytes.Repeat([]byte{2}, share.SignerSize)
+
+	var got []share.Share
+	streaming := NewStreamingSparseShareSplitter(func(s share.Share) error {
+		got = append(got, s)
+		return nil
+	})
+	want := share.NewSparseShareSplitter()
+	for _, tc := range []struct {
+		size         int
+		shareVersion uint8
+		signer       []byte
+	}{
+		{size: 1, shareVersion: share.ShareVersionZero},
+		{size: share.FirstSparseShareContentSize, shareVersion: share.ShareVersionZero},
+		{size: share.FirstSparseShareContentSize + 1, shareVersion: share.ShareVersionZero},
+		{size: 100_000, shareVersion: share.ShareVersionZero},
+		{size: 5_000, shareVersion: share.ShareVersionOne, signer: signer},
+	} {
+		data := make([]byte, tc.size)
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+		blob, err := share.NewBlob(ns, data, tc.shareVersion, tc.signer)
+		require.NoError(t, err)
+		require.NoError(t, want.Write(blob))
+		require.NoError(t, streaming.WriteBlobFrom(bytes.NewReader(data), ns, tc.shareVersion, tc.signer, uint32(tc.size)))
+	}
+	require.NoError(t, want.WriteNamespacePaddingShares(2))
+	require.NoError(t, streaming.WriteNamespacePaddingShares(2))
+
+	assert.Equal(t, want.Count(), streaming.Count())
+	assert.Equal(t, share.ToBytes(want.Export()), share.ToBytes(got))
+}
+
+func TestStreamingSparseShareSplitterErrors(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	discard := NewStreamingSparseShareSplitter(func(share.Share) error { return nil })
+
+	t.Run("short reader", func(t *testing.T) {
+		err := discard.WriteBlobFrom(bytes.NewReader(make([]byte, 600)), ns, share.ShareVersionZero, nil, 1000)
+		assert.ErrorContains(t, err, "unexpected EOF")
+	})
+	t.Run("empty blob", func(t *testing.T) {
+		assert.Error(t, discard.WriteBlobFrom(bytes.NewReader(nil), ns, share.ShareVersionZero, nil, 0))
+	})
+	t.Run("missing signer", func(t *testing.T) {
+		assert.Error(t, discard.WriteBlobFrom(bytes.NewReader([]byte{1}), ns, share.ShareVersionOne, nil, 1))
+	})
+	t.Run("padding without blob", func(t *testing.T) {
+		assert.Error(t, NewStreamingSparseShareSplitter(nil).WriteNamespacePaddingShares(1))
+	})
+	t.Run("emit error", func(t *testing.T) {
+		errEmit := errors.New("emit")
+		emitted := 0
+		splitter := NewStreamingSparseShareSplitter(func(share.Share) error {
+			if emitted == 2 {
+				return errEmit
+			}
+			emitted++
+			return nil
+		})
+		err := splitter.WriteBlobFrom(bytes.NewReader(make([]byte, 10_000)), ns, share.ShareVersionZero, nil, 10_000)
+		assert.ErrorIs(t, err, errEmit)
+		assert.Equal(t, 2, splitter.Count())
+	})
+}
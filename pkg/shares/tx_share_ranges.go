@@ -0,0 +1,121 @@
+package shares
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+// TxShareRanges returns the end-exclusive range of the shares that each tx of
+// the compact shares occupies, keyed by the sha256 hash of the tx as it is
+// written to the shares. The range of a tx starts at the share that contains
+// the first byte of its unit length delimiter and ends after the share that
+// contains its last byte, like the ranges of share.CompactShareSplitter.
+//
+// compactShares are the compact shares at the start of a data square, i.e.
+// the shares of the tx namespace optionally followed by the shares of the PFB
+// namespace, and the ranges are their indexes in the square. The txs of the
+// PFB namespace are the index wrappers of the PFBs rather than the blob txs
+// that the block contains.
+func TxShareRanges(compactShares []share.Share) (map[[sha256.Size]byte]share.Range, error) {
+	ranges := make(map[[sha256.Size]byte]share.Range)
+	start := 0
+	for start < len(compactShares) {
+		end := start + 1
+		for end < len(compactShares) && !compactShares[end].IsSequenceStart() {
+			end++
+		}
+		if err := sequenceTxShareRanges(compactShares[start:end], start, ranges); err != nil {
+			return nil, err
+		}
+		start = end
+	}
+	return ranges, nil
+}
+
+// sequenceTxShareRanges adds the ranges of the txs of a compact share
+// sequence that starts at share offset to ranges.
+func sequenceTxShareRanges(sequence []share.Share, offset int, ranges map[[sha256.Size]byte]share.Range) error {
+	first := sequence[0]
+	if !first.IsSequenceStart() {
+		return fmt.Errorf("share %d continues a sequence that doesn't start in the shares", offset)
+	}
+	if !first.IsCompactShare() {
+		return fmt.Errorf("share %d of namespace %x is not a compact share", offset, first.Namespace().Bytes())
+	}
+	if first.Version() != share.ShareVersionZero {
+		return fmt.Errorf("unsupported share version for compact shares %d", first.Version())
+	}
+
+	// shareEnds[i] is the end offset of the raw data of share i of the
+	// sequence in the raw data of the sequence.
+	var rawData []byte
+	shareEnds := make([]int, len(sequence))
+	for i, sh := range sequence {
+		rawData = append(rawData, sh.RawData()...)
+		shareEnds[i] = len(rawData)
+	}
+	sequenceLen := int(first.SequenceLen())
+	if sequenceLen > len(rawData) {
+		return fmt.Errorf("sequence length %d of share %d exceeds the %d bytes of raw data of its shares", sequenceLen, offset, len(rawData))
+	}
+	rawData = rawData[:sequenceLen]
+
+	units, truncated, err := parseUnits(rawData, shareEnds)
+	if err != nil {
+		return fmt.Errorf("%w in the sequence of share %d", err, offset)
+	}
+	if truncated {
+		return fmt.Errorf("the last unit exceeds the sequence of share %d", offset)
+	}
+	for _, u := range units {
+		ranges[sha256.Sum256(u.data)] = share.NewRange(offset+u.first, offset+u.last+1)
+	}
+	return nil
+}
+
+// unit is a unit of the raw data of a compact share sequence.
+type unit struct {
+	data []byte
+	// first and last are the indexes of the shares that contain the first
+	// byte of the unit length delimiter and the last byte of the unit.
+	first, last int
+}
+
+// parseUnits returns the units of rawData, which starts with a unit length
+// delimiter, where shareEnds[i] is the end offset of the raw data of share i
+// in rawData. It stops at the padding that follows the last unit, or at a
+// unit that rawData doesn't contain completely, which it reports as
+// truncated.
+func parseUnits(rawData []byte, shareEnds []int) (units []unit, truncated bool, err error) {
+	shareOf := func(pos int) int {
+		i := 0
+		for shareEnds[i] <= pos {
+			i++
+		}
+		return i
+	}
+	for pos := 0; pos < len(rawData); {
+		unitLen, n := binary.Uvarint(rawData[pos:])
+		if n == 0 {
+			return units, true, nil
+		}
+		if n < 0 {
+			return nil, false, fmt.Errorf("invalid unit length delimiter at byte %d", pos)
+		}
+		// the rest of the raw data is padding.
+		if unitLen == 0 {
+			break
+		}
+		unitStart := pos + n
+		if unitLen > uint64(len(rawData)-unitStart) {
+			return units, true, nil
+		}
+		unitEnd := unitStart + int(unitLen)
+		units = append(units, unit{data: rawData[unitStart:unitEnd], first: shareOf(pos), last: shareOf(unitEnd - 1)})
+		pos = unitEnd
+	}
+	return units, false, nil
+}
@@ -0,0 +1,57 @@
+package shares_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/shares"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxShareRanges(t *testing.T) {
+	txSplitter := share.NewCompactShareSplitter(share.TxNamespace, share.ShareVersionZero)
+	pfbSplitter := share.NewCompactShareSplitter(share.PayForBlobNamespace, share.ShareVersionZero)
+	// txs that fit in a share, fill a share exactly and span many shares.
+	for i, size := range []int{10, 200, share.FirstCompactShareContentSize - 2, 1000, 50, 5000} {
+		require.NoError(t, txSplitter.WriteTx(bytes.Repeat([]byte{byte(i)}, size)))
+	}
+	for i, size := range []int{300, 800} {
+		require.NoError(t, pfbSplitter.WriteTx(bytes.Repeat([]byte{byte(100 + i)}, size)))
+	}
+	txShares, err := txSplitter.Export()
+	require.NoError(t, err)
+	pfbShares, err := pfbSplitter.Export()
+	require.NoError(t, err)
+
+	want := txSplitter.ShareRanges(0)
+	for hash, r := range pfbSplitter.ShareRanges(len(txShares)) {
+		want[hash] = r
+	}
+	got, err := shares.TxShareRanges(append(append([]share.Share{}, txShares...), pfbShares...))
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	got, err = shares.TxShareRanges(nil)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestTxShareRangesErrors(t *testing.T) {
+	splitter := share.NewCompactShareSplitter(share.TxNamespace, share.ShareVersionZero)
+	require.NoError(t, splitter.WriteTx(bytes.Repeat([]byte{1}, 1000)))
+	txShares, err := splitter.Export()
+	require.NoError(t, err)
+	blob, err := share.NewV0Blob(share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize)), []byte("data"))
+	require.NoError(t, err)
+	sparse, err := blob.ToShares()
+	require.NoError(t, err)
+
+	_, err = shares.TxShareRanges(txShares[1:])
+	assert.ErrorContains(t, err, "continues a sequence")
+	_, err = shares.TxShareRanges(append(append([]share.Share{}, txShares...), sparse...))
+	assert.ErrorContains(t, err, "is not a compact share")
+	_, err = shares.TxShareRanges(txShares[:1])
+	assert.ErrorContains(t, err, "exceeds the")
+}
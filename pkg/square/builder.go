@@ -0,0 +1,213 @@
+// Package square constructs the original data square of a block with the
+// square construction of its app version. PrepareProposal and ProcessProposal
+// both build their squares with it so that the layout of a proposed block can
+// be verified, and reused by tests and tooling, with the same code.
+package square
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	blobv1 "github.com/celestiaorg/go-square/blob"
+	sharesv1 "github.com/celestiaorg/go-square/shares"
+	squarev1 "github.com/celestiaorg/go-square/square"
+	squarev2 "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+)
+
+// Square is the original data square of a block as the bytes of its shares in
+// row-major order.
+type Square [][]byte
+
+// Size returns the width of the square.
+func (s Square) Size() int {
+	return squarev2.Size(len(s))
+}
+
+// WrappedPFBs returns the index wrappers of the PFBs of the square.
+func (s Square) WrappedPFBs() ([][]byte, error) {
+	shares, err := share.FromBytes(s)
+	if err != nil {
+		return nil, err
+	}
+	return squarev2.Square(shares).WrappedPFBs()
+}
+
+// Builder allocates txs and blob txs to the square of a block. App versions
+// 1 and 2 use the square construction of go-square v1 and later versions that
+// of go-square v2, which adds support for blobs with a signer.
+type Builder struct {
+	// exactly one of the builders is set depending on the app version.
+	v1 *squarev1.Builder
+	v2 *squarev2.Builder
+}
+
+// NewBuilder returns an empty builder of a square of at most maxSquareSize
+// with the square construction of appVersion.
+func NewBuilder(appVersion uint64, maxSquareSize int) (*Builder, error) {
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(appVersion)
+	b := &Builder{}
+	var err error
+	switch appVersion {
+	case v3.Version, v4.Version:
+		b.v2, err = squarev2.NewBuilder(maxSquareSize, subtreeRootThreshold)
+	case v2.Version, v1.Version:
+		b.v1, err = squarev1.NewBuilder(maxSquareSize, subtreeRootThreshold)
+	default:
+		return nil, fmt.Errorf("unsupported app version: %d", appVersion)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// AppendTx attempts to allocate the normal tx to the square. It returns false
+// if there is not enough space in the square to fit the tx.
+func (b *Builder) AppendTx(rawTx []byte) bool {
+	if b.v2 != nil {
+		return b.v2.AppendTx(rawTx)
+	}
+	return b.v1.AppendTx(rawTx)
+}
+
+// AppendBlobTx attempts to allocate the blob tx to the square. It returns
+// false if there is not enough space in the square to fit the tx and an error
+// if rawTx isn't a valid blob tx.
+func (b *Builder) AppendBlobTx(rawTx []byte) (bool, error) {
+	isBlobTx, appended, err := b.append(rawTx, true)
+	if err != nil {
+		return false, err
+	}
+	if !isBlobTx {
+		return false, fmt.Errorf("not a blob tx")
+	}
+	return appended, nil
+}
+
+// append attempts to allocate rawTx to the square as a blob tx if it is one
+// and as a normal tx otherwise, unless onlyBlobTx is set.
+func (b *Builder) append(rawTx []byte, onlyBlobTx bool) (isBlobTx, appended bool, err error) {
+	if b.v2 != nil {
+		blobTx, isBlobTx, err := tx.UnmarshalBlobTx(rawTx)
+		if err != nil && isBlobTx {
+			return true, false, err
+		}
+		if isBlobTx {
+			return true, b.v2.AppendBlobTx(blobTx), nil
+		}
+	} else {
+		blobTx, isBlobTx := blobv1.UnmarshalBlobTx(rawTx)
+		if isBlobTx {
+			return true, b.v1.AppendBlobTx(blobTx), nil
+		}
+	}
+	if onlyBlobTx {
+		return false, false, nil
+	}
+	return false, b.AppendTx(rawTx), nil
+}
+
+// Export constructs the square of the appended txs. The blob txs are in the
+// square as the index wrappers of their PFBs.
+func (b *Builder) Export() (Square, error) {
+	if b.v2 != nil {
+		dataSquare, err := b.v2.Export()
+		if err != nil {
+			return nil, err
+		}
+		return share.ToBytes(dataSquare), nil
+	}
+	dataSquare, err := b.v1.Export()
+	if err != nil {
+		return nil, err
+	}
+	return sharesv1.ToBytes(dataSquare), nil
+}
+
+// FindTxShareRange returns the range of the shares of the square that the tx
+// at txIndex of the appended txs, normal txs first, occupies. The exported
+// square must not change between getting the range and using it.
+func (b *Builder) FindTxShareRange(txIndex int) (share.Range, error) {
+	if b.v2 != nil {
+		return b.v2.FindTxShareRange(txIndex)
+	}
+	r, err := b.v1.FindTxShareRange(txIndex)
+	if err != nil {
+		return share.Range{}, err
+	}
+	return share.NewRange(r.Start, r.End), nil
+}
+
+// Build builds a square of at most maxSquareSize from the prioritized txs with
+// the square construction of appVersion. Txs that don't fit are left out. It
+// returns the square and the txs of the square in block order, i.e. with the
+// blob txs after the normal txs. The validity of the txs isn't checked.
+func Build(appVersion uint64, txs [][]byte, maxSquareSize int) (Square, [][]byte, error) {
+	return BuildContext(context.Background(), appVersion, txs, maxSquareSize)
+}
+
+// BuildContext is Build that stops appending txs once ctx is done, returning
+// the error of ctx. The square is only exported if ctx isn't done by then.
+func BuildContext(ctx context.Context, appVersion uint64, txs [][]byte, maxSquareSize int) (Square, [][]byte, error) {
+	builder, err := NewBuilder(appVersion, maxSquareSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	normalTxs := make([][]byte, 0, len(txs))
+	blobTxs := make([][]byte, 0, len(txs))
+	for idx, rawTx := range txs {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		isBlobTx, appended, err := builder.append(rawTx, false)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unmarshalling blob tx at index %d: %w", idx, err)
+		}
+		switch {
+		case !appended:
+		case isBlobTx:
+			blobTxs = append(blobTxs, rawTx)
+		default:
+			normalTxs = append(normalTxs, rawTx)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	dataSquare, err := builder.Export()
+	return dataSquare, append(normalTxs, blobTxs...), err
+}
+
+// Construct constructs the square of the txs of a block with the square
+// construction of appVersion. It returns an error if the blob txs aren't
+// after the normal txs or if the txs don't fit in a square of maxSquareSize.
+// The validity of the txs isn't checked.
+func Construct(appVersion uint64, txs [][]byte, maxSquareSize int) (Square, error) {
+	builder, err := NewBuilder(appVersion, maxSquareSize)
+	if err != nil {
+		return nil, err
+	}
+	seenFirstBlobTx := false
+	for idx, rawTx := range txs {
+		isBlobTx, appended, err := builder.append(rawTx, seenFirstBlobTx)
+		switch {
+		case err != nil:
+			return nil, fmt.Errorf("unmarshalling blob tx at index %d: %w", idx, err)
+		case !isBlobTx && seenFirstBlobTx:
+			return nil, fmt.Errorf("normal tx at index %d can not be appended after blob tx", idx)
+		case !appended && isBlobTx:
+			return nil, fmt.Errorf("not enough space to append blob tx at index %d", idx)
+		case !appended:
+			return nil, fmt.Errorf("not enough space to append tx at index %d", idx)
+		}
+		seenFirstBlobTx = seenFirstBlobTx || isBlobTx
+	}
+	return builder.Export()
+}
@@ -0,0 +1,73 @@
+package square_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	appshares "github.com/celestiaorg/celestia-app/v3/pkg/shares"
+	"github.com/celestiaorg/celestia-app/v3/pkg/square"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/require"
+)
+
+// FuzzBuildConstruct builds squares from random mixes of normal txs and blob
+// txs the way PrepareProposal does and checks that constructing the square
+// from the txs that were kept, the way ProcessProposal does, always succeeds
+// with the same square. Run it continuously with:
+//
+//	go test ./pkg/square -run FuzzBuildConstruct -fuzz FuzzBuildConstruct
+func FuzzBuildConstruct(f *testing.F) {
+	f.Add(int64(1), uint8(3), uint8(10), uint8(0), uint16(1000), uint8(1))
+	f.Add(int64(2), uint8(2), uint8(100), uint8(50), uint16(10_000), uint8(4))
+	f.Add(int64(3), uint8(1), uint8(0), uint8(200), uint16(100), uint8(6))
+	f.Add(int64(4), uint8(3), uint8(255), uint8(255), uint16(65_535), uint8(7))
+
+	f.Fuzz(func(t *testing.T, seed int64, version, normalTxs, blobTxs uint8, maxBlobSize uint16, squareSizeExp uint8) {
+		appVersion := uint64(version%3) + 1
+		maxSquareSize := 1 << (squareSizeExp % 8)
+		r := rand.New(rand.NewSource(seed))
+
+		txs := make([][]byte, 0, int(normalTxs)+int(blobTxs))
+		for range normalTxs {
+			txs = append(txs, randBytes(r, 1+r.Intn(2000)))
+		}
+		for range blobTxs {
+			blobs := make([]*share.Blob, 1+r.Intn(4))
+			for i := range blobs {
+				namespace, err := share.NewV0Namespace(randBytes(r, share.NamespaceVersionZeroIDSize))
+				require.NoError(t, err)
+				blobs[i], err = share.NewV0Blob(namespace, randBytes(r, 1+r.Intn(int(maxBlobSize)+1)))
+				require.NoError(t, err)
+			}
+			blobTx, err := tx.MarshalBlobTx(randBytes(r, 100+r.Intn(400)), blobs...)
+			require.NoError(t, err)
+			txs = append(txs, blobTx)
+		}
+		// the mempool interleaves normal txs and blob txs.
+		r.Shuffle(len(txs), func(i, j int) { txs[i], txs[j] = txs[j], txs[i] })
+
+		built, blockTxs, err := square.Build(appVersion, txs, maxSquareSize)
+		require.NoError(t, err)
+		require.LessOrEqual(t, built.Size(), maxSquareSize)
+
+		constructed, err := square.Construct(appVersion, blockTxs, maxSquareSize)
+		require.NoError(t, err)
+		require.Equal(t, built, constructed)
+
+		shares, err := share.FromBytes(constructed)
+		require.NoError(t, err)
+		require.NoError(t, appshares.ValidateReservedBytes(shares))
+		eds, err := da.ExtendShares(constructed)
+		require.NoError(t, err)
+		_, err = da.NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+	})
+}
+
+func randBytes(r *rand.Rand, n int) []byte {
+	b := make([]byte, n)
+	_, _ = r.Read(b)
+	return b
+}
@@ -0,0 +1,126 @@
+package square_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/square"
+	sharesv1 "github.com/celestiaorg/go-square/shares"
+	squarev1 "github.com/celestiaorg/go-square/square"
+	squarev2 "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildAndConstruct(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	newBlobTx := func(size int) []byte {
+		blob, err := share.NewV0Blob(ns, bytes.Repeat([]byte{2}, size))
+		require.NoError(t, err)
+		// the validity of the txs isn't checked.
+		rawTx, err := tx.MarshalBlobTx([]byte("pfb"), blob)
+		require.NoError(t, err)
+		return rawTx
+	}
+	normalTx := bytes.Repeat([]byte{3}, 100)
+	// the blob tx comes first in priority order.
+	txs := [][]byte{newBlobTx(1000), normalTx, newBlobTx(2000)}
+
+	for _, appVersion := range []uint64{1, 2, 3} {
+		maxSquareSize := appconsts.SquareSizeUpperBound(appVersion)
+		dataSquare, blockTxs, err := square.Build(appVersion, txs, maxSquareSize)
+		require.NoError(t, err, appVersion)
+		assert.Equal(t, [][]byte{normalTx, txs[0], txs[2]}, blockTxs, appVersion)
+
+		// the square is the same as that of the square construction of the
+		// app version.
+		var want [][]byte
+		if appVersion >= 3 {
+			wantSquare, _, err := squarev2.Build(txs, maxSquareSize, appconsts.SubtreeRootThreshold(appVersion))
+			require.NoError(t, err)
+			want = share.ToBytes(wantSquare)
+		} else {
+			wantSquare, _, err := squarev1.Build(txs, maxSquareSize, appconsts.SubtreeRootThreshold(appVersion))
+			require.NoError(t, err)
+			want = sharesv1.ToBytes(wantSquare)
+		}
+		assert.Equal(t, want, [][]byte(dataSquare), appVersion)
+
+		constructed, err := square.Construct(appVersion, blockTxs, maxSquareSize)
+		require.NoError(t, err, appVersion)
+		assert.Equal(t, dataSquare, constructed, appVersion)
+		assert.Equal(t, squarev2.Size(len(want)), constructed.Size(), appVersion)
+
+		wrappedPFBs, err := constructed.WrappedPFBs()
+		require.NoError(t, err, appVersion)
+		assert.Len(t, wrappedPFBs, 2, appVersion)
+	}
+}
+
+func TestBuildLeavesOutTxsThatDontFit(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	blob, err := share.NewV0Blob(ns, bytes.Repeat([]byte{2}, 10*share.ContinuationSparseShareContentSize))
+	require.NoError(t, err)
+	bigBlobTx, err := tx.MarshalBlobTx([]byte("pfb"), blob)
+	require.NoError(t, err)
+	normalTx := []byte("tx")
+
+	dataSquare, blockTxs, err := square.Build(appconsts.LatestVersion, [][]byte{bigBlobTx, normalTx}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{normalTx}, blockTxs)
+	assert.Equal(t, 1, dataSquare.Size())
+
+	_, err = square.Construct(appconsts.LatestVersion, [][]byte{bigBlobTx}, 2)
+	assert.ErrorContains(t, err, "not enough space to append blob tx at index 0")
+}
+
+func TestConstructRejectsNormalTxAfterBlobTx(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	blob, err := share.NewV0Blob(ns, []byte("data"))
+	require.NoError(t, err)
+	blobTx, err := tx.MarshalBlobTx([]byte("pfb"), blob)
+	require.NoError(t, err)
+
+	for _, appVersion := range []uint64{1, 2, 3} {
+		_, err := square.Construct(appVersion, [][]byte{blobTx, []byte("tx")}, 64)
+		assert.ErrorContains(t, err, "normal tx at index 1 can not be appended after blob tx", appVersion)
+	}
+}
+
+func TestBuilder(t *testing.T) {
+	_, err := square.NewBuilder(0, 64)
+	assert.ErrorContains(t, err, "unsupported app version")
+
+	builder, err := square.NewBuilder(appconsts.LatestVersion, 64)
+	require.NoError(t, err)
+	_, err = builder.AppendBlobTx([]byte("tx"))
+	assert.ErrorContains(t, err, "not a blob tx")
+
+	require.True(t, builder.AppendTx(bytes.Repeat([]byte{1}, 1000)))
+	txRange, err := builder.FindTxShareRange(0)
+	require.NoError(t, err)
+	assert.Equal(t, share.NewRange(0, 3), txRange)
+}
+
+func TestBuildCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	txs := [][]byte{bytes.Repeat([]byte{3}, 100)}
+	for _, appVersion := range []uint64{1, 2, 3} {
+		maxSquareSize := appconsts.SquareSizeUpperBound(appVersion)
+		_, _, err := square.BuildContext(ctx, appVersion, txs, maxSquareSize)
+		assert.ErrorIs(t, err, context.Canceled, appVersion)
+
+		// the square doesn't change if ctx isn't done.
+		want, wantTxs, err := square.Build(appVersion, txs, maxSquareSize)
+		require.NoError(t, err)
+		got, gotTxs, err := square.BuildContext(context.Background(), appVersion, txs, maxSquareSize)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, appVersion)
+		assert.Equal(t, wantTxs, gotTxs, appVersion)
+	}
+}
@@ -0,0 +1,117 @@
+package square
+
+import (
+	_ "embed"
+	"encoding/hex"
+	"html/template"
+	"io"
+
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+// The kinds of the ranges of shares of a Layout.
+const (
+	KindTx              = "tx"
+	KindPayForBlob      = "pfb"
+	KindBlob            = "blob"
+	KindReservedPadding = "reserved_padding"
+	KindPadding         = "padding"
+	KindTailPadding     = "tail_padding"
+)
+
+// Layout describes which parts of a square its shares belong to. It is meant
+// for debugging, e.g. to see how much of a square that jumped a size is
+// padding.
+type Layout struct {
+	Size int `json:"size"`
+	// Ranges are the ranges of shares in share order. The shares of every blob
+	// are a range of their own, and so are the shares of every kind of
+	// padding that sits in between.
+	Ranges        []ShareRange `json:"ranges"`
+	PaddingShares int          `json:"padding_shares"`
+	// PaddingRatio is the share of the square that is padding.
+	PaddingRatio float64 `json:"padding_ratio"`
+}
+
+// ShareRange is the end exclusive range of shares of one kind.
+type ShareRange struct {
+	Kind string `json:"kind"`
+	// Namespace is the hex encoded namespace of the shares.
+	Namespace string `json:"namespace"`
+	Start     int    `json:"start"`
+	End       int    `json:"end"`
+}
+
+// IsPadding returns true if the range is padding of any kind.
+func (r ShareRange) IsPadding() bool {
+	return r.Kind == KindReservedPadding || r.Kind == KindPadding || r.Kind == KindTailPadding
+}
+
+// Layout returns the layout of the square.
+func (s Square) Layout() (Layout, error) {
+	shares, err := share.FromBytes(s)
+	if err != nil {
+		return Layout{}, err
+	}
+
+	layout := Layout{Size: s.Size()}
+	for i, sh := range shares {
+		kind := shareKind(&sh)
+		// a blob starts a new range even if it directly follows a blob of the
+		// same namespace.
+		newBlob := kind == KindBlob && sh.IsSequenceStart()
+		ns := hex.EncodeToString(sh.Namespace().Bytes())
+		if last := len(layout.Ranges) - 1; last >= 0 && !newBlob && layout.Ranges[last].Kind == kind && layout.Ranges[last].Namespace == ns {
+			layout.Ranges[last].End = i + 1
+			continue
+		}
+		layout.Ranges = append(layout.Ranges, ShareRange{Kind: kind, Namespace: ns, Start: i, End: i + 1})
+	}
+	for _, r := range layout.Ranges {
+		if r.IsPadding() {
+			layout.PaddingShares += r.End - r.Start
+		}
+	}
+	if len(shares) > 0 {
+		layout.PaddingRatio = float64(layout.PaddingShares) / float64(len(shares))
+	}
+	return layout, nil
+}
+
+func shareKind(sh *share.Share) string {
+	ns := sh.Namespace()
+	switch {
+	case ns.IsTx():
+		return KindTx
+	case ns.IsPayForBlob():
+		return KindPayForBlob
+	case ns.IsPrimaryReservedPadding():
+		return KindReservedPadding
+	case ns.IsTailPadding():
+		return KindTailPadding
+	case sh.IsPadding():
+		return KindPadding
+	default:
+		return KindBlob
+	}
+}
+
+//go:embed layout.html
+var layoutHTML string
+
+var layoutTemplate = template.Must(template.New("layout").Funcs(template.FuncMap{
+	"odd": func(i int) bool { return i%2 == 1 },
+	"cells": func(r ShareRange) []int {
+		cells := make([]int, 0, r.End-r.Start)
+		for i := r.Start; i < r.End; i++ {
+			cells = append(cells, i)
+		}
+		return cells
+	},
+}).Parse(layoutHTML))
+
+// WriteHTML writes the layout as an HTML page with a grid of the shares of the
+// square in which every share is colored by its kind.
+func (l Layout) WriteHTML(w io.Writer) error {
+	return layoutTemplate.Execute(w, l)
+}
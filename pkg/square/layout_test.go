@@ -0,0 +1,80 @@
+package square_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/square"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLayout(t *testing.T) {
+	ns1 := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	ns2 := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	newBlob := func(ns share.Namespace, size int) *share.Blob {
+		blob, err := share.NewV0Blob(ns, bytes.Repeat([]byte{2}, size))
+		require.NoError(t, err)
+		return blob
+	}
+	// the validity of the txs isn't checked.
+	blobTx, err := tx.MarshalBlobTx([]byte("pfb"), newBlob(ns1, 1000), newBlob(ns1, 100), newBlob(ns2, 100))
+	require.NoError(t, err)
+	normalTx := bytes.Repeat([]byte{3}, 100)
+
+	dataSquare, err := square.Construct(appconsts.LatestVersion, [][]byte{normalTx, blobTx}, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+	layout, err := dataSquare.Layout()
+	require.NoError(t, err)
+
+	nsHex := func(ns share.Namespace) string { return hex.EncodeToString(ns.Bytes()) }
+	want := square.Layout{
+		Size: 4,
+		Ranges: []square.ShareRange{
+			{Kind: square.KindTx, Namespace: nsHex(share.TxNamespace), Start: 0, End: 1},
+			{Kind: square.KindPayForBlob, Namespace: nsHex(share.PayForBlobNamespace), Start: 1, End: 2},
+			{Kind: square.KindBlob, Namespace: nsHex(ns1), Start: 2, End: 5},
+			{Kind: square.KindBlob, Namespace: nsHex(ns1), Start: 5, End: 6},
+			{Kind: square.KindBlob, Namespace: nsHex(ns2), Start: 6, End: 7},
+			{Kind: square.KindTailPadding, Namespace: nsHex(share.TailPaddingNamespace), Start: 7, End: 16},
+		},
+		PaddingShares: 9,
+		PaddingRatio:  9.0 / 16,
+	}
+	assert.Equal(t, want, layout)
+
+	var html bytes.Buffer
+	require.NoError(t, layout.WriteHTML(&html))
+	assert.Contains(t, html.String(), "4x4 square, 9 padding shares")
+	assert.Equal(t, 16, bytes.Count(html.Bytes(), []byte(`<div class="share `)))
+}
+
+func TestLayoutPadding(t *testing.T) {
+	newBlob := func(nsID byte, size int) *share.Blob {
+		ns := share.MustNewV0Namespace(bytes.Repeat([]byte{nsID}, share.NamespaceVersionZeroIDSize))
+		blob, err := share.NewV0Blob(ns, bytes.Repeat([]byte{1}, size))
+		require.NoError(t, err)
+		return blob
+	}
+	// blobs of 200 shares are aligned to 4 shares, so the first one is
+	// preceded by reserved padding and the second one by namespace padding.
+	bigBlobSize := 200 * share.ContinuationSparseShareContentSize
+	blobTx, err := tx.MarshalBlobTx([]byte("pfb"), newBlob(1, bigBlobSize), newBlob(2, 10), newBlob(3, bigBlobSize))
+	require.NoError(t, err)
+
+	dataSquare, err := square.Construct(appconsts.LatestVersion, [][]byte{blobTx}, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+	layout, err := dataSquare.Layout()
+	require.NoError(t, err)
+
+	kinds := make([]string, 0, len(layout.Ranges))
+	for _, r := range layout.Ranges {
+		kinds = append(kinds, r.Kind)
+	}
+	assert.Equal(t, []string{square.KindPayForBlob, square.KindReservedPadding, square.KindBlob, square.KindBlob, square.KindPadding, square.KindBlob, square.KindTailPadding}, kinds)
+	assert.Equal(t, layout.Size*layout.Size, layout.Ranges[len(layout.Ranges)-1].End)
+}
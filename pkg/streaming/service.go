@@ -0,0 +1,59 @@
+// Package streaming provides a base for the streaming services that observe
+// the blocks executed by the app.
+package streaming
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+var _ baseapp.StreamingService = BaseService{}
+
+// BaseService implements baseapp.StreamingService with methods that do
+// nothing. Streaming services embed it and override the methods of the ABCI
+// calls that they observe.
+//
+// The errors of the ABCI listener methods are returned to the ABCI calls of
+// the app and halt the node, so services whose failures must not affect
+// consensus should log them and return nil.
+type BaseService struct{}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (BaseService) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (BaseService) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+func (BaseService) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener.
+func (BaseService) ListenCommit(context.Context, abci.ResponseCommit) error {
+	return nil
+}
+
+// Listeners implements baseapp.StreamingService. It doesn't listen to any
+// store writes.
+func (BaseService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Stream implements baseapp.StreamingService. There is no streaming loop.
+func (BaseService) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Close implements baseapp.StreamingService.
+func (BaseService) Close() error {
+	return nil
+}
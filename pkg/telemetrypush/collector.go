@@ -0,0 +1,117 @@
+// Package telemetrypush periodically pushes a report of anonymized
+// performance metrics of a node to a remote collector. Operators opt into it
+// by setting an endpoint, which gives the core team aggregate data about the
+// performance of the network.
+//
+// Reports contain no addresses, keys or tx contents. Nodes are identified by
+// a random ID that is generated once and stored in the data directory of the
+// node, so that reports of the same node can be aggregated without linking
+// them to its node ID.
+package telemetrypush
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// FlagEndpoint is the flag to specify the URL that reports are posted to.
+	// Pushing is disabled if the flag is empty.
+	FlagEndpoint = "telemetry-push-endpoint"
+	// FlagInterval is the flag to specify the interval between two reports.
+	FlagInterval = "telemetry-push-interval"
+
+	// DefaultInterval is the default interval between two reports.
+	DefaultInterval = 5 * time.Minute
+
+	// maxLatencySamples is the max number of ProcessProposal latencies that
+	// are kept between two reports. Later samples are dropped.
+	maxLatencySamples = 10_000
+)
+
+// Collector accumulates the metrics that the app observes between two
+// reports. It is safe for concurrent use.
+type Collector struct {
+	mtx       sync.Mutex
+	latencies []time.Duration
+	blocks    BlockStats
+	// newPFBs is the number of blob txs that the mempool accepted.
+	newPFBs int
+	// fullnessSum is the sum of the fullness of the observed blocks.
+	fullnessSum float64
+	// squareSizeSum is the sum of the square sizes of the observed blocks.
+	squareSizeSum uint64
+}
+
+// NewCollector returns an empty collector.
+func NewCollector() *Collector {
+	return &Collector{}
+}
+
+// ObserveProcessProposal records the duration of a ProcessProposal call.
+func (c *Collector) ObserveProcessProposal(duration time.Duration) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if len(c.latencies) < maxLatencySamples {
+		c.latencies = append(c.latencies, duration)
+	}
+}
+
+// ObserveNewPFB records a blob tx that the mempool accepted.
+func (c *Collector) ObserveNewPFB() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.newPFBs++
+}
+
+// ObserveBlock records an accepted proposal with a square of squareSize with
+// usedShares shares that aren't padding, of a max of maxSquareSize.
+func (c *Collector) ObserveBlock(squareSize, usedShares, maxSquareSize int) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.blocks.Count++
+	c.squareSizeSum += uint64(squareSize)
+	c.blocks.MaxSquareSize = max(c.blocks.MaxSquareSize, uint64(squareSize))
+	if maxSquareSize > 0 {
+		c.fullnessSum += float64(usedShares) / float64(maxSquareSize*maxSquareSize)
+	}
+}
+
+// flush returns the statistics of the metrics observed since the last flush
+// and resets the collector.
+func (c *Collector) flush() (BlockStats, LatencyStats, int) {
+	c.mtx.Lock()
+	blocks, latencies, newPFBs := c.blocks, c.latencies, c.newPFBs
+	if blocks.Count > 0 {
+		blocks.AvgSquareSize = float64(c.squareSizeSum) / float64(blocks.Count)
+		blocks.AvgFullness = c.fullnessSum / float64(blocks.Count)
+	}
+	c.blocks, c.latencies, c.newPFBs, c.fullnessSum, c.squareSizeSum = BlockStats{}, nil, 0, 0, 0
+	c.mtx.Unlock()
+
+	return blocks, latencyStats(latencies), newPFBs
+}
+
+// latencyStats returns the nearest-rank percentiles of latencies.
+func latencyStats(latencies []time.Duration) LatencyStats {
+	if len(latencies) == 0 {
+		return LatencyStats{}
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	percentile := func(p int) float64 {
+		rank := (p*len(latencies) + 99) / 100
+		return milliseconds(latencies[max(rank, 1)-1])
+	}
+	return LatencyStats{
+		Count: len(latencies),
+		P50Ms: percentile(50),
+		P90Ms: percentile(90),
+		P99Ms: percentile(99),
+		MaxMs: milliseconds(latencies[len(latencies)-1]),
+	}
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,196 @@
+package telemetrypush
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// ReporterIDFile is the name of the file in the data directory of the
+	// node that stores its reporter ID.
+	ReporterIDFile = "telemetry_reporter_id"
+
+	// pushTimeout is the timeout of posting a report.
+	pushTimeout = 10 * time.Second
+
+	// reporterIDBytes is the number of random bytes of a reporter ID.
+	reporterIDBytes = 16
+)
+
+// Report is the JSON body that is posted to the endpoint.
+type Report struct {
+	// ReporterID is the random ID of the node, see LoadOrGenReporterID.
+	ReporterID string    `json:"reporter_id"`
+	ChainID    string    `json:"chain_id"`
+	Version    string    `json:"version"`
+	Timestamp  time.Time `json:"timestamp"`
+	// IntervalSeconds is the interval that the blocks and latencies of the
+	// report were observed over.
+	IntervalSeconds float64      `json:"interval_seconds"`
+	Mempool         MempoolStats `json:"mempool"`
+	Blocks          BlockStats   `json:"blocks"`
+	// ProcessProposal are the latencies of ProcessProposal.
+	ProcessProposal LatencyStats `json:"process_proposal"`
+}
+
+// MempoolStats are the contents of the mempool when the report was created
+// and the blob txs that it accepted over the interval.
+type MempoolStats struct {
+	Txs     int   `json:"txs"`
+	Bytes   int64 `json:"bytes"`
+	NewPFBs int   `json:"new_pfbs"`
+}
+
+// BlockStats are the statistics of the accepted proposals. The fullness of a
+// block is the share of the max square that isn't padding.
+type BlockStats struct {
+	Count         int     `json:"count"`
+	AvgSquareSize float64 `json:"avg_square_size"`
+	MaxSquareSize uint64  `json:"max_square_size"`
+	AvgFullness   float64 `json:"avg_fullness"`
+}
+
+// LatencyStats are percentiles of latencies in milliseconds.
+type LatencyStats struct {
+	Count int     `json:"count"`
+	P50Ms float64 `json:"p50_ms"`
+	P90Ms float64 `json:"p90_ms"`
+	P99Ms float64 `json:"p99_ms"`
+	MaxMs float64 `json:"max_ms"`
+}
+
+// Mempool is the subset of the mempool of the node that reports use.
+type Mempool interface {
+	Size() int
+	SizeBytes() int64
+}
+
+// Pusher posts a report to an endpoint at every interval.
+type Pusher struct {
+	endpoint   string
+	interval   time.Duration
+	collector  *Collector
+	mempool    Mempool
+	reporterID string
+	chainID    string
+	version    string
+	client     *http.Client
+	logger     log.Logger
+}
+
+// NewPusher returns a pusher that reports the metrics of collector and
+// mempool to endpoint under reporterID.
+func NewPusher(endpoint string, interval time.Duration, collector *Collector, mempool Mempool, reporterID, chainID, version string, logger log.Logger) *Pusher {
+	return &Pusher{
+		endpoint:   endpoint,
+		interval:   interval,
+		collector:  collector,
+		mempool:    mempool,
+		reporterID: reporterID,
+		chainID:    chainID,
+		version:    version,
+		client:     &http.Client{Timeout: pushTimeout},
+		logger:     logger.With("module", "telemetrypush"),
+	}
+}
+
+// LoadOrGenReporterID returns the reporter ID stored in the file at path. If
+// the file doesn't exist, it generates a random ID and stores it there, so
+// that the ID stays the same across restarts but can't be derived from any
+// key of the node.
+func LoadOrGenReporterID(path string) (string, error) {
+	bz, err := os.ReadFile(path)
+	if err == nil {
+		id := strings.TrimSpace(string(bz))
+		if decoded, err := hex.DecodeString(id); err != nil || len(decoded) != reporterIDBytes {
+			return "", fmt.Errorf("invalid reporter ID in %s", path)
+		}
+		return id, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+
+	b := make([]byte, reporterIDBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	id := hex.EncodeToString(b)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, []byte(id), 0o600); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// Run pushes a report at every interval until ctx is done. Failed pushes are
+// logged and not retried, so the metrics of their interval are lost.
+func (p *Pusher) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.push(ctx, p.report(time.Now())); err != nil {
+				p.logger.Error("failed to push telemetry report", "endpoint", p.endpoint, "err", err)
+			}
+		}
+	}
+}
+
+// report returns the report of the metrics observed since the last report.
+func (p *Pusher) report(now time.Time) Report {
+	blocks, latencies, newPFBs := p.collector.flush()
+	report := Report{
+		ReporterID:      p.reporterID,
+		ChainID:         p.chainID,
+		Version:         p.version,
+		Timestamp:       now.UTC(),
+		IntervalSeconds: p.interval.Seconds(),
+		Mempool: MempoolStats{
+			Txs:     p.mempool.Size(),
+			Bytes:   p.mempool.SizeBytes(),
+			NewPFBs: newPFBs,
+		},
+		Blocks:          blocks,
+		ProcessProposal: latencies,
+	}
+	return report
+}
+
+func (p *Pusher) push(ctx context.Context, report Report) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status %s", res.Status)
+	}
+	return nil
+}
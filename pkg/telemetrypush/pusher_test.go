@@ -0,0 +1,94 @@
+package telemetrypush_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/telemetrypush"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+type mockMempool struct {
+	txs coretypes.Txs
+}
+
+func (m mockMempool) Size() int { return len(m.txs) }
+
+func (m mockMempool) SizeBytes() int64 {
+	var size int64
+	for _, tx := range m.txs {
+		size += int64(len(tx))
+	}
+	return size
+}
+
+func TestPusher(t *testing.T) {
+	reports := make(chan telemetrypush.Report, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var report telemetrypush.Report
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&report))
+		reports <- report
+	}))
+	defer server.Close()
+
+	mempool := mockMempool{txs: coretypes.Txs{[]byte("tx"), []byte("other tx")}}
+
+	collector := telemetrypush.NewCollector()
+	for i := 1; i <= 100; i++ {
+		collector.ObserveProcessProposal(time.Duration(i) * time.Millisecond)
+	}
+	collector.ObserveBlock(8, 32, 16)
+	collector.ObserveBlock(16, 128, 16)
+	collector.ObserveNewPFB()
+
+	const reporterID = "reporter"
+	pusher := telemetrypush.NewPusher(server.URL, 50*time.Millisecond, collector, mempool, reporterID, "chain", "v1.0.0", log.NewNopLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go pusher.Run(ctx)
+
+	report := <-reports
+	assert.Equal(t, reporterID, report.ReporterID)
+	assert.Equal(t, "chain", report.ChainID)
+	assert.Equal(t, "v1.0.0", report.Version)
+	assert.Equal(t, telemetrypush.MempoolStats{Txs: 2, Bytes: mempool.SizeBytes(), NewPFBs: 1}, report.Mempool)
+	assert.Equal(t, telemetrypush.BlockStats{Count: 2, AvgSquareSize: 12, MaxSquareSize: 16, AvgFullness: 0.3125}, report.Blocks)
+	assert.Equal(t, telemetrypush.LatencyStats{Count: 100, P50Ms: 50, P90Ms: 90, P99Ms: 99, MaxMs: 100}, report.ProcessProposal)
+
+	// the metrics are reset after every report.
+	report = <-reports
+	assert.Equal(t, telemetrypush.BlockStats{}, report.Blocks)
+	assert.Equal(t, telemetrypush.LatencyStats{}, report.ProcessProposal)
+	assert.Equal(t, telemetrypush.MempoolStats{Txs: 2, Bytes: mempool.SizeBytes()}, report.Mempool)
+}
+
+func TestLoadOrGenReporterID(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", telemetrypush.ReporterIDFile)
+	id, err := telemetrypush.LoadOrGenReporterID(path)
+	require.NoError(t, err)
+	assert.Len(t, id, 32)
+
+	// the ID is stored and reused
+	loaded, err := telemetrypush.LoadOrGenReporterID(path)
+	require.NoError(t, err)
+	assert.Equal(t, id, loaded)
+
+	// IDs of different installs differ
+	other, err := telemetrypush.LoadOrGenReporterID(filepath.Join(t.TempDir(), telemetrypush.ReporterIDFile))
+	require.NoError(t, err)
+	assert.NotEqual(t, id, other)
+
+	require.NoError(t, os.WriteFile(path, []byte("not hex"), 0o600))
+	_, err = telemetrypush.LoadOrGenReporterID(path)
+	assert.Error(t, err)
+}
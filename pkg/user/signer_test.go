@@ -0,0 +1,89 @@
+package user_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+// TestPayForBlobsSignBytes verifies that the signature of a PFB only covers
+// the inner sdk tx of the blob tx envelope. External signers, e.g. a KMS, sign
+// the sign bytes of the inner tx and the envelope is attached afterwards, so
+// the sign bytes must be reproducible from the envelope alone.
+func TestPayForBlobsSignBytes(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	account := signer.Account(testfactory.TestAccName)
+	require.NotNil(t, account)
+
+	blobs := blobfactory.ManyRandBlobs(tmrand.NewRand(), 100, 1000)
+	rawBlobTx, _, err := signer.CreatePayForBlobs(testfactory.TestAccName, blobs, blobfactory.DefaultTxOpts()...)
+	require.NoError(t, err)
+
+	blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawBlobTx)
+	require.NoError(t, err)
+	require.True(t, isBlobTx)
+	require.NoError(t, blobtypes.ValidateBlobTx(encCfg.TxConfig, blobTx, appconsts.DefaultSubtreeRootThreshold, appconsts.LatestVersion))
+
+	sdkTx, err := signer.DecodeTx(blobTx.Tx)
+	require.NoError(t, err)
+	signBytes := directSignBytes(t, encCfg, signer.ChainID(), account.AccountNumber(), account.Sequence(), sdkTx)
+
+	sigs, err := sdkTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	sigData, ok := sigs[0].Data.(*signing.SingleSignatureData)
+	require.True(t, ok)
+	require.Equal(t, signing.SignMode_SIGN_MODE_DIRECT, sigData.SignMode)
+	require.True(t, account.PubKey().VerifySignature(signBytes, sigData.Signature))
+
+	// The sign bytes are stable across encoding round trips of the envelope.
+	reencoded, err := blobtx.MarshalBlobTx(blobTx.Tx, blobTx.Blobs...)
+	require.NoError(t, err)
+	require.Equal(t, rawBlobTx, reencoded)
+
+	// Replacing a blob doesn't change the sign bytes so the signature remains
+	// valid. The blob is instead bound to the tx by the share commitment of
+	// the signed MsgPayForBlobs.
+	otherBlob, err := share.NewV0Blob(blobTx.Blobs[1].Namespace(), tmrand.Bytes(len(blobTx.Blobs[1].Data())))
+	require.NoError(t, err)
+	tampered, err := blobtx.MarshalBlobTx(blobTx.Tx, blobTx.Blobs[0], otherBlob)
+	require.NoError(t, err)
+	tamperedBlobTx, _, err := blobtx.UnmarshalBlobTx(tampered)
+	require.NoError(t, err)
+	tamperedSdkTx, err := signer.DecodeTx(tamperedBlobTx.Tx)
+	require.NoError(t, err)
+	require.Equal(t, signBytes, directSignBytes(t, encCfg, signer.ChainID(), account.AccountNumber(), account.Sequence(), tamperedSdkTx))
+	require.ErrorIs(t, blobtypes.ValidateBlobTx(encCfg.TxConfig, tamperedBlobTx, appconsts.DefaultSubtreeRootThreshold, appconsts.LatestVersion), blobtypes.ErrInvalidShareCommitment)
+}
+
+func directSignBytes(t *testing.T, encCfg encoding.Config, chainID string, accountNumber, sequence uint64, tx authsigning.Tx) []byte {
+	signers := tx.GetSigners()
+	require.Len(t, signers, 1)
+	signBytes, err := encCfg.TxConfig.SignModeHandler().GetSignBytes(
+		signing.SignMode_SIGN_MODE_DIRECT,
+		authsigning.SignerData{
+			Address:       sdk.AccAddress(signers[0]).String(),
+			ChainID:       chainID,
+			AccountNumber: accountNumber,
+			Sequence:      sequence,
+		},
+		tx,
+	)
+	require.NoError(t, err)
+	return signBytes
+}
@@ -0,0 +1,280 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+)
+
+// ErrTxEvicted is returned by ConfirmTx when the tx was evicted from the
+// mempool. The sequence of the signer is rolled back so that the tx can be
+// resubmitted.
+var ErrTxEvicted = errors.New("tx was evicted from the mempool")
+
+// RetryPolicy configures how SubmitPayForBlob, SubmitPayForBlobWithAccount
+// and SubmitTx resubmit a tx that didn't make it into a block. A tx is
+// resubmitted when it was evicted from the mempool, when the node rejected it
+// because its mempool is full or its fee is too low, or when the node was
+// unavailable. Other errors, including execution errors, are returned
+// immediately.
+//
+// The zero value doesn't resubmit txs.
+type RetryPolicy struct {
+	// MaxResubmissions is the number of times a tx is resubmitted after its
+	// first submission failed.
+	MaxResubmissions int
+	// InitialBackoff is the time to wait before the first resubmission.
+	InitialBackoff time.Duration
+	// BackoffMultiplier multiplies the backoff after every resubmission. It
+	// must be at least 1.
+	BackoffMultiplier float64
+	// MaxBackoff caps the backoff. 0 means no cap.
+	MaxBackoff time.Duration
+	// FeeBumpMultiplier multiplies the fee of a tx that is resubmitted after it
+	// was evicted or rejected for its fee. It must be at least 1.
+	FeeBumpMultiplier float64
+	// MaxGasPrice caps the gas price that fee bumps can reach, in utia. 0
+	// means no cap.
+	MaxGasPrice float64
+}
+
+// DefaultRetryPolicy returns a policy that resubmits a tx up to 5 times with
+// a backoff that starts at a second and doubles up to 30 seconds and a fee
+// that increases by 50% after every eviction.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxResubmissions:  5,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2,
+		MaxBackoff:        30 * time.Second,
+		FeeBumpMultiplier: 1.5,
+	}
+}
+
+// Validate returns an error if the policy is invalid.
+func (p RetryPolicy) Validate() error {
+	if p.MaxResubmissions < 0 {
+		return fmt.Errorf("max resubmissions %d must not be negative", p.MaxResubmissions)
+	}
+	if p.InitialBackoff < 0 || p.MaxBackoff < 0 {
+		return fmt.Errorf("backoffs must not be negative")
+	}
+	if p.MaxResubmissions > 0 && p.BackoffMultiplier < 1 {
+		return fmt.Errorf("backoff multiplier %v must be at least 1", p.BackoffMultiplier)
+	}
+	if p.MaxResubmissions > 0 && p.FeeBumpMultiplier < 1 {
+		return fmt.Errorf("fee bump multiplier %v must be at least 1", p.FeeBumpMultiplier)
+	}
+	if p.MaxGasPrice < 0 {
+		return fmt.Errorf("max gas price %v must not be negative", p.MaxGasPrice)
+	}
+	return nil
+}
+
+// backoff returns the time to wait before the resubmission that follows the
+// given number of failed submissions.
+func (p RetryPolicy) backoff(failures int) time.Duration {
+	backoff := float64(p.InitialBackoff) * math.Pow(p.BackoffMultiplier, float64(failures-1))
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+	return time.Duration(backoff)
+}
+
+// ConfirmationPolicy configures when a submitted tx is considered confirmed.
+// The zero value confirms a tx as soon as it is committed in a block.
+type ConfirmationPolicy struct {
+	// Depth is the number of blocks that must be committed on top of the block
+	// of the tx before it is confirmed.
+	Depth int64
+}
+
+// SubmitHooks are called while a tx is submitted so that integrators can
+// collect metrics. Every hook is optional. Hooks are called synchronously so
+// they should return quickly.
+type SubmitHooks struct {
+	// OnBroadcast is called after an attempt of the tx was accepted by the
+	// mempool. The first attempt is 1.
+	OnBroadcast func(txHash string, attempt int)
+	// OnEviction is called when an attempt of the tx was evicted from the
+	// mempool.
+	OnEviction func(txHash string, attempt int)
+	// OnRetry is called before waiting backoff to resubmit the tx after the
+	// attempt failed with err.
+	OnRetry func(attempt int, backoff time.Duration, err error)
+	// OnConfirmation is called when the tx is confirmed after the given number
+	// of attempts and the time since the submission started.
+	OnConfirmation func(resp *TxResponse, attempts int, elapsed time.Duration)
+	// OnFailure is called when the submission failed with err after the given
+	// number of attempts.
+	OnFailure func(err error, attempts int)
+}
+
+// WithRetryPolicy sets the policy to resubmit txs with.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *TxClient) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithConfirmationPolicy sets the policy to confirm txs with.
+func WithConfirmationPolicy(policy ConfirmationPolicy) Option {
+	return func(c *TxClient) {
+		c.confirmationPolicy = policy
+	}
+}
+
+// WithSubmitHooks sets the hooks that are called while txs are submitted.
+func WithSubmitHooks(hooks SubmitHooks) Option {
+	return func(c *TxClient) {
+		c.submitHooks = hooks
+	}
+}
+
+// broadcastFunc signs and broadcasts a tx with its fee multiplied by
+// feeMultiplier.
+type broadcastFunc func(ctx context.Context, feeMultiplier float64) (*sdktypes.TxResponse, error)
+
+// submit broadcasts and confirms a tx with broadcast, resubmitting it
+// according to the retry policy and waiting for the confirmation depth.
+func (client *TxClient) submit(ctx context.Context, broadcast broadcastFunc) (*TxResponse, error) {
+	start := time.Now()
+	feeMultiplier := 1.0
+	for attempt := 1; ; attempt++ {
+		resp, err := client.submitAttempt(ctx, broadcast, feeMultiplier, attempt)
+		if err == nil {
+			if client.submitHooks.OnConfirmation != nil {
+				client.submitHooks.OnConfirmation(resp, attempt, time.Since(start))
+			}
+			return resp, nil
+		}
+
+		retry, bumpFee := isRetryable(err)
+		if !retry || attempt > client.retryPolicy.MaxResubmissions || ctx.Err() != nil {
+			if client.submitHooks.OnFailure != nil {
+				client.submitHooks.OnFailure(err, attempt)
+			}
+			return nil, err
+		}
+		if bumpFee {
+			feeMultiplier *= client.retryPolicy.FeeBumpMultiplier
+		}
+		backoff := client.retryPolicy.backoff(attempt)
+		if client.submitHooks.OnRetry != nil {
+			client.submitHooks.OnRetry(attempt, backoff, err)
+		}
+		select {
+		case <-ctx.Done():
+			if client.submitHooks.OnFailure != nil {
+				client.submitHooks.OnFailure(ctx.Err(), attempt)
+			}
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// submitAttempt broadcasts a tx, waits for it to be committed and for the
+// confirmation depth.
+func (client *TxClient) submitAttempt(ctx context.Context, broadcast broadcastFunc, feeMultiplier float64, attempt int) (*TxResponse, error) {
+	broadcastResp, err := broadcast(ctx, feeMultiplier)
+	if err != nil {
+		return nil, err
+	}
+	if client.submitHooks.OnBroadcast != nil {
+		client.submitHooks.OnBroadcast(broadcastResp.TxHash, attempt)
+	}
+
+	resp, err := client.ConfirmTx(ctx, broadcastResp.TxHash)
+	if err != nil {
+		if errors.Is(err, ErrTxEvicted) && client.submitHooks.OnEviction != nil {
+			client.submitHooks.OnEviction(broadcastResp.TxHash, attempt)
+		}
+		return nil, err
+	}
+	if err := client.waitForDepth(ctx, resp.Height); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// waitForDepth waits until the confirmation depth is reached on top of the
+// block at height.
+func (client *TxClient) waitForDepth(ctx context.Context, height int64) error {
+	if client.confirmationPolicy.Depth <= 0 {
+		return nil
+	}
+	serviceClient := tmservice.NewServiceClient(client.grpc)
+
+	pollTicker := time.NewTicker(client.pollTime)
+	defer pollTicker.Stop()
+
+	for {
+		resp, err := serviceClient.GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+		if err != nil {
+			return err
+		}
+		if resp.SdkBlock.Header.Height >= height+client.confirmationPolicy.Depth {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-pollTicker.C:
+		}
+	}
+}
+
+// isRetryable returns whether a tx that failed with err should be
+// resubmitted and whether its fee should be bumped.
+func isRetryable(err error) (retry, bumpFee bool) {
+	if errors.Is(err, ErrTxEvicted) {
+		return true, true
+	}
+	var broadcastErr *BroadcastTxError
+	if errors.As(err, &broadcastErr) {
+		switch broadcastErr.Code {
+		case sdkerrors.ErrInsufficientFee.ABCICode():
+			return true, true
+		case sdkerrors.ErrMempoolIsFull.ABCICode():
+			return true, false
+		}
+		return false, false
+	}
+	return status.Code(err) == codes.Unavailable, false
+}
+
+// bumpFeeOption returns a TxOption that bumps the fee set by the preceding
+// options by multiplier.
+func bumpFeeOption(c *TxClient, multiplier float64) TxOption {
+	return func(builder client.TxBuilder) client.TxBuilder {
+		c.bumpFee(builder, multiplier)
+		return builder
+	}
+}
+
+// bumpFee multiplies the fee of txBuilder by multiplier without raising its
+// gas price above the max gas price of the retry policy.
+func (client *TxClient) bumpFee(txBuilder client.TxBuilder, multiplier float64) {
+	if multiplier == 1 {
+		return
+	}
+	fee := float64(txBuilder.GetTx().GetFee().AmountOf(appconsts.BondDenom).Int64())
+	bumped := math.Ceil(fee * multiplier)
+	if client.retryPolicy.MaxGasPrice > 0 {
+		maxFee := math.Floor(client.retryPolicy.MaxGasPrice * float64(txBuilder.GetTx().GetGas()))
+		bumped = math.Max(fee, math.Min(bumped, maxFee))
+	}
+	txBuilder.SetFeeAmount(sdktypes.NewCoins(sdktypes.NewInt64Coin(appconsts.BondDenom, int64(bumped))))
+}
@@ -0,0 +1,94 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2,
+		MaxBackoff:        5 * time.Second,
+	}
+	assert.Equal(t, time.Second, policy.backoff(1))
+	assert.Equal(t, 2*time.Second, policy.backoff(2))
+	assert.Equal(t, 4*time.Second, policy.backoff(3))
+	assert.Equal(t, 5*time.Second, policy.backoff(4))
+
+	policy.MaxBackoff = 0
+	assert.Equal(t, 8*time.Second, policy.backoff(4))
+}
+
+func TestRetryPolicyValidate(t *testing.T) {
+	require.NoError(t, RetryPolicy{}.Validate())
+	require.NoError(t, DefaultRetryPolicy().Validate())
+
+	invalid := map[string]func(*RetryPolicy){
+		"negative resubmissions":  func(p *RetryPolicy) { p.MaxResubmissions = -1 },
+		"negative backoff":        func(p *RetryPolicy) { p.InitialBackoff = -time.Second },
+		"backoff multiplier < 1":  func(p *RetryPolicy) { p.BackoffMultiplier = 0.5 },
+		"fee bump multiplier < 1": func(p *RetryPolicy) { p.FeeBumpMultiplier = 0.5 },
+		"negative max gas price":  func(p *RetryPolicy) { p.MaxGasPrice = -1 },
+	}
+	for name, modify := range invalid {
+		t.Run(name, func(t *testing.T) {
+			policy := DefaultRetryPolicy()
+			modify(&policy)
+			assert.Error(t, policy.Validate())
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	testCases := []struct {
+		err     error
+		retry   bool
+		bumpFee bool
+	}{
+		{ErrTxEvicted, true, true},
+		{fmt.Errorf("confirming: %w", ErrTxEvicted), true, true},
+		{&BroadcastTxError{Code: sdkerrors.ErrInsufficientFee.ABCICode()}, true, true},
+		{&BroadcastTxError{Code: sdkerrors.ErrMempoolIsFull.ABCICode()}, true, false},
+		{&BroadcastTxError{Code: sdkerrors.ErrWrongSequence.ABCICode()}, false, false},
+		{&ExecutionError{Code: sdkerrors.ErrInsufficientFunds.ABCICode()}, false, false},
+		{status.Error(codes.Unavailable, "connection refused"), true, false},
+		{status.Error(codes.InvalidArgument, "invalid tx"), false, false},
+		{errors.New("rejected"), false, false},
+	}
+	for _, tc := range testCases {
+		retry, bumpFee := isRetryable(tc.err)
+		assert.Equal(t, tc.retry, retry, tc.err.Error())
+		assert.Equal(t, tc.bumpFee, bumpFee, tc.err.Error())
+	}
+}
+
+func TestBumpFee(t *testing.T) {
+	txConfig := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+	fee := func(client *TxClient, multiplier float64) int64 {
+		builder := SetGasLimitAndGasPrice(100_000, appconsts.DefaultMinGasPrice)(txConfig.NewTxBuilder())
+		client.bumpFee(builder, multiplier)
+		return builder.GetTx().GetFee().AmountOf(appconsts.BondDenom).Int64()
+	}
+
+	client := &TxClient{}
+	assert.EqualValues(t, 200, fee(client, 1))
+	assert.EqualValues(t, 300, fee(client, 1.5))
+
+	// the max gas price caps the bump but doesn't lower the fee.
+	client.retryPolicy.MaxGasPrice = 0.0025
+	assert.EqualValues(t, 250, fee(client, 1.5))
+	client.retryPolicy.MaxGasPrice = 0.001
+	assert.EqualValues(t, 200, fee(client, 1.5))
+}
@@ -147,6 +147,11 @@ type TxClient struct {
 	// txTracker maps the tx hash to the Sequence and signer of the transaction
 	// that was submitted to the chain
 	txTracker map[string]txInfo
+	// retryPolicy, confirmationPolicy and submitHooks configure how the Submit
+	// methods resubmit and confirm txs
+	retryPolicy        RetryPolicy
+	confirmationPolicy ConfirmationPolicy
+	submitHooks        SubmitHooks
 }
 
 // NewTxClient returns a new signer using the provided keyring
@@ -186,6 +191,10 @@ func NewTxClient(
 		opt(txClient)
 	}
 
+	if err := txClient.retryPolicy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid retry policy: %w", err)
+	}
+
 	return txClient, nil
 }
 
@@ -245,25 +254,19 @@ func SetupTxClient(
 }
 
 // SubmitPayForBlob forms a transaction from the provided blobs, signs it, and submits it to the chain.
-// TxOptions may be provided to set the fee and gas limit.
+// TxOptions may be provided to set the fee and gas limit. The transaction is resubmitted and
+// confirmed according to the retry and confirmation policies of the client.
 func (client *TxClient) SubmitPayForBlob(ctx context.Context, blobs []*share.Blob, opts ...TxOption) (*TxResponse, error) {
-	resp, err := client.BroadcastPayForBlob(ctx, blobs, opts...)
-	if err != nil {
-		return nil, err
-	}
-
-	return client.ConfirmTx(ctx, resp.TxHash)
+	return client.SubmitPayForBlobWithAccount(ctx, client.defaultAccount, blobs, opts...)
 }
 
 // SubmitPayForBlobWithAccount forms a transaction from the provided blobs, signs it with the provided account, and submits it to the chain.
-// TxOptions may be provided to set the fee and gas limit.
+// TxOptions may be provided to set the fee and gas limit. The transaction is resubmitted and
+// confirmed according to the retry and confirmation policies of the client.
 func (client *TxClient) SubmitPayForBlobWithAccount(ctx context.Context, account string, blobs []*share.Blob, opts ...TxOption) (*TxResponse, error) {
-	resp, err := client.BroadcastPayForBlobWithAccount(ctx, account, blobs, opts...)
-	if err != nil {
-		return nil, err
-	}
-
-	return client.ConfirmTx(ctx, resp.TxHash)
+	return client.submit(ctx, func(ctx context.Context, feeMultiplier float64) (*sdktypes.TxResponse, error) {
+		return client.broadcastPayForBlob(ctx, account, blobs, feeMultiplier, opts...)
+	})
 }
 
 // BroadcastPayForBlob signs and broadcasts a transaction to pay for blobs.
@@ -275,6 +278,12 @@ func (client *TxClient) BroadcastPayForBlob(ctx context.Context, blobs []*share.
 }
 
 func (client *TxClient) BroadcastPayForBlobWithAccount(ctx context.Context, account string, blobs []*share.Blob, opts ...TxOption) (*sdktypes.TxResponse, error) {
+	return client.broadcastPayForBlob(ctx, account, blobs, 1, opts...)
+}
+
+// broadcastPayForBlob signs and broadcasts a transaction to pay for blobs
+// with its fee multiplied by feeMultiplier.
+func (client *TxClient) broadcastPayForBlob(ctx context.Context, account string, blobs []*share.Blob, feeMultiplier float64, opts ...TxOption) (*sdktypes.TxResponse, error) {
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
 	if err := client.checkAccountLoaded(ctx, account); err != nil {
@@ -290,6 +299,7 @@ func (client *TxClient) BroadcastPayForBlobWithAccount(ctx context.Context, acco
 	fee := uint64(math.Ceil(appconsts.DefaultMinGasPrice * float64(gasLimit)))
 	// prepend calculated params, so it can be overwritten in case the user has specified it.
 	opts = append([]TxOption{SetGasLimit(gasLimit), SetFee(fee)}, opts...)
+	opts = append(opts, bumpFeeOption(client, feeMultiplier))
 
 	txBytes, _, err := client.signer.CreatePayForBlobs(account, blobs, opts...)
 	if err != nil {
@@ -300,17 +310,21 @@ func (client *TxClient) BroadcastPayForBlobWithAccount(ctx context.Context, acco
 }
 
 // SubmitTx forms a transaction from the provided messages, signs it, and submits it to the chain. TxOptions
-// may be provided to set the fee and gas limit.
+// may be provided to set the fee and gas limit. The transaction is resubmitted and confirmed according
+// to the retry and confirmation policies of the client.
 func (client *TxClient) SubmitTx(ctx context.Context, msgs []sdktypes.Msg, opts ...TxOption) (*TxResponse, error) {
-	resp, err := client.BroadcastTx(ctx, msgs, opts...)
-	if err != nil {
-		return nil, err
-	}
-
-	return client.ConfirmTx(ctx, resp.TxHash)
+	return client.submit(ctx, func(ctx context.Context, feeMultiplier float64) (*sdktypes.TxResponse, error) {
+		return client.broadcastMsgs(ctx, msgs, feeMultiplier, opts...)
+	})
 }
 
 func (client *TxClient) BroadcastTx(ctx context.Context, msgs []sdktypes.Msg, opts ...TxOption) (*sdktypes.TxResponse, error) {
+	return client.broadcastMsgs(ctx, msgs, 1, opts...)
+}
+
+// broadcastMsgs signs and broadcasts a transaction of msgs with its fee
+// multiplied by feeMultiplier.
+func (client *TxClient) broadcastMsgs(ctx context.Context, msgs []sdktypes.Msg, feeMultiplier float64, opts ...TxOption) (*sdktypes.TxResponse, error) {
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
 
@@ -358,6 +372,7 @@ func (client *TxClient) BroadcastTx(ctx context.Context, msgs []sdktypes.Msg, op
 		fee := int64(math.Ceil(appconsts.DefaultMinGasPrice * float64(gasLimit)))
 		txBuilder.SetFeeAmount(sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdktypes.NewInt(fee))))
 	}
+	client.bumpFee(txBuilder, feeMultiplier)
 
 	account, _, err = client.signer.signTransaction(txBuilder)
 	if err != nil {
@@ -461,6 +476,9 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 			return txResponse, nil
 		case core.TxStatusEvicted:
 			return nil, client.handleEvictions(txHash)
+		case tx.TxStatusRejected:
+			client.deleteFromTxTracker(txHash)
+			return nil, fmt.Errorf("transaction with hash %s was rejected by the mempool (%s): %s", txHash, resp.Reason, resp.Error)
 		default:
 			client.deleteFromTxTracker(txHash)
 			return nil, fmt.Errorf("transaction with hash %s not found; it was likely rejected", txHash)
@@ -485,7 +503,7 @@ func (client *TxClient) handleEvictions(txHash string) error {
 		return fmt.Errorf("setting sequence: %w", err)
 	}
 	delete(client.txTracker, txHash)
-	return fmt.Errorf("tx was evicted from the mempool")
+	return ErrTxEvicted
 }
 
 // deleteFromTxTracker safely deletes a transaction from the local tx tracker.
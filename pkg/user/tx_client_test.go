@@ -252,6 +252,82 @@ func TestEvictions(t *testing.T) {
 	require.Equal(t, seqBeforeEviction, seqAfterEviction)
 }
 
+// TestSubmitPolicies verifies that the Submit methods resubmit txs according
+// to the retry policy and wait for the depth of the confirmation policy.
+func TestSubmitPolicies(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	ctx, _, _ := testnode.NewNetwork(t, testnode.DefaultConfig().WithFundedAccounts("a"))
+	_, err := ctx.WaitForHeight(1)
+	require.NoError(t, err)
+
+	// the min gas price requires a fee of 200utia for 100,000 gas so the fees
+	// of 1utia, 10utia and 100utia are rejected.
+	lowFee := []user.TxOption{user.SetGasLimit(100_000), user.SetFee(1)}
+	newMsg := func(txClient *user.TxClient) sdk.Msg {
+		return bank.NewMsgSend(txClient.DefaultAddress(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+	}
+
+	t.Run("resubmit with a bumped fee", func(t *testing.T) {
+		var retries []error
+		var confirmedAttempts int
+		policy := user.RetryPolicy{
+			MaxResubmissions:  5,
+			InitialBackoff:    10 * time.Millisecond,
+			BackoffMultiplier: 1,
+			FeeBumpMultiplier: 10,
+		}
+		hooks := user.SubmitHooks{
+			OnRetry: func(_ int, _ time.Duration, err error) { retries = append(retries, err) },
+			OnConfirmation: func(_ *user.TxResponse, attempts int, _ time.Duration) {
+				confirmedAttempts = attempts
+			},
+		}
+		txClient, err := user.SetupTxClient(ctx.GoContext(), ctx.Keyring, ctx.GRPCClient, encCfg, user.WithRetryPolicy(policy), user.WithSubmitHooks(hooks))
+		require.NoError(t, err)
+
+		resp, err := txClient.SubmitTx(ctx.GoContext(), []sdk.Msg{newMsg(txClient)}, lowFee...)
+		require.NoError(t, err)
+		require.Equal(t, abci.CodeTypeOK, resp.Code)
+		require.Len(t, retries, 3)
+		for _, err := range retries {
+			var broadcastErr *user.BroadcastTxError
+			require.ErrorAs(t, err, &broadcastErr)
+		}
+		require.Equal(t, 4, confirmedAttempts)
+
+		getTxResp, err := sdktx.NewServiceClient(ctx.GRPCClient).GetTx(ctx.GoContext(), &sdktx.GetTxRequest{Hash: resp.TxHash})
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1000)), getTxResp.Tx.AuthInfo.Fee.Amount)
+	})
+
+	t.Run("return the rejection without resubmissions", func(t *testing.T) {
+		txClient, err := user.SetupTxClient(ctx.GoContext(), ctx.Keyring, ctx.GRPCClient, encCfg)
+		require.NoError(t, err)
+		_, err = txClient.SubmitTx(ctx.GoContext(), []sdk.Msg{newMsg(txClient)}, lowFee...)
+		var broadcastErr *user.BroadcastTxError
+		require.ErrorAs(t, err, &broadcastErr)
+	})
+
+	t.Run("wait for the confirmation depth", func(t *testing.T) {
+		txClient, err := user.SetupTxClient(ctx.GoContext(), ctx.Keyring, ctx.GRPCClient, encCfg,
+			user.WithConfirmationPolicy(user.ConfirmationPolicy{Depth: 2}), user.WithPollTime(100*time.Millisecond))
+		require.NoError(t, err)
+
+		subCtx, cancel := context.WithTimeout(ctx.GoContext(), 30*time.Second)
+		defer cancel()
+		resp, err := txClient.SubmitPayForBlob(subCtx, blobfactory.ManyRandBlobs(rand.NewRand(), 1e3))
+		require.NoError(t, err)
+		require.Equal(t, abci.CodeTypeOK, resp.Code)
+
+		height, err := ctx.LatestHeight()
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, height, resp.Height+2)
+	})
+}
+
 func (suite *TxClientTestSuite) TestGasEstimation() {
 	addr := suite.txClient.DefaultAddress()
 	msg := bank.NewMsgSend(addr, testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
@@ -350,3 +426,16 @@ func setupTxClient(t *testing.T, ttlDuration time.Duration) (encoding.Config, *u
 	require.NoError(t, err)
 	return encCfg, txClient, ctx
 }
+
+func (suite *TxClientTestSuite) TestQueryVersionMetadata() {
+	t := suite.T()
+	md, err := user.QueryVersionMetadata(suite.ctx.GoContext(), suite.ctx.GRPCClient)
+	require.NoError(t, err)
+	require.Equal(t, appconsts.LatestVersion, md.AppVersion)
+	require.Equal(t, suite.ctx.ChainID, md.ChainID)
+	require.Equal(t, appconsts.ConstantsDigest(appconsts.LatestVersion), md.ConstantsDigest)
+	require.True(t, md.IsSupported())
+
+	md.AppVersion = appconsts.LatestVersion + 1
+	require.False(t, md.IsSupported())
+}
@@ -0,0 +1,85 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2/share"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// VersionMetadata is the version information that a node attaches to the
+// headers of every gRPC query response. It allows clients to keep working
+// across network upgrades by adapting to the version reported by the node.
+type VersionMetadata struct {
+	AppVersion      uint64
+	ChainID         string
+	ConstantsDigest string
+}
+
+// ParseVersionMetadata parses the version metadata from the headers of a gRPC
+// response. The headers can be captured with the grpc.Header call option.
+func ParseVersionMetadata(header metadata.MD) (VersionMetadata, error) {
+	versions := header.Get(app.AppVersionHeader)
+	if len(versions) != 1 {
+		return VersionMetadata{}, fmt.Errorf("expected exactly one %s header, got %d", app.AppVersionHeader, len(versions))
+	}
+	appVersion, err := strconv.ParseUint(versions[0], 10, 64)
+	if err != nil {
+		return VersionMetadata{}, fmt.Errorf("parsing %s header: %w", app.AppVersionHeader, err)
+	}
+
+	md := VersionMetadata{AppVersion: appVersion}
+	if chainIDs := header.Get(app.ChainIDHeader); len(chainIDs) == 1 {
+		md.ChainID = chainIDs[0]
+	}
+	if digests := header.Get(app.ConstantsDigestHeader); len(digests) == 1 {
+		md.ConstantsDigest = digests[0]
+	}
+	return md, nil
+}
+
+// QueryVersionMetadata returns the version metadata of the node the grpcConn
+// is connected to.
+func QueryVersionMetadata(ctx context.Context, grpcConn *grpc.ClientConn) (VersionMetadata, error) {
+	var header metadata.MD
+	_, err := nodeservice.NewServiceClient(grpcConn).Config(ctx, &nodeservice.ConfigRequest{}, grpc.Header(&header))
+	if err != nil {
+		return VersionMetadata{}, err
+	}
+	return ParseVersionMetadata(header)
+}
+
+// IsSupported returns true if the client knows the constants for the app
+// version reported by the node, i.e. the app version is not newer than the
+// latest version compiled into the client and the constants digests match.
+// Nodes that don't report a digest are assumed to be compatible.
+func (m VersionMetadata) IsSupported() bool {
+	if m.AppVersion > appconsts.LatestVersion {
+		return false
+	}
+	return m.ConstantsDigest == "" || m.ConstantsDigest == appconsts.ConstantsDigest(m.AppVersion)
+}
+
+// NamespaceSize returns the size in bytes of a namespace for the reported app
+// version.
+func (m VersionMetadata) NamespaceSize() int {
+	return share.NamespaceSize
+}
+
+// SubtreeRootThreshold returns the subtree root threshold used to create share
+// commitments for the reported app version.
+func (m VersionMetadata) SubtreeRootThreshold() int {
+	return appconsts.SubtreeRootThreshold(m.AppVersion)
+}
+
+// SquareSizeUpperBound returns the upper bound of the square size for the
+// reported app version.
+func (m VersionMetadata) SquareSizeUpperBound() int {
+	return appconsts.SquareSizeUpperBound(m.AppVersion)
+}
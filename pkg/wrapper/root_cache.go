@@ -0,0 +1,151 @@
+package wrapper
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"sync"
+
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// DefaultRootCacheSize is the default number of roots kept by a RootCache. It
+// fits the row and column roots of two squares of the max square size upper
+// bound.
+const DefaultRootCacheSize = 2 * 4 * 128
+
+var _ rsmt2d.Tree = &cachedTree{}
+
+// RootCache caches the roots of ErasuredNamespacedMerkleTrees by a hash of
+// their leaves. Rows and columns that are unchanged between two data squares,
+// e.g. between PrepareProposal and ProcessProposal on the proposer, aren't
+// recomputed. The least recently used roots are evicted once the cache holds
+// more than its size.
+type RootCache struct {
+	size int
+
+	mtx     sync.Mutex
+	entries map[[sha256.Size]byte]*list.Element
+	lru     *list.List
+	hits    uint64
+	misses  uint64
+}
+
+type rootCacheEntry struct {
+	key  [sha256.Size]byte
+	root []byte
+}
+
+// NewRootCache returns a cache that keeps up to size roots.
+func NewRootCache(size int) *RootCache {
+	return &RootCache{
+		size:    size,
+		entries: make(map[[sha256.Size]byte]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// NewConstructor returns a tree constructor like NewConstructor whose trees
+// take their roots from the cache. The leaves of a tree are only pushed to
+// an ErasuredNamespacedMerkleTree if its root isn't cached.
+func (c *RootCache) NewConstructor(squareSize uint64) rsmt2d.TreeConstructorFn {
+	return func(_ rsmt2d.Axis, axisIndex uint) rsmt2d.Tree {
+		hasher := sha256.New()
+		// The root of a tree depends on its leaves and on which of them are
+		// parity shares, which is determined by the square size and whether
+		// the axis is part of the original data square.
+		var prefix [9]byte
+		binary.BigEndian.PutUint64(prefix[:8], squareSize)
+		if uint64(axisIndex) < squareSize {
+			prefix[8] = 1
+		}
+		hasher.Write(prefix[:])
+		return &cachedTree{
+			cache:      c,
+			squareSize: squareSize,
+			axisIndex:  axisIndex,
+			hasher:     hasher,
+		}
+	}
+}
+
+// Stats returns the number of cache hits and misses.
+func (c *RootCache) Stats() (hits, misses uint64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.hits, c.misses
+}
+
+func (c *RootCache) get(key [sha256.Size]byte) ([]byte, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		telemetry.IncrCounter(1, "nmt_root_cache", "misses")
+		return nil, false
+	}
+	c.hits++
+	telemetry.IncrCounter(1, "nmt_root_cache", "hits")
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*rootCacheEntry).root, true
+}
+
+func (c *RootCache) add(key [sha256.Size]byte, root []byte) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&rootCacheEntry{key: key, root: root})
+	for c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*rootCacheEntry).key)
+	}
+}
+
+// cachedTree buffers the leaves that are pushed to it and only computes the
+// root if it isn't cached.
+type cachedTree struct {
+	cache      *RootCache
+	squareSize uint64
+	axisIndex  uint
+	hasher     hash.Hash
+	leaves     [][]byte
+}
+
+// Push implements rsmt2d.Tree.
+func (t *cachedTree) Push(data []byte) error {
+	var length [8]byte
+	binary.BigEndian.PutUint64(length[:], uint64(len(data)))
+	t.hasher.Write(length[:])
+	t.hasher.Write(data)
+	t.leaves = append(t.leaves, data)
+	return nil
+}
+
+// Root implements rsmt2d.Tree.
+func (t *cachedTree) Root() ([]byte, error) {
+	var key [sha256.Size]byte
+	copy(key[:], t.hasher.Sum(nil))
+	if root, ok := t.cache.get(key); ok {
+		return root, nil
+	}
+
+	tree := NewErasuredNamespacedMerkleTree(t.squareSize, t.axisIndex)
+	for _, leaf := range t.leaves {
+		if err := tree.Push(leaf); err != nil {
+			return nil, err
+		}
+	}
+	root, err := tree.Root()
+	if err != nil {
+		return nil, err
+	}
+	t.cache.add(key, root)
+	return root, nil
+}
@@ -0,0 +1,77 @@
+package wrapper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRootCache(t *testing.T) {
+	squareSize := 8
+	data := testfactory.GenerateRandNamespacedRawData(squareSize * squareSize)
+
+	eds, err := rsmt2d.ComputeExtendedDataSquare(data, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(squareSize)))
+	require.NoError(t, err)
+	wantRows, err := eds.RowRoots()
+	require.NoError(t, err)
+	wantCols, err := eds.ColRoots()
+	require.NoError(t, err)
+
+	cache := wrapper.NewRootCache(wrapper.DefaultRootCacheSize)
+	computeRoots := func(data [][]byte) (rows, cols [][]byte) {
+		eds, err := rsmt2d.ComputeExtendedDataSquare(data, appconsts.DefaultCodec(), cache.NewConstructor(uint64(squareSize)))
+		require.NoError(t, err)
+		rows, err = eds.RowRoots()
+		require.NoError(t, err)
+		cols, err = eds.ColRoots()
+		require.NoError(t, err)
+		return rows, cols
+	}
+
+	// The roots don't depend on the cache.
+	rows, cols := computeRoots(data)
+	require.Equal(t, wantRows, rows)
+	require.Equal(t, wantCols, cols)
+	hits, misses := cache.Stats()
+	require.Zero(t, hits)
+	require.Equal(t, uint64(4*squareSize), misses)
+
+	rows, cols = computeRoots(data)
+	require.Equal(t, wantRows, rows)
+	require.Equal(t, wantCols, cols)
+	hits, misses = cache.Stats()
+	require.Equal(t, uint64(4*squareSize), hits)
+	require.Equal(t, uint64(4*squareSize), misses)
+
+	// Changing the last share of the original square only changes the last
+	// original row and column and all parity rows and columns.
+	changed := append([][]byte(nil), data...)
+	last := append([]byte(nil), data[len(data)-1]...)
+	last[len(last)-1]++
+	changed[len(changed)-1] = last
+	computeRoots(changed)
+	hits, _ = cache.Stats()
+	require.Equal(t, uint64(4*squareSize+2*(squareSize-1)), hits)
+}
+
+func TestRootCacheEviction(t *testing.T) {
+	squareSize := 2
+	data := testfactory.GenerateRandNamespacedRawData(squareSize * squareSize)
+	cache := wrapper.NewRootCache(1)
+
+	for i := 0; i < 2; i++ {
+		eds, err := rsmt2d.ComputeExtendedDataSquare(data, appconsts.DefaultCodec(), cache.NewConstructor(uint64(squareSize)))
+		require.NoError(t, err)
+		_, err = eds.RowRoots()
+		require.NoError(t, err)
+	}
+	// Only one root fits in the cache so at most one tree per square is a
+	// hit.
+	hits, misses := cache.Stats()
+	require.LessOrEqual(t, hits, uint64(2))
+	require.Equal(t, uint64(2*4*squareSize), hits+misses)
+}
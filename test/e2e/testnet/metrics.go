@@ -0,0 +1,70 @@
+package testnet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+// BlockMetrics summarises the blocks produced by a testnet.
+type BlockMetrics struct {
+	StartHeight int64
+	EndHeight   int64
+	TotalTxs    int
+	// TotalBytes is the sum of the sizes of all blocks in bytes.
+	TotalBytes int
+	// MaxBlockBytes is the size of the largest block in bytes.
+	MaxBlockBytes int
+	// AvgBlockTime is the average time between two consecutive blocks.
+	AvgBlockTime time.Duration
+	// Throughput is the number of block bytes produced per second.
+	Throughput float64
+	// AppVersions maps each app version to the number of blocks produced with
+	// it.
+	AppVersions map[uint64]int
+}
+
+// NewBlockMetrics computes the BlockMetrics of the provided block metas. The
+// block metas may be in any order.
+func NewBlockMetrics(blockMetas []*types.BlockMeta) BlockMetrics {
+	metrics := BlockMetrics{AppVersions: make(map[uint64]int)}
+	if len(blockMetas) == 0 {
+		return metrics
+	}
+
+	var start, end time.Time
+	for i, blockMeta := range blockMetas {
+		header := blockMeta.Header
+		if i == 0 || header.Height < metrics.StartHeight {
+			metrics.StartHeight = header.Height
+			start = header.Time
+		}
+		if i == 0 || header.Height > metrics.EndHeight {
+			metrics.EndHeight = header.Height
+			end = header.Time
+		}
+		metrics.TotalTxs += blockMeta.NumTxs
+		metrics.TotalBytes += blockMeta.BlockSize
+		if blockMeta.BlockSize > metrics.MaxBlockBytes {
+			metrics.MaxBlockBytes = blockMeta.BlockSize
+		}
+		metrics.AppVersions[header.Version.App]++
+	}
+
+	if metrics.EndHeight > metrics.StartHeight {
+		elapsed := end.Sub(start)
+		metrics.AvgBlockTime = elapsed / time.Duration(metrics.EndHeight-metrics.StartHeight)
+		if elapsed > 0 {
+			metrics.Throughput = float64(metrics.TotalBytes) / elapsed.Seconds()
+		}
+	}
+	return metrics
+}
+
+func (m BlockMetrics) String() string {
+	return fmt.Sprintf(
+		"heights %d-%d: %d txs, %d bytes (max block %d bytes), avg block time %v, throughput %.2f bytes/s, app versions %v",
+		m.StartHeight, m.EndHeight, m.TotalTxs, m.TotalBytes, m.MaxBlockBytes, m.AvgBlockTime, m.Throughput, m.AppVersions,
+	)
+}
@@ -0,0 +1,43 @@
+package testnet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/version"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestNewBlockMetrics(t *testing.T) {
+	start := time.Now()
+	blockMeta := func(height int64, appVersion uint64, numTxs, size int) *types.BlockMeta {
+		return &types.BlockMeta{
+			Header: types.Header{
+				Height:  height,
+				Time:    start.Add(time.Duration(height) * time.Second),
+				Version: tmproto.Consensus{App: appVersion},
+			},
+			NumTxs:    numTxs,
+			BlockSize: size,
+		}
+	}
+
+	// block metas are returned in descending order by the RPC
+	metrics := NewBlockMetrics([]*types.BlockMeta{
+		blockMeta(3, 3, 2, 300),
+		blockMeta(2, 2, 1, 500),
+		blockMeta(1, 2, 0, 200),
+	})
+	require.Equal(t, int64(1), metrics.StartHeight)
+	require.Equal(t, int64(3), metrics.EndHeight)
+	require.Equal(t, 3, metrics.TotalTxs)
+	require.Equal(t, 1000, metrics.TotalBytes)
+	require.Equal(t, 500, metrics.MaxBlockBytes)
+	require.Equal(t, time.Second, metrics.AvgBlockTime)
+	require.Equal(t, float64(500), metrics.Throughput)
+	require.Equal(t, map[uint64]int{2: 2, 3: 1}, metrics.AppVersions)
+
+	empty := NewBlockMetrics(nil)
+	require.Zero(t, empty.TotalTxs)
+}
@@ -0,0 +1,102 @@
+package testnet
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+const (
+	// ValidatorsEnvVar overrides the number of validators of a Topology.
+	ValidatorsEnvVar = "E2E_VALIDATORS"
+	// FullNodesEnvVar overrides the number of full nodes of a Topology.
+	FullNodesEnvVar = "E2E_FULL_NODES"
+	// LatencyEnvVar overrides the latency in milliseconds of a Topology.
+	LatencyEnvVar = "E2E_LATENCY"
+	// JitterEnvVar overrides the jitter in milliseconds of a Topology.
+	JitterEnvVar = "E2E_JITTER"
+)
+
+// Topology describes the shape of a testnet.
+type Topology struct {
+	// Validators is the number of genesis validators.
+	Validators int
+	// FullNodes is the number of non-validating nodes started at genesis.
+	FullNodes int
+	// Version is the celestia-app docker image version every node starts with.
+	Version        string
+	SelfDelegation int64
+	Resources      Resources
+	// Latency and Jitter are applied to every node in milliseconds. No network
+	// shaping is applied if both are zero.
+	Latency int64
+	Jitter  int64
+}
+
+// WithEnvOverrides returns a copy of the topology with the fields that are
+// overridden by environment variables replaced.
+func (t Topology) WithEnvOverrides() (Topology, error) {
+	overrides := []struct {
+		envVar string
+		set    func(int64)
+	}{
+		{ValidatorsEnvVar, func(v int64) { t.Validators = int(v) }},
+		{FullNodesEnvVar, func(v int64) { t.FullNodes = int(v) }},
+		{LatencyEnvVar, func(v int64) { t.Latency = v }},
+		{JitterEnvVar, func(v int64) { t.Jitter = v }},
+	}
+	for _, override := range overrides {
+		value, ok := os.LookupEnv(override.envVar)
+		if !ok {
+			continue
+		}
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed < 0 {
+			return t, fmt.Errorf("invalid %s value %q: must be a non-negative integer", override.envVar, value)
+		}
+		override.set(parsed)
+	}
+	if t.Validators == 0 {
+		return t, fmt.Errorf("topology must have at least one validator")
+	}
+	return t, nil
+}
+
+// CreateTopology creates the nodes described by the topology. The nodes still
+// need to be set up and started.
+func (t *Testnet) CreateTopology(ctx context.Context, topology Topology, upgradeHeightV2 int64, disableBBR bool) error {
+	first := len(t.nodes)
+	if err := t.CreateGenesisNodes(ctx, topology.Validators, topology.Version, topology.SelfDelegation, upgradeHeightV2, topology.Resources, disableBBR); err != nil {
+		return err
+	}
+	for i := 0; i < topology.FullNodes; i++ {
+		if err := t.CreateNode(ctx, topology.Version, 0, upgradeHeightV2, topology.Resources, disableBBR); err != nil {
+			return err
+		}
+	}
+	if topology.Latency != 0 || topology.Jitter != 0 {
+		for _, node := range t.nodes[first:] {
+			node.EnableNetShaper()
+		}
+	}
+	return nil
+}
+
+// ApplyTopologyLatency sets the latency and jitter of the topology on all
+// nodes with network shaping enabled. It must be called after the nodes are
+// started.
+func (t *Testnet) ApplyTopologyLatency(topology Topology) error {
+	if topology.Latency == 0 && topology.Jitter == 0 {
+		return nil
+	}
+	for _, node := range t.nodes {
+		if node.netShaper == nil {
+			continue
+		}
+		if err := node.SetLatencyAndJitter(topology.Latency, topology.Jitter); err != nil {
+			return fmt.Errorf("setting latency of node %s: %w", node.Name, err)
+		}
+	}
+	return nil
+}
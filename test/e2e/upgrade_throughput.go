@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	"github.com/celestiaorg/celestia-app/v3/test/e2e/testnet"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/knuu/pkg/knuu"
+)
+
+// UpgradeThroughput runs a network with a configurable topology (see
+// testnet.Topology) on v2, upgrades it to v3 while txsim keeps submitting
+// blobs and reports the block metrics observed by every node. It fails if the
+// nodes didn't upgrade, diverged or didn't commit any blob throughput.
+func UpgradeThroughput(logger *log.Logger) error {
+	const (
+		testName        = "UpgradeThroughput"
+		upgradeHeightV3 = int64(20)
+		testDuration    = 5 * time.Minute
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scope := fmt.Sprintf("%s_%s", testName, time.Now().Format(timeFormat))
+	kn, err := knuu.New(ctx, knuu.Options{
+		Scope:        scope,
+		ProxyEnabled: true,
+	})
+	testnet.NoError("failed to initialize Knuu", err)
+	kn.HandleStopSignal(ctx)
+	logger.Printf("Knuu initialized with scope %s", kn.Scope)
+
+	testNet, err := testnet.New(logger, kn, testnet.Options{
+		ChainID: appconsts.TestChainID,
+	})
+	testnet.NoError("failed to create testnet", err)
+
+	defer testNet.Cleanup(ctx)
+
+	latestVersion, err := testnet.GetLatestVersion()
+	testnet.NoError("failed to get latest version", err)
+
+	topology, err := testnet.Topology{
+		Validators:     4,
+		FullNodes:      1,
+		Version:        latestVersion,
+		SelfDelegation: 10000000,
+		Resources:      testnet.DefaultResources,
+	}.WithEnvOverrides()
+	testnet.NoError("invalid topology", err)
+	logger.Printf("Running %s with %d validators and %d full nodes on version %s", testName, topology.Validators, topology.FullNodes, latestVersion)
+
+	consensusParams := app.DefaultConsensusParams()
+	consensusParams.Version.AppVersion = v2.Version
+	testNet.SetConsensusParams(consensusParams)
+
+	testnet.NoError("failed to create topology", testNet.CreateTopology(ctx, topology, 0, true))
+
+	logger.Println("Creating txsim")
+	endpoints, err := testNet.RemoteGRPCEndpoints()
+	testnet.NoError("failed to get remote gRPC endpoints", err)
+	upgradeSchedule := map[int64]uint64{
+		upgradeHeightV3: v3.Version,
+	}
+	err = testNet.CreateTxClient(ctx, "txsim", latestVersion, 10, "10000-100000", 100, testnet.DefaultResources, endpoints[0], upgradeSchedule)
+	testnet.NoError("failed to create tx client", err)
+
+	logger.Println("Setting up testnet")
+	testnet.NoError("failed to setup testnet", testNet.Setup(ctx))
+	logger.Println("Starting testnet")
+	testnet.NoError("failed to start testnet", testNet.Start(ctx))
+	testnet.NoError("failed to apply latency", testNet.ApplyTopologyLatency(topology))
+
+	logger.Printf("Submitting blobs for %v", testDuration)
+	time.Sleep(testDuration)
+
+	var reference testnet.BlockMetrics
+	for i, node := range testNet.Nodes() {
+		blockchain, err := testnode.ReadBlockchainHeaders(ctx, node.AddressRPC())
+		testnet.NoError("failed to read blockchain headers", err)
+
+		metrics := testnet.NewBlockMetrics(blockchain)
+		logger.Printf("%s: %s", node.Name, metrics)
+
+		if metrics.AppVersions[v3.Version] == 0 {
+			return fmt.Errorf("node %s did not upgrade to v3 by height %d", node.Name, metrics.EndHeight)
+		}
+		if metrics.TotalTxs == 0 {
+			return fmt.Errorf("node %s did not commit any transactions", node.Name)
+		}
+		if i == 0 {
+			reference = metrics
+			continue
+		}
+		// nodes are read one after the other so the latest heights may
+		// differ by a few blocks.
+		if diff := reference.EndHeight - metrics.EndHeight; diff > 5 || diff < -5 {
+			return fmt.Errorf("node %s is at height %d while %s is at height %d", node.Name, metrics.EndHeight, testNet.Node(0).Name, reference.EndHeight)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package ibcfee
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	utils "github.com/celestiaorg/celestia-app/v3/test/tokenfilter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/cosmos/ibc-go/v6/testing/simapp"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func SetupTest(t *testing.T) (*ibctesting.Coordinator, *ibctesting.TestChain, *ibctesting.TestChain) {
+	chains := make(map[string]*ibctesting.TestChain)
+	coordinator := &ibctesting.Coordinator{
+		T:           t,
+		CurrentTime: time.Now(),
+		Chains:      chains,
+	}
+	celestiaChain := utils.NewTestChain(t, coordinator, ibctesting.GetChainID(1))
+	otherChain := ibctesting.NewTestChain(t, coordinator, ibctesting.GetChainID(2))
+	coordinator.Chains[ibctesting.GetChainID(1)] = celestiaChain
+	coordinator.Chains[ibctesting.GetChainID(2)] = otherChain
+	return coordinator, celestiaChain, otherChain
+}
+
+// NewFeeTransferPath returns a path of a fee enabled transfer channel.
+func NewFeeTransferPath(celestiaChain, otherChain *ibctesting.TestChain) *ibctesting.Path {
+	version := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{FeeVersion: ibcfeetypes.Version, AppVersion: transfertypes.Version}))
+	return NewTransferPath(celestiaChain, otherChain, version)
+}
+
+// NewTransferPath returns a path of a transfer channel with version.
+func NewTransferPath(celestiaChain, otherChain *ibctesting.TestChain, version string) *ibctesting.Path {
+	path := ibctesting.NewPath(celestiaChain, otherChain)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+	return path
+}
+
+// TestIncentivizedTransfer sends a transfer from Celestia over a fee enabled
+// channel with a fee for the relayers. It verifies that the relayers of the
+// packet and of its acknowledgement are paid and that the timeout fee is
+// refunded to the sender.
+func TestIncentivizedTransfer(t *testing.T) {
+	coordinator, celestia, otherChain := SetupTest(t)
+	path := NewFeeTransferPath(celestia, otherChain)
+	coordinator.Setup(path)
+
+	celestiaApp := celestia.App.(*app.App)
+	require.True(t, celestiaApp.IBCFeeKeeper.IsFeeEnabled(celestia.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+	// The relayer of the acknowledgement, the sender account of Celestia, is
+	// paid to its payee. The relayer of the packet, the sender account of
+	// the other chain, is paid to its counterparty payee on Celestia.
+	ackPayee := sdk.AccAddress(authtypes.NewModuleAddress("ack_payee"))
+	recvPayee := sdk.AccAddress(authtypes.NewModuleAddress("recv_payee"))
+	_, err := celestia.SendMsgs(ibcfeetypes.NewMsgRegisterPayee(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, celestia.SenderAccount.GetAddress().String(), ackPayee.String()))
+	require.NoError(t, err)
+	_, err = otherChain.SendMsgs(ibcfeetypes.NewMsgRegisterCounterpartyPayee(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, otherChain.SenderAccount.GetAddress().String(), recvPayee.String()))
+	require.NoError(t, err)
+
+	sender := celestia.SenderAccount.GetAddress()
+	balanceBefore := celestiaApp.BankKeeper.GetBalance(celestia.GetContext(), sender, sdk.DefaultBondDenom)
+	transferCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+	fee := ibcfeetypes.NewFee(
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))), // recv fee
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))),  // ack fee
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(25))),  // timeout fee
+	)
+	msgPayPacketFee := ibcfeetypes.NewMsgPayPacketFee(fee, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sender.String(), nil)
+	msgTransfer := transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, transferCoin, sender.String(), otherChain.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 300), 0, "")
+	res, err := celestia.SendMsgs(msgPayPacketFee, msgTransfer)
+	require.NoError(t, err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	packetID := channeltypes.NewPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	_, found := celestiaApp.IBCFeeKeeper.GetFeesInEscrow(celestia.GetContext(), packetID)
+	require.True(t, found)
+
+	require.NoError(t, path.RelayPacket(packet))
+
+	_, found = celestiaApp.IBCFeeKeeper.GetFeesInEscrow(celestia.GetContext(), packetID)
+	require.False(t, found)
+	require.Equal(t, fee.AckFee, celestiaApp.BankKeeper.GetAllBalances(celestia.GetContext(), ackPayee))
+	require.Equal(t, fee.RecvFee, celestiaApp.BankKeeper.GetAllBalances(celestia.GetContext(), recvPayee))
+	require.True(t, celestiaApp.BankKeeper.GetAllBalances(celestia.GetContext(), authtypes.NewModuleAddress(ibcfeetypes.ModuleName)).IsZero())
+
+	// The sender paid the transfer and the fees of the relayers but the
+	// timeout fee was refunded.
+	balanceAfter := celestiaApp.BankKeeper.GetBalance(celestia.GetContext(), sender, sdk.DefaultBondDenom)
+	require.Equal(t, balanceBefore.Amount.Sub(transferCoin.Amount).Sub(fee.RecvFee.AmountOf(sdk.DefaultBondDenom)).Sub(fee.AckFee.AmountOf(sdk.DefaultBondDenom)), balanceAfter.Amount)
+
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+	require.Equal(t, transferCoin.Amount, otherChain.App.(*simapp.SimApp).BankKeeper.GetBalance(otherChain.GetContext(), otherChain.SenderAccount.GetAddress(), voucher.IBCDenom()).Amount)
+}
+
+// TestTransferWithoutFee verifies that transfers over channels that aren't fee
+// enabled are unaffected by the IBC fee middleware.
+func TestTransferWithoutFee(t *testing.T) {
+	coordinator, celestia, otherChain := SetupTest(t)
+	path := NewTransferPath(celestia, otherChain, transfertypes.Version)
+	coordinator.Setup(path)
+
+	celestiaApp := celestia.App.(*app.App)
+	require.False(t, celestiaApp.IBCFeeKeeper.IsFeeEnabled(celestia.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+	transferCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+	msgTransfer := transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, transferCoin, celestia.SenderAccount.GetAddress().String(), otherChain.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 300), 0, "")
+	res, err := celestia.SendMsgs(msgTransfer)
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+	require.Equal(t, transferCoin.Amount, otherChain.App.(*simapp.SimApp).BankKeeper.GetBalance(otherChain.GetContext(), otherChain.SenderAccount.GetAddress(), voucher.IBCDenom()).Amount)
+}
+
+// TestIncentivizedInterchainAccountTx registers an interchain account over a
+// fee enabled channel, with Celestia as the controller and as the host chain,
+// and sends a tx of the interchain account with a fee for the relayers. It
+// verifies that the tx is executed on the host chain and that the relayer of
+// the packet is paid on the controller chain.
+func TestIncentivizedInterchainAccountTx(t *testing.T) {
+	t.Run("Celestia controller", func(t *testing.T) {
+		coordinator, celestia, otherChain := SetupTest(t)
+		testIncentivizedInterchainAccountTx(t, coordinator, celestia, otherChain)
+	})
+	t.Run("Celestia host", func(t *testing.T) {
+		coordinator, celestia, otherChain := SetupTest(t)
+		testIncentivizedInterchainAccountTx(t, coordinator, otherChain, celestia)
+	})
+}
+
+func testIncentivizedInterchainAccountTx(t *testing.T, coordinator *ibctesting.Coordinator, controller, host *ibctesting.TestChain) {
+	path := ibctesting.NewPath(controller, host)
+	coordinator.SetupConnections(path)
+
+	owner := controller.SenderAccount.GetAddress().String()
+	portID, err := icatypes.NewControllerPortID(owner)
+	require.NoError(t, err)
+	icaVersion := icatypes.NewDefaultMetadataString(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	version := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{FeeVersion: ibcfeetypes.Version, AppVersion: icaVersion}))
+	res, err := controller.SendMsgs(icacontrollertypes.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, owner, version))
+	require.NoError(t, err)
+
+	path.EndpointA.ChannelID, err = ibctesting.ParseChannelIDFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointB.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	require.NoError(t, path.EndpointB.ChanOpenTry())
+	require.NoError(t, path.EndpointA.ChanOpenAck())
+	require.NoError(t, path.EndpointB.ChanOpenConfirm())
+
+	icaAddress, found := icaControllerKeeper(controller).GetInterchainAccountAddress(controller.GetContext(), path.EndpointA.ConnectionID, portID)
+	require.True(t, found)
+	sendCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	_, err = host.SendMsgs(banktypes.NewMsgSend(host.SenderAccount.GetAddress(), sdk.MustAccAddressFromBech32(icaAddress), sendCoins))
+	require.NoError(t, err)
+
+	// The relayer of the packet, the sender account of the host chain, is
+	// paid to its counterparty payee on the controller chain. The relayer of
+	// the acknowledgement is the owner so its fee is paid back to it.
+	recvPayee := sdk.AccAddress(authtypes.NewModuleAddress("recv_payee"))
+	_, err = host.SendMsgs(ibcfeetypes.NewMsgRegisterCounterpartyPayee(icatypes.HostPortID, path.EndpointB.ChannelID, host.SenderAccount.GetAddress().String(), recvPayee.String()))
+	require.NoError(t, err)
+
+	receiver := sdk.AccAddress(authtypes.NewModuleAddress("receiver"))
+	data, err := icatypes.SerializeCosmosTx(host.App.AppCodec(), []proto.Message{banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(icaAddress), receiver, sendCoins)})
+	require.NoError(t, err)
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+	fee := ibcfeetypes.NewFee(
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))), // recv fee
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))),  // ack fee
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(25))),  // timeout fee
+	)
+	msgPayPacketFee := ibcfeetypes.NewMsgPayPacketFee(fee, portID, path.EndpointA.ChannelID, owner, nil)
+	msgSendTx := icacontrollertypes.NewMsgSendTx(owner, path.EndpointA.ConnectionID, uint64(time.Hour.Nanoseconds()), packetData)
+	res, err = controller.SendMsgs(msgPayPacketFee, msgSendTx)
+	require.NoError(t, err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+
+	require.Equal(t, sendCoins, bankKeeper(host).GetAllBalances(host.GetContext(), receiver))
+	require.Equal(t, fee.RecvFee, bankKeeper(controller).GetAllBalances(controller.GetContext(), recvPayee))
+	require.True(t, bankKeeper(controller).GetAllBalances(controller.GetContext(), authtypes.NewModuleAddress(ibcfeetypes.ModuleName)).IsZero())
+}
+
+func bankKeeper(chain *ibctesting.TestChain) bankkeeper.Keeper {
+	if celestiaApp, ok := chain.App.(*app.App); ok {
+		return celestiaApp.BankKeeper
+	}
+	return chain.App.(*simapp.SimApp).BankKeeper
+}
+
+func icaControllerKeeper(chain *ibctesting.TestChain) icacontrollerkeeper.Keeper {
+	if celestiaApp, ok := chain.App.(*app.App); ok {
+		return celestiaApp.ICAControllerKeeper
+	}
+	return chain.App.(*simapp.SimApp).ICAControllerKeeper
+}
@@ -1,7 +1,10 @@
 package chainspec
 
 import (
+	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/strangelove-ventures/interchaintest/v6"
 	"github.com/strangelove-ventures/interchaintest/v6/chain/cosmos"
@@ -25,6 +28,46 @@ func GetCelestia(t *testing.T) *cosmos.CosmosChain {
 	return chains[0].(*cosmos.CosmosChain)
 }
 
+// GetCelestiaWithUnbondingTime returns a CosmosChain for Celestia whose
+// genesis has unbondingTime instead of the unbonding time of celestia-app, so
+// that tests can wait for unbondings to complete. The other params keep the
+// defaults of celestia-app. The trusting period of the IBC clients of the
+// chain is half of unbondingTime because it must be shorter.
+func GetCelestiaWithUnbondingTime(t *testing.T, unbondingTime time.Duration) *cosmos.CosmosChain {
+	spec := *celestia
+	spec.ChainConfig.TrustingPeriod = (unbondingTime / 2).String()
+	spec.ChainConfig.ModifyGenesis = func(_ ibc.ChainConfig, genesis []byte) ([]byte, error) {
+		return setUnbondingTime(genesis, unbondingTime)
+	}
+	factory := interchaintest.NewBuiltinChainFactory(zaptest.NewLogger(t), []*interchaintest.ChainSpec{&spec})
+	chains, err := factory.Chains(t.Name())
+	require.NoError(t, err)
+	return chains[0].(*cosmos.CosmosChain)
+}
+
+// setUnbondingTime returns genesis with the unbonding time of the staking
+// params set to unbondingTime.
+func setUnbondingTime(genesis []byte, unbondingTime time.Duration) ([]byte, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(genesis, &doc); err != nil {
+		return nil, err
+	}
+	appState, ok := doc["app_state"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("genesis has no app state")
+	}
+	staking, ok := appState["staking"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("genesis has no staking state")
+	}
+	params, ok := staking["params"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("genesis has no staking params")
+	}
+	params["unbonding_time"] = fmt.Sprintf("%ds", int64(unbondingTime.Seconds()))
+	return json.Marshal(doc)
+}
+
 var celestia = &interchaintest.ChainSpec{
 	Name: "celestia",
 	ChainConfig: ibc.ChainConfig{
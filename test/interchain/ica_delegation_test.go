@@ -0,0 +1,267 @@
+package interchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/test/interchain/chainspec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/strangelove-ventures/interchaintest/v6"
+	"github.com/strangelove-ventures/interchaintest/v6/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v6/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v6/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// unbondingTime is the unbonding time of Celestia in TestICADelegation. It
+	// is short so that the unbonding completes during the test.
+	unbondingTime = 5 * time.Minute
+	// icaFunds is the amount of utia that TestICADelegation sends to the ICA.
+	icaFunds = 1_000_000_000
+	// icaDelegation is the amount of utia that the ICA delegates.
+	icaDelegation = 500_000_000
+)
+
+// TestICADelegation verifies that an Inter-Chain Account (ICA) on Celestia
+// (host chain) that is controlled from the Cosmos Hub (controller chain) can
+// delegate, withdraw its rewards and undelegate until the unbonding completes.
+// Celestia uses the ICA host and staking params of celestia-app except for a
+// shorter unbonding time.
+func TestICADelegation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping TestICADelegation in short mode.")
+	}
+
+	client, network := interchaintest.DockerSetup(t)
+	celestia := chainspec.GetCelestiaWithUnbondingTime(t, unbondingTime)
+	cosmosHub := chainspec.GetCosmosHub(t)
+	relayer := getRelayerFactory(t).Build(t, client, network)
+	pathName := fmt.Sprintf("%s-to-%s", celestia.Config().ChainID, cosmosHub.Config().ChainID)
+	interchain := interchaintest.NewInterchain().
+		AddChain(celestia).
+		AddChain(cosmosHub).
+		AddRelayer(relayer, getRelayerName()).
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  celestia,
+			Chain2:  cosmosHub,
+			Relayer: relayer,
+			Path:    pathName,
+		})
+
+	ctx := context.Background()
+	reporter := testreporter.NewNopReporter().RelayerExecReporter(t)
+	err := interchain.Build(ctx, reporter, interchaintest.InterchainBuildOptions{
+		TestName:  t.Name(),
+		Client:    client,
+		NetworkID: network,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = interchain.Close() })
+
+	err = relayer.StartRelayer(ctx, reporter, pathName)
+	require.NoError(t, err)
+
+	err = testutil.WaitForBlocks(ctx, 2, celestia, cosmosHub)
+	require.NoError(t, err)
+
+	cosmosConnections, err := relayer.GetConnections(ctx, reporter, cosmosHub.Config().ChainID)
+	require.NoError(t, err)
+	require.Len(t, cosmosConnections, 2) // 2 connections: the first is connection-0 and the second is connection-localhost.
+	cosmosConnection := cosmosConnections[0]
+
+	users := interchaintest.GetAndFundTestUsers(t, ctx, t.Name(), math.NewInt(10_000_000_000), celestia, cosmosHub)
+	err = testutil.WaitForBlocks(ctx, 2, celestia, cosmosHub)
+	require.NoError(t, err)
+
+	celestiaUser, cosmosUser := users[0], users[1]
+	cosmosAddr := cosmosUser.(*cosmos.CosmosWallet).FormattedAddressWithPrefix(cosmosHub.Config().Bech32Prefix)
+
+	registerICA := []string{
+		cosmosHub.Config().Bin, "tx", "interchain-accounts", "controller", "register", cosmosConnection.ID,
+		"--chain-id", cosmosHub.Config().ChainID,
+		"--home", cosmosHub.HomeDir(),
+		"--node", cosmosHub.GetRPCAddress(),
+		"--from", cosmosUser.KeyName(),
+		"--keyring-backend", keyring.BackendTest,
+		"--fees", fmt.Sprintf("300000%v", cosmosHub.Config().Denom),
+		"--gas", "300000", // the auto gas estimation underestimates the gas required.
+		"--yes",
+	}
+	_, stderr, err := cosmosHub.Exec(ctx, registerICA, nil)
+	require.NoError(t, err)
+	require.Empty(t, stderr)
+
+	err = testutil.WaitForBlocks(ctx, 5, celestia, cosmosHub)
+	require.NoError(t, err)
+
+	queryICA := []string{
+		cosmosHub.Config().Bin, "query", "interchain-accounts", "controller", "interchain-account", cosmosAddr, cosmosConnection.ID,
+		"--chain-id", cosmosHub.Config().ChainID,
+		"--home", cosmosHub.HomeDir(),
+		"--node", cosmosHub.GetRPCAddress(),
+		"--output", "json",
+	}
+	var icaResponse struct {
+		Address string `json:"address"`
+	}
+	execJSON(ctx, t, cosmosHub, queryICA, &icaResponse)
+	icaAddr := icaResponse.Address
+	require.NotEmpty(t, icaAddr)
+	t.Logf("ICA address %v", icaAddr)
+
+	fundICA := celestiaTx(celestia, celestiaUser.KeyName(), "bank", "send", celestiaUser.KeyName(), icaAddr, fmt.Sprintf("%d%v", icaFunds, celestia.Config().Denom))
+	_, _, err = celestia.Exec(ctx, fundICA, nil)
+	require.NoError(t, err)
+	err = testutil.WaitForBlocks(ctx, 2, celestia)
+	require.NoError(t, err)
+	require.Equal(t, int64(icaFunds), queryBalance(ctx, t, celestia, icaAddr))
+
+	var validators struct {
+		Validators []struct {
+			OperatorAddress string `json:"operator_address"`
+		} `json:"validators"`
+	}
+	execJSON(ctx, t, celestia, celestiaQuery(celestia, "staking", "validators"), &validators)
+	require.NotEmpty(t, validators.Validators)
+	validatorAddr := validators.Validators[0].OperatorAddress
+
+	icaTx := func(msg string) {
+		t.Helper()
+		sendICATx(ctx, t, celestia, cosmosHub, cosmosUser.KeyName(), cosmosConnection.ID, msg)
+	}
+	amount := fmt.Sprintf(`{"denom":"%v","amount":"%d"}`, celestia.Config().Denom, icaDelegation)
+
+	icaTx(fmt.Sprintf(`{"@type":"/cosmos.staking.v1beta1.MsgDelegate","delegator_address":"%v","validator_address":"%v","amount":%v}`, icaAddr, validatorAddr, amount))
+	var delegation struct {
+		Balance struct {
+			Amount string `json:"amount"`
+		} `json:"balance"`
+	}
+	execJSON(ctx, t, celestia, celestiaQuery(celestia, "staking", "delegation", icaAddr, validatorAddr), &delegation)
+	assert.Equal(t, fmt.Sprint(icaDelegation), delegation.Balance.Amount)
+	balance := queryBalance(ctx, t, celestia, icaAddr)
+	assert.Equal(t, int64(icaFunds-icaDelegation), balance)
+
+	icaTx(fmt.Sprintf(`{"@type":"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward","delegator_address":"%v","validator_address":"%v"}`, icaAddr, validatorAddr))
+	balanceWithRewards := queryBalance(ctx, t, celestia, icaAddr)
+	assert.Greater(t, balanceWithRewards, balance, "the ICA didn't receive rewards")
+
+	icaTx(fmt.Sprintf(`{"@type":"/cosmos.staking.v1beta1.MsgUndelegate","delegator_address":"%v","validator_address":"%v","amount":%v}`, icaAddr, validatorAddr, amount))
+	var unbonding struct {
+		Entries []struct {
+			CompletionTime time.Time `json:"completion_time"`
+			Balance        string    `json:"balance"`
+		} `json:"entries"`
+	}
+	execJSON(ctx, t, celestia, celestiaQuery(celestia, "staking", "unbonding-delegation", icaAddr, validatorAddr), &unbonding)
+	require.Len(t, unbonding.Entries, 1)
+	assert.Equal(t, fmt.Sprint(icaDelegation), unbonding.Entries[0].Balance)
+	_, _, err = celestia.Exec(ctx, celestiaQuery(celestia, "staking", "delegation", icaAddr, validatorAddr), nil)
+	assert.Error(t, err, "the ICA still has a delegation after undelegating all of it")
+
+	// the undelegation withdraws the rewards accrued since the withdrawal.
+	balance = queryBalance(ctx, t, celestia, icaAddr)
+	assert.GreaterOrEqual(t, balance, balanceWithRewards)
+
+	// the unbonding completes in the first block after its completion time.
+	time.Sleep(time.Until(unbonding.Entries[0].CompletionTime))
+	err = testutil.WaitForBlocks(ctx, 2, celestia)
+	require.NoError(t, err)
+	assert.Equal(t, balance+icaDelegation, queryBalance(ctx, t, celestia, icaAddr))
+	_, _, err = celestia.Exec(ctx, celestiaQuery(celestia, "staking", "unbonding-delegation", icaAddr, validatorAddr), nil)
+	assert.Error(t, err, "the unbonding delegation of the ICA still exists after its completion time")
+}
+
+// sendICATx sends a tx with msg, the JSON of an sdk.Msg, from the controller
+// user keyName on cosmosHub to its ICA on celestia and waits for the tx to be
+// relayed and executed.
+func sendICATx(ctx context.Context, t *testing.T, celestia, cosmosHub *cosmos.CosmosChain, keyName, connectionID, msg string) {
+	t.Helper()
+
+	generatePacketData := []string{
+		celestia.Config().Bin, "tx", "interchain-accounts", "host", "generate-packet-data", msg,
+		"--home", celestia.HomeDir(),
+	}
+	stdout, stderr, err := celestia.Exec(ctx, generatePacketData, nil)
+	require.NoError(t, err)
+	// the command prints the packet data to stderr.
+	packetData := bytes.TrimSpace(append(stdout, stderr...))
+	require.True(t, json.Valid(packetData), "invalid packet data %s", packetData)
+
+	packetFile := path.Join(cosmosHub.HomeDir(), "packet.json")
+	writePacketData := []string{"sh", "-c", `printf '%s' "$1" > "$2"`, "sh", string(packetData), packetFile}
+	_, _, err = cosmosHub.Exec(ctx, writePacketData, nil)
+	require.NoError(t, err)
+
+	sendTx := []string{
+		cosmosHub.Config().Bin, "tx", "interchain-accounts", "controller", "send-tx", connectionID, packetFile,
+		"--chain-id", cosmosHub.Config().ChainID,
+		"--home", cosmosHub.HomeDir(),
+		"--node", cosmosHub.GetRPCAddress(),
+		"--from", keyName,
+		"--keyring-backend", keyring.BackendTest,
+		"--fees", fmt.Sprintf("300000%v", cosmosHub.Config().Denom),
+		"--gas", "300000",
+		"--yes",
+	}
+	_, stderr, err = cosmosHub.Exec(ctx, sendTx, nil)
+	require.NoError(t, err)
+	require.Empty(t, stderr)
+
+	err = testutil.WaitForBlocks(ctx, 5, celestia, cosmosHub)
+	require.NoError(t, err)
+}
+
+// celestiaTx returns the command of a tx of celestia signed by keyName.
+func celestiaTx(celestia *cosmos.CosmosChain, keyName string, args ...string) []string {
+	cmd := append([]string{celestia.Config().Bin, "tx"}, args...)
+	return append(cmd,
+		"--chain-id", celestia.Config().ChainID,
+		"--home", celestia.HomeDir(),
+		"--node", celestia.GetRPCAddress(),
+		"--from", keyName,
+		"--keyring-backend", keyring.BackendTest,
+		"--fees", fmt.Sprintf("20000%v", celestia.Config().Denom),
+		"--gas", "200000",
+		"--yes",
+	)
+}
+
+// celestiaQuery returns the command of a query of celestia with JSON output.
+func celestiaQuery(celestia *cosmos.CosmosChain, args ...string) []string {
+	cmd := append([]string{celestia.Config().Bin, "query"}, args...)
+	return append(cmd,
+		"--chain-id", celestia.Config().ChainID,
+		"--home", celestia.HomeDir(),
+		"--node", celestia.GetRPCAddress(),
+		"--output", "json",
+	)
+}
+
+// queryBalance returns the utia balance of addr on celestia.
+func queryBalance(ctx context.Context, t *testing.T, celestia *cosmos.CosmosChain, addr string) int64 {
+	t.Helper()
+	var balance struct {
+		Amount string `json:"amount"`
+	}
+	execJSON(ctx, t, celestia, celestiaQuery(celestia, "bank", "balances", addr, "--denom", celestia.Config().Denom), &balance)
+	amount, ok := math.NewIntFromString(balance.Amount)
+	require.True(t, ok, "invalid balance %q", balance.Amount)
+	return amount.Int64()
+}
+
+// execJSON executes cmd on chain and unmarshals its output into v.
+func execJSON(ctx context.Context, t *testing.T, chain *cosmos.CosmosChain, cmd []string, v any) {
+	t.Helper()
+	stdout, _, err := chain.Exec(ctx, cmd, nil)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(stdout, v), "output %s", stdout)
+}
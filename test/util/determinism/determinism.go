@@ -0,0 +1,166 @@
+// Package determinism is a test harness that runs code with concurrency, e.g.
+// parallel erasure coding, share parsing or signature verification, under
+// perturbed goroutine scheduling and asserts that every run produces the same
+// output. It guards parallelized code paths against nondeterminism, which
+// would halt the chain if validators computed different results.
+//
+// The number of runs defaults to DefaultRuns and can be raised with the
+// DETERMINISM_RUNS environment variable. DETERMINISM_SEED fixes the seed of
+// the perturbations to reproduce a failure. `make test-determinism` runs the
+// determinism tests of the repo with the race detector and many runs.
+package determinism
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	// DefaultRuns is the number of runs of a function if DETERMINISM_RUNS
+	// isn't set.
+	DefaultRuns = 4
+	// RunsEnv is the environment variable that sets the number of runs.
+	RunsEnv = "DETERMINISM_RUNS"
+	// SeedEnv is the environment variable that sets the seed of the
+	// perturbations.
+	SeedEnv = "DETERMINISM_SEED"
+
+	maxYieldSleep = 50 * time.Microsecond
+)
+
+// Perturber perturbs the scheduling of the goroutines of a run. Code under
+// test can call Yield at points where goroutines interleave, e.g. before a
+// worker picks up a task, to provoke orderings that rarely occur otherwise.
+type Perturber struct {
+	mtx sync.Mutex
+	rng *rand.Rand
+}
+
+func newPerturber(seed int64) *Perturber {
+	return &Perturber{rng: rand.New(rand.NewSource(seed))}
+}
+
+// Yield randomly does nothing, yields the processor or sleeps for a few
+// microseconds. It is safe for concurrent use and a no-op on a nil Perturber
+// so that code under test doesn't need to check whether it is perturbed.
+func (p *Perturber) Yield() {
+	if p == nil {
+		return
+	}
+	p.mtx.Lock()
+	n := p.rng.Intn(4)
+	sleep := time.Duration(p.rng.Int63n(int64(maxYieldSleep)))
+	p.mtx.Unlock()
+
+	switch n {
+	case 1:
+		runtime.Gosched()
+	case 2:
+		time.Sleep(sleep)
+	}
+}
+
+func (p *Perturber) intn(n int) int {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.rng.Intn(n)
+}
+
+// Check runs fn runs times, each with a different GOMAXPROCS, GC pressure and
+// number of goroutines competing for the processors, and returns an error if
+// an output differs from the output of the first run or if fn fails.
+func Check(runs int, seed int64, fn func(p *Perturber) (any, error)) error {
+	p := newPerturber(seed)
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(0))
+	defer debug.SetGCPercent(debug.SetGCPercent(100))
+
+	var first any
+	for run := 0; run < runs; run++ {
+		procs := 1 + p.intn(2*runtime.NumCPU())
+		runtime.GOMAXPROCS(procs)
+		debug.SetGCPercent(1 + p.intn(100))
+
+		stop := startNoise(p, p.intn(runtime.NumCPU()+1))
+		output, err := fn(p)
+		stop()
+		if err != nil {
+			return fmt.Errorf("run %d with GOMAXPROCS %d: %w", run, procs, err)
+		}
+		if run == 0 {
+			first = output
+			continue
+		}
+		if !reflect.DeepEqual(first, output) {
+			return fmt.Errorf("run %d with GOMAXPROCS %d produced a different output than the first run:\nfirst: %v\ngot:   %v", run, procs, first, output)
+		}
+	}
+	return nil
+}
+
+// Require is like Check but fails t if fn isn't deterministic. The number of
+// runs and the seed are read from the environment, otherwise the seed is
+// random and logged so that a failure can be reproduced.
+func Require(t testing.TB, fn func(p *Perturber) (any, error)) {
+	t.Helper()
+	runs := Runs(t)
+	seed := time.Now().UnixNano()
+	if env := os.Getenv(SeedEnv); env != "" {
+		var err error
+		if seed, err = strconv.ParseInt(env, 10, 64); err != nil {
+			t.Fatalf("invalid %s %q: %v", SeedEnv, env, err)
+		}
+	}
+	if err := Check(runs, seed, fn); err != nil {
+		t.Fatalf("nondeterministic output (%s=%d): %v", SeedEnv, seed, err)
+	}
+}
+
+// Runs returns the number of runs set by DETERMINISM_RUNS, or DefaultRuns.
+func Runs(t testing.TB) int {
+	t.Helper()
+	env := os.Getenv(RunsEnv)
+	if env == "" {
+		return DefaultRuns
+	}
+	runs, err := strconv.Atoi(env)
+	if err != nil || runs < 2 {
+		t.Fatalf("%s must be an integer of at least 2, got %q", RunsEnv, env)
+	}
+	return runs
+}
+
+// startNoise starts count goroutines that compete for the processors and
+// allocate memory until the returned function is called.
+func startNoise(p *Perturber, count int) (stop func()) {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var sink []byte
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				sink = make([]byte, 1024)
+				_ = sink
+				p.Yield()
+			}
+		}()
+	}
+	return func() {
+		close(done)
+		wg.Wait()
+	}
+}
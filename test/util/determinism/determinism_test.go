@@ -0,0 +1,53 @@
+package determinism_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/test/util/determinism"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	t.Run("deterministic output", func(t *testing.T) {
+		err := determinism.Check(8, 1, func(p *determinism.Perturber) (any, error) {
+			results := make([]int, 16)
+			var wg sync.WaitGroup
+			for i := range results {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					p.Yield()
+					results[i] = i * i
+				}()
+			}
+			wg.Wait()
+			return results, nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("nondeterministic output", func(t *testing.T) {
+		calls := 0
+		err := determinism.Check(2, 1, func(_ *determinism.Perturber) (any, error) {
+			calls++
+			return calls, nil
+		})
+		assert.ErrorContains(t, err, "run 1")
+	})
+
+	t.Run("failing run", func(t *testing.T) {
+		wantErr := errors.New("failed")
+		err := determinism.Check(2, 1, func(_ *determinism.Perturber) (any, error) {
+			return nil, wantErr
+		})
+		assert.ErrorIs(t, err, wantErr)
+	})
+}
+
+func TestNilPerturberYield(t *testing.T) {
+	var p *determinism.Perturber
+	p.Yield()
+}
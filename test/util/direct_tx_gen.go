@@ -27,7 +27,7 @@ import (
 // provided configuration. The account info is queried directly from the
 // application. One blob transaction is generated per account provided.
 func RandBlobTxsWithAccounts(
-	t *testing.T,
+	t testing.TB,
 	capp *app.App,
 	cfg client.TxConfig,
 	kr keyring.Keyring,
@@ -84,7 +84,7 @@ func DirectQueryAccount(app *app.App, addr sdk.AccAddress) authtypes.AccountI {
 // provided configuration. One blob transaction is generated per account
 // provided. The sequence and account numbers are set manually using the provided values.
 func RandBlobTxsWithManualSequence(
-	t *testing.T,
+	t testing.TB,
 	cfg client.TxConfig,
 	kr keyring.Keyring,
 	size int,
@@ -160,7 +160,7 @@ func RandBlobTxsWithManualSequence(
 // send all funds to the "toAccount". The account info is queried directly from
 // the application.
 func SendTxsWithAccounts(
-	t *testing.T,
+	t testing.TB,
 	capp *app.App,
 	enc client.TxConfig,
 	kr keyring.Keyring,
@@ -202,7 +202,7 @@ func SendTxsWithAccounts(
 // SendTxsWithAccounts will create a send transaction per account provided. The
 // account info must be provided.
 func SendTxWithManualSequence(
-	t *testing.T,
+	t testing.TB,
 	cfg client.TxConfig,
 	kr keyring.Keyring,
 	fromAcc, toAcc string,
@@ -7,6 +7,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/app"
 	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
 	bstypes "github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	namespacetypes "github.com/celestiaorg/celestia-app/v3/x/namespace/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -31,6 +32,20 @@ func SetBlobParams(codec codec.Codec, params blobtypes.Params) Modifier {
 	}
 }
 
+// RegisterNamespaces will register the provided namespaces in the namespace
+// module's genesis state.
+func RegisterNamespaces(codec codec.Codec, registrations ...namespacetypes.Registration) Modifier {
+	return func(state map[string]json.RawMessage) map[string]json.RawMessage {
+		namespaceGenState := namespacetypes.DefaultGenesis()
+		if raw, ok := state[namespacetypes.ModuleName]; ok {
+			codec.MustUnmarshalJSON(raw, namespaceGenState)
+		}
+		namespaceGenState.Registrations = append(namespaceGenState.Registrations, registrations...)
+		state[namespacetypes.ModuleName] = codec.MustMarshalJSON(namespaceGenState)
+		return state
+	}
+}
+
 // SetSlashingParams will set the provided slashing params as genesis state.
 func SetSlashingParams(codec codec.Codec, parans slashingtypes.Params) Modifier {
 	return func(state map[string]json.RawMessage) map[string]json.RawMessage {
@@ -19,6 +19,18 @@ const (
 	// OutOfOrderHandlerKey is the key used to set the out of order prepare
 	// proposal handler.
 	OutOfOrderHandlerKey = "out_of_order"
+
+	// WrongShareIndexesHandlerKey is the key used to set the prepare proposal
+	// handler whose PFBs have wrong share indexes.
+	WrongShareIndexesHandlerKey = "wrong_share_indexes"
+
+	// BadPaddingHandlerKey is the key used to set the prepare proposal handler
+	// that writes namespace padding with the wrong namespace.
+	BadPaddingHandlerKey = "bad_padding"
+
+	// WrongSequenceLengthHandlerKey is the key used to set the prepare proposal
+	// handler that writes a blob with the wrong sequence length.
+	WrongSequenceLengthHandlerKey = "wrong_sequence_length"
 )
 
 // BehaviorConfig defines the malicious behavior for the application. It
@@ -37,7 +49,10 @@ type PrepareProposalHandler func(req abci.RequestPrepareProposal) abci.ResponseP
 // PrepareProposalHandlerMap is a map of all the known prepare proposal handlers.
 func (a *App) PrepareProposalHandlerMap() map[string]PrepareProposalHandler {
 	return map[string]PrepareProposalHandler{
-		OutOfOrderHandlerKey: a.OutOfOrderPrepareProposal,
+		OutOfOrderHandlerKey:          a.OutOfOrderPrepareProposal,
+		WrongShareIndexesHandlerKey:   a.PrepareProposalWithExport(WrongShareIndexesExport),
+		BadPaddingHandlerKey:          a.PrepareProposalWithExport(BadPaddingExport),
+		WrongSequenceLengthHandlerKey: a.PrepareProposalWithExport(WrongSequenceLengthExport),
 	}
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
 	square "github.com/celestiaorg/go-square/v2"
 	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
@@ -116,3 +117,67 @@ func TestMaliciousTestNode(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEqual(t, block.Block.DataHash.Bytes(), goodDah.Hash())
 }
+
+// TestInvalidExports tests that each malicious export function constructs the
+// correct square except for the consensus rule that it violates.
+func TestInvalidExports(t *testing.T) {
+	rand := tmrand.NewRand()
+	namespaces := []share.Namespace{
+		share.RandomBlobNamespace(),
+		share.RandomBlobNamespace(),
+	}
+	// the small blob before the large blob results in namespace padding.
+	blobs := blobfactory.ManyBlobs(rand, namespaces, []int{1_000, 100_000})
+	blobTx, err := blobtx.MarshalBlobTx(rand.Bytes(300), blobs...)
+	require.NoError(t, err)
+	txs := [][]byte{rand.Bytes(500), blobTx}
+
+	correctSquare, err := square.Construct(txs, appconsts.DefaultSquareSizeUpperBound, appconsts.DefaultSubtreeRootThreshold)
+	require.NoError(t, err)
+	correctPFBs, err := correctSquare.WrappedPFBs()
+	require.NoError(t, err)
+	correctWrapper, isIndexWrapper := blobtx.UnmarshalIndexWrapper(correctPFBs[0])
+	require.True(t, isIndexWrapper)
+	firstBlob := min(correctWrapper.ShareIndexes[0], correctWrapper.ShareIndexes[1])
+
+	construct := func(t *testing.T, efn ExportFn) square.Square {
+		s, err := Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound, efn)
+		require.NoError(t, err)
+		require.NotEqual(t, correctSquare, s)
+		return s
+	}
+
+	t.Run("wrong share indexes", func(t *testing.T) {
+		s := construct(t, WrongShareIndexesExport)
+		pfbs, err := s.WrappedPFBs()
+		require.NoError(t, err)
+		wrapper, isIndexWrapper := blobtx.UnmarshalIndexWrapper(pfbs[0])
+		require.True(t, isIndexWrapper)
+		for i, index := range wrapper.ShareIndexes {
+			require.Equal(t, correctWrapper.ShareIndexes[i]+1, index)
+		}
+		require.Equal(t, correctSquare[firstBlob:], s[firstBlob:])
+	})
+
+	t.Run("bad padding", func(t *testing.T) {
+		s := construct(t, BadPaddingExport)
+		badPadding := 0
+		for i := range s {
+			if s[i].IsPadding() && !s[i].Namespace().Equals(correctSquare[i].Namespace()) {
+				// the padding has the namespace of the blob after it.
+				require.True(t, s[i].Namespace().Equals(s[i+1].Namespace()))
+				badPadding++
+				continue
+			}
+			require.Equal(t, correctSquare[i], s[i])
+		}
+		require.Positive(t, badPadding)
+	})
+
+	t.Run("wrong sequence length", func(t *testing.T) {
+		s := construct(t, WrongSequenceLengthExport)
+		require.Equal(t, correctSquare[firstBlob].SequenceLen()+1, s[firstBlob].SequenceLen())
+		require.Equal(t, correctSquare[:firstBlob], s[:firstBlob])
+		require.Equal(t, correctSquare[firstBlob+1:], s[firstBlob+1:])
+	})
+}
@@ -0,0 +1,110 @@
+package malicious
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	_ ExportFn = WrongShareIndexesExport
+	_ ExportFn = BadPaddingExport
+	_ ExportFn = WrongSequenceLengthExport
+)
+
+// WrongShareIndexesExport constructs the square correctly except that the
+// index wrappers of the PFBs record every blob one share after the share where
+// it starts.
+func WrongShareIndexesExport(b *square.Builder) (square.Square, error) {
+	dataSquare, err := b.Export()
+	if err != nil || len(b.Blobs) == 0 {
+		return dataSquare, err
+	}
+	nonReservedStart := int(b.Pfbs[b.Blobs[0].PfbIndex].ShareIndexes[b.Blobs[0].BlobIndex])
+
+	pfbWriter := share.NewCompactShareSplitter(share.PayForBlobNamespace, share.ShareVersionZero)
+	for _, iw := range b.Pfbs {
+		for i := range iw.ShareIndexes {
+			iw.ShareIndexes[i]++
+		}
+		iwBytes, err := proto.Marshal(iw)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling pay for blob tx: %w", err)
+		}
+		if err := pfbWriter.WriteTx(iwBytes); err != nil {
+			return nil, fmt.Errorf("writing pay for blob tx into compact shares: %w", err)
+		}
+	}
+	pfbShares, err := pfbWriter.Export()
+	if err != nil {
+		return nil, fmt.Errorf("exporting pay for blob shares: %w", err)
+	}
+
+	// the wrapped PFBs can take more shares than before but the reserved
+	// padding until the first blob has room for the worst case share indexes.
+	pfbStart := 0
+	for pfbStart < len(dataSquare) && !dataSquare[pfbStart].Namespace().IsPayForBlob() {
+		pfbStart++
+	}
+	if pfbStart+len(pfbShares) > nonReservedStart {
+		return nil, fmt.Errorf("%d pay for blob shares don't fit before the first blob at %d", len(pfbShares), nonReservedStart)
+	}
+	copy(dataSquare[pfbStart:], pfbShares)
+	copy(dataSquare[pfbStart+len(pfbShares):nonReservedStart], share.ReservedPaddingShares(nonReservedStart-pfbStart-len(pfbShares)))
+	return dataSquare, nil
+}
+
+// BadPaddingExport constructs the square correctly except that the namespace
+// padding shares after a blob have the namespace of the next blob instead of
+// that of the blob. The namespaces of the square remain in order. Padding
+// between blobs of the same namespace can't be wrong this way so a square
+// needs padding between blobs of different namespaces to be invalid.
+func BadPaddingExport(b *square.Builder) (square.Square, error) {
+	dataSquare, err := b.Export()
+	if err != nil {
+		return nil, err
+	}
+	var next *share.Namespace
+	for i := len(dataSquare) - 1; i >= 0; i-- {
+		ns := dataSquare[i].Namespace()
+		if !ns.IsUsableNamespace() {
+			continue
+		}
+		if !dataSquare[i].IsPadding() {
+			next = &ns
+			continue
+		}
+		if next != nil && !ns.Equals(*next) {
+			padding, err := share.NamespacePaddingShare(*next, dataSquare[i].Version())
+			if err != nil {
+				return nil, err
+			}
+			dataSquare[i] = padding
+		}
+	}
+	return dataSquare, nil
+}
+
+// WrongSequenceLengthExport constructs the square correctly except that the
+// first share of the first blob declares a sequence length one byte longer
+// than the blob.
+func WrongSequenceLengthExport(b *square.Builder) (square.Square, error) {
+	dataSquare, err := b.Export()
+	if err != nil || len(b.Blobs) == 0 {
+		return dataSquare, err
+	}
+	start := b.Pfbs[b.Blobs[0].PfbIndex].ShareIndexes[b.Blobs[0].BlobIndex]
+
+	rawShare := append([]byte{}, dataSquare[start].ToBytes()...)
+	sequenceLen := rawShare[share.NamespaceSize+share.ShareInfoBytes:][:share.SequenceLenBytes]
+	binary.BigEndian.PutUint32(sequenceLen, dataSquare[start].SequenceLen()+1)
+	malformed, err := share.NewShare(rawShare)
+	if err != nil {
+		return nil, err
+	}
+	dataSquare[start] = *malformed
+	return dataSquare, nil
+}
@@ -16,6 +16,19 @@ import (
 // for. It will swap the order of two blobs in the square and then use the
 // modified nmt to create a commitment over the modified square.
 func (a *App) OutOfOrderPrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+	return a.prepareProposal(req, OutOfOrderExport)
+}
+
+// PrepareProposalWithExport returns a prepare proposal handler that prepares
+// the proposal block data like OutOfOrderPrepareProposal but constructs the
+// square with efn.
+func (a *App) PrepareProposalWithExport(efn ExportFn) PrepareProposalHandler {
+	return func(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		return a.prepareProposal(req, efn)
+	}
+}
+
+func (a *App) prepareProposal(req abci.RequestPrepareProposal, efn ExportFn) abci.ResponsePrepareProposal {
 	// create a context using a branch of the state and loaded using the
 	// proposal height and chain-id
 	sdkCtx := a.NewProposalContext(core.Header{
@@ -34,6 +47,7 @@ func (a *App) OutOfOrderPrepareProposal(req abci.RequestPrepareProposal) abci.Re
 		a.AccountKeeper,
 		a.BankKeeper,
 		a.BlobKeeper,
+		a.NamespaceKeeper,
 		a.FeeGrantKeeper,
 		a.GetTxConfig().SignModeHandler(),
 		ante.DefaultSigVerificationGasConsumer,
@@ -42,11 +56,11 @@ func (a *App) OutOfOrderPrepareProposal(req abci.RequestPrepareProposal) abci.Re
 		a.MsgGateKeeper,
 	)
 
-	txs := app.FilterTxs(a.Logger(), sdkCtx, handler, a.GetTxConfig(), req.BlockData.Txs)
+	txs := app.FilterTxs(a.Logger(), sdkCtx, handler, a.GetTxConfig(), req.BlockData.Txs, a.MaxPFBMessages(sdkCtx))
 
 	// build the square from the set of valid and prioritised transactions.
 	// The txs returned are the ones used in the square and block
-	dataSquare, txs, err := Build(txs, a.GetBaseApp().AppVersion(), a.MaxEffectiveSquareSize(sdkCtx), OutOfOrderExport)
+	dataSquare, txs, err := Build(txs, a.GetBaseApp().AppVersion(), a.MaxEffectiveSquareSize(sdkCtx), efn)
 	if err != nil {
 		panic(err)
 	}
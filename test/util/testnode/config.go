@@ -120,6 +120,14 @@ func (c *Config) WithConsensusParams(params *tmproto.ConsensusParams) *Config {
 	return c
 }
 
+// WithRemoteSigner makes the validator sign votes and proposals with a remote
+// signer instead of a local key file and returns the Config. See
+// StartRemoteSigner.
+func (c *Config) WithRemoteSigner() *Config {
+	c.TmConfig.PrivValidatorListenAddr = fmt.Sprintf("tcp://127.0.0.1:%d", mustGetFreePort())
+	return c
+}
+
 // DefaultConfig returns the default configuration of a test node.
 func DefaultConfig() *Config {
 	cfg := &Config{}
@@ -24,6 +24,16 @@ func NewNetwork(t testing.TB, config *Config) (cctx Context, rpcAddr, grpcAddr s
 	err := genesis.InitFiles(baseDir, config.TmConfig, config.AppConfig, config.Genesis, 0)
 	require.NoError(t, err)
 
+	if config.TmConfig.PrivValidatorListenAddr != "" {
+		signer, err := StartRemoteSigner(&config.UniversalTestingConfig, config.Genesis.ChainID)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			if err := signer.Stop(); err != nil {
+				t.Logf("error stopping remote signer %v", err)
+			}
+		})
+	}
+
 	tmNode, app, err := NewCometNode(baseDir, &config.UniversalTestingConfig)
 	require.NoError(t, err)
 
@@ -0,0 +1,37 @@
+package testnode
+
+import (
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/privval"
+)
+
+const (
+	remoteSignerConnRetries = 100
+	remoteSignerRetryWait   = 100 * time.Millisecond
+	remoteSignerTimeout     = 5 * time.Second
+)
+
+// StartRemoteSigner starts a signer that holds the validator key of the
+// initialized config files and serves signing requests of the node listening
+// on TmConfig.PrivValidatorListenAddr. The signer dials the node over the
+// privval socket protocol with a secret connection, the same way tmkms and
+// similar key management systems connect to a validator. It must be started
+// before the node, which waits for the signer to connect.
+func StartRemoteSigner(config *UniversalTestingConfig, chainID string) (*privval.SignerServer, error) {
+	pv := privval.LoadFilePV(config.TmConfig.PrivValidatorKeyFile(), config.TmConfig.PrivValidatorStateFile())
+	addr := strings.TrimPrefix(config.TmConfig.PrivValidatorListenAddr, "tcp://")
+	endpoint := privval.NewSignerDialerEndpoint(
+		NewLogger(config).With("module", "remote-signer"),
+		privval.DialTCPFn(addr, remoteSignerTimeout, ed25519.GenPrivKey()),
+		privval.SignerDialerEndpointConnRetries(remoteSignerConnRetries),
+		privval.SignerDialerEndpointRetryWaitInterval(remoteSignerRetryWait),
+	)
+	signer := privval.NewSignerServer(endpoint, chainID, pv)
+	if err := signer.Start(); err != nil {
+		return nil, err
+	}
+	return signer, nil
+}
@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"strconv"
+
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
 	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
@@ -44,7 +46,13 @@ func (d MinGasPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 			}
 			gasToConsume := pfb.Gas(gasPerByte)
 			if gasToConsume > txGas {
-				return ctx, errors.Wrapf(sdkerrors.ErrInsufficientFee, "not enough gas to pay for blobs (minimum: %d, got: %d)", gasToConsume, txGas)
+				return ctx, types.WithDetails(
+					errors.Wrapf(sdkerrors.ErrInsufficientFee, "not enough gas to pay for blobs (minimum: %d, got: %d)", gasToConsume, txGas),
+					map[string]string{
+						types.MetadataRequiredGas: strconv.FormatUint(gasToConsume, 10),
+						types.MetadataGasWanted:   strconv.FormatUint(txGas, 10),
+					},
+				)
 			}
 		}
 	}
@@ -55,4 +63,8 @@ func (d MinGasPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 type BlobKeeper interface {
 	GasPerBlobByte(ctx sdk.Context) uint32
 	GovMaxSquareSize(ctx sdk.Context) uint64
+	MaxBlobsPerPFB(ctx sdk.Context) uint32
+	MaxTotalBlobSizePerPFB(ctx sdk.Context) uint32
+	AllowedSigners(ctx sdk.Context) []string
+	SpendBlobFees(ctx sdk.Context, payer sdk.AccAddress, fee uint64) error
 }
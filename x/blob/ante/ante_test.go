@@ -150,3 +150,19 @@ func (mockBlobKeeper) GasPerBlobByte(_ sdk.Context) uint32 {
 func (mockBlobKeeper) GovMaxSquareSize(_ sdk.Context) uint64 {
 	return testGovMaxSquareSize
 }
+
+func (mockBlobKeeper) MaxBlobsPerPFB(_ sdk.Context) uint32 {
+	return 0
+}
+
+func (mockBlobKeeper) MaxTotalBlobSizePerPFB(_ sdk.Context) uint32 {
+	return 0
+}
+
+func (mockBlobKeeper) AllowedSigners(_ sdk.Context) []string {
+	return nil
+}
+
+func (mockBlobKeeper) SpendBlobFees(_ sdk.Context, _ sdk.AccAddress, _ uint64) error {
+	return nil
+}
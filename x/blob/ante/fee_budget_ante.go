@@ -0,0 +1,60 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BlobFeeBudgetDecorator enforces the blob fee budgets that accounts set on
+// themselves with MsgSetBlobFeeBudget.
+type BlobFeeBudgetDecorator struct {
+	k BlobKeeper
+}
+
+func NewBlobFeeBudgetDecorator(k BlobKeeper) BlobFeeBudgetDecorator {
+	return BlobFeeBudgetDecorator{k}
+}
+
+// AnteHandle implements the Cosmos SDK AnteHandler function signature. If tx
+// contains a MsgPayForBlobs, its fee is charged to the budget of the account
+// that pays it, i.e. the fee granter if there is one and otherwise the fee
+// payer. It returns an error if the fee exceeds what is left of the budget in
+// the current epoch. Budgets can only be set from app version 4 onwards.
+func (d BlobFeeBudgetDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeader().Version.App < v4.Version || !hasPFB(tx) {
+		return next(ctx, tx, simulate)
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "tx must be a FeeTx")
+	}
+	payer := feeTx.FeePayer()
+	if granter := feeTx.FeeGranter(); granter != nil {
+		payer = granter
+	}
+	fee := feeTx.GetFee().AmountOf(appconsts.BondDenom)
+	if !fee.IsUint64() {
+		return ctx, blobtypes.WithDetails(
+			errors.Wrapf(blobtypes.ErrBlobFeeBudgetExceeded, "fee %sutia is too large", fee),
+			map[string]string{blobtypes.MetadataFee: fee.String()},
+		)
+	}
+	if err := d.k.SpendBlobFees(ctx, payer, fee.Uint64()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func hasPFB(tx sdk.Tx) bool {
+	for _, m := range tx.GetMsgs() {
+		if _, ok := m.(*blobtypes.MsgPayForBlobs); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	ante "github.com/celestiaorg/celestia-app/v3/x/blob/ante"
+	blob "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestBlobFeeBudgetDecorator(t *testing.T) {
+	payer := sdk.AccAddress("payer")
+	granter := sdk.AccAddress("granter")
+
+	testCases := []struct {
+		name       string
+		msg        sdk.Msg
+		granter    sdk.AccAddress
+		appVersion uint64
+		wantPayer  sdk.AccAddress
+		wantCharge bool
+	}{
+		{
+			name:       "PFB is charged to the fee payer",
+			msg:        &blob.MsgPayForBlobs{Signer: payer.String(), BlobSizes: []uint32{1}},
+			appVersion: v4.Version,
+			wantPayer:  payer,
+			wantCharge: true,
+		},
+		{
+			name:       "PFB is charged to the fee granter",
+			msg:        &blob.MsgPayForBlobs{Signer: payer.String(), BlobSizes: []uint32{1}},
+			granter:    granter,
+			appVersion: v4.Version,
+			wantPayer:  granter,
+			wantCharge: true,
+		},
+		{
+			name:       "tx without PFB is not charged",
+			msg:        banktypes.NewMsgSend(payer, granter, sdk.NewCoins()),
+			appVersion: v4.Version,
+		},
+		{
+			name:       "PFB is not charged before v4",
+			msg:        &blob.MsgPayForBlobs{Signer: payer.String(), BlobSizes: []uint32{1}},
+			appVersion: v3.Version,
+		},
+	}
+
+	txConfig := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msg))
+			txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 42)))
+			txBuilder.SetFeeGranter(tc.granter)
+
+			k := &budgetBlobKeeper{}
+			decorator := ante.NewBlobFeeBudgetDecorator(k)
+			ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Version: version.Consensus{App: tc.appVersion}})
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, mockNext)
+			require.NoError(t, err)
+			if !tc.wantCharge {
+				assert.Nil(t, k.payer)
+				return
+			}
+			assert.Equal(t, tc.wantPayer, k.payer)
+			assert.Equal(t, uint64(42), k.fee)
+		})
+	}
+
+	t.Run("exceeded budget rejects the tx", func(t *testing.T) {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(&blob.MsgPayForBlobs{Signer: payer.String(), BlobSizes: []uint32{1}}))
+		decorator := ante.NewBlobFeeBudgetDecorator(&budgetBlobKeeper{err: blob.ErrBlobFeeBudgetExceeded})
+		ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Version: version.Consensus{App: v4.Version}})
+		_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, mockNext)
+		assert.ErrorIs(t, err, blob.ErrBlobFeeBudgetExceeded)
+	})
+}
+
+// budgetBlobKeeper records the fees that are charged to budgets.
+type budgetBlobKeeper struct {
+	mockBlobKeeper
+	payer sdk.AccAddress
+	fee   uint64
+	err   error
+}
+
+func (k *budgetBlobKeeper) SpendBlobFees(_ sdk.Context, payer sdk.AccAddress, fee uint64) error {
+	k.payer = payer
+	k.fee = fee
+	return k.err
+}
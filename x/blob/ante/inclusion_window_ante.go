@@ -0,0 +1,80 @@
+package ante
+
+import (
+	"strconv"
+
+	"cosmossdk.io/errors"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// InclusionWindowDecorator enforces the inclusion windows of PFBs, i.e. the
+// not_before_height and not_after_height of a MsgPayForBlobs.
+type InclusionWindowDecorator struct{}
+
+func NewInclusionWindowDecorator() InclusionWindowDecorator {
+	return InclusionWindowDecorator{}
+}
+
+// AnteHandle implements the Cosmos SDK AnteHandler function signature. It
+// returns an error if tx contains a MsgPayForBlobs that can't be included at
+// the height of the block. In CheckTx, which runs against the last committed
+// block, PFBs are only rejected once they can no longer be included in the
+// next block, so that they can be submitted ahead of their window. Such PFBs
+// stay in the mempool and are skipped by PrepareProposal until their window
+// starts, which also holds back the later txs of their signer. Inclusion
+// windows were introduced in app version 4 and PFBs that set one are rejected
+// in earlier versions, whose binaries can't decode them. PFBs nested in an
+// authz MsgExec are checked as well.
+func (d InclusionWindowDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// checkMsgs returns an error if any of the PFBs of msgs, including those
+// nested in an authz MsgExec, can't be included at the height of the block.
+func (d InclusionWindowDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, m := range msgs {
+		if execMsg, ok := m.(*authz.MsgExec); ok {
+			nestedMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := d.checkMsgs(ctx, nestedMsgs); err != nil {
+				return err
+			}
+			continue
+		}
+		pfb, ok := m.(*blobtypes.MsgPayForBlobs)
+		if !ok || !pfb.HasInclusionWindow() {
+			continue
+		}
+		if ctx.BlockHeader().Version.App < v4.Version {
+			return errors.Wrapf(blobtypes.ErrInvalidInclusionWindow, "inclusion windows are not supported before app version %d", v4.Version)
+		}
+		if err := pfb.ValidateInclusionWindow(); err != nil {
+			return err
+		}
+		height := uint64(ctx.BlockHeight())
+		inWindow := pfb.InInclusionWindow(height)
+		if ctx.IsCheckTx() {
+			height++
+			inWindow = pfb.NotAfterHeight == 0 || height <= pfb.NotAfterHeight
+		}
+		if !inWindow {
+			return blobtypes.WithDetails(
+				errors.Wrapf(blobtypes.ErrOutsideInclusionWindow, "height %d is outside of the inclusion window [%d, %d]", height, pfb.NotBeforeHeight, pfb.NotAfterHeight),
+				map[string]string{
+					blobtypes.MetadataHeight:          strconv.FormatUint(height, 10),
+					blobtypes.MetadataNotBeforeHeight: strconv.FormatUint(pfb.NotBeforeHeight, 10),
+					blobtypes.MetadataNotAfterHeight:  strconv.FormatUint(pfb.NotAfterHeight, 10),
+				},
+			)
+		}
+	}
+	return nil
+}
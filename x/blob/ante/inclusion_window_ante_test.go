@@ -0,0 +1,145 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	ante "github.com/celestiaorg/celestia-app/v3/x/blob/ante"
+	blob "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestInclusionWindowDecorator(t *testing.T) {
+	type testCase struct {
+		name       string
+		pfb        *blob.MsgPayForBlobs
+		height     int64
+		isCheckTx  bool
+		appVersion uint64
+		// depth is the number of authz MsgExecs that the PFB is nested in.
+		depth   int
+		wantErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "no window",
+			pfb:    &blob.MsgPayForBlobs{},
+			height: 10,
+		},
+		{
+			name:   "within window",
+			pfb:    &blob.MsgPayForBlobs{NotBeforeHeight: 10, NotAfterHeight: 10},
+			height: 10,
+		},
+		{
+			name:   "no upper bound",
+			pfb:    &blob.MsgPayForBlobs{NotBeforeHeight: 5},
+			height: 100,
+		},
+		{
+			name:    "before window",
+			pfb:     &blob.MsgPayForBlobs{NotBeforeHeight: 11, NotAfterHeight: 20},
+			height:  10,
+			wantErr: blob.ErrOutsideInclusionWindow,
+		},
+		{
+			name:    "after window",
+			pfb:     &blob.MsgPayForBlobs{NotAfterHeight: 9},
+			height:  10,
+			wantErr: blob.ErrOutsideInclusionWindow,
+		},
+		{
+			name:      "check tx before window",
+			pfb:       &blob.MsgPayForBlobs{NotBeforeHeight: 100},
+			height:    10,
+			isCheckTx: true,
+		},
+		{
+			name:      "check tx for the last height of the window",
+			pfb:       &blob.MsgPayForBlobs{NotAfterHeight: 11},
+			height:    10,
+			isCheckTx: true,
+		},
+		{
+			name:      "check tx after window",
+			pfb:       &blob.MsgPayForBlobs{NotAfterHeight: 10},
+			height:    10,
+			isCheckTx: true,
+			wantErr:   blob.ErrOutsideInclusionWindow,
+		},
+		{
+			name:    "window that ends before it starts",
+			pfb:     &blob.MsgPayForBlobs{NotBeforeHeight: 10, NotAfterHeight: 9},
+			height:  10,
+			wantErr: blob.ErrInvalidInclusionWindow,
+		},
+		{
+			name:       "window before v4",
+			pfb:        &blob.MsgPayForBlobs{NotBeforeHeight: 10, NotAfterHeight: 10},
+			height:     10,
+			appVersion: v3.Version,
+			wantErr:    blob.ErrInvalidInclusionWindow,
+		},
+		{
+			name:       "no window before v4",
+			pfb:        &blob.MsgPayForBlobs{},
+			height:     10,
+			appVersion: v3.Version,
+		},
+		{
+			name:    "nested PFB before window",
+			pfb:     &blob.MsgPayForBlobs{NotBeforeHeight: 11},
+			height:  10,
+			depth:   1,
+			wantErr: blob.ErrOutsideInclusionWindow,
+		},
+		{
+			name:       "nested window before v4",
+			pfb:        &blob.MsgPayForBlobs{NotBeforeHeight: 1},
+			height:     10,
+			appVersion: v3.Version,
+			depth:      1,
+			wantErr:    blob.ErrInvalidInclusionWindow,
+		},
+		{
+			name:       "doubly nested window before v4",
+			pfb:        &blob.MsgPayForBlobs{NotAfterHeight: 100},
+			height:     10,
+			appVersion: v3.Version,
+			depth:      2,
+			wantErr:    blob.ErrInvalidInclusionWindow,
+		},
+	}
+
+	txConfig := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var msg sdk.Msg = tc.pfb
+			for range tc.depth {
+				exec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{msg})
+				msg = &exec
+			}
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(msg))
+			tx := txBuilder.GetTx()
+
+			appVersion := tc.appVersion
+			if appVersion == 0 {
+				appVersion = v4.Version
+			}
+			decorator := ante.NewInclusionWindowDecorator()
+			ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Height: tc.height, Version: version.Consensus{App: appVersion}}).WithIsCheckTx(tc.isCheckTx)
+			_, err := decorator.AnteHandle(ctx, tx, false, mockNext)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
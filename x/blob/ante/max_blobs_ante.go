@@ -0,0 +1,53 @@
+package ante
+
+import (
+	"strconv"
+
+	"cosmossdk.io/errors"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxBlobsPerPFBDecorator bounds the cost of verifying the share commitments
+// of a PFB independently of the size of its blobs.
+type MaxBlobsPerPFBDecorator struct {
+	k BlobKeeper
+}
+
+func NewMaxBlobsPerPFBDecorator(k BlobKeeper) MaxBlobsPerPFBDecorator {
+	return MaxBlobsPerPFBDecorator{k}
+}
+
+// AnteHandle implements the Cosmos SDK AnteHandler function signature. It
+// returns an error if tx contains a MsgPayForBlobs with more blobs than the
+// MaxBlobsPerPFB param allows. The param only applies from app version 4
+// onwards.
+func (d MaxBlobsPerPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeader().Version.App < v4.Version {
+		return next(ctx, tx, simulate)
+	}
+
+	var max uint32
+	for _, m := range tx.GetMsgs() {
+		if pfb, ok := m.(*blobtypes.MsgPayForBlobs); ok {
+			if max == 0 {
+				// lazily fetch the param
+				if max = d.k.MaxBlobsPerPFB(ctx); max == 0 {
+					break
+				}
+			}
+			if len(pfb.BlobSizes) > int(max) {
+				return ctx, blobtypes.WithDetails(
+					errors.Wrapf(blobtypes.ErrTooManyBlobs, "%d blobs exceed max %d per PFB", len(pfb.BlobSizes), max),
+					map[string]string{
+						blobtypes.MetadataBlobCount: strconv.Itoa(len(pfb.BlobSizes)),
+						blobtypes.MetadataMaxBlobs:  strconv.FormatUint(uint64(max), 10),
+					},
+				)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,93 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	ante "github.com/celestiaorg/celestia-app/v3/x/blob/ante"
+	blob "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestMaxBlobsPerPFBDecorator(t *testing.T) {
+	type testCase struct {
+		name           string
+		pfbs           []*blob.MsgPayForBlobs
+		maxBlobsPerPFB uint32
+		appVersion     uint64
+		wantErr        error
+	}
+
+	testCases := []testCase{
+		{
+			name:           "PFB with 1 blob",
+			pfbs:           []*blob.MsgPayForBlobs{{BlobSizes: []uint32{1}}},
+			maxBlobsPerPFB: 2,
+		},
+		{
+			name:           "PFB with max blobs",
+			pfbs:           []*blob.MsgPayForBlobs{{BlobSizes: []uint32{1, 1}}},
+			maxBlobsPerPFB: 2,
+		},
+		{
+			name:           "PFB with more than max blobs",
+			pfbs:           []*blob.MsgPayForBlobs{{BlobSizes: []uint32{1, 1, 1}}},
+			maxBlobsPerPFB: 2,
+			wantErr:        blob.ErrTooManyBlobs,
+		},
+		{
+			name:           "second PFB with more than max blobs",
+			pfbs:           []*blob.MsgPayForBlobs{{BlobSizes: []uint32{1}}, {BlobSizes: []uint32{1, 1, 1}}},
+			maxBlobsPerPFB: 2,
+			wantErr:        blob.ErrTooManyBlobs,
+		},
+		{
+			name:           "no limit",
+			pfbs:           []*blob.MsgPayForBlobs{{BlobSizes: []uint32{1, 1, 1}}},
+			maxBlobsPerPFB: 0,
+		},
+		{
+			name:           "PFB with more than max blobs before v4",
+			pfbs:           []*blob.MsgPayForBlobs{{BlobSizes: []uint32{1, 1, 1}}},
+			maxBlobsPerPFB: 2,
+			appVersion:     v3.Version,
+		},
+	}
+
+	txConfig := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			msgs := make([]sdk.Msg, len(tc.pfbs))
+			for i, pfb := range tc.pfbs {
+				msgs[i] = pfb
+			}
+			require.NoError(t, txBuilder.SetMsgs(msgs...))
+			tx := txBuilder.GetTx()
+
+			decorator := ante.NewMaxBlobsPerPFBDecorator(maxBlobsBlobKeeper{max: tc.maxBlobsPerPFB})
+			if tc.appVersion == 0 {
+				tc.appVersion = v4.Version
+			}
+			ctx := sdk.Context{}.WithIsCheckTx(true).WithBlockHeader(tmproto.Header{Version: version.Consensus{App: tc.appVersion}})
+			_, err := decorator.AnteHandle(ctx, tx, false, mockNext)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+type maxBlobsBlobKeeper struct {
+	mockBlobKeeper
+	max uint32
+}
+
+func (k maxBlobsBlobKeeper) MaxBlobsPerPFB(_ sdk.Context) uint32 {
+	return k.max
+}
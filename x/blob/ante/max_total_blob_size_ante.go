@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"strconv"
+
 	"cosmossdk.io/errors"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	v1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
@@ -35,7 +37,13 @@ func (d MaxTotalBlobSizeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	for _, m := range tx.GetMsgs() {
 		if pfb, ok := m.(*blobtypes.MsgPayForBlobs); ok {
 			if total := getTotal(pfb.BlobSizes); total > max {
-				return ctx, errors.Wrapf(blobtypes.ErrTotalBlobSizeTooLarge, "total blob size %d exceeds max %d", total, max)
+				return ctx, blobtypes.WithDetails(
+					errors.Wrapf(blobtypes.ErrTotalBlobSizeTooLarge, "total blob size %d exceeds max %d", total, max),
+					map[string]string{
+						blobtypes.MetadataTotalBlobSize:    strconv.Itoa(total),
+						blobtypes.MetadataMaxTotalBlobSize: strconv.Itoa(max),
+					},
+				)
 			}
 		}
 	}
@@ -0,0 +1,53 @@
+package ante
+
+import (
+	"strconv"
+
+	"cosmossdk.io/errors"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTotalBlobSizePerPFBDecorator prevents a single PFB from taking up a
+// whole data square and crowding out the blobs of all other users.
+type MaxTotalBlobSizePerPFBDecorator struct {
+	k BlobKeeper
+}
+
+func NewMaxTotalBlobSizePerPFBDecorator(k BlobKeeper) MaxTotalBlobSizePerPFBDecorator {
+	return MaxTotalBlobSizePerPFBDecorator{k}
+}
+
+// AnteHandle implements the Cosmos SDK AnteHandler function signature. It
+// returns an error if tx contains a MsgPayForBlobs whose blobs are larger in
+// total than the MaxTotalBlobSizePerPFB param allows. The param only applies
+// from app version 4 onwards.
+func (d MaxTotalBlobSizePerPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeader().Version.App < v4.Version {
+		return next(ctx, tx, simulate)
+	}
+
+	var max uint32
+	for _, m := range tx.GetMsgs() {
+		if pfb, ok := m.(*blobtypes.MsgPayForBlobs); ok {
+			if max == 0 {
+				// lazily fetch the param
+				if max = d.k.MaxTotalBlobSizePerPFB(blobtypes.UnmeteredContext(ctx)); max == 0 {
+					break
+				}
+			}
+			if total := getTotal(pfb.BlobSizes); total > int(max) {
+				return ctx, blobtypes.WithDetails(
+					errors.Wrapf(blobtypes.ErrTotalBlobSizeTooLarge, "total blob size %d exceeds max %d per PFB", total, max),
+					map[string]string{
+						blobtypes.MetadataTotalBlobSize:    strconv.Itoa(total),
+						blobtypes.MetadataMaxTotalBlobSize: strconv.FormatUint(uint64(max), 10),
+					},
+				)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
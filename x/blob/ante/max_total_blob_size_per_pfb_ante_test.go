@@ -0,0 +1,99 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	ante "github.com/celestiaorg/celestia-app/v3/x/blob/ante"
+	blob "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestMaxTotalBlobSizePerPFBDecorator(t *testing.T) {
+	type testCase struct {
+		name                   string
+		pfbs                   []*blob.MsgPayForBlobs
+		maxTotalBlobSizePerPFB uint32
+		appVersion             uint64
+		wantErr                error
+	}
+
+	testCases := []testCase{
+		{
+			name:                   "PFB with a blob smaller than max",
+			pfbs:                   []*blob.MsgPayForBlobs{{BlobSizes: []uint32{100}}},
+			maxTotalBlobSizePerPFB: 1000,
+		},
+		{
+			name:                   "PFB with blobs of max total size",
+			pfbs:                   []*blob.MsgPayForBlobs{{BlobSizes: []uint32{400, 600}}},
+			maxTotalBlobSizePerPFB: 1000,
+		},
+		{
+			name:                   "PFB with a blob larger than max",
+			pfbs:                   []*blob.MsgPayForBlobs{{BlobSizes: []uint32{1001}}},
+			maxTotalBlobSizePerPFB: 1000,
+			wantErr:                blob.ErrTotalBlobSizeTooLarge,
+		},
+		{
+			name:                   "PFB with blobs larger than max in total",
+			pfbs:                   []*blob.MsgPayForBlobs{{BlobSizes: []uint32{400, 400, 400}}},
+			maxTotalBlobSizePerPFB: 1000,
+			wantErr:                blob.ErrTotalBlobSizeTooLarge,
+		},
+		{
+			name:                   "second PFB with blobs larger than max in total",
+			pfbs:                   []*blob.MsgPayForBlobs{{BlobSizes: []uint32{1000}}, {BlobSizes: []uint32{600, 600}}},
+			maxTotalBlobSizePerPFB: 1000,
+			wantErr:                blob.ErrTotalBlobSizeTooLarge,
+		},
+		{
+			name:                   "no limit",
+			pfbs:                   []*blob.MsgPayForBlobs{{BlobSizes: []uint32{1_000_000, 1_000_000}}},
+			maxTotalBlobSizePerPFB: 0,
+		},
+		{
+			name:                   "PFB with a blob larger than max before v4",
+			pfbs:                   []*blob.MsgPayForBlobs{{BlobSizes: []uint32{1001}}},
+			maxTotalBlobSizePerPFB: 1000,
+			appVersion:             v3.Version,
+		},
+	}
+
+	txConfig := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			msgs := make([]sdk.Msg, len(tc.pfbs))
+			for i, pfb := range tc.pfbs {
+				msgs[i] = pfb
+			}
+			require.NoError(t, txBuilder.SetMsgs(msgs...))
+			tx := txBuilder.GetTx()
+
+			decorator := ante.NewMaxTotalBlobSizePerPFBDecorator(maxTotalBlobSizeBlobKeeper{max: tc.maxTotalBlobSizePerPFB})
+			if tc.appVersion == 0 {
+				tc.appVersion = v4.Version
+			}
+			ctx := sdk.Context{}.WithIsCheckTx(true).WithBlockHeader(tmproto.Header{Version: version.Consensus{App: tc.appVersion}})
+			_, err := decorator.AnteHandle(ctx, tx, false, mockNext)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+type maxTotalBlobSizeBlobKeeper struct {
+	mockBlobKeeper
+	max uint32
+}
+
+func (k maxTotalBlobSizeBlobKeeper) MaxTotalBlobSizePerPFB(_ sdk.Context) uint32 {
+	return k.max
+}
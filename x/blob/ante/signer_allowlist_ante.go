@@ -0,0 +1,49 @@
+package ante
+
+import (
+	"slices"
+
+	"cosmossdk.io/errors"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SignerAllowlistDecorator restricts the signers of PFBs to the AllowedSigners
+// param on private networks that set it.
+type SignerAllowlistDecorator struct {
+	k BlobKeeper
+}
+
+func NewSignerAllowlistDecorator(k BlobKeeper) SignerAllowlistDecorator {
+	return SignerAllowlistDecorator{k}
+}
+
+// AnteHandle implements the Cosmos SDK AnteHandler function signature. It
+// returns an error if tx contains a MsgPayForBlobs whose signer isn't in the
+// AllowedSigners param, unless the param is empty. The param only applies from
+// app version 4 onwards.
+func (d SignerAllowlistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeader().Version.App < v4.Version {
+		return next(ctx, tx, simulate)
+	}
+
+	var allowed []string
+	for _, m := range tx.GetMsgs() {
+		pfb, ok := m.(*blobtypes.MsgPayForBlobs)
+		if !ok {
+			continue
+		}
+		if allowed == nil {
+			// lazily fetch the param
+			if allowed = d.k.AllowedSigners(blobtypes.UnmeteredContext(ctx)); len(allowed) == 0 {
+				break
+			}
+		}
+		if !slices.Contains(allowed, pfb.Signer) {
+			return ctx, errors.Wrapf(blobtypes.ErrSignerNotAllowed, "%s is not in the allowed signers", pfb.Signer)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
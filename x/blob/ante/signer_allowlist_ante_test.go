@@ -0,0 +1,85 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	ante "github.com/celestiaorg/celestia-app/v3/x/blob/ante"
+	blob "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestSignerAllowlistDecorator(t *testing.T) {
+	allowed := sdk.AccAddress("allowed").String()
+	other := sdk.AccAddress("other").String()
+
+	type testCase struct {
+		name           string
+		msgs           []sdk.Msg
+		allowedSigners []string
+		appVersion     uint64
+		wantErr        error
+	}
+
+	testCases := []testCase{
+		{
+			name:           "PFB of an allowed signer",
+			msgs:           []sdk.Msg{&blob.MsgPayForBlobs{Signer: allowed}},
+			allowedSigners: []string{allowed},
+		},
+		{
+			name:           "PFB of another signer",
+			msgs:           []sdk.Msg{&blob.MsgPayForBlobs{Signer: other}},
+			allowedSigners: []string{allowed},
+			wantErr:        blob.ErrSignerNotAllowed,
+		},
+		{
+			name:           "other msgs aren't restricted",
+			msgs:           []sdk.Msg{banktypes.NewMsgSend(sdk.AccAddress("other"), sdk.AccAddress("allowed"), sdk.NewCoins())},
+			allowedSigners: []string{allowed},
+		},
+		{
+			name: "no allowlist",
+			msgs: []sdk.Msg{&blob.MsgPayForBlobs{Signer: other}},
+		},
+		{
+			name:           "PFB of another signer before v4",
+			msgs:           []sdk.Msg{&blob.MsgPayForBlobs{Signer: other}},
+			allowedSigners: []string{allowed},
+			appVersion:     v3.Version,
+		},
+	}
+
+	txConfig := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+
+			decorator := ante.NewSignerAllowlistDecorator(allowlistBlobKeeper{allowed: tc.allowedSigners})
+			if tc.appVersion == 0 {
+				tc.appVersion = v4.Version
+			}
+			ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Version: version.Consensus{App: tc.appVersion}})
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, mockNext)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+type allowlistBlobKeeper struct {
+	mockBlobKeeper
+	allowed []string
+}
+
+func (k allowlistBlobKeeper) AllowedSigners(_ sdk.Context) []string {
+	return k.allowed
+}
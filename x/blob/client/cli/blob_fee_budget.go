@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+// CmdSetBlobFeeBudget returns a command that sets the blob fee budget of the
+// signer.
+func CmdSetBlobFeeBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-blob-fee-budget <limit> <epoch_length>",
+		Short: "Cap the utia that the signer can spend on the fees of PFBs per epoch of epoch_length blocks. A limit of 0 removes the budget",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			limit, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			epochLength, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetBlobFeeBudget(clientCtx.GetFromAddress().String(), limit, epochLength)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryBlobFeeBudget returns a command that shows the blob fee budget of an
+// address.
+func CmdQueryBlobFeeBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blob-fee-budget <address>",
+		Short: "shows the blob fee budget of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlobFeeBudget(cmd.Context(), &types.QueryBlobFeeBudgetRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+// CmdBroadcastBlobTx returns a command that attaches blobs to a signed
+// MsgPayForBlobs transaction and broadcasts the resulting blob tx. It
+// completes the offline signing flow for accounts that cannot sign as part of
+// pay-for-blob (e.g. multisig accounts).
+func CmdBroadcastBlobTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "broadcast-blob-tx [signed-tx-file] [namespaceID blob]",
+		Example: "celestia-appd tx blob pay-for-blob 0x00010203040506070809 0x48656c6c6f2c20576f726c6421 \\\n" +
+			"\t--from multisig --fees 21000utia --generate-only > unsigned.json\n" +
+			"celestia-appd tx sign unsigned.json --from validator > signed.json\n" +
+			"celestia-appd tx blob broadcast-blob-tx signed.json 0x00010203040506070809 0x48656c6c6f2c20576f726c6421\n",
+		Short: "Attach blob(s) to a signed PayForBlobs transaction and broadcast it.",
+		Long: `Attach blob(s) to a signed PayForBlobs transaction and broadcast it.
+The signed transaction must be a JSON file produced by "tx sign" or
+"tx multisign" from the output of "tx blob pay-for-blob --generate-only".
+The blobs must be identical to the ones used to generate the transaction and
+can be provided either as arguments or via --input-file (see pay-for-blob).
+The blobs are validated against the share commitments in the signed
+MsgPayForBlobs before broadcasting.
+		`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			path, err := cmd.Flags().GetString(FlagFileInput)
+			if err != nil {
+				return err
+			}
+
+			if path != "" {
+				if filepath.Ext(path) != FileInputExtension {
+					return fmt.Errorf("invalid file extension %v. The only supported extension is %s", filepath.Ext(path), FileInputExtension)
+				}
+				return cobra.ExactArgs(1)(cmd, args)
+			}
+
+			if len(args) != 3 {
+				return fmt.Errorf("broadcast-blob-tx requires three arguments if %s isn't provided: signed-tx-file, namespaceID and blob", FlagFileInput)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespaceVersion, err := cmd.Flags().GetUint8(FlagNamespaceVersion)
+			if err != nil {
+				return err
+			}
+
+			shareVersion, err := cmd.Flags().GetUint8(FlagShareVersion)
+			if err != nil {
+				return err
+			}
+
+			path, err := cmd.Flags().GetString(FlagFileInput)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signedTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			pfb, err := getPFB(signedTx)
+			if err != nil {
+				return err
+			}
+
+			signer, err := sdk.AccAddressFromBech32(pfb.Signer)
+			if err != nil {
+				return err
+			}
+
+			blobs, err := getBlobs(path, args[1:], namespaceVersion, shareVersion, signer)
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(signedTx)
+			if err != nil {
+				return err
+			}
+
+			appVersion, err := getAppVersion(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			blobTx, err := attachBlobs(clientCtx.TxConfig, appVersion, txBytes, blobs...)
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.BroadcastTx(blobTx)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.PersistentFlags().Uint8(FlagNamespaceVersion, 0, "Specify the namespace version (default 0)")
+	cmd.PersistentFlags().Uint8(FlagShareVersion, 0, "Specify the share version (default 0)")
+	cmd.PersistentFlags().String(FlagFileInput, "", "Specify the file input")
+	cmd.Flags().Uint64(FlagAppVersion, 0, "App version to validate the blobs with. Queried from the node if not set, required with --offline")
+	return cmd
+}
+
+// getAppVersion returns the app version set with FlagAppVersion or, if it
+// isn't set, the app version of the latest block of the node.
+func getAppVersion(cmd *cobra.Command, clientCtx client.Context) (uint64, error) {
+	appVersion, err := cmd.Flags().GetUint64(FlagAppVersion)
+	if err != nil {
+		return 0, err
+	}
+	if appVersion != 0 {
+		return appVersion, nil
+	}
+	if clientCtx.Offline {
+		return 0, fmt.Errorf("%s must be set in offline mode", FlagAppVersion)
+	}
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+	info, err := node.ABCIInfo(cmd.Context())
+	if err != nil {
+		return 0, err
+	}
+	return info.Response.AppVersion, nil
+}
+
+// getPFB returns the MsgPayForBlobs contained in tx. It returns an error if tx
+// does not contain exactly one message or that message is not a
+// MsgPayForBlobs.
+func getPFB(tx sdk.Tx) (*types.MsgPayForBlobs, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, types.ErrMultipleMsgsInBlobTx.Wrapf("expected 1 message, got %d", len(msgs))
+	}
+	pfb, ok := msgs[0].(*types.MsgPayForBlobs)
+	if !ok {
+		return nil, types.ErrNoPFB
+	}
+	return pfb, nil
+}
+
+// attachBlobs wraps the signed txBytes with blobs and verifies that the
+// resulting blob tx is valid in appVersion, i.e. that the blobs match the
+// namespaces, sizes, share versions and share commitments of the signed
+// MsgPayForBlobs.
+func attachBlobs(txConfig client.TxEncodingConfig, appVersion uint64, txBytes []byte, blobs ...*share.Blob) ([]byte, error) {
+	rawBlobTx, err := blobtx.MarshalBlobTx(txBytes, blobs...)
+	if err != nil {
+		return nil, err
+	}
+
+	bTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawBlobTx)
+	if !isBlobTx {
+		return nil, fmt.Errorf("failed to unmarshal blob tx: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateBlobTx(txConfig, bTx, appconsts.SubtreeRootThreshold(appVersion), appVersion); err != nil {
+		return nil, err
+	}
+	return rawBlobTx, nil
+}
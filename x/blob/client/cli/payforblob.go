@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -38,6 +39,26 @@ const (
 	// FileInputExtension is the only file extension supported for
 	// FlagFileInput.
 	FileInputExtension = ".json"
+
+	// FlagFile allows the user to provide the path to a file whose raw bytes
+	// are submitted as a single blob, or - to read them from stdin.
+	FlagFile = "file"
+
+	// FlagNamespace specifies the namespaceID of the blob of FlagFile.
+	FlagNamespace = "namespace"
+
+	// FlagNotBeforeHeight allows the user to specify the first height that
+	// the PayForBlob can be included at.
+	FlagNotBeforeHeight = "not-before-height"
+
+	// FlagNotAfterHeight allows the user to specify the last height that the
+	// PayForBlob can be included at.
+	FlagNotAfterHeight = "not-after-height"
+
+	// FlagAppVersion allows the user to specify the app version that the
+	// blobs of broadcast-blob-tx are validated with instead of querying it
+	// from the node.
+	FlagAppVersion = "app-version"
 )
 
 func CmdPayForBlob() *cobra.Command {
@@ -54,10 +75,19 @@ func CmdPayForBlob() *cobra.Command {
 			"\t--from validator \\\n" +
 			"\t--keyring-backend test \\\n" +
 			"\t--fees 21000utia \\\n" +
+			"\t--yes \n\n" +
+			"celestia-appd tx blob pay-for-blob --file path/to/blob.bin --namespace 0x00010203040506070809 \\\n" +
+			"\t--chain-id private \\\n" +
+			"\t--from validator \\\n" +
+			"\t--keyring-backend test \\\n" +
+			"\t--fees 21000utia \\\n" +
 			"\t--yes \n",
 		Short: "Pay for data blob(s) to be published to Celestia.",
 		Long: `Pay for data blob(s) to be published to Celestia.
 To publish a single blob, specify the namespaceID and blob via CLI arguments.
+To publish the raw bytes of a file as a blob, use the --file flag with the path
+to the file, or - to read the bytes from stdin, and the --namespace flag with the
+namespaceID. This supports binary and large blobs that don't fit in a CLI argument.
 To publish multiple blobs, use the --input-file flag with the path to a JSON file.
 The JSON should look like:
 
@@ -77,6 +107,15 @@ The JSON should look like:
 The namespaceID is the user-specifiable portion of a version 0 namespace.
 The namespaceID must be a hex encoded string of 10 bytes.
 The blob must be a hex encoded string of non-zero length.
+
+To sign offline (e.g. with a multisig account), pass --generate-only to print
+the unsigned MsgPayForBlobs transaction, sign it with "tx sign" or
+"tx multisign", and broadcast it along with the same blobs using
+"tx blob broadcast-blob-tx".
+
+To schedule the publication, pass --not-before-height and/or --not-after-height.
+The PayForBlob is only included in a block within these heights, so it can be
+resubmitted once it expired without risking a double inclusion.
 		`,
 		Aliases: []string{"pay-for-blobs", "PayForBlobs", "PayForBlob"},
 		Args: func(cmd *cobra.Command, args []string) error {
@@ -84,6 +123,24 @@ The blob must be a hex encoded string of non-zero length.
 			if err != nil {
 				return err
 			}
+			file, err := cmd.Flags().GetString(FlagFile)
+			if err != nil {
+				return err
+			}
+
+			if file != "" {
+				if path != "" {
+					return fmt.Errorf("only one of %s and %s can be provided", FlagFile, FlagFileInput)
+				}
+				if len(args) != 0 {
+					return fmt.Errorf("pay-for-blob accepts no arguments if %s is provided", FlagFile)
+				}
+				if namespace, err := cmd.Flags().GetString(FlagNamespace); err != nil || namespace == "" {
+					return fmt.Errorf("%s is required if %s is provided", FlagNamespace, FlagFile)
+				}
+
+				return nil
+			}
 
 			if path != "" {
 				if filepath.Ext(path) != FileInputExtension {
@@ -120,30 +177,27 @@ The blob must be a hex encoded string of non-zero length.
 				return err
 			}
 
-			signer := clientCtx.FromAddress
-
-			// In case of no file input, get the namespaceID and blob from the arguments
-			if path == "" {
-				blob, err := getBlobFromArguments(args[0], args[1], namespaceVersion, shareVersion, signer)
-				if err != nil {
-					return err
-				}
-
-				return broadcastPFB(cmd, blob)
-			}
-
-			paresdBlobs, err := parseSubmitBlobs(path)
+			file, err := cmd.Flags().GetString(FlagFile)
 			if err != nil {
 				return err
 			}
 
 			var blobs []*share.Blob
-			for _, paresdBlob := range paresdBlobs {
-				blob, err := getBlobFromArguments(paresdBlob.NamespaceID, paresdBlob.Blob, namespaceVersion, shareVersion, signer)
+			if file != "" {
+				namespaceID, err := cmd.Flags().GetString(FlagNamespace)
+				if err != nil {
+					return err
+				}
+				blob, err := getBlobFromFile(cmd.InOrStdin(), file, namespaceID, namespaceVersion, shareVersion, clientCtx.FromAddress)
+				if err != nil {
+					return err
+				}
+				blobs = []*share.Blob{blob}
+			} else {
+				blobs, err = getBlobs(path, args, namespaceVersion, shareVersion, clientCtx.FromAddress)
 				if err != nil {
 					return err
 				}
-				blobs = append(blobs, blob)
 			}
 
 			return broadcastPFB(cmd, blobs...)
@@ -154,10 +208,42 @@ The blob must be a hex encoded string of non-zero length.
 	cmd.PersistentFlags().Uint8(FlagNamespaceVersion, 0, "Specify the namespace version (default 0)")
 	cmd.PersistentFlags().Uint8(FlagShareVersion, 0, "Specify the share version (default 0)")
 	cmd.PersistentFlags().String(FlagFileInput, "", "Specify the file input")
+	cmd.PersistentFlags().String(FlagFile, "", "Specify the path of a file whose raw bytes are the blob, or - to read them from stdin")
+	cmd.PersistentFlags().String(FlagNamespace, "", "Specify the hex encoded namespaceID of the blob of --file")
+	cmd.PersistentFlags().Uint64(FlagNotBeforeHeight, 0, "Specify the first height that the blobs can be included at (default no lower bound)")
+	cmd.PersistentFlags().Uint64(FlagNotAfterHeight, 0, "Specify the last height that the blobs can be included at (default no upper bound)")
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 	return cmd
 }
 
+// getBlobs returns the blobs specified either by the JSON file at path or, if
+// path is empty, by the first two arguments (namespaceID and blob).
+func getBlobs(path string, args []string, namespaceVersion, shareVersion uint8, signer sdk.AccAddress) ([]*share.Blob, error) {
+	// In case of no file input, get the namespaceID and blob from the arguments
+	if path == "" {
+		blob, err := getBlobFromArguments(args[0], args[1], namespaceVersion, shareVersion, signer)
+		if err != nil {
+			return nil, err
+		}
+		return []*share.Blob{blob}, nil
+	}
+
+	paresdBlobs, err := parseSubmitBlobs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var blobs []*share.Blob
+	for _, paresdBlob := range paresdBlobs {
+		blob, err := getBlobFromArguments(paresdBlob.NamespaceID, paresdBlob.Blob, namespaceVersion, shareVersion, signer)
+		if err != nil {
+			return nil, err
+		}
+		blobs = append(blobs, blob)
+	}
+	return blobs, nil
+}
+
 func getBlobFromArguments(namespaceIDArg, blobArg string, namespaceVersion, shareVersion uint8, signer sdk.AccAddress) (*share.Blob, error) {
 	namespaceID, err := hex.DecodeString(strings.TrimPrefix(namespaceIDArg, "0x"))
 	if err != nil {
@@ -172,7 +258,36 @@ func getBlobFromArguments(namespaceIDArg, blobArg string, namespaceVersion, shar
 	if err != nil {
 		return nil, fmt.Errorf("failure to decode hex blob value %s: %s", hexStr, err.Error())
 	}
+	return newBlob(namespace, rawblob, shareVersion, signer)
+}
+
+// getBlobFromFile returns the blob with the raw bytes of the file at path, or
+// of stdin if path is -.
+func getBlobFromFile(stdin io.Reader, path, namespaceIDArg string, namespaceVersion, shareVersion uint8, signer sdk.AccAddress) (*share.Blob, error) {
+	namespaceID, err := hex.DecodeString(strings.TrimPrefix(namespaceIDArg, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode hex namespace ID: %w", err)
+	}
+	namespace, err := getNamespace(namespaceID, namespaceVersion)
+	if err != nil {
+		return nil, err
+	}
+	var rawblob []byte
+	if path == "-" {
+		rawblob, err = io.ReadAll(stdin)
+	} else {
+		rawblob, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blob: %w", err)
+	}
+	if len(rawblob) == 0 {
+		return nil, fmt.Errorf("blob of %s is empty", path)
+	}
+	return newBlob(namespace, rawblob, shareVersion, signer)
+}
 
+func newBlob(namespace share.Namespace, rawblob []byte, shareVersion uint8, signer sdk.AccAddress) (*share.Blob, error) {
 	switch shareVersion {
 	case share.ShareVersionZero:
 		return types.NewV0Blob(namespace, rawblob)
@@ -210,16 +325,31 @@ func broadcastPFB(cmd *cobra.Command, b ...*share.Blob) error {
 	if err != nil {
 		return err
 	}
+	if pfbMsg.NotBeforeHeight, err = cmd.Flags().GetUint64(FlagNotBeforeHeight); err != nil {
+		return err
+	}
+	if pfbMsg.NotAfterHeight, err = cmd.Flags().GetUint64(FlagNotAfterHeight); err != nil {
+		return err
+	}
 
 	// run message checks
 	if err = pfbMsg.ValidateBasic(); err != nil {
 		return err
 	}
+	if err = pfbMsg.ValidateInclusionWindow(); err != nil {
+		return err
+	}
 
 	txBytes, err := writeTx(clientCtx, sdktx.NewFactoryCLI(clientCtx, cmd.Flags()), pfbMsg)
 	if err != nil {
 		return err
 	}
+	// writeTx returns no bytes if the tx was only generated (e.g. for offline
+	// signing), simulated, or if the user declined to sign it. The blobs are
+	// attached later via the broadcast-blob-tx command in the offline case.
+	if txBytes == nil {
+		return nil
+	}
 
 	blobTx, err := tx.MarshalBlobTx(txBytes, b...)
 	if err != nil {
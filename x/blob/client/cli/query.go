@@ -20,6 +20,17 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryBlobFeeBudget())
+	cmd.AddCommand(CmdQueryBlobProof())
+	cmd.AddCommand(CmdQueryBlobProofEstimate())
+	cmd.AddCommand(CmdQueryEstimateGas())
+	cmd.AddCommand(CmdQueryLayoutConstants())
+	cmd.AddCommand(CmdQueryReservedNamespaces())
+	cmd.AddCommand(CmdQueryNamespaceShares())
+	cmd.AddCommand(CmdQueryShare())
+	cmd.AddCommand(CmdQueryTxShareRanges())
+	cmd.AddCommand(CmdQueryBlobs())
+	cmd.AddCommand(CmdQueryBlockDataStatus())
 
 	return cmd
 }
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// CmdQueryBlobProof returns a command that shows the inclusion proof of a
+// committed blob.
+func CmdQueryBlobProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blob-proof <height> <namespace> <commitment>",
+		Short: "shows the inclusion proof of a committed blob to the data root of its block",
+		Long: `Shows the inclusion proof of the shares of the blob with the hex encoded namespace,
+including its version, and the hex encoded share commitment at height.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			namespace, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+			if err != nil {
+				return fmt.Errorf("failed to decode hex namespace: %w", err)
+			}
+			commitment, err := hex.DecodeString(strings.TrimPrefix(args[2], "0x"))
+			if err != nil {
+				return fmt.Errorf("failed to decode hex commitment: %w", err)
+			}
+
+			queryClient := types.NewProofQueryClient(clientCtx)
+
+			res, err := queryClient.BlobProof(cmd.Context(), &types.QueryBlobProofRequest{
+				Height:     height,
+				Namespace:  namespace,
+				Commitment: commitment,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const flagSquareSize = "square-size"
+
+// CmdQueryBlobProofEstimate returns a command that shows the expected size
+// and verification cost of the inclusion proof of a blob.
+func CmdQueryBlobProofEstimate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blob-proof-estimate <blob_size>",
+		Short: "shows the expected size and verification cost of the inclusion proof of a blob of blob_size bytes",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			blobSize, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+			squareSize, err := cmd.Flags().GetUint64(flagSquareSize)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlobProofEstimate(cmd.Context(), &types.QueryBlobProofEstimateRequest{
+				BlobSize:   uint32(blobSize),
+				SquareSize: squareSize,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagSquareSize, 0, "Size of the square that contains the blob. Defaults to the max square size of the chain")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const flagNamespace = "namespace"
+
+// CmdQueryBlobs returns a command that lists the blobs of a committed block.
+func CmdQueryBlobs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "lists the namespace, size, share commitment, share range and signer of the blobs of a block",
+		Long: `Lists the metadata of the blobs of the block at --height in the order of their shares,
+optionally only those of the hex encoded --namespace, including its version. Use --reverse to list
+the blobs in descending share order.`,
+		Example: "celestia-appd query blob list --height 100 --limit 10 --page 2",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			height, err := cmd.Flags().GetInt64(flags.FlagHeight)
+			if err != nil {
+				return err
+			}
+			namespaceHex, err := cmd.Flags().GetString(flagNamespace)
+			if err != nil {
+				return err
+			}
+			namespace, err := hex.DecodeString(strings.TrimPrefix(namespaceHex, "0x"))
+			if err != nil {
+				return fmt.Errorf("failed to decode hex namespace: %w", err)
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewProofQueryClient(clientCtx)
+
+			res, err := queryClient.Blobs(cmd.Context(), &types.QueryBlobsRequest{
+				Height:     height,
+				Namespace:  namespace,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	// the query flags already define the height flag.
+	cmd.Flags().Lookup(flags.FlagHeight).Usage = "Height of the block of the blobs"
+	cmd.Flags().String(flagNamespace, "", "Hex encoded namespace of the blobs, including its version. Defaults to all namespaces")
+	flags.AddPaginationFlagsToCmd(cmd, "blobs")
+	_ = cmd.MarkFlagRequired(flags.FlagHeight)
+
+	return cmd
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// CmdQueryBlockDataStatus returns a command that shows whether the blob data
+// of a committed block is pruned.
+func CmdQueryBlockDataStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-data-status <height>",
+		Short: "shows whether the node pruned the txs and blobs of the block at height and the data root of the block",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewProofQueryClient(clientCtx)
+
+			res, err := queryClient.BlockDataStatus(cmd.Context(), &types.QueryBlockDataStatusRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
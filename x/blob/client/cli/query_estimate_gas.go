@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// CmdQueryEstimateGas returns a command that shows the gas that a PFB with
+// blobs of the given sizes consumes.
+func CmdQueryEstimateGas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "estimate-gas <blob_size>...",
+		Short:   "shows the gas that a PFB with blobs of the given sizes in bytes consumes",
+		Example: "celestia-appd query blob estimate-gas 1000 250000",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			blobSizes := make([]uint32, len(args))
+			for i, arg := range args {
+				blobSize, err := strconv.ParseUint(arg, 10, 32)
+				if err != nil {
+					return err
+				}
+				blobSizes[i] = uint32(blobSize)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateGas(cmd.Context(), &types.QueryEstimateGasRequest{BlobSizes: blobSizes})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
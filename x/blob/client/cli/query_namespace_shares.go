@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// CmdQueryNamespaceShares returns a command that shows the shares of a
+// namespace in the data square of a committed block.
+func CmdQueryNamespaceShares() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "namespace-shares <height> <namespace>",
+		Short: "shows the shares of a namespace in the original data square of the block at height and their positions",
+		Long: `Shows the shares of the hex encoded namespace, including its version, in the original data square of
+the block at height. The shares are paginated by their index in the square.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			namespace, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+			if err != nil {
+				return fmt.Errorf("failed to decode hex namespace: %w", err)
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewProofQueryClient(clientCtx)
+
+			res, err := queryClient.NamespaceShares(cmd.Context(), &types.QueryNamespaceSharesRequest{
+				Height:     height,
+				Namespace:  namespace,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "namespace-shares")
+
+	return cmd
+}
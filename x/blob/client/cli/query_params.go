@@ -32,3 +32,55 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+// CmdQueryLayoutConstants returns a command that shows the share versions and
+// the constants of the layout of the data square of the current app version.
+func CmdQueryLayoutConstants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "layout-constants",
+		Short: "shows the supported share versions and the constants of the layout of the data square",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LayoutConstants(cmd.Context(), &types.QueryLayoutConstantsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryReservedNamespaces returns a command that shows the namespaces that
+// blobs can't use.
+func CmdQueryReservedNamespaces() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserved-namespaces",
+		Short: "shows the reserved namespace ranges and the error codes of PFBs that use them",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReservedNamespaces(cmd.Context(), &types.QueryReservedNamespacesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
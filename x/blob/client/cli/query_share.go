@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// CmdQueryShare returns a command that shows a share of the data square of a
+// committed block with its inclusion proof.
+func CmdQueryShare() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "share <height> <row> <col>",
+		Short: "shows the share at row and col of the original data square of the block at height and its inclusion proof to the data root",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			row, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+			col, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewProofQueryClient(clientCtx)
+
+			res, err := queryClient.Share(cmd.Context(), &types.QueryShareRequest{
+				Height: height,
+				Row:    uint32(row),
+				Col:    uint32(col),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
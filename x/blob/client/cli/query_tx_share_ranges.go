@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// CmdQueryTxShareRanges returns a command that shows the share ranges of the
+// txs of a committed block.
+func CmdQueryTxShareRanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-share-ranges <height>",
+		Short: "shows the ranges of the shares of the original data square of the block at height that its txs occupy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewProofQueryClient(clientCtx)
+
+			res, err := queryClient.TxShareRanges(cmd.Context(), &types.QueryTxShareRangesRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
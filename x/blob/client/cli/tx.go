@@ -19,6 +19,8 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdPayForBlob())
+	cmd.AddCommand(CmdBroadcastBlobTx())
+	cmd.AddCommand(CmdSetBlobFeeBudget())
 
 	return cmd
 }
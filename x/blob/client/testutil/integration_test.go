@@ -14,6 +14,7 @@ import (
 
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcli "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
@@ -92,6 +93,8 @@ func (s *IntegrationTestSuite) TestSubmitPayForBlob() {
 	`, hex.EncodeToString(share.RandomBlobNamespaceID()), hexBlob, hex.EncodeToString(share.RandomBlobNamespaceID()), hexBlob)
 	validPropFile := createTestFile(s.T(), validBlob, true)
 	invalidPropFile := createTestFile(s.T(), validBlob, false)
+	// the raw bytes of a blob file don't need to be valid UTF-8.
+	binaryBlobFile := createTestFile(s.T(), string([]byte{0x00, 0xff, 0xfe, 0x80, 0x01}), false)
 
 	testCases := []struct {
 		name         string
@@ -127,6 +130,33 @@ func (s *IntegrationTestSuite) TestSubmitPayForBlob() {
 			expectedCode: 0,
 			respType:     &sdk.TxResponse{},
 		},
+		{
+			name: "blob from a binary file",
+			args: []string{
+				fmt.Sprintf("--from=%s", username),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, sdk.NewInt(1000))).String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", paycli.FlagFile, binaryBlobFile.Name()),
+				fmt.Sprintf("--%s=%s", paycli.FlagNamespace, hex.EncodeToString(share.RandomBlobNamespaceID())),
+			},
+			expectErr:    false,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		{
+			name: "blob from a file without a namespace",
+			args: []string{
+				fmt.Sprintf("--from=%s", username),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, sdk.NewInt(1000))).String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", paycli.FlagFile, binaryBlobFile.Name()),
+			},
+			expectErr:    true,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
 		{
 			name: "multiple blobs with invalid file path extension",
 			args: []string{
@@ -185,6 +215,80 @@ func (s *IntegrationTestSuite) TestSubmitPayForBlob() {
 	}
 }
 
+// TestOfflineSignPayForBlob verifies that a PayForBlobs transaction can be
+// generated without signing, signed separately (as is done with Ledger and
+// multisig accounts) and then broadcast along with its blob.
+func (s *IntegrationTestSuite) TestOfflineSignPayForBlob() {
+	require := s.Require()
+
+	namespaceID := hex.EncodeToString(share.RandomBlobNamespaceID())
+	hexBlob := "0204033704032c0b162109000908094d425837422c2116"
+	fees := fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, sdk.NewInt(1000))).String())
+
+	out, err := clitestutil.ExecTestCLICmd(s.ctx.Context, paycli.CmdPayForBlob(), []string{
+		namespaceID,
+		hexBlob,
+		fmt.Sprintf("--from=%s", username),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		fees,
+	})
+	require.NoError(err, out.String())
+	unsignedTx, err := s.ctx.TxConfig.TxJSONDecoder()(out.Bytes())
+	require.NoError(err, out.String())
+	require.Len(unsignedTx.GetMsgs(), 1)
+	require.IsType(&types.MsgPayForBlobs{}, unsignedTx.GetMsgs()[0])
+	unsignedFile := createTestFile(s.T(), out.String(), true)
+
+	out, err = clitestutil.ExecTestCLICmd(s.ctx.Context, authcli.GetSignCommand(), []string{
+		unsignedFile.Name(),
+		fmt.Sprintf("--from=%s", username),
+		fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeLegacyAminoJSON),
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, s.ctx.ChainID),
+	})
+	require.NoError(err, out.String())
+	signedFile := createTestFile(s.T(), out.String(), true)
+
+	// broadcasting with a blob that doesn't match the signed commitment fails
+	_, err = clitestutil.ExecTestCLICmd(s.ctx.Context, paycli.CmdBroadcastBlobTx(), []string{
+		signedFile.Name(),
+		namespaceID,
+		"ff04033704032c0b162109000908094d425837422c2116",
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+	})
+	require.ErrorIs(err, types.ErrInvalidShareCommitment)
+
+	// the app version can't be queried from the node in offline mode
+	_, err = clitestutil.ExecTestCLICmd(s.ctx.Context, paycli.CmdBroadcastBlobTx(), []string{
+		signedFile.Name(),
+		namespaceID,
+		hexBlob,
+		fmt.Sprintf("--%s=true", flags.FlagOffline),
+	})
+	require.ErrorContains(err, paycli.FlagAppVersion)
+
+	// the blobs are validated with the app version of the flag
+	_, err = clitestutil.ExecTestCLICmd(s.ctx.Context, paycli.CmdBroadcastBlobTx(), []string{
+		signedFile.Name(),
+		namespaceID,
+		"ff04033704032c0b162109000908094d425837422c2116",
+		fmt.Sprintf("--%s=%d", paycli.FlagAppVersion, appconsts.LatestVersion),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+	})
+	require.ErrorIs(err, types.ErrInvalidShareCommitment)
+
+	out, err = clitestutil.ExecTestCLICmd(s.ctx.Context, paycli.CmdBroadcastBlobTx(), []string{
+		signedFile.Name(),
+		namespaceID,
+		hexBlob,
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+	})
+	require.NoError(err, out.String())
+
+	var txResp sdk.TxResponse
+	require.NoError(s.ctx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	require.Equal(abci.CodeTypeOK, txResp.Code, out.String())
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test in short mode.")
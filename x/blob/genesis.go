@@ -10,11 +10,15 @@ import (
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
+	for _, budget := range genState.BlobFeeBudgets {
+		k.SetBlobFeeBudget(ctx, budget)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.BlobFeeBudgets = k.GetAllBlobFeeBudgets(ctx)
 	return genesis
 }
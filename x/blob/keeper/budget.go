@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBlobFeeBudget returns the blob fee budget of addr and whether it exists.
+func (k Keeper) GetBlobFeeBudget(ctx sdk.Context, addr sdk.AccAddress) (types.BlobFeeBudget, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlobFeeBudgetKey(addr))
+	if bz == nil {
+		return types.BlobFeeBudget{}, false
+	}
+	var budget types.BlobFeeBudget
+	k.cdc.MustUnmarshal(bz, &budget)
+	return budget, true
+}
+
+// SetBlobFeeBudget stores budget. The address of the budget must be valid.
+func (k Keeper) SetBlobFeeBudget(ctx sdk.Context, budget types.BlobFeeBudget) {
+	addr := sdk.MustAccAddressFromBech32(budget.Address)
+	ctx.KVStore(k.storeKey).Set(types.BlobFeeBudgetKey(addr), k.cdc.MustMarshal(&budget))
+}
+
+// DeleteBlobFeeBudget removes the blob fee budget of addr.
+func (k Keeper) DeleteBlobFeeBudget(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.BlobFeeBudgetKey(addr))
+}
+
+// GetAllBlobFeeBudgets returns all blob fee budgets ordered by address.
+func (k Keeper) GetAllBlobFeeBudgets(ctx sdk.Context) []types.BlobFeeBudget {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlobFeeBudgetKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var budgets []types.BlobFeeBudget
+	for ; iterator.Valid(); iterator.Next() {
+		var budget types.BlobFeeBudget
+		k.cdc.MustUnmarshal(iterator.Value(), &budget)
+		budgets = append(budgets, budget)
+	}
+	return budgets
+}
+
+// SpendBlobFees records that payer spent fee on blob fees in the current
+// block. It returns ErrBlobFeeBudgetExceeded if payer has a budget that fee
+// exceeds. The store is accessed without metering gas, see
+// types.UnmeteredContext.
+func (k Keeper) SpendBlobFees(ctx sdk.Context, payer sdk.AccAddress, fee uint64) error {
+	ctx = types.UnmeteredContext(ctx)
+	budget, ok := k.GetBlobFeeBudget(ctx, payer)
+	if !ok {
+		return nil
+	}
+	budget, err := budget.Spend(ctx.BlockHeight(), fee)
+	if err != nil {
+		return err
+	}
+	k.SetBlobFeeBudget(ctx, budget)
+	return nil
+}
+
+// SetBlobFeeBudget sets, updates or, if the limit is 0, removes the blob fee
+// budget of the signer. An updated budget keeps the amount spent in its
+// current epoch.
+func (k msgServer) SetBlobFeeBudget(goCtx context.Context, msg *types.MsgSetBlobFeeBudget) (*types.MsgSetBlobFeeBudgetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Limit == 0 {
+		k.DeleteBlobFeeBudget(ctx, signer)
+		return &types.MsgSetBlobFeeBudgetResponse{}, nil
+	}
+
+	budget, ok := k.GetBlobFeeBudget(ctx, signer)
+	if ok {
+		// roll the budget over to the current epoch before changing it.
+		budget, _ = budget.Spend(ctx.BlockHeight(), 0)
+	} else {
+		budget = types.BlobFeeBudget{
+			Address:    msg.Signer,
+			EpochStart: ctx.BlockHeight(),
+		}
+	}
+	budget.Limit = msg.Limit
+	budget.EpochLength = msg.EpochLength
+	if err := budget.Validate(); err != nil {
+		return nil, err
+	}
+	k.Keeper.SetBlobFeeBudget(ctx, budget)
+	return &types.MsgSetBlobFeeBudgetResponse{}, nil
+}
+
+// BlobFeeBudget returns the blob fee budget of an address.
+func (k Keeper) BlobFeeBudget(c context.Context, req *types.QueryBlobFeeBudgetRequest) (*types.QueryBlobFeeBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	budget, ok := k.GetBlobFeeBudget(ctx, addr)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no blob fee budget for %s", req.Address)
+	}
+	// report the amount spent in the current epoch.
+	budget, _ = budget.Spend(ctx.BlockHeight(), 0)
+	return &types.QueryBlobFeeBudgetResponse{Budget: budget}, nil
+}
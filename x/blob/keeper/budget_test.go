@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/x/blob"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/keeper"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBlobFeeBudget(t *testing.T) {
+	k, _, ctx := CreateKeeper(t, appconsts.LatestVersion)
+	ctx = ctx.WithBlockHeight(10)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	addr := sdk.AccAddress("signer")
+	other := sdk.AccAddress("other")
+
+	// accounts without a budget aren't limited
+	require.NoError(t, k.SpendBlobFees(ctx, addr, 1_000_000))
+
+	_, err := msgServer.SetBlobFeeBudget(ctx, types.NewMsgSetBlobFeeBudget(addr.String(), 100, 5))
+	require.NoError(t, err)
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	require.NoError(t, k.SpendBlobFees(ctx, addr, 70))
+	assert.Equal(t, gasBefore, ctx.GasMeter().GasConsumed(), "spending should not consume gas")
+	assert.ErrorIs(t, k.SpendBlobFees(ctx, addr, 31), types.ErrBlobFeeBudgetExceeded)
+	require.NoError(t, k.SpendBlobFees(ctx, other, 31))
+
+	// raising the limit keeps the amount spent in the epoch
+	_, err = msgServer.SetBlobFeeBudget(ctx, types.NewMsgSetBlobFeeBudget(addr.String(), 200, 5))
+	require.NoError(t, err)
+	res, err := k.BlobFeeBudget(ctx, &types.QueryBlobFeeBudgetRequest{Address: addr.String()})
+	require.NoError(t, err)
+	assert.Equal(t, types.BlobFeeBudget{Address: addr.String(), Limit: 200, EpochLength: 5, EpochStart: 10, Spent: 70}, res.Budget)
+
+	// the next epoch resets the amount spent
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, k.SpendBlobFees(ctx, addr, 200))
+	assert.ErrorIs(t, k.SpendBlobFees(ctx, addr, 1), types.ErrBlobFeeBudgetExceeded)
+
+	gs := blob.ExportGenesis(ctx, *k)
+	require.Len(t, gs.BlobFeeBudgets, 1)
+	assert.Equal(t, uint64(200), gs.BlobFeeBudgets[0].Spent)
+	require.NoError(t, gs.Validate())
+
+	// a limit of 0 removes the budget
+	_, err = msgServer.SetBlobFeeBudget(ctx, types.NewMsgSetBlobFeeBudget(addr.String(), 0, 0))
+	require.NoError(t, err)
+	require.NoError(t, k.SpendBlobFees(ctx, addr, 1_000_000))
+	_, err = k.BlobFeeBudget(ctx, &types.QueryBlobFeeBudgetRequest{Address: addr.String()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
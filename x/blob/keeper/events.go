@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// EmitBlobEvents emits an EventBlob for every blob of the block whose txs, as
+// delivered to the app without their blobs, are txs. Tendermint strips the
+// blobs before delivering blob txs, so the square of the block is
+// reconstructed from placeholder blobs with the namespaces, sizes, share
+// versions and signers that the PFBs pay for. The layout of a square only
+// depends on those, so the events are the same on every node.
+func (k Keeper) EmitBlobEvents(ctx sdk.Context, txs [][]byte, decoder sdk.TxDecoder) error {
+	rawTxs := make([][]byte, len(txs))
+	pfbs := make([]*types.MsgPayForBlobs, len(txs))
+	for i, tx := range txs {
+		rawTxs[i] = tx
+		pfb, ok := payForBlobs(tx, decoder)
+		if !ok {
+			continue
+		}
+		blobs, err := placeholderBlobs(pfb)
+		if err != nil {
+			return fmt.Errorf("tx %d: %w", i, err)
+		}
+		if rawTxs[i], err = blobtx.MarshalBlobTx(tx, blobs...); err != nil {
+			return err
+		}
+		pfbs[i] = pfb
+	}
+
+	appVersion := ctx.BlockHeader().Version.App
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion), rawTxs...)
+	if err != nil {
+		return fmt.Errorf("constructing square: %w", err)
+	}
+	for i, pfb := range pfbs {
+		if pfb == nil {
+			continue
+		}
+		txHash := fmt.Sprintf("%X", tmtypes.Tx(txs[i]).Hash())
+		for j := range pfb.Namespaces {
+			start, err := builder.FindBlobStartingIndex(i, j)
+			if err != nil {
+				return fmt.Errorf("finding start of blob %d of tx %d: %w", j, i, err)
+			}
+			length, err := builder.BlobShareLength(i, j)
+			if err != nil {
+				return fmt.Errorf("finding length of blob %d of tx %d: %w", j, i, err)
+			}
+			err = ctx.EventManager().EmitTypedEvent(&types.EventBlob{
+				TxHash:          txHash,
+				BlobIndex:       uint32(j),
+				Signer:          pfb.Signer,
+				Namespace:       pfb.Namespaces[j],
+				ShareCommitment: pfb.ShareCommitments[j],
+				ShareVersion:    pfb.ShareVersions[j],
+				Size_:           pfb.BlobSizes[j],
+				ShareStart:      uint32(start),
+				ShareEnd:        uint32(start + length),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// payForBlobs returns the MsgPayForBlobs of tx if it is a PFB tx.
+func payForBlobs(tx []byte, decoder sdk.TxDecoder) (*types.MsgPayForBlobs, bool) {
+	sdkTx, err := decoder(tx)
+	if err != nil {
+		return nil, false
+	}
+	msgs := sdkTx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, false
+	}
+	pfb, ok := msgs[0].(*types.MsgPayForBlobs)
+	return pfb, ok
+}
+
+// placeholderBlobs returns blobs of zeros that occupy the same shares as the
+// blobs that pfb pays for.
+func placeholderBlobs(pfb *types.MsgPayForBlobs) ([]*share.Blob, error) {
+	if len(pfb.BlobSizes) != len(pfb.Namespaces) || len(pfb.ShareVersions) != len(pfb.Namespaces) || len(pfb.ShareCommitments) != len(pfb.Namespaces) {
+		return nil, fmt.Errorf("the blob fields of the PFB have different lengths")
+	}
+	var signer []byte
+	blobs := make([]*share.Blob, len(pfb.Namespaces))
+	for i, nsBytes := range pfb.Namespaces {
+		ns, err := share.NewNamespaceFromBytes(nsBytes)
+		if err != nil {
+			return nil, err
+		}
+		var blobSigner []byte
+		if pfb.ShareVersions[i] == uint32(share.ShareVersionOne) {
+			if signer == nil {
+				addr, err := sdk.AccAddressFromBech32(pfb.Signer)
+				if err != nil {
+					return nil, err
+				}
+				signer = addr.Bytes()
+			}
+			blobSigner = signer
+		}
+		blobs[i], err = share.NewBlob(ns, make([]byte, pfb.BlobSizes[i]), uint8(pfb.ShareVersions[i]), blobSigner)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return blobs, nil
+}
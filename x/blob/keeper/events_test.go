@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestEmitBlobEvents(t *testing.T) {
+	k, _, ctx := CreateKeeper(t, appconsts.LatestVersion)
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	rand := tmrand.NewRand()
+
+	txs := testfactory.GenerateRandomTxs(5, 300).ToSliceOfBytes()
+	txs = append(txs, blobfactory.RandBlobTxs(signer, rand, 3, 2, 1000).ToSliceOfBytes()...)
+	addr := signer.Account(testfactory.TestAccName).Address()
+	v1Blob, err := share.NewV1Blob(share.RandomBlobNamespace(), rand.Bytes(600), addr)
+	require.NoError(t, err)
+	v1BlobTx, _, err := signer.CreatePayForBlobs(testfactory.TestAccName, []*share.Blob{v1Blob}, blobfactory.DefaultTxOpts()...)
+	require.NoError(t, err)
+	txs = append(txs, v1BlobTx)
+
+	// the app is delivered the txs without their blobs
+	delivered := make([][]byte, len(txs))
+	blobTxs := make([]*blobtx.BlobTx, len(txs))
+	for i, tx := range txs {
+		delivered[i] = tx
+		if blobTx, isBlobTx, _ := blobtx.UnmarshalBlobTx(tx); isBlobTx {
+			delivered[i] = blobTx.Tx
+			blobTxs[i] = blobTx
+		}
+	}
+	require.NoError(t, k.EmitBlobEvents(ctx, delivered, encCfg.TxConfig.TxDecoder()))
+
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion), txs...)
+	require.NoError(t, err)
+	events := ctx.EventManager().Events().ToABCIEvents()
+	require.Len(t, events, 7)
+	for i, blobTx := range blobTxs {
+		if blobTx == nil {
+			continue
+		}
+		for j, blob := range blobTx.Blobs {
+			start, err := builder.FindBlobStartingIndex(i, j)
+			require.NoError(t, err)
+			length, err := builder.BlobShareLength(i, j)
+			require.NoError(t, err)
+
+			msg, err := sdk.ParseTypedEvent(events[0])
+			require.NoError(t, err)
+			events = events[1:]
+			event, ok := msg.(*types.EventBlob)
+			require.True(t, ok)
+			assert.Equal(t, fmt.Sprintf("%X", tmtypes.Tx(blobTx.Tx).Hash()), event.TxHash)
+			assert.EqualValues(t, j, event.BlobIndex)
+			assert.Equal(t, addr.String(), event.Signer)
+			assert.Equal(t, blob.Namespace().Bytes(), event.Namespace)
+			assert.Len(t, event.ShareCommitment, 32)
+			assert.EqualValues(t, blob.ShareVersion(), event.ShareVersion)
+			assert.EqualValues(t, len(blob.Data()), event.Size_)
+			assert.EqualValues(t, start, event.ShareStart, "tx %d blob %d", i, j)
+			assert.EqualValues(t, start+length, event.ShareEnd, "tx %d blob %d", i, j)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v3/x/blob"
 	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,3 +21,24 @@ func TestGenesis(t *testing.T) {
 	require.NotNil(t, got)
 	require.Equal(t, types.DefaultParams(), got.Params)
 }
+
+// TestGenesisRoundTrip tests that a genesis with non default params, allowed
+// signers and blob fee budgets is exported as it was initialized.
+func TestGenesisRoundTrip(t *testing.T) {
+	params := types.DefaultParams()
+	params.GovMaxSquareSize = 32
+	params.MaxBlobsPerPfb = 4
+	params.AllowedSigners = []string{sdk.AccAddress("signer").String(), sdk.AccAddress("other").String()}
+	genesisState := types.GenesisState{
+		Params: params,
+		BlobFeeBudgets: []types.BlobFeeBudget{
+			{Address: sdk.AccAddress("signer").String(), Limit: 100, EpochLength: 10, EpochStart: 5, Spent: 20},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, _, ctx := CreateKeeper(t, appconsts.LatestVersion)
+	blob.InitGenesis(ctx, *k, genesisState)
+	got := blob.ExportGenesis(ctx, *k)
+	require.Equal(t, genesisState, *got)
+}
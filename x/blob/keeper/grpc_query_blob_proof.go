@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/client"
+	rpctypes "github.com/tendermint/tendermint/rpc/core/types"
+	coretypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.ProofQueryServer = proofQueryServer{}
+
+// proofQueryServer serves the inclusion proofs of committed blobs from the
+// blocks of the node of clientCtx, so unlike the Keeper it doesn't need any
+// state.
+type proofQueryServer struct {
+	clientCtx client.Context
+}
+
+// NewProofQueryServer returns a ProofQueryServer that fetches blocks from the
+// node of clientCtx.
+func NewProofQueryServer(clientCtx client.Context) types.ProofQueryServer {
+	return proofQueryServer{clientCtx: clientCtx}
+}
+
+// BlobProof reconstructs the data square of the block at the requested height
+// and returns the inclusion proof of the shares of the blob to its data root.
+func (s proofQueryServer) BlobProof(ctx context.Context, req *types.QueryBlobProofRequest) (*types.QueryBlobProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d must be positive", req.Height)
+	}
+	namespace, err := share.NewNamespaceFromBytes(req.Namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %s", err)
+	}
+	if err := namespace.ValidateForBlob(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %s", err)
+	}
+	if len(req.Commitment) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "commitment cannot be empty")
+	}
+
+	block, err := s.block(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	shareProof, shareRange, err := proof.NewBlobInclusionProof(block.Txs.ToSliceOfBytes(), namespace, req.Commitment, block.Version.App)
+	if errors.Is(err, proof.ErrBlobNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no blob with namespace %x and commitment %x at height %d", req.Namespace, req.Commitment, req.Height)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "creating blob proof: %s", err)
+	}
+	// the square is reconstructed from the txs so make sure that it is the one
+	// that was committed before returning a proof that doesn't verify.
+	if err := shareProof.Validate(block.DataHash); err != nil {
+		return nil, status.Errorf(codes.Internal, "blob proof doesn't match the data root of height %d: %s", req.Height, err)
+	}
+
+	rawProof, err := shareProof.Marshal()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshalling blob proof: %s", err)
+	}
+	return &types.QueryBlobProofResponse{
+		Proof:      rawProof,
+		StartShare: uint32(shareRange.Start),
+		EndShare:   uint32(shareRange.End),
+		DataRoot:   block.DataHash,
+	}, nil
+}
+
+// block returns the block at height from the node. If the node pruned the
+// block or hasn't committed it yet, the error is a NotFound error with the
+// heights of the blocks that the node has instead of the error of the node.
+func (s proofQueryServer) block(ctx context.Context, height int64) (*coretypes.Block, error) {
+	resBlock, err := s.resultBlock(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock.Block == nil {
+		return nil, status.Errorf(codes.NotFound, "the blob data of height %d is pruned: the node only retains its header", height)
+	}
+	return resBlock.Block, nil
+}
+
+// resultBlock returns the block at height from the node like block, except
+// that the block is nil if the node pruned its blob data but retained its
+// header.
+func (s proofQueryServer) resultBlock(ctx context.Context, height int64) (*rpctypes.ResultBlock, error) {
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	resBlock, err := node.Block(ctx, &height)
+	if err == nil {
+		return resBlock, nil
+	}
+
+	nodeStatus, statusErr := node.Status(ctx)
+	if statusErr != nil {
+		return nil, err
+	}
+	earliest, latest := nodeStatus.SyncInfo.EarliestBlockHeight, nodeStatus.SyncInfo.LatestBlockHeight
+	switch {
+	case height < earliest:
+		return nil, status.Errorf(codes.NotFound, "height %d is pruned: the node has the blocks of heights %d to %d", height, earliest, latest)
+	case height > latest:
+		return nil, status.Errorf(codes.NotFound, "height %d is not committed yet: the node has the blocks of heights %d to %d", height, earliest, latest)
+	default:
+		return nil, err
+	}
+}
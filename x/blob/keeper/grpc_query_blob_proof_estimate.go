@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/inclusion"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BlobProofEstimate(c context.Context, req *types.QueryBlobProofEstimateRequest) (*types.QueryBlobProofEstimateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	appVersion := ctx.BlockHeader().Version.App
+
+	squareSize := req.SquareSize
+	if squareSize == 0 {
+		squareSize = min(k.GovMaxSquareSize(ctx), uint64(appconsts.SquareSizeUpperBound(appVersion)))
+	}
+	if squareSize > uint64(appconsts.SquareSizeUpperBound(appVersion)) {
+		return nil, status.Errorf(codes.InvalidArgument, "square size %d exceeds the square size upper bound %d", squareSize, appconsts.SquareSizeUpperBound(appVersion))
+	}
+
+	estimate, err := inclusion.EstimateBlobProof(int(req.BlobSize), int(squareSize), appconsts.SubtreeRootThreshold(appVersion))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryBlobProofEstimateResponse{
+		SquareSize:    squareSize,
+		Shares:        uint64(estimate.Shares),
+		Rows:          uint64(estimate.Rows),
+		NmtNodes:      uint64(estimate.NMTNodes),
+		RowProofAunts: uint64(estimate.RowProofAunts),
+		DataBytes:     uint64(estimate.DataBytes),
+		ProofBytes:    uint64(estimate.ProofBytes),
+		HashCount:     uint64(estimate.HashCount),
+	}, nil
+}
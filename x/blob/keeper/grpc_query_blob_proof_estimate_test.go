@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/inclusion"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobProofEstimateQuery(t *testing.T) {
+	keeper, _, ctx := CreateKeeper(t, appconsts.LatestVersion)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	response, err := keeper.BlobProofEstimate(wctx, &types.QueryBlobProofEstimateRequest{BlobSize: 100_000})
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultGovMaxSquareSize, response.SquareSize)
+	estimate, err := inclusion.EstimateBlobProof(100_000, int(types.DefaultGovMaxSquareSize), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	assert.EqualValues(t, estimate.ProofBytes, response.ProofBytes)
+	assert.EqualValues(t, estimate.HashCount, response.HashCount)
+	assert.EqualValues(t, estimate.Shares, response.Shares)
+
+	response, err = keeper.BlobProofEstimate(wctx, &types.QueryBlobProofEstimateRequest{BlobSize: 1000, SquareSize: 8})
+	require.NoError(t, err)
+	assert.EqualValues(t, 8, response.SquareSize)
+
+	_, err = keeper.BlobProofEstimate(wctx, &types.QueryBlobProofEstimateRequest{BlobSize: 0})
+	assert.Error(t, err)
+	_, err = keeper.BlobProofEstimate(wctx, &types.QueryBlobProofEstimateRequest{BlobSize: 1000, SquareSize: 1024})
+	assert.Error(t, err)
+}
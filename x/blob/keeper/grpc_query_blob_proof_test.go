@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blob/keeper"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/stretchr/testify/assert"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestProofQueriesOfMissingHeights(t *testing.T) {
+	node := mockNode{blockErr: errBlock, earliest: 100, latest: 200}
+	server := keeper.NewProofQueryServer(client.Context{}.WithClient(node))
+	namespace := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+
+	type testCase struct {
+		name     string
+		height   int64
+		wantCode codes.Code
+		wantMsg  string
+	}
+	testCases := []testCase{
+		{name: "pruned height", height: 10, wantCode: codes.NotFound, wantMsg: "height 10 is pruned: the node has the blocks of heights 100 to 200"},
+		{name: "future height", height: 300, wantCode: codes.NotFound, wantMsg: "height 300 is not committed yet"},
+		{name: "available height", height: 150, wantCode: codes.Unknown, wantMsg: errBlock.Error()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := server.BlobProof(context.Background(), &types.QueryBlobProofRequest{Height: tc.height, Namespace: namespace.Bytes(), Commitment: []byte("commitment")})
+			assert.Equal(t, tc.wantCode, status.Code(err))
+			assert.ErrorContains(t, err, tc.wantMsg)
+
+			_, err = server.NamespaceShares(context.Background(), &types.QueryNamespaceSharesRequest{Height: tc.height, Namespace: namespace.Bytes()})
+			assert.Equal(t, tc.wantCode, status.Code(err))
+			assert.ErrorContains(t, err, tc.wantMsg)
+
+			_, err = server.Share(context.Background(), &types.QueryShareRequest{Height: tc.height})
+			assert.Equal(t, tc.wantCode, status.Code(err))
+			assert.ErrorContains(t, err, tc.wantMsg)
+		})
+	}
+}
+
+var errBlock = errors.New("block error")
+
+// mockNode is a node with the blocks of heights earliest to latest that
+// returns block at every height. If blockErr is set, it fails to return any
+// block. If blobPruned is set, it pruned the blob data of every height and
+// only retained the header of block.
+type mockNode struct {
+	rpcclient.Client
+	block            *tmtypes.Block
+	blockErr         error
+	blobPruned       bool
+	earliest, latest int64
+}
+
+func (n mockNode) Block(context.Context, *int64) (*coretypes.ResultBlock, error) {
+	if n.blockErr != nil {
+		return nil, n.blockErr
+	}
+	if n.blobPruned {
+		return &coretypes.ResultBlock{BlockID: tmtypes.BlockID{Hash: n.block.Header.Hash()}}, nil
+	}
+	return &coretypes.ResultBlock{Block: n.block}, nil
+}
+
+func (n mockNode) Header(context.Context, *int64) (*coretypes.ResultHeader, error) {
+	return &coretypes.ResultHeader{Header: &n.block.Header}, nil
+}
+
+func (n mockNode) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{EarliestBlockHeight: n.earliest, LatestBlockHeight: n.latest}}, nil
+}
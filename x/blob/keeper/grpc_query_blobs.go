@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"slices"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/shares"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBlobsLimit is the max number of blobs that a Blobs response contains.
+const maxBlobsLimit = 1000
+
+// Blobs reconstructs the original data square of the block at the requested
+// height and returns the page of the metadata of its blobs.
+func (s proofQueryServer) Blobs(ctx context.Context, req *types.QueryBlobsRequest) (*types.QueryBlobsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d must be positive", req.Height)
+	}
+	var namespace *share.Namespace
+	if len(req.Namespace) > 0 {
+		ns, err := share.NewNamespaceFromBytes(req.Namespace)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %s", err)
+		}
+		namespace = &ns
+	}
+	if s.clientCtx.TxConfig == nil {
+		return nil, status.Error(codes.Unavailable, "the node can't decode txs")
+	}
+
+	block, err := s.block(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	appVersion := block.Version.App
+	txs := block.Txs.ToSliceOfBytes()
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion), txs...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "constructing square of height %d: %s", req.Height, err)
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "constructing square of height %d: %s", req.Height, err)
+	}
+	blobs, err := s.blobInfos(builder, txs, namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading the blobs of height %d: %s", req.Height, err)
+	}
+	res, err := pageBlobs(blobs, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	res.SquareSize = uint64(dataSquare.Size())
+	res.DataRoot = block.DataHash
+	return res, nil
+}
+
+// blobInfos returns the metadata of the blobs of txs in namespace, or of all
+// blobs if namespace is nil, in ascending share order. builder must have
+// built the square of txs.
+func (s proofQueryServer) blobInfos(builder *square.Builder, txs [][]byte, namespace *share.Namespace) ([]types.BlobInfo, error) {
+	var blobs []types.BlobInfo
+	for i, tx := range txs {
+		bTx, isBlobTx, err := blobtx.UnmarshalBlobTx(tx)
+		if !isBlobTx {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		msg, err := s.payForBlobs(bTx.Tx)
+		if err != nil {
+			return nil, fmt.Errorf("tx %d: %w", i, err)
+		}
+		if len(msg.ShareCommitments) != len(bTx.Blobs) {
+			return nil, fmt.Errorf("tx %d has %d blobs but %d share commitments", i, len(bTx.Blobs), len(msg.ShareCommitments))
+		}
+		txHash := sha256.Sum256(tx)
+		for j, blob := range bTx.Blobs {
+			if namespace != nil && !blob.Namespace().Equals(*namespace) {
+				continue
+			}
+			start, err := builder.FindBlobStartingIndex(i, j)
+			if err != nil {
+				return nil, err
+			}
+			shareLen, err := shares.BlobShareLen(len(blob.Data()), blob.ShareVersion())
+			if err != nil {
+				return nil, err
+			}
+			blobs = append(blobs, types.BlobInfo{
+				Namespace:    blob.Namespace().Bytes(),
+				Size_:        uint32(len(blob.Data())),
+				ShareVersion: uint32(blob.ShareVersion()),
+				Commitment:   msg.ShareCommitments[j],
+				StartShare:   uint32(start),
+				EndShare:     uint32(start + shareLen),
+				Signer:       msg.Signer,
+				TxHash:       txHash[:],
+				BlobIndex:    uint32(j),
+			})
+		}
+	}
+	slices.SortFunc(blobs, func(a, b types.BlobInfo) int {
+		return int(a.StartShare) - int(b.StartShare)
+	})
+	return blobs, nil
+}
+
+// payForBlobs returns the PFB of the sdk tx of a blob tx.
+func (s proofQueryServer) payForBlobs(rawTx []byte) (*types.MsgPayForBlobs, error) {
+	sdkTx, err := s.clientCtx.TxConfig.TxDecoder()(rawTx)
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range sdkTx.GetMsgs() {
+		if pfb, ok := msg.(*types.MsgPayForBlobs); ok {
+			return pfb, nil
+		}
+	}
+	return nil, types.ErrNoPFB
+}
+
+// pageBlobs returns the page of blobs, which are in ascending share order.
+func pageBlobs(blobs []types.BlobInfo, pageReq *query.PageRequest) (*types.QueryBlobsResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	limit = min(limit, maxBlobsLimit)
+
+	if pageReq.Reverse {
+		blobs = slices.Clone(blobs)
+		slices.Reverse(blobs)
+	}
+	// before returns whether the blob comes before the share index in the
+	// order of the page.
+	before := func(blob types.BlobInfo, index uint32) bool {
+		if pageReq.Reverse {
+			return blob.StartShare > index
+		}
+		return blob.StartShare < index
+	}
+
+	start := 0
+	switch {
+	case pageReq.Key != nil:
+		if len(pageReq.Key) != 4 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pagination key %x", pageReq.Key)
+		}
+		startIndex := binary.BigEndian.Uint32(pageReq.Key)
+		for start < len(blobs) && before(blobs[start], startIndex) {
+			start++
+		}
+	default:
+		start = int(min(pageReq.Offset, uint64(len(blobs))))
+	}
+	end := min(start+int(limit), len(blobs))
+
+	res := &types.QueryBlobsResponse{
+		Blobs:      append([]types.BlobInfo{}, blobs[start:end]...),
+		Pagination: &query.PageResponse{},
+	}
+	if end < len(blobs) {
+		res.Pagination.NextKey = binary.BigEndian.AppendUint32(nil, blobs[end].StartShare)
+	}
+	if pageReq.CountTotal {
+		res.Pagination.Total = uint64(len(blobs))
+	}
+	return res, nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/keeper"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBlobs(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	ns1 := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	ns2 := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	txs := testfactory.GenerateRandomTxs(5, 300).ToSliceOfBytes()
+	blobTxs := tmtypes.Txs(blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{ns2, ns1, ns2}, []int{3000, 100, 700}))
+	txs = append(txs, blobTxs.ToSliceOfBytes()...)
+	block := &tmtypes.Block{
+		Header: tmtypes.Header{Version: tmversion.Consensus{App: appconsts.LatestVersion}, DataHash: []byte("data root")},
+		Data:   tmtypes.Data{Txs: tmtypes.ToTxs(txs)},
+	}
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	server := keeper.NewProofQueryServer(client.Context{}.WithClient(mockNode{block: block}).WithTxConfig(encCfg.TxConfig))
+
+	dataSquare, err := square.Construct(txs, appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	res, err := server.Blobs(context.Background(), &types.QueryBlobsRequest{Height: 10})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data root"), res.DataRoot)
+	assert.EqualValues(t, dataSquare.Size(), res.SquareSize)
+	require.Len(t, res.Blobs, 3)
+	for i, info := range res.Blobs {
+		if i > 0 {
+			assert.Less(t, res.Blobs[i-1].StartShare, info.StartShare, "blobs are in share order")
+		}
+		blobTx := findBlobTx(t, txs, info.TxHash)
+		blob := blobTx.Blobs[info.BlobIndex]
+		commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+		require.NoError(t, err)
+		blobShares, err := blob.ToShares()
+		require.NoError(t, err)
+		assert.Equal(t, blob.Namespace().Bytes(), info.Namespace)
+		assert.EqualValues(t, len(blob.Data()), info.Size_)
+		assert.Equal(t, commitment, info.Commitment)
+		assert.Equal(t, signer.Account(testfactory.TestAccName).Address().String(), info.Signer)
+		assert.Equal(t, share.ToBytes(blobShares), share.ToBytes(dataSquare[info.StartShare:info.EndShare]))
+	}
+
+	t.Run("namespace", func(t *testing.T) {
+		res, err := server.Blobs(context.Background(), &types.QueryBlobsRequest{Height: 10, Namespace: ns2.Bytes()})
+		require.NoError(t, err)
+		require.Len(t, res.Blobs, 2)
+		for _, info := range res.Blobs {
+			assert.Equal(t, ns2.Bytes(), info.Namespace)
+		}
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		for _, reverse := range []bool{false, true} {
+			var got []types.BlobInfo
+			pageReq := &query.PageRequest{Limit: 2, Reverse: reverse, CountTotal: true}
+			for {
+				page, err := server.Blobs(context.Background(), &types.QueryBlobsRequest{Height: 10, Pagination: pageReq})
+				require.NoError(t, err)
+				if pageReq.CountTotal {
+					assert.EqualValues(t, 3, page.Pagination.Total)
+				}
+				got = append(got, page.Blobs...)
+				if page.Pagination.NextKey == nil {
+					break
+				}
+				pageReq = &query.PageRequest{Key: page.Pagination.NextKey, Limit: 2, Reverse: reverse}
+			}
+			want := res.Blobs
+			if reverse {
+				want = []types.BlobInfo{res.Blobs[2], res.Blobs[1], res.Blobs[0]}
+			}
+			assert.Equal(t, want, got)
+		}
+
+		page, err := server.Blobs(context.Background(), &types.QueryBlobsRequest{Height: 10, Pagination: &query.PageRequest{Offset: 1, Limit: 1}})
+		require.NoError(t, err)
+		assert.Equal(t, res.Blobs[1:2], page.Blobs)
+	})
+
+	_, err = server.Blobs(context.Background(), &types.QueryBlobsRequest{Height: 10, Namespace: []byte("namespace")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func findBlobTx(t *testing.T, txs [][]byte, hash []byte) *blobtx.BlobTx {
+	for _, tx := range txs {
+		if txHash := sha256.Sum256(tx); bytes.Equal(txHash[:], hash) {
+			blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(tx)
+			require.NoError(t, err)
+			require.True(t, isBlobTx)
+			return blobTx
+		}
+	}
+	t.Fatalf("tx %x not found", hash)
+	return nil
+}
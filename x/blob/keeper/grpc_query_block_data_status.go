@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BlockDataStatus returns whether the node pruned the blob data of the block
+// at the requested height. The data root of a pruned block is taken from its
+// header if the node retains it.
+func (s proofQueryServer) BlockDataStatus(ctx context.Context, req *types.QueryBlockDataStatusRequest) (*types.QueryBlockDataStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d must be positive", req.Height)
+	}
+
+	resBlock, err := s.resultBlock(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock.Block != nil {
+		return &types.QueryBlockDataStatusResponse{DataRoot: resBlock.Block.DataHash}, nil
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	resHeader, err := node.Header(ctx, &req.Height)
+	if err != nil {
+		return nil, err
+	}
+	if resHeader.Header == nil {
+		return nil, status.Errorf(codes.NotFound, "height %d is pruned", req.Height)
+	}
+	return &types.QueryBlockDataStatusResponse{Pruned: true, DataRoot: resHeader.Header.DataHash}, nil
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blob/keeper"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBlockDataStatus(t *testing.T) {
+	header := tmtypes.Header{DataHash: []byte("data root")}
+
+	server := keeper.NewProofQueryServer(client.Context{}.WithClient(mockNode{block: &tmtypes.Block{Header: header}}))
+	res, err := server.BlockDataStatus(context.Background(), &types.QueryBlockDataStatusRequest{Height: 10})
+	require.NoError(t, err)
+	assert.Equal(t, &types.QueryBlockDataStatusResponse{DataRoot: header.DataHash}, res)
+
+	server = keeper.NewProofQueryServer(client.Context{}.WithClient(mockNode{block: &tmtypes.Block{Header: header}, blobPruned: true}))
+	res, err = server.BlockDataStatus(context.Background(), &types.QueryBlockDataStatusRequest{Height: 10})
+	require.NoError(t, err)
+	assert.Equal(t, &types.QueryBlockDataStatusResponse{Pruned: true, DataRoot: header.DataHash}, res)
+
+	// the blob data of a pruned block can't be queried
+	_, err = server.TxShareRanges(context.Background(), &types.QueryTxShareRangesRequest{Height: 10})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.ErrorContains(t, err, "blob data of height 10 is pruned")
+
+	_, err = server.BlockDataStatus(context.Background(), &types.QueryBlockDataStatusRequest{Height: 0})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
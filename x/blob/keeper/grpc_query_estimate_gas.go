@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EstimateGas returns the gas that a PFB with blobs of the requested sizes
+// consumes. The blob gas is computed like in PayForBlobs and the total gas
+// with the model of types.EstimateGas.
+func (k Keeper) EstimateGas(c context.Context, req *types.QueryEstimateGasRequest) (*types.QueryEstimateGasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.BlobSizes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, types.ErrNoBlobSizes.Error())
+	}
+	for _, size := range req.BlobSizes {
+		if size == 0 {
+			return nil, status.Error(codes.InvalidArgument, types.ErrZeroBlobSize.Error())
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	gasPerBlobByte := k.gasPerBlobByte(ctx)
+	// up to app version 2 the tx size cost is a param of the auth module that
+	// the blob module can't read so its default is used instead.
+	txSizeCostPerByte := appconsts.TxSizeCostPerByte(ctx.BlockHeader().Version.App)
+	return &types.QueryEstimateGasResponse{
+		BlobGas:           types.GasToConsume(req.BlobSizes, gasPerBlobByte),
+		Gas:               types.EstimateGas(req.BlobSizes, gasPerBlobByte, txSizeCostPerByte),
+		GasPerBlobByte:    gasPerBlobByte,
+		TxSizeCostPerByte: txSizeCostPerByte,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateGasQuery(t *testing.T) {
+	keeper, _, ctx := CreateKeeper(t, appconsts.LatestVersion)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	blobSizes := []uint32{1000, 250_000}
+	response, err := keeper.EstimateGas(wctx, &types.QueryEstimateGasRequest{BlobSizes: blobSizes})
+	require.NoError(t, err)
+	assert.Equal(t, appconsts.GasPerBlobByte(appconsts.LatestVersion), response.GasPerBlobByte)
+	assert.Equal(t, appconsts.TxSizeCostPerByte(appconsts.LatestVersion), response.TxSizeCostPerByte)
+	assert.Equal(t, types.GasToConsume(blobSizes, response.GasPerBlobByte), response.BlobGas)
+	assert.Equal(t, types.DefaultEstimateGas(blobSizes), response.Gas)
+
+	// the blob gas is exactly the gas that PayForBlobs consumes.
+	gasBefore := ctx.GasMeter().GasConsumed()
+	_, err = keeper.PayForBlobs(wctx, &types.MsgPayForBlobs{BlobSizes: blobSizes})
+	require.NoError(t, err)
+	assert.Equal(t, response.BlobGas, ctx.GasMeter().GasConsumed()-gasBefore)
+
+	_, err = keeper.EstimateGas(wctx, &types.QueryEstimateGasRequest{})
+	assert.Error(t, err)
+	_, err = keeper.EstimateGas(wctx, &types.QueryEstimateGasRequest{BlobSizes: []uint32{0}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LayoutConstants returns the share versions and the constants of the layout
+// of the data square of the current app version so that clients don't need to
+// hard code them.
+func (k Keeper) LayoutConstants(c context.Context, req *types.QueryLayoutConstantsRequest) (*types.QueryLayoutConstantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	appVersion := ctx.BlockHeader().Version.App
+
+	shareVersions := appconsts.ShareVersions(appVersion)
+	res := &types.QueryLayoutConstantsResponse{
+		AppVersion:           appVersion,
+		ShareVersions:        make([]uint32, len(shareVersions)),
+		NamespaceSize:        uint64(appconsts.NamespaceSize(appVersion)),
+		ShareSize:            uint64(appconsts.ShareSize(appVersion)),
+		SquareSizeUpperBound: uint64(appconsts.SquareSizeUpperBound(appVersion)),
+		MaxSquareSize:        min(k.GovMaxSquareSize(ctx), uint64(appconsts.SquareSizeUpperBound(appVersion))),
+		SubtreeRootThreshold: uint64(appconsts.SubtreeRootThreshold(appVersion)),
+		ConstantsDigest:      appconsts.ConstantsDigest(appVersion),
+	}
+	for i, version := range shareVersions {
+		res.ShareVersions[i] = uint32(version)
+	}
+	return res, nil
+}
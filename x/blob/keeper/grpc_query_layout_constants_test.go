@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLayoutConstantsQuery(t *testing.T) {
+	keeper, _, ctx := CreateKeeper(t, appconsts.LatestVersion)
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	response, err := keeper.LayoutConstants(sdk.WrapSDKContext(ctx), &types.QueryLayoutConstantsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, appconsts.LatestVersion, response.AppVersion)
+	assert.Equal(t, []uint32{uint32(share.ShareVersionZero), uint32(share.ShareVersionOne)}, response.ShareVersions)
+	assert.EqualValues(t, share.NamespaceSize, response.NamespaceSize)
+	assert.EqualValues(t, share.ShareSize, response.ShareSize)
+	assert.EqualValues(t, appconsts.DefaultSquareSizeUpperBound, response.SquareSizeUpperBound)
+	assert.Equal(t, types.DefaultGovMaxSquareSize, response.MaxSquareSize)
+	assert.EqualValues(t, appconsts.DefaultSubtreeRootThreshold, response.SubtreeRootThreshold)
+	assert.Equal(t, appconsts.ConstantsDigest(appconsts.LatestVersion), response.ConstantsDigest)
+
+	keeper, _, ctx = CreateKeeper(t, v2.Version)
+	keeper.SetParams(ctx, types.DefaultParams())
+	response, err = keeper.LayoutConstants(sdk.WrapSDKContext(ctx), &types.QueryLayoutConstantsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{uint32(share.ShareVersionZero)}, response.ShareVersions)
+}
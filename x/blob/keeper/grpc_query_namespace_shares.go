@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxNamespaceSharesLimit is the max number of shares that a NamespaceShares
+// response contains so that a response stays well below the default max size
+// of gRPC messages.
+const maxNamespaceSharesLimit = 1000
+
+// NamespaceShares reconstructs the original data square of the block at the
+// requested height and returns the page of its shares in the namespace.
+func (s proofQueryServer) NamespaceShares(ctx context.Context, req *types.QueryNamespaceSharesRequest) (*types.QueryNamespaceSharesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d must be positive", req.Height)
+	}
+	namespace, err := share.NewNamespaceFromBytes(req.Namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %s", err)
+	}
+
+	block, err := s.block(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	appVersion := block.Version.App
+	dataSquare, err := square.Construct(block.Txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "constructing square of height %d: %s", req.Height, err)
+	}
+	res, err := namespaceShares(dataSquare, namespace, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	res.DataRoot = block.DataHash
+	return res, nil
+}
+
+// namespaceShares returns the page of the shares of dataSquare in namespace.
+func namespaceShares(dataSquare square.Square, namespace share.Namespace, pageReq *query.PageRequest) (*types.QueryNamespaceSharesResponse, error) {
+	squareSize := dataSquare.Size()
+	// the shares of a namespace are contiguous in the square as it's ordered
+	// by namespace.
+	matches := make([]int, 0)
+	for i, sh := range dataSquare {
+		if sh.Namespace().Equals(namespace) {
+			matches = append(matches, i)
+		}
+	}
+
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	limit = min(limit, maxNamespaceSharesLimit)
+
+	start := 0
+	switch {
+	case pageReq.Key != nil:
+		if len(pageReq.Key) != 4 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pagination key %x", pageReq.Key)
+		}
+		startIndex := int(binary.BigEndian.Uint32(pageReq.Key))
+		for start < len(matches) && matches[start] < startIndex {
+			start++
+		}
+	default:
+		start = int(min(pageReq.Offset, uint64(len(matches))))
+	}
+	end := min(start+int(limit), len(matches))
+
+	res := &types.QueryNamespaceSharesResponse{
+		Shares:     make([]types.NamespaceShare, 0, end-start),
+		SquareSize: uint64(squareSize),
+		Pagination: &query.PageResponse{},
+	}
+	for _, index := range matches[start:end] {
+		sh := dataSquare[index]
+		res.Shares = append(res.Shares, types.NamespaceShare{
+			Data:    sh.ToBytes(),
+			Index:   uint32(index),
+			Row:     uint32(index / squareSize),
+			Col:     uint32(index % squareSize),
+			Padding: sh.IsPadding(),
+		})
+	}
+	if end < len(matches) {
+		res.Pagination.NextKey = binary.BigEndian.AppendUint32(nil, uint32(matches[end]))
+	}
+	if pageReq.CountTotal {
+		res.Pagination.Total = uint64(len(matches))
+	}
+	return res, nil
+}
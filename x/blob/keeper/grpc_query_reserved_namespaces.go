@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReservedNamespaces returns the namespaces that blobs can't use so that
+// clients can reject them before submitting a PFB.
+func (k Keeper) ReservedNamespaces(_ context.Context, req *types.QueryReservedNamespacesRequest) (*types.QueryReservedNamespacesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res := &types.QueryReservedNamespacesResponse{
+		Ranges:            types.ReservedNamespaceRanges(),
+		NamespaceVersions: make([]uint32, len(share.SupportedBlobNamespaceVersions)),
+	}
+	for i, version := range share.SupportedBlobNamespaceVersions {
+		res.NamespaceVersions[i] = uint32(version)
+	}
+	return res, nil
+}
@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReservedNamespacesQuery(t *testing.T) {
+	keeper, _, ctx := CreateKeeper(t, appconsts.LatestVersion)
+
+	response, err := keeper.ReservedNamespaces(sdk.WrapSDKContext(ctx), &types.QueryReservedNamespacesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, types.ReservedNamespaceRanges(), response.Ranges)
+	assert.Equal(t, []uint32{uint32(share.NamespaceVersionZero)}, response.NamespaceVersions)
+	assert.Contains(t, response.Ranges, types.ReservedNamespaceRange{
+		Name: "parity shares",
+		Min:  share.ParitySharesNamespace.Bytes(),
+		Max:  share.ParitySharesNamespace.Bytes(),
+		Code: types.ErrReservedNamespace.ABCICode(),
+	})
+}
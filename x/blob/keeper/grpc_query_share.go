@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Share reconstructs the original data square of the block at the requested
+// height and returns the share at the requested row and col with its
+// inclusion proof to the data root.
+func (s proofQueryServer) Share(ctx context.Context, req *types.QueryShareRequest) (*types.QueryShareResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d must be positive", req.Height)
+	}
+
+	block, err := s.block(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	appVersion := block.Version.App
+	dataSquare, err := square.Construct(block.Txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "constructing square of height %d: %s", req.Height, err)
+	}
+	squareSize := dataSquare.Size()
+	if int(req.Row) >= squareSize || int(req.Col) >= squareSize {
+		return nil, status.Errorf(codes.OutOfRange, "share (%d, %d) is outside of the original data square of size %d of height %d", req.Row, req.Col, squareSize, req.Height)
+	}
+
+	index := int(req.Row)*squareSize + int(req.Col)
+	sh := dataSquare[index]
+	shareProof, err := proof.NewShareInclusionProof(dataSquare, sh.Namespace(), share.NewRange(index, index+1))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "creating share proof: %s", err)
+	}
+	// the square is reconstructed from the txs so make sure that it is the one
+	// that was committed before returning a proof that doesn't verify.
+	if err := shareProof.Validate(block.DataHash); err != nil {
+		return nil, status.Errorf(codes.Internal, "share proof doesn't match the data root of height %d: %s", req.Height, err)
+	}
+
+	rawProof, err := shareProof.Marshal()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshalling share proof: %s", err)
+	}
+	return &types.QueryShareResponse{
+		Share:      sh.ToBytes(),
+		Proof:      rawProof,
+		SquareSize: uint64(squareSize),
+		DataRoot:   block.DataHash,
+	}, nil
+}
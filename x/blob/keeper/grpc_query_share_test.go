@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/keeper"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestShare(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	txs := testfactory.GenerateRandomTxs(10, 700).ToSliceOfBytes()
+	txs = append(txs, blobfactory.RandBlobTxs(signer, tmrand.NewRand(), 5, 2, 2000).ToSliceOfBytes()...)
+
+	dataSquare, err := square.Construct(txs, appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	block := &tmtypes.Block{
+		Header: tmtypes.Header{Version: tmversion.Consensus{App: appconsts.LatestVersion}, DataHash: dah.Hash()},
+		Data:   tmtypes.Data{Txs: tmtypes.ToTxs(txs)},
+	}
+	server := keeper.NewProofQueryServer(client.Context{}.WithClient(mockNode{block: block}))
+
+	squareSize := dataSquare.Size()
+	for _, index := range []int{0, squareSize + 1, len(dataSquare) - 1} {
+		row, col := index/squareSize, index%squareSize
+		res, err := server.Share(context.Background(), &types.QueryShareRequest{Height: 10, Row: uint32(row), Col: uint32(col)})
+		require.NoError(t, err)
+		assert.Equal(t, dataSquare[index].ToBytes(), res.Share)
+		assert.EqualValues(t, squareSize, res.SquareSize)
+		assert.Equal(t, dah.Hash(), []byte(res.DataRoot))
+
+		// the proof proves the share to its row root and the row root to the
+		// data root.
+		var shareProof proof.ShareProof
+		require.NoError(t, shareProof.Unmarshal(res.Proof))
+		require.NoError(t, shareProof.Validate(res.DataRoot))
+		assert.Equal(t, [][]byte{res.Share}, shareProof.Data)
+		assert.Equal(t, [][]byte{dah.RowRoots[row]}, shareProof.RowProof.RowRoots)
+		assert.EqualValues(t, col, shareProof.ShareProofs[0].Start)
+	}
+
+	_, err = server.Share(context.Background(), &types.QueryShareRequest{Height: 10, Row: uint32(squareSize)})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	_, err = server.Share(context.Background(), &types.QueryShareRequest{Height: 10, Col: uint32(squareSize)})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+
+	// a square that doesn't match the data root isn't proven.
+	block.DataHash = []byte("data root")
+	_, err = server.Share(context.Background(), &types.QueryShareRequest{Height: 10})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	_, err = server.Share(context.Background(), &types.QueryShareRequest{Height: 0})
+	assert.ErrorContains(t, err, "height 0 must be positive")
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/shares"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// TxShareRanges reconstructs the original data square of the block at the
+// requested height and returns the share range of each of its txs.
+func (s proofQueryServer) TxShareRanges(ctx context.Context, req *types.QueryTxShareRangesRequest) (*types.QueryTxShareRangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d must be positive", req.Height)
+	}
+
+	block, err := s.block(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	appVersion := block.Version.App
+	txs := block.Txs.ToSliceOfBytes()
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion), txs...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "constructing square of height %d: %s", req.Height, err)
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "constructing square of height %d: %s", req.Height, err)
+	}
+	ranges, err := txShareRanges(builder, dataSquare, txs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "finding the share ranges of the txs of height %d: %s", req.Height, err)
+	}
+	return &types.QueryTxShareRangesResponse{
+		Ranges:     ranges,
+		SquareSize: uint64(dataSquare.Size()),
+		DataRoot:   block.DataHash,
+	}, nil
+}
+
+// txShareRanges returns the share ranges of txs in dataSquare, which builder
+// built from txs. The compact shares contain the PFBs of blob txs wrapped with
+// the indexes of their blobs, which only builder knows.
+func txShareRanges(builder *square.Builder, dataSquare square.Square, txs [][]byte) ([]types.TxShareRange, error) {
+	compactEnd := 0
+	for compactEnd < len(dataSquare) && dataSquare[compactEnd].IsCompactShare() {
+		compactEnd++
+	}
+	ranges, err := shares.TxShareRanges(dataSquare[:compactEnd])
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]types.TxShareRange, len(txs))
+	for i, tx := range txs {
+		written := tx
+		if _, isBlobTx, _ := blobtx.UnmarshalBlobTx(tx); isBlobTx {
+			wrapped, err := builder.GetWrappedPFB(i)
+			if err != nil {
+				return nil, err
+			}
+			if written, err = proto.Marshal(wrapped); err != nil {
+				return nil, err
+			}
+		}
+		r, ok := ranges[sha256.Sum256(written)]
+		if !ok {
+			return nil, fmt.Errorf("tx %d is not in the compact shares", i)
+		}
+		res[i] = txShareRange(tx, r)
+	}
+	return res, nil
+}
+
+func txShareRange(tx []byte, r share.Range) types.TxShareRange {
+	hash := sha256.Sum256(tx)
+	return types.TxShareRange{
+		TxHash:     hash[:],
+		StartShare: uint32(r.Start),
+		EndShare:   uint32(r.End),
+	}
+}
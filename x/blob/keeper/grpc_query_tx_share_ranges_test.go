@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/keeper"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestTxShareRanges(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	txs := testfactory.GenerateRandomTxs(20, 700).ToSliceOfBytes()
+	txs = append(txs, blobfactory.RandBlobTxs(signer, tmrand.NewRand(), 10, 2, 1000).ToSliceOfBytes()...)
+	block := &tmtypes.Block{
+		Header: tmtypes.Header{Version: tmversion.Consensus{App: appconsts.LatestVersion}, DataHash: []byte("data root")},
+		Data:   tmtypes.Data{Txs: tmtypes.ToTxs(txs)},
+	}
+	server := keeper.NewProofQueryServer(client.Context{}.WithClient(mockNode{block: block}))
+
+	res, err := server.TxShareRanges(context.Background(), &types.QueryTxShareRangesRequest{Height: 10})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data root"), res.DataRoot)
+	require.Len(t, res.Ranges, len(txs))
+
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion), txs...)
+	require.NoError(t, err)
+	dataSquare, err := builder.Export()
+	require.NoError(t, err)
+	assert.EqualValues(t, dataSquare.Size(), res.SquareSize)
+	for i, tx := range txs {
+		want, err := builder.FindTxShareRange(i)
+		require.NoError(t, err)
+		hash := sha256.Sum256(tx)
+		assert.Equal(t, types.TxShareRange{TxHash: hash[:], StartShare: uint32(want.Start), EndShare: uint32(want.End)}, res.Ranges[i], i)
+	}
+
+	_, err = server.TxShareRanges(context.Background(), &types.QueryTxShareRangesRequest{Height: 0})
+	assert.ErrorContains(t, err, "height 0 must be positive")
+}
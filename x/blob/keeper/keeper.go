@@ -8,6 +8,7 @@ import (
 	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
 	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -20,11 +21,13 @@ const (
 // Keeper handles all the state changes for the blob module.
 type Keeper struct {
 	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
 	paramStore paramtypes.Subspace
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
 ) *Keeper {
 	if !ps.HasKeyTable() {
@@ -33,6 +36,7 @@ func NewKeeper(
 
 	return &Keeper{
 		cdc:        cdc,
+		storeKey:   storeKey,
 		paramStore: ps,
 	}
 }
@@ -45,14 +49,7 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 func (k Keeper) PayForBlobs(goCtx context.Context, msg *types.MsgPayForBlobs) (*types.MsgPayForBlobsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// GasPerBlobByte is a versioned param from version 3 onwards.
-	var gasToConsume uint64
-	if ctx.BlockHeader().Version.App <= v2.Version {
-		gasToConsume = types.GasToConsume(msg.BlobSizes, k.GasPerBlobByte(ctx))
-	} else {
-		gasToConsume = types.GasToConsume(msg.BlobSizes, appconsts.GasPerBlobByte(ctx.BlockHeader().Version.App))
-	}
-
+	gasToConsume := types.GasToConsume(msg.BlobSizes, k.gasPerBlobByte(ctx))
 	ctx.GasMeter().ConsumeGas(gasToConsume, payForBlobGasDescriptor)
 
 	err := ctx.EventManager().EmitTypedEvent(
@@ -64,3 +61,13 @@ func (k Keeper) PayForBlobs(goCtx context.Context, msg *types.MsgPayForBlobs) (*
 
 	return &types.MsgPayForBlobsResponse{}, nil
 }
+
+// gasPerBlobByte returns the gas that PFBs consume per byte of the shares of
+// their blobs. It is a param up to app version 2 and a versioned constant from
+// version 3 onwards.
+func (k Keeper) gasPerBlobByte(ctx sdk.Context) uint32 {
+	if ctx.BlockHeader().Version.App <= v2.Version {
+		return k.GasPerBlobByte(ctx)
+	}
+	return appconsts.GasPerBlobByte(ctx.BlockHeader().Version.App)
+}
@@ -10,12 +10,35 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
 		k.GasPerBlobByte(ctx),
 		k.GovMaxSquareSize(ctx),
+		k.MaxBlobsPerPFB(ctx),
+		k.MaxPFBsPerBlock(ctx),
+		k.AllowedSigners(ctx),
+		k.MaxTotalBlobSizePerPFB(ctx),
 	)
 }
 
-// SetParams sets the params
+// SetParams sets the params. MaxBlobsPerPFB, MaxPFBsPerBlock, AllowedSigners
+// and MaxTotalBlobSizePerPFB are only stored if they are set so that the state
+// of chains that don't limit blobs, including their genesis state, is
+// unchanged.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramStore.SetParamSet(ctx, &params)
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	k.paramStore.Set(ctx, types.KeyGasPerBlobByte, params.GasPerBlobByte)
+	k.paramStore.Set(ctx, types.KeyGovMaxSquareSize, params.GovMaxSquareSize)
+	if params.MaxBlobsPerPfb != 0 || k.paramStore.Has(ctx, types.KeyMaxBlobsPerPFB) {
+		k.paramStore.Set(ctx, types.KeyMaxBlobsPerPFB, params.MaxBlobsPerPfb)
+	}
+	if params.MaxPfbsPerBlock != 0 || k.paramStore.Has(ctx, types.KeyMaxPFBsPerBlock) {
+		k.paramStore.Set(ctx, types.KeyMaxPFBsPerBlock, params.MaxPfbsPerBlock)
+	}
+	if len(params.AllowedSigners) != 0 || k.paramStore.Has(ctx, types.KeyAllowedSigners) {
+		k.paramStore.Set(ctx, types.KeyAllowedSigners, params.AllowedSigners)
+	}
+	if params.MaxTotalBlobSizePerPfb != 0 || k.paramStore.Has(ctx, types.KeyMaxTotalBlobSizePerPFB) {
+		k.paramStore.Set(ctx, types.KeyMaxTotalBlobSizePerPFB, params.MaxTotalBlobSizePerPfb)
+	}
 }
 
 // GasPerBlobByte returns the GasPerBlobByte param
@@ -24,8 +47,43 @@ func (k Keeper) GasPerBlobByte(ctx sdk.Context) (res uint32) {
 	return res
 }
 
-// GovMaxSquareSize returns the GovMaxSquareSize param
-func (k Keeper) GovMaxSquareSize(ctx sdk.Context) (res uint64) {
-	k.paramStore.Get(ctx, types.KeyGovMaxSquareSize, &res)
+// GovMaxSquareSize returns the GovMaxSquareSize param. It is the default
+// before the genesis state is committed, i.e. when the first block of a chain
+// is prepared, which is not necessarily at height 1 for chains that start from
+// an exported state.
+func (k Keeper) GovMaxSquareSize(ctx sdk.Context) uint64 {
+	res := types.DefaultGovMaxSquareSize
+	k.paramStore.GetIfExists(ctx, types.KeyGovMaxSquareSize, &res)
+	return res
+}
+
+// MaxBlobsPerPFB returns the MaxBlobsPerPFB param. The param didn't exist
+// before, so it is 0, i.e. no limit, on chains that haven't set it.
+func (k Keeper) MaxBlobsPerPFB(ctx sdk.Context) (res uint32) {
+	k.paramStore.GetIfExists(ctx, types.KeyMaxBlobsPerPFB, &res)
+	return res
+}
+
+// MaxPFBsPerBlock returns the MaxPFBsPerBlock param. The param didn't exist
+// before, so it is 0, i.e. no limit, on chains that haven't set it.
+func (k Keeper) MaxPFBsPerBlock(ctx sdk.Context) (res uint32) {
+	k.paramStore.GetIfExists(ctx, types.KeyMaxPFBsPerBlock, &res)
+	return res
+}
+
+// AllowedSigners returns the AllowedSigners param. The param didn't exist
+// before, so it is empty, i.e. any signer is allowed, on chains that haven't
+// set it.
+func (k Keeper) AllowedSigners(ctx sdk.Context) []string {
+	res := []string{}
+	k.paramStore.GetIfExists(ctx, types.KeyAllowedSigners, &res)
+	return res
+}
+
+// MaxTotalBlobSizePerPFB returns the MaxTotalBlobSizePerPFB param. The param
+// didn't exist before, so it is 0, i.e. no limit, on chains that haven't set
+// it.
+func (k Keeper) MaxTotalBlobSizePerPFB(ctx sdk.Context) (res uint32) {
+	k.paramStore.GetIfExists(ctx, types.KeyMaxTotalBlobSizePerPFB, &res)
 	return res
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -17,3 +18,31 @@ func TestGetParams(t *testing.T) {
 	require.EqualValues(t, params, k.GetParams(ctx))
 	require.EqualValues(t, params.GasPerBlobByte, k.GasPerBlobByte(ctx))
 }
+
+func TestGetBlobLimitParams(t *testing.T) {
+	k, _, ctx := CreateKeeper(t, appconsts.LatestVersion)
+	params := types.NewParams(types.DefaultGasPerBlobByte, types.DefaultGovMaxSquareSize, 10, 100, types.DefaultAllowedSigners, 1_000_000)
+
+	k.SetParams(ctx, params)
+
+	require.EqualValues(t, params, k.GetParams(ctx))
+	require.EqualValues(t, 10, k.MaxBlobsPerPFB(ctx))
+	require.EqualValues(t, 100, k.MaxPFBsPerBlock(ctx))
+	require.EqualValues(t, 1_000_000, k.MaxTotalBlobSizePerPFB(ctx))
+}
+
+func TestAllowedSignersParam(t *testing.T) {
+	k, _, ctx := CreateKeeper(t, appconsts.LatestVersion)
+	require.Empty(t, k.AllowedSigners(ctx))
+
+	signers := []string{sdk.AccAddress("signer").String()}
+	params := types.DefaultParams()
+	params.AllowedSigners = signers
+	k.SetParams(ctx, params)
+	require.Equal(t, signers, k.AllowedSigners(ctx))
+	require.EqualValues(t, params, k.GetParams(ctx))
+
+	// the allowlist can be removed again
+	k.SetParams(ctx, types.DefaultParams())
+	require.Empty(t, k.AllowedSigners(ctx))
+}
@@ -76,6 +76,9 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
 		panic(err)
 	}
+	if err := types.RegisterProofQueryHandlerClient(context.Background(), mux, types.NewProofQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // GetTxCmd returns the capability module's root tx command.
@@ -92,17 +95,34 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 // AppModule
 // ----------------------------------------------------------------------------
 
+// consensusVersion is the latest consensus version of the blob module.
+// Version 3 accepts MsgSetBlobFeeBudget.
+const consensusVersion uint64 = 3
+
 // AppModule implements the AppModule interface for the capability module.
 type AppModule struct {
 	AppModuleBasic
 
-	keeper keeper.Keeper
+	keeper           keeper.Keeper
+	consensusVersion uint64
 }
 
+// NewAppModule returns the latest consensus version of the blob module.
 func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
 	return AppModule{
-		AppModuleBasic: NewAppModuleBasic(cdc),
-		keeper:         keeper,
+		AppModuleBasic:   NewAppModuleBasic(cdc),
+		keeper:           keeper,
+		consensusVersion: consensusVersion,
+	}
+}
+
+// NewAppModuleV2 returns consensus version 2 of the blob module, which doesn't
+// accept MsgSetBlobFeeBudget.
+func NewAppModuleV2(cdc codec.Codec, keeper keeper.Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic:   NewAppModuleBasic(cdc),
+		keeper:           keeper,
+		consensusVersion: 2,
 	}
 }
 
@@ -127,8 +147,19 @@ func (am AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	msgServer := cfg.MsgServer()
+	if am.consensusVersion < 3 {
+		msgServer = withoutMethods(msgServer, "SetBlobFeeBudget")
+	}
+	types.RegisterMsgServer(msgServer, keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	if am.consensusVersion >= 3 {
+		// Version 3 only adds a message so there is no state to migrate.
+		if err := cfg.RegisterMigration(types.ModuleName, 2, func(sdk.Context) error { return nil }); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // RegisterInvariants registers the capability module's invariants.
@@ -153,7 +184,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (am AppModule) ConsensusVersion() uint64 { return am.consensusVersion }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
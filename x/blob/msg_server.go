@@ -0,0 +1,27 @@
+package blob
+
+import (
+	"slices"
+
+	pbgrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+)
+
+// methodFilter registers the services of a msg server without the methods
+// that an older consensus version of the module doesn't accept.
+type methodFilter struct {
+	pbgrpc.Server
+	excluded []string
+}
+
+func withoutMethods(server pbgrpc.Server, methodNames ...string) pbgrpc.Server {
+	return methodFilter{Server: server, excluded: methodNames}
+}
+
+func (f methodFilter) RegisterService(sd *grpc.ServiceDesc, v interface{}) {
+	filtered := *sd
+	filtered.Methods = slices.DeleteFunc(slices.Clone(sd.Methods), func(method grpc.MethodDesc) bool {
+		return slices.Contains(f.excluded, method.MethodName)
+	})
+	f.Server.RegisterService(&filtered, v)
+}
@@ -3,7 +3,7 @@ package types
 import (
 	"bytes"
 
-	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/go-square/v2/inclusion"
 	"github.com/celestiaorg/go-square/v2/share"
 	"github.com/celestiaorg/go-square/v2/tx"
@@ -77,12 +77,12 @@ func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx *tx.BlobTx, subtreeRootTh
 		return err
 	}
 	for _, blob := range bTx.Blobs {
+		if !appconsts.IsSupportedShareVersion(appVersion, blob.ShareVersion()) {
+			return ErrUnsupportedShareVersion.Wrapf("share version %d is not supported in %d", blob.ShareVersion(), appVersion)
+		}
 		// If share version is 1, assert that the signer in the blob
 		// matches the signer in the msgPFB.
 		if blob.ShareVersion() == share.ShareVersionOne {
-			if appVersion < v3.Version {
-				return ErrUnsupportedShareVersion.Wrapf("share version %d is not supported in %d. Supported from v3 onwards", blob.ShareVersion(), appVersion)
-			}
 			if !bytes.Equal(blob.Signer(), signer) {
 				return ErrInvalidBlobSigner.Wrapf("blob signer %s does not match msgPFB signer %s", sdk.AccAddress(blob.Signer()).String(), msgPFB.Signer)
 			}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"strconv"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const (
+	URLMsgSetBlobFeeBudget = "/celestia.blob.v1.MsgSetBlobFeeBudget"
+)
+
+var (
+	_ sdk.Msg            = &MsgSetBlobFeeBudget{}
+	_ legacytx.LegacyMsg = &MsgSetBlobFeeBudget{}
+)
+
+// NewMsgSetBlobFeeBudget returns a msg that sets the blob fee budget of
+// signer.
+func NewMsgSetBlobFeeBudget(signer string, limit, epochLength uint64) *MsgSetBlobFeeBudget {
+	return &MsgSetBlobFeeBudget{
+		Signer:      signer,
+		Limit:       limit,
+		EpochLength: epochLength,
+	}
+}
+
+// Route fulfills the legacytx.LegacyMsg interface
+func (msg *MsgSetBlobFeeBudget) Route() string { return RouterKey }
+
+// Type fulfills the legacytx.LegacyMsg interface
+func (msg *MsgSetBlobFeeBudget) Type() string {
+	return URLMsgSetBlobFeeBudget
+}
+
+// ValidateBasic fulfills the sdk.Msg interface by performing stateless
+// validity checks on the msg.
+func (msg *MsgSetBlobFeeBudget) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address %s: %s", msg.Signer, err)
+	}
+	if msg.Limit != 0 && msg.EpochLength == 0 {
+		return errors.Wrap(ErrInvalidBlobFeeBudget, "epoch length must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes fulfills the legacytx.LegacyMsg interface by returning the
+// bytes that are signed over.
+func (msg *MsgSetBlobFeeBudget) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners fulfills the sdk.Msg interface by returning the signer's address
+func (msg *MsgSetBlobFeeBudget) GetSigners() []sdk.AccAddress {
+	address, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{address}
+}
+
+// Validate returns an error if the budget is invalid.
+func (b BlobFeeBudget) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.Address); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s: %s", b.Address, err)
+	}
+	if b.Limit == 0 {
+		return errors.Wrap(ErrInvalidBlobFeeBudget, "limit must be positive")
+	}
+	if b.EpochLength == 0 {
+		return errors.Wrap(ErrInvalidBlobFeeBudget, "epoch length must be positive")
+	}
+	if b.EpochStart < 0 {
+		return errors.Wrap(ErrInvalidBlobFeeBudget, "epoch start cannot be negative")
+	}
+	return nil
+}
+
+// Spend returns the budget after fee was spent at height. The spent amount is
+// reset if the epoch of the budget ended before height. It returns an error
+// if fee exceeds what is left of the budget in the epoch.
+func (b BlobFeeBudget) Spend(height int64, fee uint64) (BlobFeeBudget, error) {
+	if elapsed := height - b.EpochStart; elapsed >= int64(b.EpochLength) {
+		// start the epoch that height is part of so that epochs stay aligned
+		// to the height at which the budget was set.
+		b.EpochStart += elapsed - elapsed%int64(b.EpochLength)
+		b.Spent = 0
+	}
+	if fee > b.Remaining() {
+		epochEnd := b.EpochStart + int64(b.EpochLength)
+		return b, WithDetails(
+			errors.Wrapf(
+				ErrBlobFeeBudgetExceeded,
+				"fee %dutia exceeds the %dutia left of the budget of %dutia per %d blocks of %s until height %d",
+				fee, b.Remaining(), b.Limit, b.EpochLength, b.Address, epochEnd,
+			),
+			map[string]string{
+				MetadataFee:             strconv.FormatUint(fee, 10),
+				MetadataBudgetRemaining: strconv.FormatUint(b.Remaining(), 10),
+				MetadataBudgetLimit:     strconv.FormatUint(b.Limit, 10),
+				MetadataBudgetEpochEnd:  strconv.FormatInt(epochEnd, 10),
+			},
+		)
+	}
+	b.Spent += fee
+	return b, nil
+}
+
+// Remaining returns the amount that is left of the budget in its current
+// epoch. It is 0 if the limit was lowered below the amount already spent.
+func (b BlobFeeBudget) Remaining() uint64 {
+	if b.Spent >= b.Limit {
+		return 0
+	}
+	return b.Limit - b.Spent
+}
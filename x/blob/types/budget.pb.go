@@ -0,0 +1,469 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/blob/v1/budget.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BlobFeeBudget is a budget that an account set on itself to cap the fees it
+// pays for txs with a MsgPayForBlobs, e.g. as a circuit breaker against a
+// runaway submission loop.
+type BlobFeeBudget struct {
+	// address is the bech32 encoded address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// limit is the maximum amount of utia that the account can pay in fees for
+	// txs with a MsgPayForBlobs per epoch.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// epoch_length is the number of blocks after which spent is reset.
+	EpochLength uint64 `protobuf:"varint,3,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// epoch_start is the height at which the current epoch started.
+	EpochStart int64 `protobuf:"varint,4,opt,name=epoch_start,json=epochStart,proto3" json:"epoch_start,omitempty"`
+	// spent is the amount of utia paid in the current epoch.
+	Spent uint64 `protobuf:"varint,5,opt,name=spent,proto3" json:"spent,omitempty"`
+}
+
+func (m *BlobFeeBudget) Reset()         { *m = BlobFeeBudget{} }
+func (m *BlobFeeBudget) String() string { return proto.CompactTextString(m) }
+func (*BlobFeeBudget) ProtoMessage()    {}
+func (*BlobFeeBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34ef47f2a9b90832, []int{0}
+}
+func (m *BlobFeeBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobFeeBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobFeeBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobFeeBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobFeeBudget.Merge(m, src)
+}
+func (m *BlobFeeBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobFeeBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobFeeBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobFeeBudget proto.InternalMessageInfo
+
+func (m *BlobFeeBudget) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BlobFeeBudget) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *BlobFeeBudget) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *BlobFeeBudget) GetEpochStart() int64 {
+	if m != nil {
+		return m.EpochStart
+	}
+	return 0
+}
+
+func (m *BlobFeeBudget) GetSpent() uint64 {
+	if m != nil {
+		return m.Spent
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BlobFeeBudget)(nil), "celestia.blob.v1.BlobFeeBudget")
+}
+
+func init() { proto.RegisterFile("celestia/blob/v1/budget.proto", fileDescriptor_34ef47f2a9b90832) }
+
+var fileDescriptor_34ef47f2a9b90832 = []byte{
+	// 242 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x14, 0x45, 0x63, 0xda, 0x82, 0x30, 0x20, 0x21, 0x8b, 0xc1, 0x0b, 0x26, 0x30, 0x65, 0x21, 0xa6,
+	0xe2, 0x0f, 0x32, 0x30, 0x20, 0xa6, 0xb0, 0xb1, 0x54, 0x71, 0xf2, 0x94, 0x44, 0x72, 0x6b, 0x2b,
+	0x7e, 0xad, 0xe0, 0x2f, 0x58, 0xf9, 0x23, 0xc6, 0x8c, 0x8c, 0x28, 0xf9, 0x11, 0x14, 0x5b, 0x61,
+	0xf3, 0x39, 0xd7, 0xba, 0xd2, 0xbb, 0xf4, 0xba, 0x04, 0x0d, 0x0e, 0xdb, 0x42, 0x2a, 0x6d, 0x94,
+	0x3c, 0xac, 0xa5, 0xda, 0x57, 0x35, 0x60, 0x6a, 0x3b, 0x83, 0x86, 0x5d, 0xce, 0x71, 0x3a, 0xc5,
+	0xe9, 0x61, 0x7d, 0xf7, 0x45, 0xe8, 0x45, 0xa6, 0x8d, 0x7a, 0x02, 0xc8, 0xfc, 0x4f, 0xc6, 0xe9,
+	0x49, 0x51, 0x55, 0x1d, 0x38, 0xc7, 0x49, 0x4c, 0x92, 0xd3, 0x7c, 0x46, 0x76, 0x45, 0x57, 0xba,
+	0xdd, 0xb6, 0xc8, 0x8f, 0x62, 0x92, 0x2c, 0xf3, 0x00, 0xec, 0x96, 0x9e, 0x83, 0x35, 0x65, 0xb3,
+	0xd1, 0xb0, 0xab, 0xb1, 0xe1, 0x0b, 0x1f, 0x9e, 0x79, 0xf7, 0xe2, 0x15, 0xbb, 0xa1, 0x01, 0x37,
+	0x0e, 0x8b, 0x0e, 0xf9, 0x32, 0x26, 0xc9, 0x22, 0xa7, 0x5e, 0xbd, 0x4e, 0x66, 0x6a, 0x76, 0x16,
+	0x76, 0xc8, 0x57, 0xa1, 0xd9, 0x43, 0xf6, 0xfc, 0x3d, 0x08, 0xd2, 0x0f, 0x82, 0xfc, 0x0e, 0x82,
+	0x7c, 0x8e, 0x22, 0xea, 0x47, 0x11, 0xfd, 0x8c, 0x22, 0x7a, 0x7b, 0xa8, 0x5b, 0x6c, 0xf6, 0x2a,
+	0x2d, 0xcd, 0x56, 0xce, 0x27, 0x99, 0xae, 0xfe, 0x7f, 0xdf, 0x17, 0xd6, 0xca, 0xf7, 0xb0, 0x01,
+	0x7e, 0x58, 0x70, 0xea, 0xd8, 0x0f, 0xf0, 0xf8, 0x37, 0x00, 0x65, 0x1e, 0x4f, 0xc2, 0x21, 0x01,
+	0x00, 0x00,
+}
+
+func (m *BlobFeeBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobFeeBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobFeeBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Spent != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.Spent))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EpochStart != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.EpochStart))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBudget(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBudget(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlobFeeBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovBudget(uint64(m.Limit))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovBudget(uint64(m.EpochLength))
+	}
+	if m.EpochStart != 0 {
+		n += 1 + sovBudget(uint64(m.EpochStart))
+	}
+	if m.Spent != 0 {
+		n += 1 + sovBudget(uint64(m.Spent))
+	}
+	return n
+}
+
+func sovBudget(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBudget(x uint64) (n int) {
+	return sovBudget(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlobFeeBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobFeeBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobFeeBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
+			}
+			m.EpochStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			m.Spent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Spent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBudget(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBudget
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBudget
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBudget
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBudget        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBudget          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBudget = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetBlobFeeBudgetTypeURLParity(t *testing.T) {
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgSetBlobFeeBudget{}), types.URLMsgSetBlobFeeBudget)
+}
+
+func TestMsgSetBlobFeeBudgetValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress("signer").String()
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetBlobFeeBudget
+		wantErr error
+	}{
+		{
+			name: "valid budget",
+			msg:  types.NewMsgSetBlobFeeBudget(signer, 100, 10),
+		},
+		{
+			name: "remove budget",
+			msg:  types.NewMsgSetBlobFeeBudget(signer, 0, 0),
+		},
+		{
+			name:    "invalid signer",
+			msg:     types.NewMsgSetBlobFeeBudget("invalid", 100, 10),
+			wantErr: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:    "zero epoch length",
+			msg:     types.NewMsgSetBlobFeeBudget(signer, 100, 0),
+			wantErr: types.ErrInvalidBlobFeeBudget,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.msg.ValidateBasic(), tc.wantErr)
+		})
+	}
+}
+
+func TestBlobFeeBudgetSpend(t *testing.T) {
+	budget := types.BlobFeeBudget{
+		Address:     sdk.AccAddress("signer").String(),
+		Limit:       100,
+		EpochLength: 10,
+		EpochStart:  5,
+	}
+
+	budget, err := budget.Spend(5, 60)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(60), budget.Spent)
+
+	_, err = budget.Spend(14, 41)
+	assert.ErrorIs(t, err, types.ErrBlobFeeBudgetExceeded)
+
+	budget, err = budget.Spend(14, 40)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), budget.Remaining())
+
+	// the epoch ends at height 15 and epochs stay aligned to the start.
+	budget, err = budget.Spend(27, 30)
+	require.NoError(t, err)
+	assert.Equal(t, int64(25), budget.EpochStart)
+	assert.Equal(t, uint64(30), budget.Spent)
+
+	// a limit lowered below the amount spent leaves nothing to spend.
+	budget.Limit = 20
+	assert.Equal(t, uint64(0), budget.Remaining())
+	_, err = budget.Spend(27, 1)
+	assert.ErrorIs(t, err, types.ErrBlobFeeBudgetExceeded)
+}
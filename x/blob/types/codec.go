@@ -12,11 +12,13 @@ var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPayForBlobs{}, URLMsgPayForBlobs, nil)
+	cdc.RegisterConcrete(&MsgSetBlobFeeBudget{}, URLMsgSetBlobFeeBudget, nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPayForBlobs{},
+		&MsgSetBlobFeeBudget{},
 	)
 
 	registry.RegisterInterface(
@@ -4,37 +4,47 @@ package types
 
 import (
 	"cosmossdk.io/errors"
+	"google.golang.org/grpc/codes"
 )
 
 var (
-	ErrReservedNamespace              = errors.Register(ModuleName, 11110, "cannot use reserved namespace IDs")
-	ErrInvalidNamespaceLen            = errors.Register(ModuleName, 11111, "invalid namespace length")
-	ErrInvalidDataSize                = errors.Register(ModuleName, 11112, "data must be multiple of shareSize")
-	ErrBlobSizeMismatch               = errors.Register(ModuleName, 11113, "actual blob size differs from that specified in the MsgPayForBlob")
-	ErrCommittedSquareSizeNotPowOf2   = errors.Register(ModuleName, 11114, "committed to invalid square size: must be power of two")
-	ErrCalculateCommitment            = errors.Register(ModuleName, 11115, "unexpected error calculating commitment for share")
-	ErrInvalidShareCommitment         = errors.Register(ModuleName, 11116, "invalid commitment for share")
-	ErrParitySharesNamespace          = errors.Register(ModuleName, 11117, "cannot use parity shares namespace ID")
-	ErrTailPaddingNamespace           = errors.Register(ModuleName, 11118, "cannot use tail padding namespace ID")
-	ErrTxNamespace                    = errors.Register(ModuleName, 11119, "cannot use transaction namespace ID")
-	ErrInvalidShareCommitments        = errors.Register(ModuleName, 11122, "invalid share commitments: all relevant square sizes must be committed to")
-	ErrUnsupportedShareVersion        = errors.Register(ModuleName, 11123, "unsupported share version")
-	ErrZeroBlobSize                   = errors.Register(ModuleName, 11124, "cannot use zero blob size")
-	ErrMismatchedNumberOfPFBorBlob    = errors.Register(ModuleName, 11125, "mismatched number of blobs per MsgPayForBlob")
-	ErrNoPFB                          = errors.Register(ModuleName, 11126, "no MsgPayForBlobs found in blob transaction")
-	ErrNamespaceMismatch              = errors.Register(ModuleName, 11127, "namespace of blob and its respective MsgPayForBlobs differ")
-	ErrProtoParsing                   = errors.Register(ModuleName, 11128, "failure to parse a transaction from its protobuf representation")
-	ErrMultipleMsgsInBlobTx           = errors.Register(ModuleName, 11129, "not yet supported: multiple sdk.Msgs found in BlobTx")
-	ErrMismatchedNumberOfPFBComponent = errors.Register(ModuleName, 11130, "number of each component in a MsgPayForBlobs must be identical")
-	ErrNoBlobs                        = errors.Register(ModuleName, 11131, "no blobs provided")
-	ErrNoNamespaces                   = errors.Register(ModuleName, 11132, "no namespaces provided")
-	ErrNoShareVersions                = errors.Register(ModuleName, 11133, "no share versions provided")
-	ErrNoBlobSizes                    = errors.Register(ModuleName, 11134, "no blob sizes provided")
-	ErrNoShareCommitments             = errors.Register(ModuleName, 11135, "no share commitments provided")
-	ErrInvalidNamespace               = errors.Register(ModuleName, 11136, "invalid namespace")
-	ErrInvalidNamespaceVersion        = errors.Register(ModuleName, 11137, "invalid namespace version")
-	// ErrTotalBlobSize is deprecated, use ErrBlobsTooLarge instead.
-	ErrTotalBlobSizeTooLarge = errors.Register(ModuleName, 11138, "total blob size too large")
-	ErrBlobsTooLarge         = errors.Register(ModuleName, 11139, "blob(s) too large")
-	ErrInvalidBlobSigner     = errors.Register(ModuleName, 11140, "invalid blob signer")
+	ErrReservedNamespace              = errors.RegisterWithGRPCCode(ModuleName, 11110, codes.InvalidArgument, "cannot use reserved namespace IDs")
+	ErrInvalidNamespaceLen            = errors.RegisterWithGRPCCode(ModuleName, 11111, codes.InvalidArgument, "invalid namespace length")
+	ErrInvalidDataSize                = errors.RegisterWithGRPCCode(ModuleName, 11112, codes.InvalidArgument, "data must be multiple of shareSize")
+	ErrBlobSizeMismatch               = errors.RegisterWithGRPCCode(ModuleName, 11113, codes.InvalidArgument, "actual blob size differs from that specified in the MsgPayForBlob")
+	ErrCommittedSquareSizeNotPowOf2   = errors.RegisterWithGRPCCode(ModuleName, 11114, codes.InvalidArgument, "committed to invalid square size: must be power of two")
+	ErrCalculateCommitment            = errors.RegisterWithGRPCCode(ModuleName, 11115, codes.Internal, "unexpected error calculating commitment for share")
+	ErrInvalidShareCommitment         = errors.RegisterWithGRPCCode(ModuleName, 11116, codes.InvalidArgument, "invalid commitment for share")
+	ErrParitySharesNamespace          = errors.RegisterWithGRPCCode(ModuleName, 11117, codes.InvalidArgument, "cannot use parity shares namespace ID")
+	ErrTailPaddingNamespace           = errors.RegisterWithGRPCCode(ModuleName, 11118, codes.InvalidArgument, "cannot use tail padding namespace ID")
+	ErrTxNamespace                    = errors.RegisterWithGRPCCode(ModuleName, 11119, codes.InvalidArgument, "cannot use transaction namespace ID")
+	ErrInvalidShareCommitments        = errors.RegisterWithGRPCCode(ModuleName, 11122, codes.InvalidArgument, "invalid share commitments: all relevant square sizes must be committed to")
+	ErrUnsupportedShareVersion        = errors.RegisterWithGRPCCode(ModuleName, 11123, codes.InvalidArgument, "unsupported share version")
+	ErrZeroBlobSize                   = errors.RegisterWithGRPCCode(ModuleName, 11124, codes.InvalidArgument, "cannot use zero blob size")
+	ErrMismatchedNumberOfPFBorBlob    = errors.RegisterWithGRPCCode(ModuleName, 11125, codes.InvalidArgument, "mismatched number of blobs per MsgPayForBlob")
+	ErrNoPFB                          = errors.RegisterWithGRPCCode(ModuleName, 11126, codes.InvalidArgument, "no MsgPayForBlobs found in blob transaction")
+	ErrNamespaceMismatch              = errors.RegisterWithGRPCCode(ModuleName, 11127, codes.InvalidArgument, "namespace of blob and its respective MsgPayForBlobs differ")
+	ErrProtoParsing                   = errors.RegisterWithGRPCCode(ModuleName, 11128, codes.InvalidArgument, "failure to parse a transaction from its protobuf representation")
+	ErrMultipleMsgsInBlobTx           = errors.RegisterWithGRPCCode(ModuleName, 11129, codes.Unimplemented, "not yet supported: multiple sdk.Msgs found in BlobTx")
+	ErrMismatchedNumberOfPFBComponent = errors.RegisterWithGRPCCode(ModuleName, 11130, codes.InvalidArgument, "number of each component in a MsgPayForBlobs must be identical")
+	ErrNoBlobs                        = errors.RegisterWithGRPCCode(ModuleName, 11131, codes.InvalidArgument, "no blobs provided")
+	ErrNoNamespaces                   = errors.RegisterWithGRPCCode(ModuleName, 11132, codes.InvalidArgument, "no namespaces provided")
+	ErrNoShareVersions                = errors.RegisterWithGRPCCode(ModuleName, 11133, codes.InvalidArgument, "no share versions provided")
+	ErrNoBlobSizes                    = errors.RegisterWithGRPCCode(ModuleName, 11134, codes.InvalidArgument, "no blob sizes provided")
+	ErrNoShareCommitments             = errors.RegisterWithGRPCCode(ModuleName, 11135, codes.InvalidArgument, "no share commitments provided")
+	ErrInvalidNamespace               = errors.RegisterWithGRPCCode(ModuleName, 11136, codes.InvalidArgument, "invalid namespace")
+	ErrInvalidNamespaceVersion        = errors.RegisterWithGRPCCode(ModuleName, 11137, codes.InvalidArgument, "invalid namespace version")
+	// ErrTotalBlobSizeTooLarge is returned if the blobs of a PFB exceed the
+	// MaxTotalBlobSizePerPFB param. Use ErrBlobsTooLarge for blobs that don't
+	// fit in a data square.
+	ErrTotalBlobSizeTooLarge  = errors.RegisterWithGRPCCode(ModuleName, 11138, codes.InvalidArgument, "total blob size too large")
+	ErrBlobsTooLarge          = errors.RegisterWithGRPCCode(ModuleName, 11139, codes.InvalidArgument, "blob(s) too large")
+	ErrInvalidBlobSigner      = errors.RegisterWithGRPCCode(ModuleName, 11140, codes.InvalidArgument, "invalid blob signer")
+	ErrTooManyBlobs           = errors.RegisterWithGRPCCode(ModuleName, 11141, codes.InvalidArgument, "too many blobs")
+	ErrInvalidBlobFeeBudget   = errors.RegisterWithGRPCCode(ModuleName, 11142, codes.InvalidArgument, "invalid blob fee budget")
+	ErrBlobFeeBudgetExceeded  = errors.RegisterWithGRPCCode(ModuleName, 11143, codes.ResourceExhausted, "blob fee budget exceeded")
+	ErrInvalidInclusionWindow = errors.RegisterWithGRPCCode(ModuleName, 11144, codes.InvalidArgument, "invalid inclusion window")
+	ErrOutsideInclusionWindow = errors.RegisterWithGRPCCode(ModuleName, 11145, codes.FailedPrecondition, "height outside of inclusion window")
+	ErrSignerNotAllowed       = errors.RegisterWithGRPCCode(ModuleName, 11148, codes.PermissionDenied, "signer not allowed to pay for blobs")
+	ErrFailedPFBCharged       = errors.RegisterWithGRPCCode(ModuleName, 11149, codes.FailedPrecondition, "PFB failed in a block and is charged its fee")
 )
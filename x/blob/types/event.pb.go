@@ -87,28 +87,243 @@ func (m *EventPayForBlobs) GetNamespaces() [][]byte {
 	return nil
 }
 
+// EventBlob is emitted at the end of a block for every blob of the block. It
+// locates the blob in the original data square of the block.
+type EventBlob struct {
+	// tx_hash is the hex encoded hash of the PFB tx of the blob as reported by
+	// Tendermint, i.e. of the tx without its blobs.
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// blob_index is the index of the blob in the PFB.
+	BlobIndex       uint32 `protobuf:"varint,2,opt,name=blob_index,json=blobIndex,proto3" json:"blob_index,omitempty"`
+	Signer          string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	Namespace       []byte `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ShareCommitment []byte `protobuf:"bytes,5,opt,name=share_commitment,json=shareCommitment,proto3" json:"share_commitment,omitempty"`
+	ShareVersion    uint32 `protobuf:"varint,6,opt,name=share_version,json=shareVersion,proto3" json:"share_version,omitempty"`
+	// size is the length of the blob data in bytes.
+	Size_ uint32 `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	// share_start and share_end are the range [share_start, share_end) of the
+	// shares of the original data square that contain the blob.
+	ShareStart uint32 `protobuf:"varint,8,opt,name=share_start,json=shareStart,proto3" json:"share_start,omitempty"`
+	ShareEnd   uint32 `protobuf:"varint,9,opt,name=share_end,json=shareEnd,proto3" json:"share_end,omitempty"`
+}
+
+func (m *EventBlob) Reset()         { *m = EventBlob{} }
+func (m *EventBlob) String() string { return proto.CompactTextString(m) }
+func (*EventBlob) ProtoMessage()    {}
+func (*EventBlob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d90f0a63835a06e, []int{1}
+}
+func (m *EventBlob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlob.Merge(m, src)
+}
+func (m *EventBlob) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlob) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlob proto.InternalMessageInfo
+
+func (m *EventBlob) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EventBlob) GetBlobIndex() uint32 {
+	if m != nil {
+		return m.BlobIndex
+	}
+	return 0
+}
+
+func (m *EventBlob) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *EventBlob) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *EventBlob) GetShareCommitment() []byte {
+	if m != nil {
+		return m.ShareCommitment
+	}
+	return nil
+}
+
+func (m *EventBlob) GetShareVersion() uint32 {
+	if m != nil {
+		return m.ShareVersion
+	}
+	return 0
+}
+
+func (m *EventBlob) GetSize_() uint32 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *EventBlob) GetShareStart() uint32 {
+	if m != nil {
+		return m.ShareStart
+	}
+	return 0
+}
+
+func (m *EventBlob) GetShareEnd() uint32 {
+	if m != nil {
+		return m.ShareEnd
+	}
+	return 0
+}
+
+// EventFailedPayForBlobs is emitted at the end of a block for every PFB of the
+// block that failed the checks of the ante handler. The blobs of the PFB take
+// up space in the data square, so the PFB is charged its fee as though the
+// checks had passed.
+type EventFailedPayForBlobs struct {
+	// tx_hash is the hex encoded hash of the PFB tx as reported by Tendermint.
+	TxHash   string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	FeePayer string `protobuf:"bytes,2,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// fee is the fee that the fee payer was charged. It is less than the fee of
+	// the PFB if the fee payer couldn't afford it.
+	Fee string `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	// codespace and code are the error of the ante handler.
+	Codespace string `protobuf:"bytes,4,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code      uint32 `protobuf:"varint,5,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *EventFailedPayForBlobs) Reset()         { *m = EventFailedPayForBlobs{} }
+func (m *EventFailedPayForBlobs) String() string { return proto.CompactTextString(m) }
+func (*EventFailedPayForBlobs) ProtoMessage()    {}
+func (*EventFailedPayForBlobs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d90f0a63835a06e, []int{2}
+}
+func (m *EventFailedPayForBlobs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFailedPayForBlobs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFailedPayForBlobs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFailedPayForBlobs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFailedPayForBlobs.Merge(m, src)
+}
+func (m *EventFailedPayForBlobs) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFailedPayForBlobs) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFailedPayForBlobs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFailedPayForBlobs proto.InternalMessageInfo
+
+func (m *EventFailedPayForBlobs) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EventFailedPayForBlobs) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *EventFailedPayForBlobs) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *EventFailedPayForBlobs) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *EventFailedPayForBlobs) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventPayForBlobs)(nil), "celestia.blob.v1.EventPayForBlobs")
+	proto.RegisterType((*EventBlob)(nil), "celestia.blob.v1.EventBlob")
+	proto.RegisterType((*EventFailedPayForBlobs)(nil), "celestia.blob.v1.EventFailedPayForBlobs")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/event.proto", fileDescriptor_9d90f0a63835a06e) }
 
 var fileDescriptor_9d90f0a63835a06e = []byte{
-	// 214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b,
-	0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xea, 0x81, 0x64, 0xf5,
-	0xca, 0x0c, 0x95, 0x32, 0xb9, 0x04, 0x5c, 0x41, 0x0a, 0x02, 0x12, 0x2b, 0xdd, 0xf2, 0x8b, 0x9c,
-	0x72, 0xf2, 0x93, 0x8a, 0x85, 0xc4, 0xb8, 0xd8, 0x8a, 0x33, 0xd3, 0xf3, 0x52, 0x8b, 0x24, 0x18,
-	0x15, 0x18, 0x35, 0x38, 0x83, 0xa0, 0x3c, 0x21, 0x59, 0x2e, 0x2e, 0x90, 0xb6, 0xf8, 0xe2, 0xcc,
-	0xaa, 0xd4, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0xde, 0x20, 0x4e, 0x90, 0x48, 0x30, 0x48, 0x40,
-	0x48, 0x8e, 0x8b, 0x2b, 0x2f, 0x31, 0x37, 0xb5, 0xb8, 0x20, 0x31, 0x39, 0xb5, 0x58, 0x82, 0x59,
-	0x81, 0x59, 0x83, 0x27, 0x08, 0x49, 0xc4, 0xc9, 0xeb, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4,
-	0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f,
-	0xe5, 0x18, 0xa2, 0x0c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x61,
-	0x2e, 0xcc, 0x2f, 0x4a, 0x87, 0xb3, 0x75, 0x13, 0x0b, 0x0a, 0xf4, 0x2b, 0x20, 0x3e, 0x2a, 0xa9,
-	0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xfb, 0xc7, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x7b,
-	0x9e, 0x60, 0xef, 0x00, 0x00, 0x00,
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0xb8, 0xa4, 0xd9, 0x21, 0x11, 0xd1, 0x1e, 0xca, 0x4a, 0x2d, 0xc6, 0x0a, 0x97,
+	0x70, 0x20, 0xa6, 0xe2, 0x0d, 0x8a, 0x5a, 0x01, 0xa7, 0xca, 0x95, 0x38, 0x70, 0x89, 0xd6, 0xf6,
+	0x24, 0x5e, 0x29, 0xf6, 0x5a, 0xbb, 0x4b, 0x94, 0xf0, 0x14, 0x88, 0x2b, 0x2f, 0xc4, 0xb1, 0x47,
+	0x8e, 0x28, 0x79, 0x11, 0xb4, 0xe3, 0x26, 0x0d, 0x07, 0x6e, 0x33, 0xdf, 0x3f, 0xab, 0x7f, 0xfc,
+	0x7b, 0xe0, 0x22, 0xc7, 0x25, 0x5a, 0xa7, 0x64, 0x92, 0x2d, 0x75, 0x96, 0xac, 0x2e, 0x13, 0x5c,
+	0x61, 0xed, 0xa6, 0x8d, 0xd1, 0x4e, 0xf3, 0xd1, 0x5e, 0x9d, 0x7a, 0x75, 0xba, 0xba, 0x1c, 0x2b,
+	0x18, 0x5d, 0xfb, 0x81, 0x5b, 0xb9, 0xb9, 0xd1, 0xe6, 0x6a, 0xa9, 0x33, 0xcb, 0xcf, 0xa0, 0x67,
+	0xd5, 0xa2, 0x46, 0x23, 0x82, 0x38, 0x98, 0xb0, 0xf4, 0xa1, 0xe3, 0x2f, 0x00, 0xfc, 0xb3, 0x99,
+	0x55, 0xdf, 0xd0, 0x8a, 0x6e, 0x1c, 0x4e, 0x86, 0x29, 0xf3, 0xe4, 0xce, 0x03, 0x1e, 0x01, 0xd4,
+	0xb2, 0x42, 0xdb, 0xc8, 0x1c, 0xad, 0x08, 0xe3, 0x70, 0x32, 0x48, 0x8f, 0xc8, 0xf8, 0x67, 0x17,
+	0x18, 0x79, 0x79, 0x17, 0xfe, 0x1c, 0x4e, 0xdd, 0x7a, 0x56, 0x4a, 0x5b, 0xee, 0x5d, 0xdc, 0xfa,
+	0x83, 0xb4, 0xe5, 0xc1, 0x45, 0xd5, 0x05, 0xae, 0x45, 0x37, 0x0e, 0xf6, 0x2e, 0x1f, 0x3d, 0x38,
+	0x5a, 0x2e, 0xfc, 0x67, 0xb9, 0x0b, 0x60, 0x07, 0x2f, 0x71, 0x12, 0x07, 0x93, 0x41, 0xfa, 0x08,
+	0xf8, 0x6b, 0x18, 0xd9, 0x52, 0x1a, 0x9c, 0xe5, 0xba, 0xaa, 0x94, 0xab, 0xb0, 0x76, 0xe2, 0x09,
+	0x0d, 0x3d, 0x23, 0xfe, 0xfe, 0x80, 0xf9, 0x2b, 0x18, 0xb6, 0xa3, 0x2b, 0x34, 0x56, 0xe9, 0x5a,
+	0xf4, 0x68, 0x85, 0x01, 0xc1, 0xcf, 0x2d, 0xe3, 0x1c, 0x4e, 0x7c, 0x0a, 0xe2, 0x94, 0x34, 0xaa,
+	0xf9, 0x4b, 0x78, 0xda, 0x3e, 0xb4, 0x4e, 0x1a, 0x27, 0xfa, 0x24, 0x01, 0xa1, 0x3b, 0x4f, 0xf8,
+	0x39, 0xb0, 0x76, 0x00, 0xeb, 0x42, 0x30, 0x92, 0xfb, 0x04, 0xae, 0xeb, 0x62, 0xfc, 0x23, 0x80,
+	0x33, 0x4a, 0xe7, 0x46, 0xaa, 0x25, 0x16, 0xc7, 0xff, 0xe3, 0xbf, 0x51, 0x9d, 0x03, 0x9b, 0x23,
+	0xce, 0x1a, 0xb9, 0x41, 0x43, 0x49, 0xb1, 0xb4, 0x3f, 0x47, 0xbc, 0xf5, 0x3d, 0x1f, 0x41, 0x38,
+	0x47, 0x7c, 0x48, 0xc9, 0x97, 0x3e, 0xa2, 0x5c, 0x17, 0x47, 0x11, 0xb1, 0xf4, 0x11, 0xf8, 0x4f,
+	0xf2, 0x0d, 0xc5, 0x32, 0x4c, 0xa9, 0xbe, 0xfa, 0xf4, 0x6b, 0x1b, 0x05, 0xf7, 0xdb, 0x28, 0xf8,
+	0xb3, 0x8d, 0x82, 0xef, 0xbb, 0xa8, 0x73, 0xbf, 0x8b, 0x3a, 0xbf, 0x77, 0x51, 0xe7, 0xcb, 0xdb,
+	0x85, 0x72, 0xe5, 0xd7, 0x6c, 0x9a, 0xeb, 0x2a, 0xd9, 0x1f, 0x95, 0x36, 0x8b, 0x43, 0xfd, 0x46,
+	0x36, 0x4d, 0xb2, 0x6e, 0x8f, 0xd0, 0x6d, 0x1a, 0xb4, 0x59, 0x8f, 0x4e, 0xf0, 0xdd, 0xdf, 0x01,
+	0x00, 0x06, 0x73, 0xb8, 0xb1, 0xa2, 0x02, 0x00, 0x00,
 }
 
 func (m *EventPayForBlobs) Marshal() (dAtA []byte, err error) {
@@ -168,6 +383,138 @@ func (m *EventPayForBlobs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBlob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShareEnd != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ShareEnd))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ShareStart != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ShareStart))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ShareVersion != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ShareVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ShareCommitment) > 0 {
+		i -= len(m.ShareCommitment)
+		copy(dAtA[i:], m.ShareCommitment)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ShareCommitment)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlobIndex != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlobIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFailedPayForBlobs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFailedPayForBlobs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFailedPayForBlobs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -205,6 +552,74 @@ func (m *EventPayForBlobs) Size() (n int) {
 	return n
 }
 
+func (m *EventBlob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.BlobIndex != 0 {
+		n += 1 + sovEvent(uint64(m.BlobIndex))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ShareCommitment)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ShareVersion != 0 {
+		n += 1 + sovEvent(uint64(m.ShareVersion))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovEvent(uint64(m.Size_))
+	}
+	if m.ShareStart != 0 {
+		n += 1 + sovEvent(uint64(m.ShareStart))
+	}
+	if m.ShareEnd != 0 {
+		n += 1 + sovEvent(uint64(m.ShareEnd))
+	}
+	return n
+}
+
+func (m *EventFailedPayForBlobs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovEvent(uint64(m.Code))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -401,6 +816,480 @@ func (m *EventPayForBlobs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBlob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBlob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBlob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobIndex", wireType)
+			}
+			m.BlobIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareCommitment = append(m.ShareCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ShareCommitment == nil {
+				m.ShareCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersion", wireType)
+			}
+			m.ShareVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareStart", wireType)
+			}
+			m.ShareStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareStart |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareEnd", wireType)
+			}
+			m.ShareEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareEnd |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFailedPayForBlobs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFailedPayForBlobs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFailedPayForBlobs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
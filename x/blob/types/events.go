@@ -14,3 +14,12 @@ func NewPayForBlobsEvent(signer string, blobSizes []uint32, namespaces [][]byte)
 		Namespaces: namespaces,
 	}
 }
+
+// EventTypeBlob is the type of the ABCI events of EventBlob. It is the name of
+// the message, which isn't registered yet when package variables are
+// initialized.
+const EventTypeBlob = "celestia.blob.v1.EventBlob"
+
+// EventTypeFailedPayForBlobs is the type of the ABCI events of
+// EventFailedPayForBlobs.
+const EventTypeFailedPayForBlobs = "celestia.blob.v1.EventFailedPayForBlobs"
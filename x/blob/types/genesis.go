@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
@@ -13,5 +15,18 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(gs.BlobFeeBudgets))
+	for _, budget := range gs.BlobFeeBudgets {
+		if err := budget.Validate(); err != nil {
+			return err
+		}
+		if seen[budget.Address] {
+			return fmt.Errorf("duplicate blob fee budget of %s", budget.Address)
+		}
+		seen[budget.Address] = true
+	}
+	return nil
 }
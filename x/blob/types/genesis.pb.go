@@ -25,7 +25,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the capability module's genesis state.
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params         Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	BlobFeeBudgets []BlobFeeBudget `protobuf:"bytes,2,rep,name=blob_fee_budgets,json=blobFeeBudgets,proto3" json:"blob_fee_budgets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +69,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetBlobFeeBudgets() []BlobFeeBudget {
+	if m != nil {
+		return m.BlobFeeBudgets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "celestia.blob.v1.GenesisState")
 }
@@ -75,20 +83,23 @@ func init() {
 func init() { proto.RegisterFile("celestia/blob/v1/genesis.proto", fileDescriptor_c0b3a6e29bb6777c) }
 
 var fileDescriptor_c0b3a6e29bb6777c = []byte{
-	// 198 bytes of a gzipped FileDescriptorProto
+	// 247 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4e, 0xcd, 0x49,
 	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
 	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xeb, 0x81,
 	0xe4, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44,
-	0x9d, 0x94, 0x2c, 0x86, 0x39, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x63, 0x94, 0xdc, 0xb8, 0x78,
-	0xdc, 0x21, 0xe6, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x99, 0x71, 0xb1, 0x41, 0xe4, 0x25, 0x18,
-	0x15, 0x18, 0x35, 0xb8, 0x8d, 0x24, 0xf4, 0xd0, 0xed, 0xd1, 0x0b, 0x00, 0xcb, 0x3b, 0xb1, 0x9c,
-	0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55, 0xed, 0xe4, 0x75, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0x06, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x30,
-	0xb3, 0xf2, 0x8b, 0xd2, 0xe1, 0x6c, 0xdd, 0xc4, 0x82, 0x02, 0xfd, 0x0a, 0x88, 0xeb, 0x4a, 0x2a,
-	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x4e, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x63,
-	0x14, 0xe2, 0x03, 0x01, 0x00, 0x00,
+	0x9d, 0x94, 0x2c, 0x86, 0x39, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x38, 0xa5, 0x93, 0x4a, 0x53,
+	0xd2, 0x53, 0x4b, 0x20, 0xd2, 0x4a, 0xd3, 0x19, 0xb9, 0x78, 0xdc, 0x21, 0xf6, 0x06, 0x97, 0x24,
+	0x96, 0xa4, 0x0a, 0x99, 0x71, 0xb1, 0x41, 0xf4, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x49,
+	0xe8, 0xa1, 0xbb, 0x43, 0x2f, 0x00, 0x2c, 0xef, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54,
+	0xb5, 0x90, 0x3f, 0x97, 0x00, 0x48, 0x3e, 0x3e, 0x2d, 0x35, 0x35, 0x1e, 0x62, 0x43, 0xb1, 0x04,
+	0x93, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x3c, 0xa6, 0x09, 0x4e, 0x39, 0xf9, 0x49, 0x6e, 0xa9, 0xa9,
+	0x4e, 0x60, 0x75, 0x50, 0x83, 0xf8, 0x92, 0x90, 0x05, 0x8b, 0x9d, 0xbc, 0x4e, 0x3c, 0x92, 0x63,
+	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
+	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x20, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39,
+	0x3f, 0x57, 0x1f, 0x66, 0x74, 0x7e, 0x51, 0x3a, 0x9c, 0xad, 0x9b, 0x58, 0x50, 0xa0, 0x5f, 0x01,
+	0xf1, 0x6f, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xb3, 0xc6, 0x80, 0x01, 0x00, 0xfe,
+	0x38, 0xe8, 0x03, 0x74, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +122,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlobFeeBudgets) > 0 {
+		for iNdEx := len(m.BlobFeeBudgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlobFeeBudgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -143,6 +168,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BlobFeeBudgets) > 0 {
+		for _, e := range m.BlobFeeBudgets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -214,6 +245,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobFeeBudgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlobFeeBudgets = append(m.BlobFeeBudgets, BlobFeeBudget{})
+			if err := m.BlobFeeBudgets[len(m.BlobFeeBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,163 @@
+package types
+
+import (
+	"errors"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the google.rpc.ErrorInfo that is attached to
+// the gRPC status of errors.
+const ErrorDomain = "celestia.org"
+
+// Metadata keys of the google.rpc.ErrorInfo of errors. Every ErrorInfo has
+// MetadataCodespace and MetadataCode, the other keys depend on the error.
+const (
+	MetadataCodespace        = "codespace"
+	MetadataCode             = "code"
+	MetadataNamespace        = "namespace"
+	MetadataBlobCount        = "blob_count"
+	MetadataMaxBlobs         = "max_blobs"
+	MetadataTotalBlobSize    = "total_blob_size"
+	MetadataMaxTotalBlobSize = "max_total_blob_size"
+	MetadataSharesNeeded     = "shares_needed"
+	MetadataMaxBlobShares    = "max_blob_shares"
+	MetadataGasWanted        = "gas_wanted"
+	MetadataRequiredGas      = "required_gas"
+	MetadataFee              = "fee"
+	MetadataBudgetRemaining  = "budget_remaining"
+	MetadataBudgetLimit      = "budget_limit"
+	MetadataBudgetEpochEnd   = "budget_epoch_end"
+	MetadataHeight           = "height"
+	MetadataNotBeforeHeight  = "not_before_height"
+	MetadataNotAfterHeight   = "not_after_height"
+)
+
+// errorReasons are the reasons of the google.rpc.ErrorInfo of the errors of
+// this module. Errors of other modules use their ABCI code as reason.
+var errorReasons = map[*errorsmod.Error]string{
+	ErrReservedNamespace:              "RESERVED_NAMESPACE",
+	ErrInvalidNamespaceLen:            "INVALID_NAMESPACE_LENGTH",
+	ErrInvalidDataSize:                "INVALID_DATA_SIZE",
+	ErrBlobSizeMismatch:               "BLOB_SIZE_MISMATCH",
+	ErrCommittedSquareSizeNotPowOf2:   "SQUARE_SIZE_NOT_POWER_OF_TWO",
+	ErrCalculateCommitment:            "CALCULATE_COMMITMENT",
+	ErrInvalidShareCommitment:         "INVALID_SHARE_COMMITMENT",
+	ErrParitySharesNamespace:          "PARITY_SHARES_NAMESPACE",
+	ErrTailPaddingNamespace:           "TAIL_PADDING_NAMESPACE",
+	ErrTxNamespace:                    "TX_NAMESPACE",
+	ErrInvalidShareCommitments:        "INVALID_SHARE_COMMITMENTS",
+	ErrUnsupportedShareVersion:        "UNSUPPORTED_SHARE_VERSION",
+	ErrZeroBlobSize:                   "ZERO_BLOB_SIZE",
+	ErrMismatchedNumberOfPFBorBlob:    "MISMATCHED_NUMBER_OF_PFB_OR_BLOB",
+	ErrNoPFB:                          "NO_PFB",
+	ErrNamespaceMismatch:              "NAMESPACE_MISMATCH",
+	ErrProtoParsing:                   "PROTO_PARSING",
+	ErrMultipleMsgsInBlobTx:           "MULTIPLE_MSGS_IN_BLOB_TX",
+	ErrMismatchedNumberOfPFBComponent: "MISMATCHED_NUMBER_OF_PFB_COMPONENT",
+	ErrNoBlobs:                        "NO_BLOBS",
+	ErrNoNamespaces:                   "NO_NAMESPACES",
+	ErrNoShareVersions:                "NO_SHARE_VERSIONS",
+	ErrNoBlobSizes:                    "NO_BLOB_SIZES",
+	ErrNoShareCommitments:             "NO_SHARE_COMMITMENTS",
+	ErrInvalidNamespace:               "INVALID_NAMESPACE",
+	ErrInvalidNamespaceVersion:        "INVALID_NAMESPACE_VERSION",
+	ErrTotalBlobSizeTooLarge:          "TOTAL_BLOB_SIZE_TOO_LARGE",
+	ErrBlobsTooLarge:                  "BLOBS_TOO_LARGE",
+	ErrInvalidBlobSigner:              "INVALID_BLOB_SIGNER",
+	ErrTooManyBlobs:                   "TOO_MANY_BLOBS",
+	ErrInvalidBlobFeeBudget:           "INVALID_BLOB_FEE_BUDGET",
+	ErrBlobFeeBudgetExceeded:          "BLOB_FEE_BUDGET_EXCEEDED",
+	ErrInvalidInclusionWindow:         "INVALID_INCLUSION_WINDOW",
+	ErrOutsideInclusionWindow:         "OUTSIDE_INCLUSION_WINDOW",
+	ErrSignerNotAllowed:               "SIGNER_NOT_ALLOWED",
+	ErrFailedPFBCharged:               "FAILED_PFB_CHARGED",
+}
+
+// detailedError attaches metadata to an error without changing its message
+// or its ABCI code.
+type detailedError struct {
+	err      error
+	metadata map[string]string
+}
+
+// WithDetails attaches metadata to err that is returned in the
+// google.rpc.ErrorInfo of its gRPC status so that clients don't have to parse
+// the error message to find e.g. the limit that a tx exceeded. The message
+// and the ABCI code of err are unchanged.
+func WithDetails(err error, metadata map[string]string) error {
+	if err == nil {
+		return nil
+	}
+	return &detailedError{err: err, metadata: metadata}
+}
+
+func (e *detailedError) Error() string {
+	return e.err.Error()
+}
+
+// Cause is used by cosmossdk.io/errors to find the ABCI code of the error.
+func (e *detailedError) Cause() error {
+	return e.err
+}
+
+func (e *detailedError) Unwrap() error {
+	return e.err
+}
+
+// GRPCStatus is used by grpc to convert the error to a status.
+func (e *detailedError) GRPCStatus() *status.Status {
+	st, _ := GRPCStatus(e)
+	return st
+}
+
+// GRPCStatus returns the gRPC status of err. The code of the status is the
+// gRPC code that the registered error of err was registered with and its
+// details are a google.rpc.ErrorInfo with the codespace, the ABCI code and
+// the metadata attached to err with WithDetails. It returns false if err
+// isn't a registered error.
+func GRPCStatus(err error) (*status.Status, bool) {
+	var registered *errorsmod.Error
+	if !errors.As(err, &registered) {
+		return nil, false
+	}
+
+	info := &errdetails.ErrorInfo{
+		Reason: errorReasons[registered],
+		Domain: ErrorDomain,
+		Metadata: map[string]string{
+			MetadataCodespace: registered.Codespace(),
+			MetadataCode:      strconv.FormatUint(uint64(registered.ABCICode()), 10),
+		},
+	}
+	if info.Reason == "" {
+		info.Reason = info.Metadata[MetadataCode]
+	}
+	var detailed *detailedError
+	if errors.As(err, &detailed) {
+		for k, v := range detailed.metadata {
+			info.Metadata[k] = v
+		}
+	}
+
+	st, detailsErr := status.New(registered.GRPCStatus().Code(), err.Error()).WithDetails(info)
+	if detailsErr != nil {
+		return status.New(codes.Internal, detailsErr.Error()), true
+	}
+	return st, true
+}
+
+// ErrorInfoFromStatus returns the google.rpc.ErrorInfo of a status returned
+// by GRPCStatus or nil if it has none.
+func ErrorInfoFromStatus(st *status.Status) *errdetails.ErrorInfo {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == ErrorDomain {
+			return info
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWithDetails(t *testing.T) {
+	wrapped := errors.Wrapf(types.ErrTooManyBlobs, "%d blobs exceed max %d per PFB", 3, 2)
+	err := types.WithDetails(wrapped, map[string]string{types.MetadataBlobCount: "3", types.MetadataMaxBlobs: "2"})
+
+	// the message and the ABCI code are unchanged
+	assert.Equal(t, wrapped.Error(), err.Error())
+	assert.ErrorIs(t, err, types.ErrTooManyBlobs)
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+	assert.Equal(t, types.ModuleName, codespace)
+	assert.Equal(t, types.ErrTooManyBlobs.ABCICode(), code)
+
+	// grpc finds the status of the error even if it is wrapped
+	st, ok := status.FromError(fmt.Errorf("checking tx: %w", err))
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	info := types.ErrorInfoFromStatus(st)
+	require.NotNil(t, info)
+	assert.Equal(t, "TOO_MANY_BLOBS", info.Reason)
+	assert.Equal(t, types.ErrorDomain, info.Domain)
+	assert.Equal(t, map[string]string{
+		types.MetadataCodespace: types.ModuleName,
+		types.MetadataCode:      "11141",
+		types.MetadataBlobCount: "3",
+		types.MetadataMaxBlobs:  "2",
+	}, info.Metadata)
+
+	assert.NoError(t, types.WithDetails(nil, nil))
+}
+
+func TestGRPCStatus(t *testing.T) {
+	t.Run("blob error without details", func(t *testing.T) {
+		st, ok := types.GRPCStatus(types.ErrNoBlobs)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, types.ErrNoBlobs.Error(), st.Message())
+		info := types.ErrorInfoFromStatus(st)
+		require.NotNil(t, info)
+		assert.Equal(t, "NO_BLOBS", info.Reason)
+	})
+	t.Run("error of another module", func(t *testing.T) {
+		err := types.WithDetails(sdkerrors.ErrInsufficientFee.Wrap("not enough gas"), map[string]string{types.MetadataRequiredGas: "10"})
+		st, ok := types.GRPCStatus(err)
+		require.True(t, ok)
+		info := types.ErrorInfoFromStatus(st)
+		require.NotNil(t, info)
+		assert.Equal(t, "13", info.Reason)
+		assert.Equal(t, "sdk", info.Metadata[types.MetadataCodespace])
+		assert.Equal(t, "10", info.Metadata[types.MetadataRequiredGas])
+	})
+	t.Run("unregistered error", func(t *testing.T) {
+		_, ok := types.GRPCStatus(fmt.Errorf("unregistered"))
+		assert.False(t, ok)
+	})
+	t.Run("budget exceeded", func(t *testing.T) {
+		budget := types.BlobFeeBudget{Limit: 10, EpochLength: 5, EpochStart: 1, Spent: 4}
+		_, err := budget.Spend(2, 7)
+		st, ok := types.GRPCStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.ResourceExhausted, st.Code())
+		info := types.ErrorInfoFromStatus(st)
+		require.NotNil(t, info)
+		assert.Equal(t, "7", info.Metadata[types.MetadataFee])
+		assert.Equal(t, "6", info.Metadata[types.MetadataBudgetRemaining])
+		assert.Equal(t, "10", info.Metadata[types.MetadataBudgetLimit])
+		assert.Equal(t, "6", info.Metadata[types.MetadataBudgetEpochEnd])
+	})
+}
@@ -17,6 +17,14 @@ const (
 	MemStoreKey = "mem_blob"
 )
 
+// BlobFeeBudgetKeyPrefix is the prefix of the keys of the blob fee budgets.
+var BlobFeeBudgetKeyPrefix = []byte{0x01}
+
+// BlobFeeBudgetKey returns the key of the blob fee budget of addr.
+func BlobFeeBudgetKey(addr []byte) []byte {
+	return append(append([]byte{}, BlobFeeBudgetKeyPrefix...), addr...)
+}
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
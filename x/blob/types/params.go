@@ -5,6 +5,7 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/go-square/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
@@ -12,10 +13,18 @@ import (
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 var (
-	KeyGasPerBlobByte              = []byte("GasPerBlobByte")
-	DefaultGasPerBlobByte   uint32 = appconsts.DefaultGasPerBlobByte
-	KeyGovMaxSquareSize            = []byte("GovMaxSquareSize")
-	DefaultGovMaxSquareSize uint64 = appconsts.DefaultGovMaxSquareSize
+	KeyGasPerBlobByte                    = []byte("GasPerBlobByte")
+	DefaultGasPerBlobByte         uint32 = appconsts.DefaultGasPerBlobByte
+	KeyGovMaxSquareSize                  = []byte("GovMaxSquareSize")
+	DefaultGovMaxSquareSize       uint64 = appconsts.DefaultGovMaxSquareSize
+	KeyMaxBlobsPerPFB                    = []byte("MaxBlobsPerPFB")
+	DefaultMaxBlobsPerPFB         uint32 = 0
+	KeyMaxPFBsPerBlock                   = []byte("MaxPFBsPerBlock")
+	DefaultMaxPFBsPerBlock        uint32 = 0
+	KeyAllowedSigners                    = []byte("AllowedSigners")
+	DefaultAllowedSigners                = []string{}
+	KeyMaxTotalBlobSizePerPFB            = []byte("MaxTotalBlobSizePerPFB")
+	DefaultMaxTotalBlobSizePerPFB uint32 = 0
 )
 
 // ParamKeyTable returns the param key table for the blob module
@@ -24,16 +33,20 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(gasPerBlobByte uint32, govMaxSquareSize uint64) Params {
+func NewParams(gasPerBlobByte uint32, govMaxSquareSize uint64, maxBlobsPerPFB, maxPFBsPerBlock uint32, allowedSigners []string, maxTotalBlobSizePerPFB uint32) Params {
 	return Params{
-		GasPerBlobByte:   gasPerBlobByte,
-		GovMaxSquareSize: govMaxSquareSize,
+		GasPerBlobByte:         gasPerBlobByte,
+		GovMaxSquareSize:       govMaxSquareSize,
+		MaxBlobsPerPfb:         maxBlobsPerPFB,
+		MaxPfbsPerBlock:        maxPFBsPerBlock,
+		AllowedSigners:         allowedSigners,
+		MaxTotalBlobSizePerPfb: maxTotalBlobSizePerPFB,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultGasPerBlobByte, appconsts.DefaultGovMaxSquareSize)
+	return NewParams(DefaultGasPerBlobByte, appconsts.DefaultGovMaxSquareSize, DefaultMaxBlobsPerPFB, DefaultMaxPFBsPerBlock, DefaultAllowedSigners, DefaultMaxTotalBlobSizePerPFB)
 }
 
 // ParamSetPairs gets the list of param key-value pairs
@@ -41,6 +54,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyGasPerBlobByte, &p.GasPerBlobByte, validateGasPerBlobByte),
 		paramtypes.NewParamSetPair(KeyGovMaxSquareSize, &p.GovMaxSquareSize, validateGovMaxSquareSize),
+		paramtypes.NewParamSetPair(KeyMaxBlobsPerPFB, &p.MaxBlobsPerPfb, validateUint32),
+		paramtypes.NewParamSetPair(KeyMaxPFBsPerBlock, &p.MaxPfbsPerBlock, validateUint32),
+		paramtypes.NewParamSetPair(KeyAllowedSigners, &p.AllowedSigners, validateAllowedSigners),
+		paramtypes.NewParamSetPair(KeyMaxTotalBlobSizePerPFB, &p.MaxTotalBlobSizePerPfb, validateUint32),
 	}
 }
 
@@ -50,7 +67,19 @@ func (p Params) Validate() error {
 	if err != nil {
 		return err
 	}
-	return validateGovMaxSquareSize(p.GovMaxSquareSize)
+	if err := validateGovMaxSquareSize(p.GovMaxSquareSize); err != nil {
+		return err
+	}
+	if err := validateUint32(p.MaxBlobsPerPfb); err != nil {
+		return err
+	}
+	if err := validateUint32(p.MaxPfbsPerBlock); err != nil {
+		return err
+	}
+	if err := validateAllowedSigners(p.AllowedSigners); err != nil {
+		return err
+	}
+	return validateUint32(p.MaxTotalBlobSizePerPfb)
 }
 
 // String implements the Stringer interface.
@@ -93,3 +122,32 @@ func validateGovMaxSquareSize(v interface{}) error {
 
 	return nil
 }
+
+// validateUint32 validates the MaxBlobsPerPFB, MaxPFBsPerBlock and
+// MaxTotalBlobSizePerPFB params. Any value is valid, 0 disables the limit.
+func validateUint32(v interface{}) error {
+	if _, ok := v.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateAllowedSigners validates the AllowedSigners param. The signers must
+// be distinct valid addresses.
+func validateAllowedSigners(v interface{}) error {
+	signers, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	seen := make(map[string]bool, len(signers))
+	for _, signer := range signers {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return fmt.Errorf("invalid allowed signer %q: %w", signer, err)
+		}
+		if seen[signer] {
+			return fmt.Errorf("duplicate allowed signer %s", signer)
+		}
+		seen[signer] = true
+	}
+	return nil
+}
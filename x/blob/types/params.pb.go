@@ -27,6 +27,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	GasPerBlobByte   uint32 `protobuf:"varint,1,opt,name=gas_per_blob_byte,json=gasPerBlobByte,proto3" json:"gas_per_blob_byte,omitempty" yaml:"gas_per_blob_byte"`
 	GovMaxSquareSize uint64 `protobuf:"varint,2,opt,name=gov_max_square_size,json=govMaxSquareSize,proto3" json:"gov_max_square_size,omitempty" yaml:"gov_max_square_size"`
+	// max_blobs_per_pfb is the maximum number of blobs that a MsgPayForBlobs
+	// can pay for. 0 means no limit.
+	MaxBlobsPerPfb uint32 `protobuf:"varint,3,opt,name=max_blobs_per_pfb,json=maxBlobsPerPfb,proto3" json:"max_blobs_per_pfb,omitempty" yaml:"max_blobs_per_pfb"`
+	// max_pfbs_per_block is the maximum number of MsgPayForBlobs that a block
+	// can contain. 0 means no limit other than the soft limit applied when
+	// preparing proposals.
+	MaxPfbsPerBlock uint32 `protobuf:"varint,4,opt,name=max_pfbs_per_block,json=maxPfbsPerBlock,proto3" json:"max_pfbs_per_block,omitempty" yaml:"max_pfbs_per_block"`
+	// allowed_signers are the bech32 encoded addresses that can sign
+	// MsgPayForBlobs. Any address can sign them if it is empty. It is meant to
+	// be set in the genesis of private networks.
+	AllowedSigners []string `protobuf:"bytes,5,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty" yaml:"allowed_signers"`
+	// max_total_blob_size_per_pfb is the maximum sum of the sizes of the blobs
+	// that a MsgPayForBlobs can pay for in bytes. 0 means no limit other than
+	// the size of the data square.
+	MaxTotalBlobSizePerPfb uint32 `protobuf:"varint,6,opt,name=max_total_blob_size_per_pfb,json=maxTotalBlobSizePerPfb,proto3" json:"max_total_blob_size_per_pfb,omitempty" yaml:"max_total_blob_size_per_pfb"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -75,6 +90,34 @@ func (m *Params) GetGovMaxSquareSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxBlobsPerPfb() uint32 {
+	if m != nil {
+		return m.MaxBlobsPerPfb
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPfbsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxPfbsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTotalBlobSizePerPfb() uint32 {
+	if m != nil {
+		return m.MaxTotalBlobSizePerPfb
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "celestia.blob.v1.Params")
 }
@@ -82,24 +125,33 @@ func init() {
 func init() { proto.RegisterFile("celestia/blob/v1/params.proto", fileDescriptor_2145b82d3e5371c6) }
 
 var fileDescriptor_2145b82d3e5371c6 = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x49, 0xeb, 0x81, 0xa4,
-	0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d,
-	0xd2, 0x32, 0x46, 0x2e, 0xb6, 0x00, 0xb0, 0x46, 0x21, 0x77, 0x2e, 0xc1, 0xf4, 0xc4, 0xe2, 0xf8,
-	0x82, 0xd4, 0xa2, 0x78, 0x90, 0x9e, 0xf8, 0xa4, 0xca, 0x92, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x5e, 0x27, 0x99, 0x4f, 0xf7, 0xe4, 0x25, 0x2a, 0x13, 0x73, 0x73, 0xac, 0x94, 0x30, 0x94, 0x28,
-	0x05, 0xf1, 0xa5, 0x27, 0x16, 0x07, 0xa4, 0x16, 0x39, 0xe5, 0xe4, 0x27, 0x39, 0x55, 0x96, 0xa4,
-	0x0a, 0xf9, 0x72, 0x09, 0xa7, 0xe7, 0x97, 0xc5, 0xe7, 0x26, 0x56, 0xc4, 0x17, 0x17, 0x96, 0x26,
-	0x16, 0xa5, 0xc6, 0x17, 0x67, 0x56, 0xa5, 0x4a, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x38, 0xc9, 0x7d,
-	0xba, 0x27, 0x2f, 0x05, 0x35, 0x0a, 0x53, 0x91, 0x52, 0x90, 0x40, 0x7a, 0x7e, 0x99, 0x6f, 0x62,
-	0x45, 0x30, 0x58, 0x2c, 0x38, 0xb3, 0x2a, 0xd5, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06, 0x27, 0xaf,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xf9, 0x3a, 0xbf, 0x28, 0x1d, 0xce, 0xd6, 0x4d, 0x2c,
-	0x28, 0xd0, 0xaf, 0x80, 0x04, 0x53, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xef, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x2d, 0x7d, 0x23, 0x44, 0x01, 0x00, 0x00,
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0xce, 0x93, 0x40,
+	0x14, 0xc5, 0xc1, 0xd6, 0x26, 0x92, 0xd8, 0xaf, 0xa2, 0x69, 0xb0, 0x5a, 0x68, 0x66, 0x61, 0xba,
+	0x11, 0x6c, 0xdc, 0x75, 0x89, 0x0b, 0x93, 0x26, 0x4d, 0x08, 0x75, 0xe5, 0x86, 0xcc, 0xe0, 0x30,
+	0x92, 0x82, 0x33, 0x32, 0x53, 0x84, 0x3e, 0x85, 0x4b, 0x97, 0x3e, 0x8e, 0xcb, 0x2e, 0x5d, 0x11,
+	0xd3, 0xc6, 0x17, 0xe0, 0x09, 0xcc, 0x40, 0xff, 0xc4, 0x36, 0xdf, 0x6e, 0x72, 0xef, 0x6f, 0xce,
+	0x3d, 0xe7, 0xe6, 0x6a, 0xe3, 0x10, 0x27, 0x98, 0x8b, 0x18, 0x3a, 0x28, 0xa1, 0xc8, 0xc9, 0x67,
+	0x0e, 0x83, 0x19, 0x4c, 0xb9, 0xcd, 0x32, 0x2a, 0xa8, 0x3e, 0x38, 0xb5, 0x6d, 0xd9, 0xb6, 0xf3,
+	0xd9, 0xe8, 0x19, 0xa1, 0x84, 0x36, 0x4d, 0x47, 0xbe, 0x5a, 0x0e, 0xfc, 0xed, 0x68, 0x3d, 0xaf,
+	0xf9, 0xa8, 0xbf, 0xd7, 0x9e, 0x10, 0xc8, 0x03, 0x86, 0xb3, 0x40, 0xfe, 0x09, 0x50, 0x29, 0xb0,
+	0xa1, 0x4e, 0xd4, 0xe9, 0x63, 0xf7, 0x65, 0x5d, 0x59, 0x46, 0x09, 0xd3, 0x64, 0x0e, 0x6e, 0x10,
+	0xe0, 0xf7, 0x09, 0xe4, 0x1e, 0xce, 0xdc, 0x84, 0x22, 0xb7, 0x14, 0x58, 0x5f, 0x6a, 0x4f, 0x09,
+	0xcd, 0x83, 0x14, 0x16, 0x01, 0xff, 0xba, 0x81, 0x19, 0x0e, 0x78, 0xbc, 0xc5, 0xc6, 0x83, 0x89,
+	0x3a, 0xed, 0xba, 0x66, 0x5d, 0x59, 0xa3, 0xa3, 0xd4, 0x2d, 0x04, 0xfc, 0x01, 0xa1, 0xf9, 0x12,
+	0x16, 0xab, 0xa6, 0xb6, 0x8a, 0xb7, 0x58, 0xfa, 0x92, 0x94, 0x1c, 0xd8, 0x8e, 0x66, 0x11, 0x32,
+	0x3a, 0xd7, 0xbe, 0x6e, 0x10, 0xe0, 0xf7, 0x53, 0x58, 0x48, 0x53, 0xd2, 0x9c, 0x17, 0x21, 0x7d,
+	0xa1, 0xe9, 0x92, 0x62, 0x11, 0x3a, 0x47, 0x08, 0xd7, 0x46, 0xb7, 0x51, 0x1a, 0xd7, 0x95, 0xf5,
+	0xfc, 0xa2, 0xf4, 0x3f, 0x03, 0xfc, 0xbb, 0x14, 0x16, 0x5e, 0x84, 0x8e, 0x31, 0xc3, 0xb5, 0xfe,
+	0x4e, 0xbb, 0x83, 0x49, 0x42, 0xbf, 0xe1, 0x4f, 0x01, 0x8f, 0xc9, 0x17, 0x9c, 0x71, 0xe3, 0xe1,
+	0xa4, 0x33, 0x7d, 0xe4, 0x8e, 0xea, 0xca, 0x1a, 0xb6, 0x42, 0x57, 0x00, 0xf0, 0xfb, 0xc7, 0xca,
+	0xaa, 0x2d, 0xe8, 0xa1, 0xf6, 0x42, 0x0e, 0x13, 0x54, 0xc0, 0xa4, 0x5d, 0xa8, 0xdc, 0xc1, 0x39,
+	0x63, 0xaf, 0x71, 0xf6, 0xaa, 0xae, 0x2c, 0x70, 0x71, 0x76, 0x0f, 0x0c, 0xfc, 0x61, 0x0a, 0x8b,
+	0x0f, 0xb2, 0x29, 0x23, 0xcb, 0xc5, 0xb5, 0xa9, 0xe7, 0xdd, 0x1f, 0x3f, 0x2d, 0xc5, 0x5d, 0xfc,
+	0xda, 0x9b, 0xea, 0x6e, 0x6f, 0xaa, 0x7f, 0xf6, 0xa6, 0xfa, 0xfd, 0x60, 0x2a, 0xbb, 0x83, 0xa9,
+	0xfc, 0x3e, 0x98, 0xca, 0xc7, 0x37, 0x24, 0x16, 0x9f, 0x37, 0xc8, 0x0e, 0x69, 0xea, 0x9c, 0x8e,
+	0x86, 0x66, 0xe4, 0xfc, 0x7e, 0x0d, 0x19, 0x73, 0x8a, 0xf6, 0xca, 0x44, 0xc9, 0x30, 0x47, 0xbd,
+	0xe6, 0x74, 0xde, 0xfe, 0x1b, 0x00, 0xfc, 0xde, 0xde, 0xa2, 0x83, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +174,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxTotalBlobSizePerPfb != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTotalBlobSizePerPfb))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxPfbsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPfbsPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxBlobsPerPfb != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlobsPerPfb))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.GovMaxSquareSize != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.GovMaxSquareSize))
 		i--
@@ -158,6 +234,21 @@ func (m *Params) Size() (n int) {
 	if m.GovMaxSquareSize != 0 {
 		n += 1 + sovParams(uint64(m.GovMaxSquareSize))
 	}
+	if m.MaxBlobsPerPfb != 0 {
+		n += 1 + sovParams(uint64(m.MaxBlobsPerPfb))
+	}
+	if m.MaxPfbsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxPfbsPerBlock))
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxTotalBlobSizePerPfb != 0 {
+		n += 1 + sovParams(uint64(m.MaxTotalBlobSizePerPfb))
+	}
 	return n
 }
 
@@ -234,6 +325,95 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlobsPerPfb", wireType)
+			}
+			m.MaxBlobsPerPfb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlobsPerPfb |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPfbsPerBlock", wireType)
+			}
+			m.MaxPfbsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPfbsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalBlobSizePerPfb", wireType)
+			}
+			m.MaxTotalBlobSizePerPfb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalBlobSizePerPfb |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
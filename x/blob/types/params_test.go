@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -42,3 +43,14 @@ func Test_validateGovMaxSquareSize(t *testing.T) {
 		}
 	}
 }
+
+func Test_validateAllowedSigners(t *testing.T) {
+	signer := sdk.AccAddress("signer").String()
+	other := sdk.AccAddress("other").String()
+
+	assert.NoError(t, validateAllowedSigners([]string{}))
+	assert.NoError(t, validateAllowedSigners([]string{signer, other}))
+	assert.Error(t, validateAllowedSigners([]string{signer, signer}))
+	assert.Error(t, validateAllowedSigners([]string{"invalid"}))
+	assert.Error(t, validateAllowedSigners(signer))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"encoding/hex"
 	fmt "fmt"
 
 	"cosmossdk.io/errors"
@@ -121,11 +122,11 @@ func (msg *MsgPayForBlobs) ValidateBasic() error {
 	for _, namespace := range msg.Namespaces {
 		ns, err := share.NewNamespaceFromBytes(namespace)
 		if err != nil {
-			return errors.Wrap(ErrInvalidNamespace, err.Error())
+			return WithDetails(errors.Wrap(ErrInvalidNamespace, err.Error()), map[string]string{MetadataNamespace: hex.EncodeToString(namespace)})
 		}
 		err = ValidateBlobNamespace(ns)
 		if err != nil {
-			return err
+			return WithDetails(err, map[string]string{MetadataNamespace: hex.EncodeToString(namespace)})
 		}
 	}
 
@@ -149,6 +150,31 @@ func (msg *MsgPayForBlobs) ValidateBasic() error {
 	return nil
 }
 
+// HasInclusionWindow returns whether the message sets either bound of its
+// inclusion window.
+func (msg *MsgPayForBlobs) HasInclusionWindow() bool {
+	return msg.NotBeforeHeight != 0 || msg.NotAfterHeight != 0
+}
+
+// ValidateInclusionWindow returns an error if the inclusion window of the
+// message ends before it starts. Unlike ValidateBasic, it is only checked in
+// the app versions that support inclusion windows.
+func (msg *MsgPayForBlobs) ValidateInclusionWindow() error {
+	if msg.NotAfterHeight != 0 && msg.NotAfterHeight < msg.NotBeforeHeight {
+		return ErrInvalidInclusionWindow.Wrapf("not after height %d is before not before height %d", msg.NotAfterHeight, msg.NotBeforeHeight)
+	}
+	return nil
+}
+
+// InInclusionWindow returns whether the message can be included in the block
+// at height.
+func (msg *MsgPayForBlobs) InInclusionWindow(height uint64) bool {
+	if height < msg.NotBeforeHeight {
+		return false
+	}
+	return msg.NotAfterHeight == 0 || height <= msg.NotAfterHeight
+}
+
 func (msg *MsgPayForBlobs) Gas(gasPerByte uint32) uint64 {
 	return GasToConsume(msg.BlobSizes, gasPerByte)
 }
@@ -178,11 +204,33 @@ func DefaultEstimateGas(blobSizes []uint32) uint64 {
 	return EstimateGas(blobSizes, appconsts.DefaultGasPerBlobByte, appconsts.DefaultTxSizeCostPerByte)
 }
 
+// UnmeteredContext returns ctx with an infinite gas meter whose consumption
+// isn't charged to the tx. The checks of PFBs that were added after
+// EstimateGas was calibrated read the store with it, so that PFBs consume the
+// same gas as before the checks existed and their gas estimation stays
+// accurate.
+func UnmeteredContext(ctx sdk.Context) sdk.Context {
+	return ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+}
+
 // ValidateBlobNamespace returns an error if the provided namespace is an
 // invalid user-specifiable blob namespace (e.g. reserved, parity shares, or
-// tail padding).
+// tail padding). All reserved namespaces are rejected with
+// ErrReservedNamespace, whose code is part of the results of the blocks of
+// every app version, wrapped with the name of the reserved namespace.
 func ValidateBlobNamespace(ns share.Namespace) error {
-	if ns.IsReserved() {
+	switch {
+	case ns.IsTx():
+		return errors.Wrap(ErrReservedNamespace, "transaction namespace")
+	case ns.IsPayForBlob():
+		return errors.Wrap(ErrReservedNamespace, "pay for blob namespace")
+	case ns.IsPrimaryReservedPadding():
+		return errors.Wrap(ErrReservedNamespace, "primary reserved padding namespace")
+	case ns.IsTailPadding():
+		return errors.Wrap(ErrReservedNamespace, "tail padding namespace")
+	case ns.IsParityShares():
+		return errors.Wrap(ErrReservedNamespace, "parity shares namespace")
+	case ns.IsReserved():
 		return ErrReservedNamespace
 	}
 
@@ -222,7 +270,7 @@ func ValidateBlobs(blobs ...*share.Blob) error {
 
 		err := ValidateBlobNamespace(blob.Namespace())
 		if err != nil {
-			return err
+			return WithDetails(err, map[string]string{MetadataNamespace: hex.EncodeToString(blob.Namespace().Bytes())})
 		}
 	}
 
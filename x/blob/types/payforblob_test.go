@@ -48,6 +48,14 @@ func TestValidateBasic(t *testing.T) {
 	intermediateStateRootsNamespaceMsg := validMsgPayForBlobs(t)
 	intermediateStateRootsNamespaceMsg.Namespaces[0] = share.IntermediateStateRootsNamespace.Bytes()
 
+	// MsgPayForBlobs that uses the pay for blob namespace
+	payForBlobNamespaceMsg := validMsgPayForBlobs(t)
+	payForBlobNamespaceMsg.Namespaces[0] = share.PayForBlobNamespace.Bytes()
+
+	// MsgPayForBlobs that uses a secondary reserved namespace
+	secondaryReservedNamespaceMsg := validMsgPayForBlobs(t)
+	secondaryReservedNamespaceMsg.Namespaces[0] = share.MinSecondaryReservedNamespace.Bytes()
+
 	// MsgPayForBlobs that uses the max primary reserved namespace
 	maxReservedNamespaceMsg := validMsgPayForBlobs(t)
 	maxReservedNamespaceMsg.Namespaces[0] = share.MaxPrimaryReservedNamespace.Bytes()
@@ -102,6 +110,16 @@ func TestValidateBasic(t *testing.T) {
 			msg:     intermediateStateRootsNamespaceMsg,
 			wantErr: types.ErrReservedNamespace,
 		},
+		{
+			name:    "pay for blob namespace",
+			msg:     payForBlobNamespaceMsg,
+			wantErr: types.ErrReservedNamespace,
+		},
+		{
+			name:    "secondary reserved namespace",
+			msg:     secondaryReservedNamespaceMsg,
+			wantErr: types.ErrReservedNamespace,
+		},
 		{
 			name:    "max reserved namespace",
 			msg:     maxReservedNamespaceMsg,
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -29,22 +30,23 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryReservedNamespacesRequest is the request type for the
+// Query/ReservedNamespaces RPC method.
+type QueryReservedNamespacesRequest struct {
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryReservedNamespacesRequest) Reset()         { *m = QueryReservedNamespacesRequest{} }
+func (m *QueryReservedNamespacesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReservedNamespacesRequest) ProtoMessage()    {}
+func (*QueryReservedNamespacesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_29ba8a4248383b64, []int{0}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryReservedNamespacesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryReservedNamespacesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryReservedNamespacesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -54,35 +56,44 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryReservedNamespacesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservedNamespacesRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryReservedNamespacesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryReservedNamespacesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservedNamespacesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryReservedNamespacesRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+// ReservedNamespaceRange is an inclusive range of namespaces that blobs can't
+// use.
+type ReservedNamespaceRange struct {
+	// name is the name of the namespaces of the range.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// min is the lowest namespace of the range, including its version.
+	Min []byte `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	// max is the highest namespace of the range, including its version.
+	Max []byte `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	// code is the code of the error in the blob codespace that PFBs using a
+	// namespace of the range are rejected with.
+	Code uint32 `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *ReservedNamespaceRange) Reset()         { *m = ReservedNamespaceRange{} }
+func (m *ReservedNamespaceRange) String() string { return proto.CompactTextString(m) }
+func (*ReservedNamespaceRange) ProtoMessage()    {}
+func (*ReservedNamespaceRange) Descriptor() ([]byte, []int) {
 	return fileDescriptor_29ba8a4248383b64, []int{1}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *ReservedNamespaceRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReservedNamespaceRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReservedNamespaceRange.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -92,230 +103,7796 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *ReservedNamespaceRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReservedNamespaceRange.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *ReservedNamespaceRange) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *ReservedNamespaceRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReservedNamespaceRange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ReservedNamespaceRange proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *ReservedNamespaceRange) GetName() string {
 	if m != nil {
-		return m.Params
+		return m.Name
 	}
-	return Params{}
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.blob.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.blob.v1.QueryParamsResponse")
+func (m *ReservedNamespaceRange) GetMin() []byte {
+	if m != nil {
+		return m.Min
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("celestia/blob/v1/query.proto", fileDescriptor_29ba8a4248383b64) }
+func (m *ReservedNamespaceRange) GetMax() []byte {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
 
-var fileDescriptor_29ba8a4248383b64 = []byte{
-	// 275 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xea, 0x81, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94,
-	0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e,
-	0x49, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x56, 0x16, 0xc3, 0x8e, 0x82, 0xc4, 0xa2, 0xc4,
-	0x5c, 0xa8, 0xb4, 0x92, 0x08, 0x97, 0x50, 0x20, 0xc8, 0xce, 0x00, 0xb0, 0x60, 0x50, 0x6a, 0x61,
-	0x69, 0x6a, 0x71, 0x89, 0x92, 0x2f, 0x97, 0x30, 0x8a, 0x68, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa,
-	0x90, 0x19, 0x17, 0x1b, 0x44, 0xb3, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e, 0xba,
-	0x13, 0xf5, 0x20, 0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xaa, 0x36, 0x2a, 0xe7,
-	0x62, 0x05, 0x1b, 0x27, 0x94, 0xc7, 0xc5, 0x06, 0x51, 0x20, 0xa4, 0x82, 0xa9, 0x15, 0xd3, 0x1d,
-	0x52, 0xaa, 0x04, 0x54, 0x41, 0xdc, 0xa5, 0x24, 0xde, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x41, 0x21,
-	0x7e, 0x34, 0x3f, 0x3a, 0x79, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
-	0x41, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xcc, 0x8e, 0xfc, 0xa2,
-	0x74, 0x38, 0x5b, 0x37, 0xb1, 0xa0, 0x40, 0xbf, 0x02, 0x62, 0x5e, 0x49, 0x65, 0x41, 0x6a, 0x71,
-	0x12, 0x1b, 0x38, 0xc0, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x7e, 0x20, 0x5f, 0xb5,
-	0x01, 0x00, 0x00,
+func (m *ReservedNamespaceRange) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// QueryReservedNamespacesResponse is the response type for the
+// Query/ReservedNamespaces RPC method.
+type QueryReservedNamespacesResponse struct {
+	// ranges are the reserved namespace ranges. Ranges overlap, a namespace is
+	// rejected with the code of the first range that contains it.
+	Ranges []ReservedNamespaceRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges"`
+	// namespace_versions are the namespace versions that blobs can use. Blobs
+	// of other versions are rejected with ErrInvalidNamespaceVersion.
+	NamespaceVersions []uint32 `protobuf:"varint,2,rep,packed,name=namespace_versions,json=namespaceVersions,proto3" json:"namespace_versions,omitempty"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryReservedNamespacesResponse) Reset()         { *m = QueryReservedNamespacesResponse{} }
+func (m *QueryReservedNamespacesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReservedNamespacesResponse) ProtoMessage()    {}
+func (*QueryReservedNamespacesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{2}
+}
+func (m *QueryReservedNamespacesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReservedNamespacesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReservedNamespacesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReservedNamespacesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservedNamespacesResponse.Merge(m, src)
+}
+func (m *QueryReservedNamespacesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReservedNamespacesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservedNamespacesResponse.DiscardUnknown(m)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryReservedNamespacesResponse proto.InternalMessageInfo
+
+func (m *QueryReservedNamespacesResponse) GetRanges() []ReservedNamespaceRange {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryReservedNamespacesResponse) GetNamespaceVersions() []uint32 {
+	if m != nil {
+		return m.NamespaceVersions
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryLayoutConstantsRequest is the request type for the
+// Query/LayoutConstants RPC method.
+type QueryLayoutConstantsRequest struct {
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLayoutConstantsRequest) Reset()         { *m = QueryLayoutConstantsRequest{} }
+func (m *QueryLayoutConstantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLayoutConstantsRequest) ProtoMessage()    {}
+func (*QueryLayoutConstantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{3}
+}
+func (m *QueryLayoutConstantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLayoutConstantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLayoutConstantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLayoutConstantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLayoutConstantsRequest.Merge(m, src)
+}
+func (m *QueryLayoutConstantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLayoutConstantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLayoutConstantsRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryLayoutConstantsRequest proto.InternalMessageInfo
+
+// QueryLayoutConstantsResponse is the response type for the
+// Query/LayoutConstants RPC method.
+type QueryLayoutConstantsResponse struct {
+	// app_version is the app version that the constants are of.
+	AppVersion uint64 `protobuf:"varint,1,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// share_versions are the share versions that blobs can use.
+	ShareVersions []uint32 `protobuf:"varint,2,rep,packed,name=share_versions,json=shareVersions,proto3" json:"share_versions,omitempty"`
+	// namespace_size is the size of a namespace, including its version, in
+	// bytes.
+	NamespaceSize uint64 `protobuf:"varint,3,opt,name=namespace_size,json=namespaceSize,proto3" json:"namespace_size,omitempty"`
+	// share_size is the size of a share in bytes.
+	ShareSize uint64 `protobuf:"varint,4,opt,name=share_size,json=shareSize,proto3" json:"share_size,omitempty"`
+	// square_size_upper_bound is the upper bound of the max square size.
+	SquareSizeUpperBound uint64 `protobuf:"varint,5,opt,name=square_size_upper_bound,json=squareSizeUpperBound,proto3" json:"square_size_upper_bound,omitempty"`
+	// max_square_size is the max size of the original data square, i.e. the
+	// min of the governance max square size and square_size_upper_bound.
+	MaxSquareSize uint64 `protobuf:"varint,6,opt,name=max_square_size,json=maxSquareSize,proto3" json:"max_square_size,omitempty"`
+	// subtree_root_threshold is the target upper bound of the number of subtree
+	// roots of a share commitment.
+	SubtreeRootThreshold uint64 `protobuf:"varint,7,opt,name=subtree_root_threshold,json=subtreeRootThreshold,proto3" json:"subtree_root_threshold,omitempty"`
+	// constants_digest is the digest of the versioned constants, see
+	// appconsts.ConstantsDigest.
+	ConstantsDigest string `protobuf:"bytes,8,opt,name=constants_digest,json=constantsDigest,proto3" json:"constants_digest,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryLayoutConstantsResponse) Reset()         { *m = QueryLayoutConstantsResponse{} }
+func (m *QueryLayoutConstantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLayoutConstantsResponse) ProtoMessage()    {}
+func (*QueryLayoutConstantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{4}
+}
+func (m *QueryLayoutConstantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLayoutConstantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLayoutConstantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLayoutConstantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLayoutConstantsResponse.Merge(m, src)
+}
+func (m *QueryLayoutConstantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLayoutConstantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLayoutConstantsResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryLayoutConstantsResponse proto.InternalMessageInfo
+
+func (m *QueryLayoutConstantsResponse) GetAppVersion() uint64 {
+	if m != nil {
+		return m.AppVersion
+	}
+	return 0
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryLayoutConstantsResponse) GetShareVersions() []uint32 {
+	if m != nil {
+		return m.ShareVersions
+	}
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryLayoutConstantsResponse) GetNamespaceSize() uint64 {
+	if m != nil {
+		return m.NamespaceSize
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return 0
+}
+
+func (m *QueryLayoutConstantsResponse) GetShareSize() uint64 {
+	if m != nil {
+		return m.ShareSize
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.blob.v1.Query/Params",
+	return 0
+}
+
+func (m *QueryLayoutConstantsResponse) GetSquareSizeUpperBound() uint64 {
+	if m != nil {
+		return m.SquareSizeUpperBound
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return 0
+}
+
+func (m *QueryLayoutConstantsResponse) GetMaxSquareSize() uint64 {
+	if m != nil {
+		return m.MaxSquareSize
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celestia.blob.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "celestia/blob/v1/query.proto",
+func (m *QueryLayoutConstantsResponse) GetSubtreeRootThreshold() uint64 {
+	if m != nil {
+		return m.SubtreeRootThreshold
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLayoutConstantsResponse) GetConstantsDigest() string {
+	if m != nil {
+		return m.ConstantsDigest
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEstimateGasRequest is the request type for the Query/EstimateGas RPC
+// method.
+type QueryEstimateGasRequest struct {
+	// blob_sizes are the sizes of the data of the blobs of the PFB in bytes.
+	BlobSizes []uint32 `protobuf:"varint,1,rep,packed,name=blob_sizes,json=blobSizes,proto3" json:"blob_sizes,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEstimateGasRequest) Reset()         { *m = QueryEstimateGasRequest{} }
+func (m *QueryEstimateGasRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateGasRequest) ProtoMessage()    {}
+func (*QueryEstimateGasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{5}
+}
+func (m *QueryEstimateGasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateGasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateGasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateGasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateGasRequest.Merge(m, src)
+}
+func (m *QueryEstimateGasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateGasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateGasRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEstimateGasRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateGasRequest) GetBlobSizes() []uint32 {
+	if m != nil {
+		return m.BlobSizes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEstimateGasResponse is the response type for the Query/EstimateGas RPC
+// method.
+type QueryEstimateGasResponse struct {
+	// blob_gas is the gas that is consumed for the blobs of the PFB. It is
+	// exact.
+	BlobGas uint64 `protobuf:"varint,1,opt,name=blob_gas,json=blobGas,proto3" json:"blob_gas,omitempty"`
+	// gas is the estimated total gas of a tx with a single signature and the
+	// PFB as its only message, i.e. blob_gas plus the gas of the tx size and of
+	// the fixed costs of a PFB.
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+	// gas_per_blob_byte is the gas that is consumed per byte of the shares of
+	// the blobs.
+	GasPerBlobByte uint32 `protobuf:"varint,3,opt,name=gas_per_blob_byte,json=gasPerBlobByte,proto3" json:"gas_per_blob_byte,omitempty"`
+	// tx_size_cost_per_byte is the gas that is consumed per byte of the tx.
+	TxSizeCostPerByte uint64 `protobuf:"varint,4,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryEstimateGasResponse) Reset()         { *m = QueryEstimateGasResponse{} }
+func (m *QueryEstimateGasResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateGasResponse) ProtoMessage()    {}
+func (*QueryEstimateGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{6}
+}
+func (m *QueryEstimateGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateGasResponse.Merge(m, src)
+}
+func (m *QueryEstimateGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateGasResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateGasResponse) GetBlobGas() uint64 {
+	if m != nil {
+		return m.BlobGas
+	}
+	return 0
+}
+
+func (m *QueryEstimateGasResponse) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *QueryEstimateGasResponse) GetGasPerBlobByte() uint32 {
+	if m != nil {
+		return m.GasPerBlobByte
+	}
+	return 0
+}
+
+func (m *QueryEstimateGasResponse) GetTxSizeCostPerByte() uint64 {
+	if m != nil {
+		return m.TxSizeCostPerByte
+	}
+	return 0
+}
+
+// QueryBlobProofEstimateRequest is the request type for the
+// Query/BlobProofEstimate RPC method.
+type QueryBlobProofEstimateRequest struct {
+	// blob_size is the size of the data of the blob in bytes.
+	BlobSize uint32 `protobuf:"varint,1,opt,name=blob_size,json=blobSize,proto3" json:"blob_size,omitempty"`
+	// square_size is the size of the square that contains the blob. Defaults to
+	// the max square size of the current app version and params if 0.
+	SquareSize uint64 `protobuf:"varint,2,opt,name=square_size,json=squareSize,proto3" json:"square_size,omitempty"`
+}
+
+func (m *QueryBlobProofEstimateRequest) Reset()         { *m = QueryBlobProofEstimateRequest{} }
+func (m *QueryBlobProofEstimateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobProofEstimateRequest) ProtoMessage()    {}
+func (*QueryBlobProofEstimateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{7}
+}
+func (m *QueryBlobProofEstimateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobProofEstimateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobProofEstimateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobProofEstimateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobProofEstimateRequest.Merge(m, src)
+}
+func (m *QueryBlobProofEstimateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobProofEstimateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobProofEstimateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobProofEstimateRequest proto.InternalMessageInfo
+
+func (m *QueryBlobProofEstimateRequest) GetBlobSize() uint32 {
+	if m != nil {
+		return m.BlobSize
+	}
+	return 0
+}
+
+func (m *QueryBlobProofEstimateRequest) GetSquareSize() uint64 {
+	if m != nil {
+		return m.SquareSize
+	}
+	return 0
+}
+
+// QueryBlobProofEstimateResponse is the response type for the
+// Query/BlobProofEstimate RPC method. The fields are upper bounds over all
+// the indexes at which the blob can start.
+type QueryBlobProofEstimateResponse struct {
+	// square_size is the size of the square of the estimate.
+	SquareSize uint64 `protobuf:"varint,1,opt,name=square_size,json=squareSize,proto3" json:"square_size,omitempty"`
+	// shares is the number of shares of the blob.
+	Shares uint64 `protobuf:"varint,2,opt,name=shares,proto3" json:"shares,omitempty"`
+	// rows is the number of rows that the shares of the blob span.
+	Rows uint64 `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
+	// nmt_nodes is the number of nodes of the NMT range proofs of the shares
+	// to their row roots.
+	NmtNodes uint64 `protobuf:"varint,4,opt,name=nmt_nodes,json=nmtNodes,proto3" json:"nmt_nodes,omitempty"`
+	// row_proof_aunts is the number of aunts of the Merkle proofs of the row
+	// roots to the data root.
+	RowProofAunts uint64 `protobuf:"varint,5,opt,name=row_proof_aunts,json=rowProofAunts,proto3" json:"row_proof_aunts,omitempty"`
+	// data_bytes is the size of the shares of the blob.
+	DataBytes uint64 `protobuf:"varint,6,opt,name=data_bytes,json=dataBytes,proto3" json:"data_bytes,omitempty"`
+	// proof_bytes is the size of the hashes of the proof, excluding the
+	// protobuf encoding overhead.
+	ProofBytes uint64 `protobuf:"varint,7,opt,name=proof_bytes,json=proofBytes,proto3" json:"proof_bytes,omitempty"`
+	// hash_count is the number of hashes that verifying the proof computes.
+	HashCount uint64 `protobuf:"varint,8,opt,name=hash_count,json=hashCount,proto3" json:"hash_count,omitempty"`
+}
+
+func (m *QueryBlobProofEstimateResponse) Reset()         { *m = QueryBlobProofEstimateResponse{} }
+func (m *QueryBlobProofEstimateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobProofEstimateResponse) ProtoMessage()    {}
+func (*QueryBlobProofEstimateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{8}
+}
+func (m *QueryBlobProofEstimateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobProofEstimateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobProofEstimateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobProofEstimateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobProofEstimateResponse.Merge(m, src)
+}
+func (m *QueryBlobProofEstimateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobProofEstimateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobProofEstimateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobProofEstimateResponse proto.InternalMessageInfo
+
+func (m *QueryBlobProofEstimateResponse) GetSquareSize() uint64 {
+	if m != nil {
+		return m.SquareSize
+	}
+	return 0
+}
+
+func (m *QueryBlobProofEstimateResponse) GetShares() uint64 {
+	if m != nil {
+		return m.Shares
+	}
+	return 0
+}
+
+func (m *QueryBlobProofEstimateResponse) GetRows() uint64 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (m *QueryBlobProofEstimateResponse) GetNmtNodes() uint64 {
+	if m != nil {
+		return m.NmtNodes
+	}
+	return 0
+}
+
+func (m *QueryBlobProofEstimateResponse) GetRowProofAunts() uint64 {
+	if m != nil {
+		return m.RowProofAunts
+	}
+	return 0
+}
+
+func (m *QueryBlobProofEstimateResponse) GetDataBytes() uint64 {
+	if m != nil {
+		return m.DataBytes
+	}
+	return 0
+}
+
+func (m *QueryBlobProofEstimateResponse) GetProofBytes() uint64 {
+	if m != nil {
+		return m.ProofBytes
+	}
+	return 0
+}
+
+func (m *QueryBlobProofEstimateResponse) GetHashCount() uint64 {
+	if m != nil {
+		return m.HashCount
+	}
+	return 0
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{9}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{10}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryBlobFeeBudgetRequest is the request type for the Query/BlobFeeBudget
+// RPC method.
+type QueryBlobFeeBudgetRequest struct {
+	// address is the bech32 encoded address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryBlobFeeBudgetRequest) Reset()         { *m = QueryBlobFeeBudgetRequest{} }
+func (m *QueryBlobFeeBudgetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobFeeBudgetRequest) ProtoMessage()    {}
+func (*QueryBlobFeeBudgetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{11}
+}
+func (m *QueryBlobFeeBudgetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobFeeBudgetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobFeeBudgetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobFeeBudgetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobFeeBudgetRequest.Merge(m, src)
+}
+func (m *QueryBlobFeeBudgetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobFeeBudgetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobFeeBudgetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobFeeBudgetRequest proto.InternalMessageInfo
+
+func (m *QueryBlobFeeBudgetRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryBlobFeeBudgetResponse is the response type for the Query/BlobFeeBudget
+// RPC method.
+type QueryBlobFeeBudgetResponse struct {
+	Budget BlobFeeBudget `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget"`
+}
+
+func (m *QueryBlobFeeBudgetResponse) Reset()         { *m = QueryBlobFeeBudgetResponse{} }
+func (m *QueryBlobFeeBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobFeeBudgetResponse) ProtoMessage()    {}
+func (*QueryBlobFeeBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{12}
+}
+func (m *QueryBlobFeeBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobFeeBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobFeeBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobFeeBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobFeeBudgetResponse.Merge(m, src)
+}
+func (m *QueryBlobFeeBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobFeeBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobFeeBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobFeeBudgetResponse proto.InternalMessageInfo
+
+func (m *QueryBlobFeeBudgetResponse) GetBudget() BlobFeeBudget {
+	if m != nil {
+		return m.Budget
+	}
+	return BlobFeeBudget{}
+}
+
+// QueryBlobProofRequest is the request type for the ProofQuery/BlobProof RPC
+// method.
+type QueryBlobProofRequest struct {
+	// height is the height of the block that contains the blob.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the namespace of the blob, including its version.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// commitment is the share commitment of the blob.
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *QueryBlobProofRequest) Reset()         { *m = QueryBlobProofRequest{} }
+func (m *QueryBlobProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobProofRequest) ProtoMessage()    {}
+func (*QueryBlobProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{13}
+}
+func (m *QueryBlobProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobProofRequest.Merge(m, src)
+}
+func (m *QueryBlobProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobProofRequest proto.InternalMessageInfo
+
+func (m *QueryBlobProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryBlobProofRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *QueryBlobProofRequest) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+// QueryBlobProofResponse is the response type for the ProofQuery/BlobProof RPC
+// method.
+type QueryBlobProofResponse struct {
+	// proof is the protobuf encoded celestia.core.v1.proof.ShareProof of the
+	// shares of the blob to data_root.
+	Proof []byte `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	// start_share and end_share are the end-exclusive range of the shares of
+	// the blob in the original data square.
+	StartShare uint32 `protobuf:"varint,2,opt,name=start_share,json=startShare,proto3" json:"start_share,omitempty"`
+	EndShare   uint32 `protobuf:"varint,3,opt,name=end_share,json=endShare,proto3" json:"end_share,omitempty"`
+	// data_root is the data root of the block.
+	DataRoot []byte `protobuf:"bytes,4,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+}
+
+func (m *QueryBlobProofResponse) Reset()         { *m = QueryBlobProofResponse{} }
+func (m *QueryBlobProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobProofResponse) ProtoMessage()    {}
+func (*QueryBlobProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{14}
+}
+func (m *QueryBlobProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobProofResponse.Merge(m, src)
+}
+func (m *QueryBlobProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobProofResponse proto.InternalMessageInfo
+
+func (m *QueryBlobProofResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryBlobProofResponse) GetStartShare() uint32 {
+	if m != nil {
+		return m.StartShare
+	}
+	return 0
+}
+
+func (m *QueryBlobProofResponse) GetEndShare() uint32 {
+	if m != nil {
+		return m.EndShare
+	}
+	return 0
+}
+
+func (m *QueryBlobProofResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+// QueryNamespaceSharesRequest is the request type for the
+// ProofQuery/NamespaceShares RPC method.
+type QueryNamespaceSharesRequest struct {
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the namespace of the shares, including its version.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// pagination defines an optional pagination for the request. The key is the
+	// big endian uint32 index of the share to start from.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNamespaceSharesRequest) Reset()         { *m = QueryNamespaceSharesRequest{} }
+func (m *QueryNamespaceSharesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceSharesRequest) ProtoMessage()    {}
+func (*QueryNamespaceSharesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{15}
+}
+func (m *QueryNamespaceSharesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceSharesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceSharesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceSharesRequest.Merge(m, src)
+}
+func (m *QueryNamespaceSharesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceSharesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceSharesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceSharesRequest proto.InternalMessageInfo
+
+func (m *QueryNamespaceSharesRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryNamespaceSharesRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *QueryNamespaceSharesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// NamespaceShare is a share of the original data square of a block.
+type NamespaceShare struct {
+	// data is the share.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// index is the index of the share in the original data square.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// row and col are the position of the share in the original data square.
+	Row uint32 `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
+	Col uint32 `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
+	// padding is whether the share is a namespace padding share.
+	Padding bool `protobuf:"varint,5,opt,name=padding,proto3" json:"padding,omitempty"`
+}
+
+func (m *NamespaceShare) Reset()         { *m = NamespaceShare{} }
+func (m *NamespaceShare) String() string { return proto.CompactTextString(m) }
+func (*NamespaceShare) ProtoMessage()    {}
+func (*NamespaceShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{16}
+}
+func (m *NamespaceShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceShare.Merge(m, src)
+}
+func (m *NamespaceShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceShare proto.InternalMessageInfo
+
+func (m *NamespaceShare) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *NamespaceShare) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *NamespaceShare) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *NamespaceShare) GetCol() uint32 {
+	if m != nil {
+		return m.Col
+	}
+	return 0
+}
+
+func (m *NamespaceShare) GetPadding() bool {
+	if m != nil {
+		return m.Padding
+	}
+	return false
+}
+
+// QueryNamespaceSharesResponse is the response type for the
+// ProofQuery/NamespaceShares RPC method.
+type QueryNamespaceSharesResponse struct {
+	// shares are the shares of the namespace in ascending index order.
+	Shares []NamespaceShare `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares"`
+	// square_size is the size of the original data square of the block.
+	SquareSize uint64 `protobuf:"varint,2,opt,name=square_size,json=squareSize,proto3" json:"square_size,omitempty"`
+	// data_root is the data root of the block.
+	DataRoot []byte `protobuf:"bytes,3,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNamespaceSharesResponse) Reset()         { *m = QueryNamespaceSharesResponse{} }
+func (m *QueryNamespaceSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceSharesResponse) ProtoMessage()    {}
+func (*QueryNamespaceSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{17}
+}
+func (m *QueryNamespaceSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceSharesResponse.Merge(m, src)
+}
+func (m *QueryNamespaceSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceSharesResponse proto.InternalMessageInfo
+
+func (m *QueryNamespaceSharesResponse) GetShares() []NamespaceShare {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func (m *QueryNamespaceSharesResponse) GetSquareSize() uint64 {
+	if m != nil {
+		return m.SquareSize
+	}
+	return 0
+}
+
+func (m *QueryNamespaceSharesResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func (m *QueryNamespaceSharesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryShareRequest is the request type for the ProofQuery/Share RPC method.
+type QueryShareRequest struct {
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// row and col are the position of the share in the original data square.
+	Row uint32 `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	Col uint32 `protobuf:"varint,3,opt,name=col,proto3" json:"col,omitempty"`
+}
+
+func (m *QueryShareRequest) Reset()         { *m = QueryShareRequest{} }
+func (m *QueryShareRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryShareRequest) ProtoMessage()    {}
+func (*QueryShareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{18}
+}
+func (m *QueryShareRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShareRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryShareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShareRequest.Merge(m, src)
+}
+func (m *QueryShareRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryShareRequest proto.InternalMessageInfo
+
+func (m *QueryShareRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryShareRequest) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *QueryShareRequest) GetCol() uint32 {
+	if m != nil {
+		return m.Col
+	}
+	return 0
+}
+
+// QueryShareResponse is the response type for the ProofQuery/Share RPC
+// method.
+type QueryShareResponse struct {
+	// share is the share.
+	Share []byte `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	// proof is the protobuf encoded celestia.core.v1.proof.ShareProof of the
+	// share to data_root. It contains the NMT proof of the share to its row
+	// root and the Merkle proof of the row root to data_root.
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// square_size is the size of the original data square of the block.
+	SquareSize uint64 `protobuf:"varint,3,opt,name=square_size,json=squareSize,proto3" json:"square_size,omitempty"`
+	// data_root is the data root of the block.
+	DataRoot []byte `protobuf:"bytes,4,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+}
+
+func (m *QueryShareResponse) Reset()         { *m = QueryShareResponse{} }
+func (m *QueryShareResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryShareResponse) ProtoMessage()    {}
+func (*QueryShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{19}
+}
+func (m *QueryShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShareResponse.Merge(m, src)
+}
+func (m *QueryShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryShareResponse proto.InternalMessageInfo
+
+func (m *QueryShareResponse) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+func (m *QueryShareResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryShareResponse) GetSquareSize() uint64 {
+	if m != nil {
+		return m.SquareSize
+	}
+	return 0
+}
+
+func (m *QueryShareResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+// QueryTxShareRangesRequest is the request type for the
+// ProofQuery/TxShareRanges RPC method.
+type QueryTxShareRangesRequest struct {
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryTxShareRangesRequest) Reset()         { *m = QueryTxShareRangesRequest{} }
+func (m *QueryTxShareRangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxShareRangesRequest) ProtoMessage()    {}
+func (*QueryTxShareRangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{20}
+}
+func (m *QueryTxShareRangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxShareRangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxShareRangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxShareRangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxShareRangesRequest.Merge(m, src)
+}
+func (m *QueryTxShareRangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxShareRangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxShareRangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxShareRangesRequest proto.InternalMessageInfo
+
+func (m *QueryTxShareRangesRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// TxShareRange is the range of the shares of the original data square that a
+// tx occupies.
+type TxShareRange struct {
+	// tx_hash is the hash of the tx as it is in the block.
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// start_share and end_share are the end-exclusive range of the compact
+	// shares of the tx. The shares of a blob tx are those of its PFB.
+	StartShare uint32 `protobuf:"varint,2,opt,name=start_share,json=startShare,proto3" json:"start_share,omitempty"`
+	EndShare   uint32 `protobuf:"varint,3,opt,name=end_share,json=endShare,proto3" json:"end_share,omitempty"`
+}
+
+func (m *TxShareRange) Reset()         { *m = TxShareRange{} }
+func (m *TxShareRange) String() string { return proto.CompactTextString(m) }
+func (*TxShareRange) ProtoMessage()    {}
+func (*TxShareRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{21}
+}
+func (m *TxShareRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxShareRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxShareRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxShareRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxShareRange.Merge(m, src)
+}
+func (m *TxShareRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxShareRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxShareRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxShareRange proto.InternalMessageInfo
+
+func (m *TxShareRange) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *TxShareRange) GetStartShare() uint32 {
+	if m != nil {
+		return m.StartShare
+	}
+	return 0
+}
+
+func (m *TxShareRange) GetEndShare() uint32 {
+	if m != nil {
+		return m.EndShare
+	}
+	return 0
+}
+
+// QueryTxShareRangesResponse is the response type for the
+// ProofQuery/TxShareRanges RPC method.
+type QueryTxShareRangesResponse struct {
+	// ranges are the share ranges of the txs in the order of the txs of the
+	// block.
+	Ranges []TxShareRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges"`
+	// square_size is the size of the original data square of the block.
+	SquareSize uint64 `protobuf:"varint,2,opt,name=square_size,json=squareSize,proto3" json:"square_size,omitempty"`
+	// data_root is the data root of the block.
+	DataRoot []byte `protobuf:"bytes,3,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+}
+
+func (m *QueryTxShareRangesResponse) Reset()         { *m = QueryTxShareRangesResponse{} }
+func (m *QueryTxShareRangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxShareRangesResponse) ProtoMessage()    {}
+func (*QueryTxShareRangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{22}
+}
+func (m *QueryTxShareRangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxShareRangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxShareRangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxShareRangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxShareRangesResponse.Merge(m, src)
+}
+func (m *QueryTxShareRangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxShareRangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxShareRangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxShareRangesResponse proto.InternalMessageInfo
+
+func (m *QueryTxShareRangesResponse) GetRanges() []TxShareRange {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+func (m *QueryTxShareRangesResponse) GetSquareSize() uint64 {
+	if m != nil {
+		return m.SquareSize
+	}
+	return 0
+}
+
+func (m *QueryTxShareRangesResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+// QueryBlobsRequest is the request type for the ProofQuery/Blobs RPC method.
+type QueryBlobsRequest struct {
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the optional namespace of the blobs, including its version.
+	// All the blobs of the block are returned if it is empty.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// pagination defines an optional pagination for the request. The key is the
+	// big endian uint32 index of the first share of the blob to start from.
+	// If reverse is set, the blobs are in descending share order.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlobsRequest) Reset()         { *m = QueryBlobsRequest{} }
+func (m *QueryBlobsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobsRequest) ProtoMessage()    {}
+func (*QueryBlobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{23}
+}
+func (m *QueryBlobsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobsRequest.Merge(m, src)
+}
+func (m *QueryBlobsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobsRequest proto.InternalMessageInfo
+
+func (m *QueryBlobsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryBlobsRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *QueryBlobsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// BlobInfo is the metadata of a blob of a committed block.
+type BlobInfo struct {
+	// namespace is the namespace of the blob, including its version.
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// size is the size of the data of the blob in bytes.
+	Size_ uint32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// share_version is the share version of the blob.
+	ShareVersion uint32 `protobuf:"varint,3,opt,name=share_version,json=shareVersion,proto3" json:"share_version,omitempty"`
+	// commitment is the share commitment of the blob.
+	Commitment []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// start_share and end_share are the end-exclusive range of the shares of
+	// the blob in the original data square.
+	StartShare uint32 `protobuf:"varint,5,opt,name=start_share,json=startShare,proto3" json:"start_share,omitempty"`
+	EndShare   uint32 `protobuf:"varint,6,opt,name=end_share,json=endShare,proto3" json:"end_share,omitempty"`
+	// signer is the bech32 encoded address of the signer of the PFB of the
+	// blob.
+	Signer string `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
+	// tx_hash is the hash of the blob tx of the blob.
+	TxHash []byte `protobuf:"bytes,8,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// blob_index is the index of the blob in its blob tx.
+	BlobIndex uint32 `protobuf:"varint,9,opt,name=blob_index,json=blobIndex,proto3" json:"blob_index,omitempty"`
+}
+
+func (m *BlobInfo) Reset()         { *m = BlobInfo{} }
+func (m *BlobInfo) String() string { return proto.CompactTextString(m) }
+func (*BlobInfo) ProtoMessage()    {}
+func (*BlobInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{24}
+}
+func (m *BlobInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobInfo.Merge(m, src)
+}
+func (m *BlobInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobInfo proto.InternalMessageInfo
+
+func (m *BlobInfo) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *BlobInfo) GetSize_() uint32 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *BlobInfo) GetShareVersion() uint32 {
+	if m != nil {
+		return m.ShareVersion
+	}
+	return 0
+}
+
+func (m *BlobInfo) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *BlobInfo) GetStartShare() uint32 {
+	if m != nil {
+		return m.StartShare
+	}
+	return 0
+}
+
+func (m *BlobInfo) GetEndShare() uint32 {
+	if m != nil {
+		return m.EndShare
+	}
+	return 0
+}
+
+func (m *BlobInfo) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *BlobInfo) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *BlobInfo) GetBlobIndex() uint32 {
+	if m != nil {
+		return m.BlobIndex
+	}
+	return 0
+}
+
+// QueryBlobsResponse is the response type for the ProofQuery/Blobs RPC
+// method.
+type QueryBlobsResponse struct {
+	// blobs are the metadata of the blobs in the order of the request.
+	Blobs []BlobInfo `protobuf:"bytes,1,rep,name=blobs,proto3" json:"blobs"`
+	// square_size is the size of the original data square of the block.
+	SquareSize uint64 `protobuf:"varint,2,opt,name=square_size,json=squareSize,proto3" json:"square_size,omitempty"`
+	// data_root is the data root of the block.
+	DataRoot []byte `protobuf:"bytes,3,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlobsResponse) Reset()         { *m = QueryBlobsResponse{} }
+func (m *QueryBlobsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobsResponse) ProtoMessage()    {}
+func (*QueryBlobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{25}
+}
+func (m *QueryBlobsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobsResponse.Merge(m, src)
+}
+func (m *QueryBlobsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobsResponse proto.InternalMessageInfo
+
+func (m *QueryBlobsResponse) GetBlobs() []BlobInfo {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *QueryBlobsResponse) GetSquareSize() uint64 {
+	if m != nil {
+		return m.SquareSize
+	}
+	return 0
+}
+
+func (m *QueryBlobsResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func (m *QueryBlobsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBlockDataStatusRequest is the request type for the
+// ProofQuery/BlockDataStatus RPC method.
+type QueryBlockDataStatusRequest struct {
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBlockDataStatusRequest) Reset()         { *m = QueryBlockDataStatusRequest{} }
+func (m *QueryBlockDataStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockDataStatusRequest) ProtoMessage()    {}
+func (*QueryBlockDataStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{26}
+}
+func (m *QueryBlockDataStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockDataStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockDataStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockDataStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockDataStatusRequest.Merge(m, src)
+}
+func (m *QueryBlockDataStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockDataStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockDataStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockDataStatusRequest proto.InternalMessageInfo
+
+func (m *QueryBlockDataStatusRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryBlockDataStatusResponse is the response type for the
+// ProofQuery/BlockDataStatus RPC method.
+type QueryBlockDataStatusResponse struct {
+	// pruned is whether the txs and blobs of the block are pruned. Proofs and
+	// shares of a pruned block can't be queried from the node.
+	Pruned bool `protobuf:"varint,1,opt,name=pruned,proto3" json:"pruned,omitempty"`
+	// data_root is the data root of the block, which is retained from its
+	// header if the block is pruned.
+	DataRoot []byte `protobuf:"bytes,2,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+}
+
+func (m *QueryBlockDataStatusResponse) Reset()         { *m = QueryBlockDataStatusResponse{} }
+func (m *QueryBlockDataStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockDataStatusResponse) ProtoMessage()    {}
+func (*QueryBlockDataStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{27}
+}
+func (m *QueryBlockDataStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockDataStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockDataStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockDataStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockDataStatusResponse.Merge(m, src)
+}
+func (m *QueryBlockDataStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockDataStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockDataStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockDataStatusResponse proto.InternalMessageInfo
+
+func (m *QueryBlockDataStatusResponse) GetPruned() bool {
+	if m != nil {
+		return m.Pruned
+	}
+	return false
+}
+
+func (m *QueryBlockDataStatusResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryReservedNamespacesRequest)(nil), "celestia.blob.v1.QueryReservedNamespacesRequest")
+	proto.RegisterType((*ReservedNamespaceRange)(nil), "celestia.blob.v1.ReservedNamespaceRange")
+	proto.RegisterType((*QueryReservedNamespacesResponse)(nil), "celestia.blob.v1.QueryReservedNamespacesResponse")
+	proto.RegisterType((*QueryLayoutConstantsRequest)(nil), "celestia.blob.v1.QueryLayoutConstantsRequest")
+	proto.RegisterType((*QueryLayoutConstantsResponse)(nil), "celestia.blob.v1.QueryLayoutConstantsResponse")
+	proto.RegisterType((*QueryEstimateGasRequest)(nil), "celestia.blob.v1.QueryEstimateGasRequest")
+	proto.RegisterType((*QueryEstimateGasResponse)(nil), "celestia.blob.v1.QueryEstimateGasResponse")
+	proto.RegisterType((*QueryBlobProofEstimateRequest)(nil), "celestia.blob.v1.QueryBlobProofEstimateRequest")
+	proto.RegisterType((*QueryBlobProofEstimateResponse)(nil), "celestia.blob.v1.QueryBlobProofEstimateResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.blob.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.blob.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBlobFeeBudgetRequest)(nil), "celestia.blob.v1.QueryBlobFeeBudgetRequest")
+	proto.RegisterType((*QueryBlobFeeBudgetResponse)(nil), "celestia.blob.v1.QueryBlobFeeBudgetResponse")
+	proto.RegisterType((*QueryBlobProofRequest)(nil), "celestia.blob.v1.QueryBlobProofRequest")
+	proto.RegisterType((*QueryBlobProofResponse)(nil), "celestia.blob.v1.QueryBlobProofResponse")
+	proto.RegisterType((*QueryNamespaceSharesRequest)(nil), "celestia.blob.v1.QueryNamespaceSharesRequest")
+	proto.RegisterType((*NamespaceShare)(nil), "celestia.blob.v1.NamespaceShare")
+	proto.RegisterType((*QueryNamespaceSharesResponse)(nil), "celestia.blob.v1.QueryNamespaceSharesResponse")
+	proto.RegisterType((*QueryShareRequest)(nil), "celestia.blob.v1.QueryShareRequest")
+	proto.RegisterType((*QueryShareResponse)(nil), "celestia.blob.v1.QueryShareResponse")
+	proto.RegisterType((*QueryTxShareRangesRequest)(nil), "celestia.blob.v1.QueryTxShareRangesRequest")
+	proto.RegisterType((*TxShareRange)(nil), "celestia.blob.v1.TxShareRange")
+	proto.RegisterType((*QueryTxShareRangesResponse)(nil), "celestia.blob.v1.QueryTxShareRangesResponse")
+	proto.RegisterType((*QueryBlobsRequest)(nil), "celestia.blob.v1.QueryBlobsRequest")
+	proto.RegisterType((*BlobInfo)(nil), "celestia.blob.v1.BlobInfo")
+	proto.RegisterType((*QueryBlobsResponse)(nil), "celestia.blob.v1.QueryBlobsResponse")
+	proto.RegisterType((*QueryBlockDataStatusRequest)(nil), "celestia.blob.v1.QueryBlockDataStatusRequest")
+	proto.RegisterType((*QueryBlockDataStatusResponse)(nil), "celestia.blob.v1.QueryBlockDataStatusResponse")
+}
+
+func init() { proto.RegisterFile("celestia/blob/v1/query.proto", fileDescriptor_29ba8a4248383b64) }
+
+var fileDescriptor_29ba8a4248383b64 = []byte{
+	// 1847 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x41, 0x6f, 0x1c, 0x49,
+	0x15, 0x4e, 0x8f, 0xc7, 0x93, 0x99, 0x67, 0x4f, 0x1c, 0x17, 0x89, 0x3d, 0x99, 0xd8, 0x63, 0x6f,
+	0xc7, 0xd9, 0x75, 0xb2, 0x9b, 0x99, 0xc4, 0x4b, 0x10, 0x07, 0x40, 0xc2, 0x59, 0x12, 0x16, 0xc1,
+	0x12, 0xda, 0x0b, 0x07, 0x10, 0x6a, 0xd5, 0x4c, 0x57, 0x7a, 0x46, 0xcc, 0x74, 0x75, 0xba, 0x6a,
+	0xec, 0xf1, 0x5a, 0x06, 0x09, 0xb8, 0x70, 0x82, 0xd5, 0x0a, 0x84, 0x04, 0x88, 0x0b, 0x07, 0x7e,
+	0xca, 0x1e, 0x57, 0xda, 0x0b, 0x27, 0xb4, 0x4a, 0xe0, 0xc6, 0x1f, 0xe0, 0x86, 0xea, 0x55, 0x75,
+	0x4f, 0x77, 0xcf, 0xb4, 0x6d, 0x94, 0x4b, 0x6e, 0x55, 0xaf, 0xde, 0xab, 0xf7, 0xd5, 0x7b, 0x5f,
+	0xbd, 0x7a, 0xdd, 0xb0, 0xd1, 0x63, 0x43, 0x26, 0xe4, 0x80, 0x76, 0xba, 0x43, 0xde, 0xed, 0x1c,
+	0x3e, 0xe8, 0x3c, 0x1f, 0xb3, 0xe8, 0xb8, 0x1d, 0x46, 0x5c, 0x72, 0x72, 0x35, 0x5e, 0x6d, 0xab,
+	0xd5, 0xf6, 0xe1, 0x83, 0xe6, 0x35, 0x9f, 0xfb, 0x1c, 0x17, 0x3b, 0x6a, 0xa4, 0xf5, 0x9a, 0x1b,
+	0x3e, 0xe7, 0xfe, 0x90, 0x75, 0x68, 0x38, 0xe8, 0xd0, 0x20, 0xe0, 0x92, 0xca, 0x01, 0x0f, 0x84,
+	0x59, 0xdd, 0x9c, 0xf1, 0x11, 0xd2, 0x88, 0x8e, 0x8a, 0x97, 0xbb, 0x63, 0xcf, 0x67, 0xd2, 0x2c,
+	0xdf, 0xed, 0x71, 0x31, 0xe2, 0xa2, 0xd3, 0xa5, 0x82, 0x69, 0x70, 0x9d, 0xc3, 0x07, 0x5d, 0x26,
+	0xa9, 0xda, 0xc6, 0x1f, 0x04, 0xe8, 0x4a, 0xeb, 0xda, 0xdb, 0xd0, 0xfa, 0x81, 0xd2, 0x70, 0x98,
+	0x60, 0xd1, 0x21, 0xf3, 0x3e, 0xa0, 0x23, 0x26, 0x42, 0xda, 0x63, 0xc2, 0x61, 0xcf, 0xc7, 0x4c,
+	0x48, 0xdb, 0x83, 0xb5, 0x99, 0x45, 0x87, 0x06, 0x3e, 0x23, 0x04, 0xca, 0x01, 0x1d, 0xb1, 0x86,
+	0xb5, 0x6d, 0xed, 0xd6, 0x1c, 0x1c, 0x93, 0xab, 0xb0, 0x30, 0x1a, 0x04, 0x8d, 0xd2, 0xb6, 0xb5,
+	0xbb, 0xec, 0xa8, 0x21, 0x4a, 0xe8, 0xa4, 0xb1, 0x60, 0x24, 0x74, 0xa2, 0xec, 0x7a, 0xdc, 0x63,
+	0x8d, 0xf2, 0xb6, 0xb5, 0x5b, 0x77, 0x70, 0x6c, 0xff, 0xd1, 0x82, 0xad, 0x42, 0x20, 0x22, 0xe4,
+	0x81, 0x60, 0xe4, 0x31, 0x54, 0x22, 0xe5, 0x58, 0x34, 0xac, 0xed, 0x85, 0xdd, 0xa5, 0xbd, 0xdd,
+	0x76, 0x3e, 0xd8, 0xed, 0xf9, 0x48, 0xf7, 0xcb, 0x9f, 0xfe, 0x73, 0xeb, 0x92, 0x63, 0xac, 0xc9,
+	0x3d, 0x20, 0x41, 0xbc, 0xee, 0x1e, 0xb2, 0x48, 0xa8, 0xc8, 0x37, 0x4a, 0xdb, 0x0b, 0xbb, 0x75,
+	0x67, 0x35, 0x59, 0xf9, 0x91, 0x59, 0xb0, 0x37, 0xe1, 0x26, 0x22, 0xfb, 0x2e, 0x3d, 0xe6, 0x63,
+	0xf9, 0x88, 0x07, 0x42, 0xd2, 0x40, 0x26, 0xf1, 0xf9, 0x4f, 0x09, 0x36, 0xe6, 0xaf, 0x1b, 0xd8,
+	0x5b, 0xb0, 0x44, 0xc3, 0x30, 0x76, 0x84, 0xd1, 0x2a, 0x3b, 0x40, 0xc3, 0xd0, 0x78, 0x20, 0xb7,
+	0xe1, 0x8a, 0xe8, 0xd3, 0x68, 0x06, 0x4b, 0x1d, 0xa5, 0x31, 0x0e, 0xa5, 0x36, 0x85, 0x2d, 0x06,
+	0x1f, 0x31, 0x8c, 0x69, 0xd9, 0xa9, 0x27, 0xd2, 0x83, 0xc1, 0x47, 0x8c, 0x6c, 0x02, 0xe8, 0xdd,
+	0x50, 0xa5, 0x8c, 0x2a, 0x35, 0x94, 0xe0, 0xf2, 0x43, 0x58, 0x17, 0xcf, 0xc7, 0xf1, 0xba, 0x3b,
+	0x0e, 0x43, 0x16, 0xb9, 0x5d, 0x3e, 0x0e, 0xbc, 0xc6, 0x22, 0xea, 0x5e, 0xd3, 0xcb, 0x4a, 0xf9,
+	0x87, 0x6a, 0x71, 0x5f, 0xad, 0x91, 0x37, 0x61, 0x65, 0x44, 0x27, 0x6e, 0xca, 0xb4, 0x51, 0xd1,
+	0xde, 0x47, 0x74, 0x72, 0x90, 0x58, 0x90, 0x2f, 0xc3, 0x9a, 0x18, 0x77, 0x65, 0xc4, 0x98, 0x1b,
+	0x71, 0x2e, 0x5d, 0xd9, 0x8f, 0x98, 0xe8, 0xf3, 0xa1, 0xd7, 0xb8, 0x6c, 0x76, 0xd7, 0xab, 0x0e,
+	0xe7, 0xf2, 0xc3, 0x78, 0x8d, 0xdc, 0x81, 0xab, 0xbd, 0x38, 0x6e, 0xae, 0x37, 0xf0, 0x99, 0x90,
+	0x8d, 0x2a, 0xb2, 0x6a, 0x25, 0x91, 0xbf, 0x87, 0x62, 0xfb, 0xab, 0xb0, 0x8e, 0xd1, 0xfe, 0x96,
+	0x90, 0x83, 0x11, 0x95, 0xec, 0x09, 0x8d, 0x33, 0xa1, 0x4e, 0xae, 0x78, 0x80, 0xe8, 0x34, 0x47,
+	0xea, 0x4e, 0x4d, 0x49, 0x14, 0x32, 0x61, 0xff, 0xcd, 0x82, 0xc6, 0xac, 0xa9, 0x49, 0xd2, 0x0d,
+	0xa8, 0xa2, 0xad, 0x4f, 0x85, 0xc9, 0xd0, 0x65, 0x35, 0x7f, 0x42, 0x85, 0x22, 0xb0, 0x92, 0x96,
+	0x50, 0xaa, 0x86, 0xe4, 0x0e, 0xac, 0xfa, 0x54, 0xb8, 0x18, 0x39, 0x65, 0xd4, 0x3d, 0x96, 0x3a,
+	0x19, 0x75, 0xe7, 0x8a, 0x4f, 0xc5, 0x53, 0x16, 0xed, 0x0f, 0x79, 0x77, 0xff, 0x58, 0x32, 0x72,
+	0x1f, 0xae, 0xcb, 0x89, 0x0e, 0x75, 0x8f, 0x0b, 0xa9, 0x6d, 0x8e, 0x65, 0x9c, 0x98, 0x55, 0x39,
+	0x51, 0xe0, 0x1e, 0x71, 0x21, 0x95, 0xd5, 0xb1, 0x64, 0xf6, 0x4f, 0x61, 0x13, 0x51, 0xaa, 0x2d,
+	0x9e, 0x46, 0x9c, 0x3f, 0x8b, 0xe1, 0xc6, 0xc7, 0xbc, 0x09, 0xb5, 0xe4, 0x98, 0x88, 0xb5, 0xee,
+	0x54, 0xe3, 0x53, 0x2a, 0xb2, 0xa5, 0x73, 0xa4, 0x41, 0xc3, 0x34, 0xa5, 0xf6, 0xc7, 0x25, 0x68,
+	0x15, 0xed, 0x3f, 0x25, 0x6c, 0x7a, 0x0f, 0x2b, 0xbf, 0x07, 0x59, 0x83, 0x0a, 0x12, 0x2a, 0x0e,
+	0x8a, 0x99, 0xa9, 0x8b, 0x1d, 0xf1, 0x23, 0x61, 0x78, 0x89, 0x63, 0x85, 0x36, 0x18, 0x49, 0x37,
+	0xe0, 0x1e, 0x13, 0xe6, 0xd0, 0xd5, 0x60, 0x24, 0x3f, 0x50, 0x73, 0xc5, 0xaa, 0x88, 0x1f, 0xb9,
+	0xa1, 0x82, 0xe1, 0xd2, 0x71, 0x20, 0x85, 0x21, 0x61, 0x3d, 0xe2, 0x47, 0x08, 0xee, 0x9b, 0x4a,
+	0xa8, 0x32, 0xeb, 0x51, 0x49, 0x31, 0x72, 0xc2, 0x10, 0xaf, 0xa6, 0x24, 0x2a, 0x62, 0x42, 0x01,
+	0xd6, 0x5b, 0xe8, 0x75, 0xcd, 0x34, 0x40, 0x91, 0x56, 0xd8, 0x04, 0xe8, 0x53, 0xd1, 0x77, 0x7b,
+	0x7c, 0x1c, 0x68, 0x66, 0x95, 0x9d, 0x9a, 0x92, 0x3c, 0x52, 0x02, 0xfb, 0x1a, 0x10, 0x0c, 0xc9,
+	0x53, 0x2c, 0xb2, 0xf1, 0xc5, 0xfe, 0x1e, 0x7c, 0x29, 0x23, 0x35, 0xd1, 0xf9, 0x0a, 0x54, 0x74,
+	0x31, 0xc6, 0xc0, 0x2c, 0xed, 0x35, 0x66, 0xab, 0x90, 0xb6, 0x88, 0xab, 0x8e, 0xd6, 0xb6, 0x1f,
+	0xc2, 0x8d, 0x24, 0xee, 0x8f, 0x19, 0xdb, 0xc7, 0x8a, 0x1d, 0xe7, 0xb4, 0x01, 0x97, 0xa9, 0xe7,
+	0x45, 0x4c, 0x08, 0x53, 0x4d, 0xe3, 0xa9, 0xfd, 0x13, 0x68, 0xce, 0x33, 0x33, 0x60, 0xbe, 0x0e,
+	0x15, 0x5d, 0xfa, 0x0d, 0x98, 0xad, 0x59, 0x30, 0x19, 0xc3, 0x18, 0x93, 0x36, 0xb2, 0x47, 0x70,
+	0x3d, 0xcb, 0x85, 0x18, 0xcf, 0x1a, 0x54, 0xfa, 0x6c, 0xe0, 0xf7, 0xf5, 0xbe, 0x0b, 0x8e, 0x99,
+	0x91, 0x0d, 0xa8, 0x25, 0xd5, 0xc6, 0x14, 0xf9, 0xa9, 0x80, 0xb4, 0x00, 0x7a, 0x7c, 0x34, 0x1a,
+	0xc8, 0x11, 0x0b, 0xa4, 0xa9, 0xf8, 0x29, 0x89, 0xfd, 0x1b, 0x0b, 0xd6, 0xf2, 0xfe, 0xcc, 0x41,
+	0xae, 0xc1, 0x22, 0xe6, 0x0b, 0xfd, 0x2d, 0x3b, 0x7a, 0x82, 0x4c, 0x94, 0x34, 0x92, 0x2e, 0x12,
+	0x0c, 0x1d, 0xd6, 0x1d, 0x40, 0xd1, 0x81, 0x92, 0x28, 0x76, 0xb1, 0xc0, 0x33, 0xcb, 0xfa, 0x06,
+	0x56, 0x59, 0xe0, 0x25, 0x8b, 0xc8, 0x1a, 0x55, 0x88, 0x90, 0x7a, 0xcb, 0x4e, 0x55, 0x09, 0x54,
+	0xed, 0xb1, 0xff, 0x64, 0x99, 0xb2, 0x9e, 0x3c, 0x15, 0x68, 0x24, 0x5e, 0x2d, 0x02, 0x8f, 0x01,
+	0xa6, 0x4f, 0x2c, 0x02, 0x5a, 0xda, 0x7b, 0xb3, 0xad, 0xdf, 0xe3, 0x76, 0x97, 0x0a, 0xd6, 0xd6,
+	0xcd, 0x82, 0x79, 0x8f, 0xdb, 0x4f, 0xa9, 0x1f, 0xdf, 0x6b, 0x27, 0x65, 0x69, 0x4f, 0xe0, 0x4a,
+	0x16, 0x97, 0xba, 0x5b, 0x0a, 0xbb, 0x89, 0x0f, 0x8e, 0x55, 0xd0, 0x06, 0x81, 0xc7, 0x26, 0x26,
+	0x30, 0x7a, 0xa2, 0xea, 0x55, 0xc4, 0x8f, 0x4c, 0x34, 0xd4, 0x50, 0x49, 0x7a, 0x7c, 0x68, 0xde,
+	0x5b, 0x35, 0x54, 0x7c, 0x0b, 0xa9, 0xe7, 0x0d, 0x02, 0x1f, 0x2f, 0x5c, 0xd5, 0x89, 0xa7, 0xf6,
+	0xbf, 0x2d, 0xf3, 0x9c, 0xcd, 0xc4, 0xc5, 0x64, 0xea, 0x1b, 0xc9, 0xe5, 0xd7, 0xaf, 0xf0, 0xf6,
+	0x2c, 0xe5, 0xb2, 0xa6, 0x31, 0xe7, 0x4c, 0x91, 0x38, 0xaf, 0x42, 0x65, 0xd3, 0xb6, 0x90, 0x4d,
+	0x1b, 0x79, 0x92, 0x09, 0x70, 0x19, 0x03, 0xfc, 0xd6, 0xb9, 0x01, 0xd6, 0xd0, 0x33, 0x11, 0xfe,
+	0x3e, 0xac, 0xe2, 0x31, 0x11, 0xe2, 0x79, 0x49, 0x37, 0x21, 0x2d, 0xcd, 0x84, 0x74, 0x21, 0x09,
+	0xa9, 0xfd, 0x73, 0x20, 0xe9, 0x0d, 0xa7, 0xbc, 0xd6, 0xe4, 0x34, 0xbc, 0xc6, 0xc9, 0x94, 0xed,
+	0xa5, 0x3c, 0xdb, 0x53, 0x91, 0x59, 0x38, 0x3b, 0x32, 0x79, 0x42, 0xbf, 0x6b, 0xea, 0xcb, 0x87,
+	0x13, 0x8d, 0x00, 0x7b, 0x9d, 0x73, 0x0e, 0x66, 0x33, 0x58, 0x4e, 0xeb, 0x93, 0x75, 0xb8, 0x2c,
+	0x27, 0xae, 0xaa, 0x8c, 0x06, 0x70, 0x45, 0x4e, 0xbe, 0x4d, 0x45, 0xff, 0xd5, 0x6e, 0xa2, 0xea,
+	0xee, 0x9a, 0xf3, 0xc0, 0x99, 0x20, 0x7d, 0x2d, 0xd7, 0xd8, 0xb5, 0x66, 0x29, 0x95, 0x36, 0xcc,
+	0xb5, 0x73, 0xaf, 0x44, 0x28, 0xfb, 0x63, 0x0b, 0x56, 0x93, 0x9a, 0xf4, 0x9a, 0xdc, 0xfe, 0xdf,
+	0x96, 0xa0, 0xaa, 0xe0, 0xbc, 0x1f, 0x3c, 0xe3, 0x59, 0x97, 0x56, 0xde, 0x25, 0x81, 0x72, 0x72,
+	0xea, 0xba, 0x83, 0x63, 0x72, 0x0b, 0xea, 0x99, 0x7e, 0xd2, 0xa4, 0x63, 0x39, 0xdd, 0x4e, 0xe6,
+	0x6a, 0x75, 0x39, 0x5f, 0xab, 0xf3, 0x09, 0x5f, 0x3c, 0x3b, 0xe1, 0x95, 0x5c, 0xe9, 0x55, 0x1d,
+	0xc2, 0xc0, 0x0f, 0x58, 0x84, 0x8f, 0x71, 0xcd, 0x31, 0xb3, 0x34, 0xbf, 0xaa, 0x19, 0x7e, 0xc5,
+	0xbd, 0x9b, 0xae, 0x67, 0x35, 0xdc, 0x0e, 0xdb, 0x9c, 0xf7, 0x95, 0xc0, 0xfe, 0xdc, 0x32, 0xb7,
+	0xcb, 0x64, 0x29, 0x79, 0x8b, 0x17, 0x95, 0x4e, 0xcc, 0x9b, 0xe6, 0xfc, 0xd7, 0x4f, 0x85, 0xd1,
+	0x70, 0x46, 0xab, 0xbf, 0x2e, 0x35, 0xe8, 0xa1, 0x79, 0x82, 0xf6, 0x87, 0xbc, 0xf7, 0xb3, 0xf7,
+	0xa8, 0xa4, 0x07, 0x92, 0xca, 0xf1, 0xb9, 0x97, 0xf6, 0x00, 0x36, 0xe6, 0x9b, 0x99, 0xa8, 0xac,
+	0x41, 0x25, 0x8c, 0xc6, 0x01, 0xf3, 0xd0, 0xae, 0xea, 0x98, 0x59, 0xf6, 0x50, 0xa5, 0xec, 0xa1,
+	0xf6, 0xfe, 0x5b, 0x81, 0x45, 0xdc, 0x95, 0x04, 0x50, 0xd1, 0x0d, 0x0c, 0xd9, 0x99, 0x8d, 0xe7,
+	0x6c, 0x9f, 0xd4, 0xbc, 0x7d, 0x8e, 0x96, 0x46, 0x65, 0xaf, 0xff, 0xf2, 0xf3, 0x7f, 0x7d, 0x52,
+	0x5a, 0x25, 0x2b, 0xb9, 0x6f, 0x5a, 0xf2, 0x07, 0x0b, 0xea, 0x99, 0x26, 0x85, 0xbc, 0x5d, 0xb0,
+	0xe3, 0xbc, 0xd6, 0xa9, 0xf9, 0xce, 0xc5, 0x94, 0x0d, 0x8a, 0xbb, 0x88, 0x62, 0x87, 0xd8, 0xd3,
+	0x4f, 0x67, 0x45, 0xbb, 0x67, 0x8c, 0xb9, 0xba, 0x27, 0xea, 0x9c, 0x98, 0xce, 0xeb, 0x94, 0xfc,
+	0xdd, 0x82, 0xd5, 0x99, 0x2e, 0x99, 0x74, 0xce, 0xf0, 0x37, 0xaf, 0x5f, 0x6f, 0xde, 0xbf, 0xb8,
+	0x81, 0x01, 0x79, 0x1f, 0x41, 0xde, 0x25, 0xbb, 0x59, 0x90, 0xba, 0xc7, 0x65, 0x46, 0xbb, 0x73,
+	0x92, 0x7c, 0x05, 0x9c, 0x92, 0x5f, 0x59, 0xb0, 0x94, 0xfa, 0xac, 0x21, 0x77, 0x0a, 0x7c, 0xce,
+	0x7e, 0x35, 0x35, 0xef, 0x5e, 0x44, 0xd5, 0x00, 0xdb, 0x44, 0x60, 0xeb, 0xe4, 0x7a, 0x02, 0x2c,
+	0x46, 0xa3, 0x3e, 0x9c, 0xc8, 0x27, 0x16, 0xac, 0xe4, 0xbe, 0x82, 0xc9, 0xbd, 0x82, 0xed, 0xe7,
+	0x7f, 0x4d, 0x37, 0xdb, 0x17, 0x55, 0x37, 0x88, 0xde, 0x40, 0x44, 0x37, 0xc9, 0x8d, 0x04, 0xd1,
+	0x10, 0x35, 0xdd, 0xe4, 0xbb, 0x91, 0xfc, 0xd5, 0x02, 0x32, 0xfb, 0x57, 0x81, 0x14, 0xa5, 0xa5,
+	0xf0, 0x4f, 0x48, 0xf3, 0xc1, 0xff, 0x61, 0x61, 0xe0, 0xed, 0x20, 0xbc, 0x16, 0xd9, 0x48, 0xe0,
+	0x45, 0x46, 0xd9, 0x4d, 0x6a, 0xb8, 0xd8, 0xfb, 0xa2, 0x02, 0x80, 0x4c, 0xd0, 0x17, 0xf0, 0xd7,
+	0x16, 0xd4, 0x12, 0x72, 0x90, 0xb7, 0xce, 0xa3, 0x4f, 0x0c, 0x6f, 0xf7, 0x7c, 0xc5, 0x42, 0x54,
+	0x53, 0x7e, 0x75, 0x4e, 0x74, 0x95, 0x39, 0x25, 0x7f, 0xb6, 0x60, 0x25, 0xd7, 0x04, 0x16, 0x66,
+	0x73, 0x7e, 0x13, 0xdd, 0x6c, 0x5f, 0x54, 0xbd, 0xf0, 0x76, 0xa6, 0xfe, 0x78, 0xa0, 0xea, 0x14,
+	0xde, 0x2f, 0x60, 0x51, 0xbf, 0x35, 0xb7, 0x0a, 0x9c, 0xa4, 0x3b, 0xbb, 0xe6, 0xce, 0xd9, 0x4a,
+	0xc6, 0xff, 0xdb, 0xe8, 0xff, 0x36, 0xb9, 0x95, 0xf8, 0x47, 0xaf, 0x89, 0xd3, 0xce, 0x49, 0xc4,
+	0x8f, 0x4e, 0x3b, 0x27, 0x3d, 0x3e, 0x3c, 0x25, 0xbf, 0xb7, 0xa0, 0x9e, 0xe9, 0x67, 0x0a, 0xeb,
+	0xd6, 0xbc, 0x96, 0xac, 0xf9, 0xce, 0xc5, 0x94, 0x0d, 0xb2, 0x3b, 0x88, 0xec, 0x16, 0x79, 0x23,
+	0x41, 0xa6, 0x7e, 0x2b, 0xe0, 0x2b, 0xaf, 0xdb, 0xa0, 0x69, 0x60, 0x04, 0x2c, 0xe2, 0x2b, 0x59,
+	0x18, 0x98, 0x74, 0xa7, 0xd3, 0xdc, 0x39, 0x5b, 0xc9, 0xb8, 0xdf, 0x42, 0xf7, 0x37, 0xc8, 0x7a,
+	0x86, 0x31, 0x29, 0xa7, 0x7f, 0xb1, 0x60, 0x25, 0xf7, 0x1e, 0x15, 0x92, 0x65, 0xfe, 0x73, 0xd7,
+	0x6c, 0x5f, 0x54, 0xbd, 0x30, 0x59, 0x5d, 0xa5, 0xe9, 0xe2, 0x1b, 0x27, 0x50, 0x37, 0xc1, 0xb7,
+	0xff, 0x9d, 0x4f, 0x5f, 0xb4, 0xac, 0xcf, 0x5e, 0xb4, 0xac, 0x2f, 0x5e, 0xb4, 0xac, 0xdf, 0xbd,
+	0x6c, 0x5d, 0xfa, 0xec, 0x65, 0xeb, 0xd2, 0x3f, 0x5e, 0xb6, 0x2e, 0xfd, 0xf8, 0xbe, 0x3f, 0x90,
+	0xfd, 0x71, 0xb7, 0xdd, 0xe3, 0xa3, 0x4e, 0x0c, 0x80, 0x47, 0x7e, 0x32, 0xbe, 0x47, 0xc3, 0xb0,
+	0x33, 0xd1, 0x3e, 0xe4, 0x71, 0xc8, 0x44, 0xb7, 0x82, 0xbf, 0x4e, 0xdf, 0xfd, 0xdf, 0x00, 0x3a,
+	0x91, 0xc1, 0x4c, 0x0a, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BlobFeeBudget queries the blob fee budget of an account.
+	BlobFeeBudget(ctx context.Context, in *QueryBlobFeeBudgetRequest, opts ...grpc.CallOption) (*QueryBlobFeeBudgetResponse, error)
+	// BlobProofEstimate queries the expected size and verification cost of the
+	// inclusion proof of a blob of a given size.
+	BlobProofEstimate(ctx context.Context, in *QueryBlobProofEstimateRequest, opts ...grpc.CallOption) (*QueryBlobProofEstimateResponse, error)
+	// EstimateGas queries the gas that a PFB with blobs of the given sizes
+	// consumes, derived from the gas constants of the current app version and
+	// params.
+	EstimateGas(ctx context.Context, in *QueryEstimateGasRequest, opts ...grpc.CallOption) (*QueryEstimateGasResponse, error)
+	// LayoutConstants queries the share versions and the constants of the
+	// layout of the data square of the current app version.
+	LayoutConstants(ctx context.Context, in *QueryLayoutConstantsRequest, opts ...grpc.CallOption) (*QueryLayoutConstantsResponse, error)
+	// ReservedNamespaces queries the namespaces that blobs can't use and the
+	// codes of the errors that PFBs using them are rejected with.
+	ReservedNamespaces(ctx context.Context, in *QueryReservedNamespacesRequest, opts ...grpc.CallOption) (*QueryReservedNamespacesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlobFeeBudget(ctx context.Context, in *QueryBlobFeeBudgetRequest, opts ...grpc.CallOption) (*QueryBlobFeeBudgetResponse, error) {
+	out := new(QueryBlobFeeBudgetResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Query/BlobFeeBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlobProofEstimate(ctx context.Context, in *QueryBlobProofEstimateRequest, opts ...grpc.CallOption) (*QueryBlobProofEstimateResponse, error) {
+	out := new(QueryBlobProofEstimateResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Query/BlobProofEstimate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateGas(ctx context.Context, in *QueryEstimateGasRequest, opts ...grpc.CallOption) (*QueryEstimateGasResponse, error) {
+	out := new(QueryEstimateGasResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Query/EstimateGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LayoutConstants(ctx context.Context, in *QueryLayoutConstantsRequest, opts ...grpc.CallOption) (*QueryLayoutConstantsResponse, error) {
+	out := new(QueryLayoutConstantsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Query/LayoutConstants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReservedNamespaces(ctx context.Context, in *QueryReservedNamespacesRequest, opts ...grpc.CallOption) (*QueryReservedNamespacesResponse, error) {
+	out := new(QueryReservedNamespacesResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Query/ReservedNamespaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BlobFeeBudget queries the blob fee budget of an account.
+	BlobFeeBudget(context.Context, *QueryBlobFeeBudgetRequest) (*QueryBlobFeeBudgetResponse, error)
+	// BlobProofEstimate queries the expected size and verification cost of the
+	// inclusion proof of a blob of a given size.
+	BlobProofEstimate(context.Context, *QueryBlobProofEstimateRequest) (*QueryBlobProofEstimateResponse, error)
+	// EstimateGas queries the gas that a PFB with blobs of the given sizes
+	// consumes, derived from the gas constants of the current app version and
+	// params.
+	EstimateGas(context.Context, *QueryEstimateGasRequest) (*QueryEstimateGasResponse, error)
+	// LayoutConstants queries the share versions and the constants of the
+	// layout of the data square of the current app version.
+	LayoutConstants(context.Context, *QueryLayoutConstantsRequest) (*QueryLayoutConstantsResponse, error)
+	// ReservedNamespaces queries the namespaces that blobs can't use and the
+	// codes of the errors that PFBs using them are rejected with.
+	ReservedNamespaces(context.Context, *QueryReservedNamespacesRequest) (*QueryReservedNamespacesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) BlobFeeBudget(ctx context.Context, req *QueryBlobFeeBudgetRequest) (*QueryBlobFeeBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobFeeBudget not implemented")
+}
+func (*UnimplementedQueryServer) BlobProofEstimate(ctx context.Context, req *QueryBlobProofEstimateRequest) (*QueryBlobProofEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobProofEstimate not implemented")
+}
+func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *QueryEstimateGasRequest) (*QueryEstimateGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
+}
+func (*UnimplementedQueryServer) LayoutConstants(ctx context.Context, req *QueryLayoutConstantsRequest) (*QueryLayoutConstantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LayoutConstants not implemented")
+}
+func (*UnimplementedQueryServer) ReservedNamespaces(ctx context.Context, req *QueryReservedNamespacesRequest) (*QueryReservedNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReservedNamespaces not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlobFeeBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobFeeBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlobFeeBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Query/BlobFeeBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlobFeeBudget(ctx, req.(*QueryBlobFeeBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlobProofEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobProofEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlobProofEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Query/BlobProofEstimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlobProofEstimate(ctx, req.(*QueryBlobProofEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Query/EstimateGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateGas(ctx, req.(*QueryEstimateGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LayoutConstants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLayoutConstantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LayoutConstants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Query/LayoutConstants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LayoutConstants(ctx, req.(*QueryLayoutConstantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReservedNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReservedNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReservedNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Query/ReservedNamespaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReservedNamespaces(ctx, req.(*QueryReservedNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.blob.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "BlobFeeBudget",
+			Handler:    _Query_BlobFeeBudget_Handler,
+		},
+		{
+			MethodName: "BlobProofEstimate",
+			Handler:    _Query_BlobProofEstimate_Handler,
+		},
+		{
+			MethodName: "EstimateGas",
+			Handler:    _Query_EstimateGas_Handler,
+		},
+		{
+			MethodName: "LayoutConstants",
+			Handler:    _Query_LayoutConstants_Handler,
+		},
+		{
+			MethodName: "ReservedNamespaces",
+			Handler:    _Query_ReservedNamespaces_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/blob/v1/query.proto",
+}
+
+// ProofQueryClient is the client API for ProofQuery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProofQueryClient interface {
+	// BlobProof queries the inclusion proof of the shares of a committed blob to
+	// the data root of its block.
+	BlobProof(ctx context.Context, in *QueryBlobProofRequest, opts ...grpc.CallOption) (*QueryBlobProofResponse, error)
+	// NamespaceShares queries the shares of a namespace in the original data
+	// square of a committed block and their positions.
+	NamespaceShares(ctx context.Context, in *QueryNamespaceSharesRequest, opts ...grpc.CallOption) (*QueryNamespaceSharesResponse, error)
+	// Share queries a share of the original data square of a committed block
+	// with its NMT proof to its row root and the proof of the row root to the
+	// data root, so that the share can be verified against the data root alone.
+	Share(ctx context.Context, in *QueryShareRequest, opts ...grpc.CallOption) (*QueryShareResponse, error)
+	// TxShareRanges queries the ranges of the shares of the original data square
+	// of a committed block that its txs occupy, e.g. to prove the inclusion of a
+	// tx to the data root.
+	TxShareRanges(ctx context.Context, in *QueryTxShareRangesRequest, opts ...grpc.CallOption) (*QueryTxShareRangesResponse, error)
+	// Blobs queries the metadata of the blobs of a committed block, optionally
+	// of a single namespace, in the order of their shares.
+	Blobs(ctx context.Context, in *QueryBlobsRequest, opts ...grpc.CallOption) (*QueryBlobsResponse, error)
+	// BlockDataStatus queries whether the node has pruned the blob data of a
+	// committed block while retaining its header.
+	BlockDataStatus(ctx context.Context, in *QueryBlockDataStatusRequest, opts ...grpc.CallOption) (*QueryBlockDataStatusResponse, error)
+}
+
+type proofQueryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewProofQueryClient(cc grpc1.ClientConn) ProofQueryClient {
+	return &proofQueryClient{cc}
+}
+
+func (c *proofQueryClient) BlobProof(ctx context.Context, in *QueryBlobProofRequest, opts ...grpc.CallOption) (*QueryBlobProofResponse, error) {
+	out := new(QueryBlobProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.ProofQuery/BlobProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proofQueryClient) NamespaceShares(ctx context.Context, in *QueryNamespaceSharesRequest, opts ...grpc.CallOption) (*QueryNamespaceSharesResponse, error) {
+	out := new(QueryNamespaceSharesResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.ProofQuery/NamespaceShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proofQueryClient) Share(ctx context.Context, in *QueryShareRequest, opts ...grpc.CallOption) (*QueryShareResponse, error) {
+	out := new(QueryShareResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.ProofQuery/Share", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proofQueryClient) TxShareRanges(ctx context.Context, in *QueryTxShareRangesRequest, opts ...grpc.CallOption) (*QueryTxShareRangesResponse, error) {
+	out := new(QueryTxShareRangesResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.ProofQuery/TxShareRanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proofQueryClient) Blobs(ctx context.Context, in *QueryBlobsRequest, opts ...grpc.CallOption) (*QueryBlobsResponse, error) {
+	out := new(QueryBlobsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.ProofQuery/Blobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proofQueryClient) BlockDataStatus(ctx context.Context, in *QueryBlockDataStatusRequest, opts ...grpc.CallOption) (*QueryBlockDataStatusResponse, error) {
+	out := new(QueryBlockDataStatusResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.ProofQuery/BlockDataStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProofQueryServer is the server API for ProofQuery service.
+type ProofQueryServer interface {
+	// BlobProof queries the inclusion proof of the shares of a committed blob to
+	// the data root of its block.
+	BlobProof(context.Context, *QueryBlobProofRequest) (*QueryBlobProofResponse, error)
+	// NamespaceShares queries the shares of a namespace in the original data
+	// square of a committed block and their positions.
+	NamespaceShares(context.Context, *QueryNamespaceSharesRequest) (*QueryNamespaceSharesResponse, error)
+	// Share queries a share of the original data square of a committed block
+	// with its NMT proof to its row root and the proof of the row root to the
+	// data root, so that the share can be verified against the data root alone.
+	Share(context.Context, *QueryShareRequest) (*QueryShareResponse, error)
+	// TxShareRanges queries the ranges of the shares of the original data square
+	// of a committed block that its txs occupy, e.g. to prove the inclusion of a
+	// tx to the data root.
+	TxShareRanges(context.Context, *QueryTxShareRangesRequest) (*QueryTxShareRangesResponse, error)
+	// Blobs queries the metadata of the blobs of a committed block, optionally
+	// of a single namespace, in the order of their shares.
+	Blobs(context.Context, *QueryBlobsRequest) (*QueryBlobsResponse, error)
+	// BlockDataStatus queries whether the node has pruned the blob data of a
+	// committed block while retaining its header.
+	BlockDataStatus(context.Context, *QueryBlockDataStatusRequest) (*QueryBlockDataStatusResponse, error)
+}
+
+// UnimplementedProofQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedProofQueryServer struct {
+}
+
+func (*UnimplementedProofQueryServer) BlobProof(ctx context.Context, req *QueryBlobProofRequest) (*QueryBlobProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobProof not implemented")
+}
+func (*UnimplementedProofQueryServer) NamespaceShares(ctx context.Context, req *QueryNamespaceSharesRequest) (*QueryNamespaceSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceShares not implemented")
+}
+func (*UnimplementedProofQueryServer) Share(ctx context.Context, req *QueryShareRequest) (*QueryShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Share not implemented")
+}
+func (*UnimplementedProofQueryServer) TxShareRanges(ctx context.Context, req *QueryTxShareRangesRequest) (*QueryTxShareRangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxShareRanges not implemented")
+}
+func (*UnimplementedProofQueryServer) Blobs(ctx context.Context, req *QueryBlobsRequest) (*QueryBlobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Blobs not implemented")
+}
+func (*UnimplementedProofQueryServer) BlockDataStatus(ctx context.Context, req *QueryBlockDataStatusRequest) (*QueryBlockDataStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockDataStatus not implemented")
+}
+
+func RegisterProofQueryServer(s grpc1.Server, srv ProofQueryServer) {
+	s.RegisterService(&_ProofQuery_serviceDesc, srv)
+}
+
+func _ProofQuery_BlobProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProofQueryServer).BlobProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.ProofQuery/BlobProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProofQueryServer).BlobProof(ctx, req.(*QueryBlobProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProofQuery_NamespaceShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamespaceSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProofQueryServer).NamespaceShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.ProofQuery/NamespaceShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProofQueryServer).NamespaceShares(ctx, req.(*QueryNamespaceSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProofQuery_Share_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProofQueryServer).Share(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.ProofQuery/Share",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProofQueryServer).Share(ctx, req.(*QueryShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProofQuery_TxShareRanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxShareRangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProofQueryServer).TxShareRanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.ProofQuery/TxShareRanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProofQueryServer).TxShareRanges(ctx, req.(*QueryTxShareRangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProofQuery_Blobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProofQueryServer).Blobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.ProofQuery/Blobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProofQueryServer).Blobs(ctx, req.(*QueryBlobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProofQuery_BlockDataStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockDataStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProofQueryServer).BlockDataStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.ProofQuery/BlockDataStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProofQueryServer).BlockDataStatus(ctx, req.(*QueryBlockDataStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProofQuery_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.blob.v1.ProofQuery",
+	HandlerType: (*ProofQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlobProof",
+			Handler:    _ProofQuery_BlobProof_Handler,
+		},
+		{
+			MethodName: "NamespaceShares",
+			Handler:    _ProofQuery_NamespaceShares_Handler,
+		},
+		{
+			MethodName: "Share",
+			Handler:    _ProofQuery_Share_Handler,
+		},
+		{
+			MethodName: "TxShareRanges",
+			Handler:    _ProofQuery_TxShareRanges_Handler,
+		},
+		{
+			MethodName: "Blobs",
+			Handler:    _ProofQuery_Blobs_Handler,
+		},
+		{
+			MethodName: "BlockDataStatus",
+			Handler:    _ProofQuery_BlockDataStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/blob/v1/query.proto",
+}
+
+func (m *QueryReservedNamespacesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReservedNamespacesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservedNamespacesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ReservedNamespaceRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReservedNamespaceRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReservedNamespaceRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReservedNamespacesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReservedNamespacesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservedNamespacesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NamespaceVersions) > 0 {
+		dAtA2 := make([]byte, len(m.NamespaceVersions)*10)
+		var j1 int
+		for _, num := range m.NamespaceVersions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintQuery(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ranges) > 0 {
+		for iNdEx := len(m.Ranges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ranges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLayoutConstantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLayoutConstantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLayoutConstantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLayoutConstantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLayoutConstantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLayoutConstantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConstantsDigest) > 0 {
+		i -= len(m.ConstantsDigest)
+		copy(dAtA[i:], m.ConstantsDigest)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConstantsDigest)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SubtreeRootThreshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubtreeRootThreshold))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxSquareSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxSquareSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SquareSizeUpperBound != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SquareSizeUpperBound))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ShareSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ShareSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NamespaceSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NamespaceSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ShareVersions) > 0 {
+		dAtA4 := make([]byte, len(m.ShareVersions)*10)
+		var j3 int
+		for _, num := range m.ShareVersions {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintQuery(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AppVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateGasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateGasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlobSizes) > 0 {
+		dAtA6 := make([]byte, len(m.BlobSizes)*10)
+		var j5 int
+		for _, num := range m.BlobSizes {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintQuery(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxSizeCostPerByte != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxSizeCostPerByte))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasPerBlobByte != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasPerBlobByte))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlobGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlobGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobProofEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobProofEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobProofEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SquareSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SquareSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlobSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlobSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobProofEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobProofEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobProofEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HashCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HashCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ProofBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProofBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DataBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DataBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RowProofAunts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RowProofAunts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NmtNodes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NmtNodes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Rows != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Rows))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Shares != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Shares))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SquareSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SquareSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobFeeBudgetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobFeeBudgetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobFeeBudgetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobFeeBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobFeeBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobFeeBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Budget.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndShare != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndShare))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartShare != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartShare))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespaceSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespaceShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Padding {
+		i--
+		if m.Padding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Col != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Col))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Row != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Row))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespaceSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SquareSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SquareSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Col != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Col))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Row != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Row))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SquareSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SquareSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxShareRangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxShareRangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxShareRangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxShareRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxShareRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxShareRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndShare != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndShare))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartShare != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartShare))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxShareRangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxShareRangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxShareRangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SquareSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SquareSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ranges) > 0 {
+		for iNdEx := len(m.Ranges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ranges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlobIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlobIndex))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EndShare != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndShare))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartShare != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartShare))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ShareVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ShareVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SquareSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SquareSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockDataStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockDataStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockDataStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockDataStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockDataStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockDataStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pruned {
+		i--
+		if m.Pruned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryReservedNamespacesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReservedNamespaceRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovQuery(uint64(m.Code))
+	}
+	return n
+}
+
+func (m *QueryReservedNamespacesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ranges) > 0 {
+		for _, e := range m.Ranges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.NamespaceVersions) > 0 {
+		l = 0
+		for _, e := range m.NamespaceVersions {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryLayoutConstantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLayoutConstantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppVersion != 0 {
+		n += 1 + sovQuery(uint64(m.AppVersion))
+	}
+	if len(m.ShareVersions) > 0 {
+		l = 0
+		for _, e := range m.ShareVersions {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.NamespaceSize != 0 {
+		n += 1 + sovQuery(uint64(m.NamespaceSize))
+	}
+	if m.ShareSize != 0 {
+		n += 1 + sovQuery(uint64(m.ShareSize))
+	}
+	if m.SquareSizeUpperBound != 0 {
+		n += 1 + sovQuery(uint64(m.SquareSizeUpperBound))
+	}
+	if m.MaxSquareSize != 0 {
+		n += 1 + sovQuery(uint64(m.MaxSquareSize))
+	}
+	if m.SubtreeRootThreshold != 0 {
+		n += 1 + sovQuery(uint64(m.SubtreeRootThreshold))
+	}
+	l = len(m.ConstantsDigest)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlobSizes) > 0 {
+		l = 0
+		for _, e := range m.BlobSizes {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryEstimateGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlobGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlobGas))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	if m.GasPerBlobByte != 0 {
+		n += 1 + sovQuery(uint64(m.GasPerBlobByte))
+	}
+	if m.TxSizeCostPerByte != 0 {
+		n += 1 + sovQuery(uint64(m.TxSizeCostPerByte))
+	}
+	return n
+}
+
+func (m *QueryBlobProofEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlobSize != 0 {
+		n += 1 + sovQuery(uint64(m.BlobSize))
+	}
+	if m.SquareSize != 0 {
+		n += 1 + sovQuery(uint64(m.SquareSize))
+	}
+	return n
+}
+
+func (m *QueryBlobProofEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SquareSize != 0 {
+		n += 1 + sovQuery(uint64(m.SquareSize))
+	}
+	if m.Shares != 0 {
+		n += 1 + sovQuery(uint64(m.Shares))
+	}
+	if m.Rows != 0 {
+		n += 1 + sovQuery(uint64(m.Rows))
+	}
+	if m.NmtNodes != 0 {
+		n += 1 + sovQuery(uint64(m.NmtNodes))
+	}
+	if m.RowProofAunts != 0 {
+		n += 1 + sovQuery(uint64(m.RowProofAunts))
+	}
+	if m.DataBytes != 0 {
+		n += 1 + sovQuery(uint64(m.DataBytes))
+	}
+	if m.ProofBytes != 0 {
+		n += 1 + sovQuery(uint64(m.ProofBytes))
+	}
+	if m.HashCount != 0 {
+		n += 1 + sovQuery(uint64(m.HashCount))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlobFeeBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlobFeeBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Budget.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlobProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlobProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartShare != 0 {
+		n += 1 + sovQuery(uint64(m.StartShare))
+	}
+	if m.EndShare != 0 {
+		n += 1 + sovQuery(uint64(m.EndShare))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamespaceSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *NamespaceShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	if m.Row != 0 {
+		n += 1 + sovQuery(uint64(m.Row))
+	}
+	if m.Col != 0 {
+		n += 1 + sovQuery(uint64(m.Col))
+	}
+	if m.Padding {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryNamespaceSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SquareSize != 0 {
+		n += 1 + sovQuery(uint64(m.SquareSize))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Row != 0 {
+		n += 1 + sovQuery(uint64(m.Row))
+	}
+	if m.Col != 0 {
+		n += 1 + sovQuery(uint64(m.Col))
+	}
+	return n
+}
+
+func (m *QueryShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SquareSize != 0 {
+		n += 1 + sovQuery(uint64(m.SquareSize))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxShareRangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *TxShareRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartShare != 0 {
+		n += 1 + sovQuery(uint64(m.StartShare))
+	}
+	if m.EndShare != 0 {
+		n += 1 + sovQuery(uint64(m.EndShare))
+	}
+	return n
+}
+
+func (m *QueryTxShareRangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ranges) > 0 {
+		for _, e := range m.Ranges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SquareSize != 0 {
+		n += 1 + sovQuery(uint64(m.SquareSize))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BlobInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovQuery(uint64(m.Size_))
+	}
+	if m.ShareVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ShareVersion))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartShare != 0 {
+		n += 1 + sovQuery(uint64(m.StartShare))
+	}
+	if m.EndShare != 0 {
+		n += 1 + sovQuery(uint64(m.EndShare))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlobIndex != 0 {
+		n += 1 + sovQuery(uint64(m.BlobIndex))
+	}
+	return n
+}
+
+func (m *QueryBlobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SquareSize != 0 {
+		n += 1 + sovQuery(uint64(m.SquareSize))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockDataStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockDataStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pruned {
+		n += 2
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryReservedNamespacesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReservedNamespacesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReservedNamespacesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReservedNamespaceRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReservedNamespaceRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReservedNamespaceRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Min = append(m.Min[:0], dAtA[iNdEx:postIndex]...)
+			if m.Min == nil {
+				m.Min = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = append(m.Max[:0], dAtA[iNdEx:postIndex]...)
+			if m.Max == nil {
+				m.Max = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReservedNamespacesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReservedNamespacesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReservedNamespacesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ranges = append(m.Ranges, ReservedNamespaceRange{})
+			if err := m.Ranges[len(m.Ranges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NamespaceVersions = append(m.NamespaceVersions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.NamespaceVersions) == 0 {
+					m.NamespaceVersions = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NamespaceVersions = append(m.NamespaceVersions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceVersions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLayoutConstantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLayoutConstantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLayoutConstantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLayoutConstantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLayoutConstantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLayoutConstantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			m.AppVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShareVersions = append(m.ShareVersions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShareVersions) == 0 {
+					m.ShareVersions = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShareVersions = append(m.ShareVersions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersions", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceSize", wireType)
+			}
+			m.NamespaceSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NamespaceSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareSize", wireType)
+			}
+			m.ShareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSizeUpperBound", wireType)
+			}
+			m.SquareSizeUpperBound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SquareSizeUpperBound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSquareSize", wireType)
+			}
+			m.MaxSquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSquareSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeRootThreshold", wireType)
+			}
+			m.SubtreeRootThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubtreeRootThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstantsDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConstantsDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateGasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateGasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlobSizes = append(m.BlobSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlobSizes) == 0 {
+					m.BlobSizes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlobSizes = append(m.BlobSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobSizes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobGas", wireType)
+			}
+			m.BlobGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerBlobByte", wireType)
+			}
+			m.GasPerBlobByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerBlobByte |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			}
+			m.TxSizeCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobProofEstimateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobProofEstimateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobProofEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobSize", wireType)
+			}
+			m.BlobSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSize", wireType)
+			}
+			m.SquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SquareSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobProofEstimateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobProofEstimateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobProofEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSize", wireType)
+			}
+			m.SquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SquareSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			m.Shares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shares |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			m.Rows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NmtNodes", wireType)
+			}
+			m.NmtNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NmtNodes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowProofAunts", wireType)
+			}
+			m.RowProofAunts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowProofAunts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataBytes", wireType)
+			}
+			m.DataBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofBytes", wireType)
+			}
+			m.ProofBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashCount", wireType)
+			}
+			m.HashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobFeeBudgetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobFeeBudgetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobFeeBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobFeeBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobFeeBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobFeeBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartShare", wireType)
+			}
+			m.StartShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndShare", wireType)
+			}
+			m.EndShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamespaceSharesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceSharesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Col", wireType)
+			}
+			m.Col = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Col |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Padding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Padding = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamespaceSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, NamespaceShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSize", wireType)
+			}
+			m.SquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SquareSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Col", wireType)
+			}
+			m.Col = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Col |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSize", wireType)
+			}
+			m.SquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SquareSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxShareRangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxShareRangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxShareRangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxShareRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxShareRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxShareRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartShare", wireType)
+			}
+			m.StartShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndShare", wireType)
+			}
+			m.EndShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxShareRangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxShareRangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxShareRangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ranges = append(m.Ranges, TxShareRange{})
+			if err := m.Ranges[len(m.Ranges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSize", wireType)
+			}
+			m.SquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SquareSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersion", wireType)
+			}
+			m.ShareVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartShare", wireType)
+			}
+			m.StartShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndShare", wireType)
+			}
+			m.EndShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobIndex", wireType)
+			}
+			m.BlobIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlobsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, BlobInfo{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSize", wireType)
+			}
+			m.SquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SquareSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockDataStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -338,12 +7915,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockDataStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockDataStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -365,7 +7961,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockDataStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -388,17 +7984,37 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockDataStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockDataStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pruned = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -408,23 +8024,24 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
 			}
 			iNdEx = postIndex
 		default:
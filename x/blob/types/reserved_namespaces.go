@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+// minPrimaryReservedNamespace is the lowest primary reserved namespace.
+var minPrimaryReservedNamespace = share.MustNewNamespace(share.NamespaceVersionZero, make([]byte, share.NamespaceIDSize))
+
+// ReservedNamespaceRanges returns the ranges of the namespaces that
+// ValidateBlobNamespace rejects, in the order in which it checks them, so that
+// clients can validate the namespaces of their blobs before submitting them.
+// Every range is rejected with ErrReservedNamespace.
+func ReservedNamespaceRanges() []ReservedNamespaceRange {
+	return []ReservedNamespaceRange{
+		reservedNamespace("transaction", share.TxNamespace),
+		reservedNamespace("pay for blob", share.PayForBlobNamespace),
+		reservedNamespace("primary reserved padding", share.PrimaryReservedPaddingNamespace),
+		reservedNamespace("tail padding", share.TailPaddingNamespace),
+		reservedNamespace("parity shares", share.ParitySharesNamespace),
+		reservedNamespaceRange("primary reserved", minPrimaryReservedNamespace, share.MaxPrimaryReservedNamespace),
+		reservedNamespaceRange("secondary reserved", share.MinSecondaryReservedNamespace, share.ParitySharesNamespace),
+	}
+}
+
+func reservedNamespace(name string, ns share.Namespace) ReservedNamespaceRange {
+	return reservedNamespaceRange(name, ns, ns)
+}
+
+func reservedNamespaceRange(name string, minNamespace, maxNamespace share.Namespace) ReservedNamespaceRange {
+	return ReservedNamespaceRange{
+		Name: name,
+		Min:  minNamespace.Bytes(),
+		Max:  maxNamespace.Bytes(),
+		Code: ErrReservedNamespace.ABCICode(),
+	}
+}
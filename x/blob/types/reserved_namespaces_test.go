@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReservedNamespaceRanges checks that ValidateBlobNamespace rejects the
+// bounds of every reserved range with the code of the first range that
+// contains them, which is how clients are expected to use the ranges.
+func TestReservedNamespaceRanges(t *testing.T) {
+	ranges := types.ReservedNamespaceRanges()
+	firstCode := func(ns []byte) uint32 {
+		for _, r := range ranges {
+			if bytes.Compare(r.Min, ns) <= 0 && bytes.Compare(ns, r.Max) <= 0 {
+				return r.Code
+			}
+		}
+		return 0
+	}
+
+	for _, r := range ranges {
+		for _, bound := range [][]byte{r.Min, r.Max} {
+			ns, err := share.NewNamespaceFromBytes(bound)
+			require.NoError(t, err)
+			err = types.ValidateBlobNamespace(ns)
+			require.Error(t, err, r.Name)
+			codespace, code, _ := errors.ABCIInfo(err, false)
+			assert.Equal(t, types.ModuleName, codespace, r.Name)
+			assert.Equal(t, firstCode(bound), code, r.Name)
+		}
+	}
+
+	usable := share.RandomBlobNamespace()
+	assert.Zero(t, firstCode(usable.Bytes()))
+	assert.NoError(t, types.ValidateBlobNamespace(usable))
+}
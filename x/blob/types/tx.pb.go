@@ -46,6 +46,14 @@ type MsgPayForBlobs struct {
 	// share_versions specified must match the share_versions used to generate the
 	// share_commitment in this message.
 	ShareVersions []uint32 `protobuf:"varint,8,rep,packed,name=share_versions,json=shareVersions,proto3" json:"share_versions,omitempty"`
+	// not_before_height is the first height that the message can be included
+	// at. 0 means that there is no lower bound.
+	NotBeforeHeight uint64 `protobuf:"varint,9,opt,name=not_before_height,json=notBeforeHeight,proto3" json:"not_before_height,omitempty"`
+	// not_after_height is the last height that the message can be included at.
+	// 0 means that there is no upper bound. Together with not_before_height it
+	// lets the signer schedule the publication of the blobs and retry a PFB
+	// that expired without risking a double inclusion.
+	NotAfterHeight uint64 `protobuf:"varint,10,opt,name=not_after_height,json=notAfterHeight,proto3" json:"not_after_height,omitempty"`
 }
 
 func (m *MsgPayForBlobs) Reset()         { *m = MsgPayForBlobs{} }
@@ -116,6 +124,20 @@ func (m *MsgPayForBlobs) GetShareVersions() []uint32 {
 	return nil
 }
 
+func (m *MsgPayForBlobs) GetNotBeforeHeight() uint64 {
+	if m != nil {
+		return m.NotBeforeHeight
+	}
+	return 0
+}
+
+func (m *MsgPayForBlobs) GetNotAfterHeight() uint64 {
+	if m != nil {
+		return m.NotAfterHeight
+	}
+	return 0
+}
+
 // MsgPayForBlobsResponse describes the response returned after the submission
 // of a PayForBlobs
 type MsgPayForBlobsResponse struct {
@@ -154,37 +176,153 @@ func (m *MsgPayForBlobsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPayForBlobsResponse proto.InternalMessageInfo
 
+// MsgSetBlobFeeBudget sets the blob fee budget of the signer. A limit of 0
+// removes the budget.
+type MsgSetBlobFeeBudget struct {
+	// signer is the bech32 encoded address of the account whose budget is set.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// limit is the maximum amount of utia that the signer can pay in fees for
+	// txs with a MsgPayForBlobs per epoch.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// epoch_length is the number of blocks after which the fees spent are
+	// reset.
+	EpochLength uint64 `protobuf:"varint,3,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+}
+
+func (m *MsgSetBlobFeeBudget) Reset()         { *m = MsgSetBlobFeeBudget{} }
+func (m *MsgSetBlobFeeBudget) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBlobFeeBudget) ProtoMessage()    {}
+func (*MsgSetBlobFeeBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{2}
+}
+func (m *MsgSetBlobFeeBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBlobFeeBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBlobFeeBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBlobFeeBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBlobFeeBudget.Merge(m, src)
+}
+func (m *MsgSetBlobFeeBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBlobFeeBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBlobFeeBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBlobFeeBudget proto.InternalMessageInfo
+
+func (m *MsgSetBlobFeeBudget) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSetBlobFeeBudget) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *MsgSetBlobFeeBudget) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+// MsgSetBlobFeeBudgetResponse describes the response returned after the
+// submission of a SetBlobFeeBudget.
+type MsgSetBlobFeeBudgetResponse struct {
+}
+
+func (m *MsgSetBlobFeeBudgetResponse) Reset()         { *m = MsgSetBlobFeeBudgetResponse{} }
+func (m *MsgSetBlobFeeBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBlobFeeBudgetResponse) ProtoMessage()    {}
+func (*MsgSetBlobFeeBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{3}
+}
+func (m *MsgSetBlobFeeBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBlobFeeBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBlobFeeBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBlobFeeBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBlobFeeBudgetResponse.Merge(m, src)
+}
+func (m *MsgSetBlobFeeBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBlobFeeBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBlobFeeBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBlobFeeBudgetResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgPayForBlobs)(nil), "celestia.blob.v1.MsgPayForBlobs")
 	proto.RegisterType((*MsgPayForBlobsResponse)(nil), "celestia.blob.v1.MsgPayForBlobsResponse")
+	proto.RegisterType((*MsgSetBlobFeeBudget)(nil), "celestia.blob.v1.MsgSetBlobFeeBudget")
+	proto.RegisterType((*MsgSetBlobFeeBudgetResponse)(nil), "celestia.blob.v1.MsgSetBlobFeeBudgetResponse")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/tx.proto", fileDescriptor_9157fbf3d3cd004d) }
 
 var fileDescriptor_9157fbf3d3cd004d = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xcd, 0x4a, 0xc3, 0x40,
-	0x14, 0x85, 0x3b, 0x8d, 0x14, 0x3b, 0xda, 0x52, 0x83, 0x94, 0xb4, 0x68, 0x0c, 0x01, 0x21, 0x28,
-	0x26, 0x56, 0x77, 0x2e, 0x2b, 0xb8, 0x10, 0x0a, 0x12, 0xc1, 0x85, 0x9b, 0x32, 0x09, 0x63, 0x1a,
-	0x48, 0x72, 0x87, 0xdc, 0xb1, 0xb4, 0xdd, 0xe9, 0x13, 0x08, 0x3e, 0x8e, 0x2f, 0xe0, 0xb2, 0xe0,
-	0xc6, 0xa5, 0xb4, 0x3e, 0x88, 0x24, 0xfd, 0xb1, 0x75, 0xe3, 0x2e, 0xf7, 0x3b, 0x87, 0x73, 0x6e,
-	0xe6, 0xd2, 0x86, 0xcf, 0x23, 0x8e, 0x32, 0x64, 0x8e, 0x17, 0x81, 0xe7, 0xf4, 0x5b, 0x8e, 0x1c,
-	0xd8, 0x22, 0x05, 0x09, 0x6a, 0x6d, 0x21, 0xd9, 0x99, 0x64, 0xf7, 0x5b, 0xcd, 0xbd, 0x00, 0x20,
-	0x88, 0xb8, 0xc3, 0x44, 0xe8, 0xb0, 0x24, 0x01, 0xc9, 0x64, 0x08, 0x09, 0xce, 0xfc, 0xe6, 0x1b,
-	0xa1, 0xd5, 0x0e, 0x06, 0x37, 0x6c, 0x78, 0x05, 0x69, 0x3b, 0x02, 0x0f, 0xd5, 0x3a, 0x2d, 0x61,
-	0x18, 0x24, 0x3c, 0xd5, 0x88, 0x41, 0xac, 0xb2, 0x3b, 0x9f, 0x54, 0x9d, 0xd2, 0x84, 0xc5, 0x1c,
-	0x05, 0xf3, 0x39, 0x6a, 0x45, 0x43, 0xb1, 0xb6, 0xdd, 0x15, 0xa2, 0xee, 0x53, 0x9a, 0x75, 0x76,
-	0x31, 0x1c, 0x71, 0xd4, 0x14, 0x43, 0xb1, 0x2a, 0x6e, 0x39, 0x23, 0xb7, 0x19, 0x50, 0x8f, 0xe9,
-	0x0e, 0xf6, 0x58, 0xca, 0xbb, 0x3e, 0xc4, 0x71, 0x28, 0x63, 0x9e, 0x48, 0xd4, 0x36, 0xf2, 0x94,
-	0x5a, 0x2e, 0x5c, 0xfe, 0x72, 0xf5, 0x90, 0x56, 0x67, 0xe6, 0x3e, 0x4f, 0x31, 0x5b, 0x57, 0xdb,
-	0xcc, 0xf3, 0x2a, 0x39, 0xbd, 0x9b, 0x43, 0x53, 0xa3, 0xf5, 0xf5, 0xe5, 0x5d, 0x8e, 0x02, 0x12,
-	0xe4, 0x67, 0x4f, 0x84, 0x2a, 0x1d, 0x0c, 0xd4, 0x11, 0xdd, 0x5a, 0xfd, 0x37, 0xc3, 0xfe, 0xfb,
-	0x3e, 0xf6, 0x7a, 0x40, 0xd3, 0xfa, 0xcf, 0xb1, 0xa8, 0x30, 0x0f, 0x9e, 0x3f, 0xbe, 0x5f, 0x8b,
-	0x0d, 0x73, 0x77, 0x79, 0x05, 0xc1, 0x86, 0x0f, 0x90, 0x66, 0x13, 0x5e, 0x90, 0xa3, 0xf6, 0xf5,
-	0xfb, 0x44, 0x27, 0xe3, 0x89, 0x4e, 0xbe, 0x26, 0x3a, 0x79, 0x99, 0xea, 0x85, 0xf1, 0x54, 0x2f,
-	0x7c, 0x4e, 0xf5, 0xc2, 0xfd, 0x69, 0x10, 0xca, 0xde, 0xa3, 0x67, 0xfb, 0x10, 0x3b, 0x8b, 0x3a,
-	0x48, 0x83, 0xe5, 0xf7, 0x09, 0x13, 0xc2, 0x19, 0xcc, 0x72, 0xe5, 0x50, 0x70, 0xf4, 0x4a, 0xf9,
-	0xb9, 0xce, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x82, 0x67, 0x80, 0x6a, 0xfb, 0x01, 0x00, 0x00,
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0x80, 0xb3, 0x49, 0x2d, 0xe6, 0xb5, 0x8d, 0xe9, 0x58, 0xca, 0x34, 0x9a, 0x35, 0xae, 0x14,
+	0x96, 0x48, 0x77, 0xad, 0xde, 0x7a, 0x33, 0x42, 0x11, 0x31, 0x20, 0x29, 0x78, 0xf0, 0xb2, 0xcc,
+	0xc6, 0x97, 0xd9, 0x81, 0xdd, 0x99, 0x65, 0x67, 0x1a, 0x9a, 0x1e, 0xbd, 0x0b, 0x16, 0xff, 0x94,
+	0xc7, 0x82, 0x17, 0x8f, 0x92, 0xf8, 0x43, 0x64, 0x27, 0x4d, 0x6c, 0x6b, 0xc5, 0xde, 0xf6, 0x7d,
+	0xef, 0xe3, 0xbd, 0x37, 0x6f, 0x66, 0x61, 0x67, 0x88, 0x29, 0x6a, 0x23, 0x58, 0x18, 0xa7, 0x2a,
+	0x0e, 0xc7, 0xfb, 0xa1, 0x39, 0x09, 0xf2, 0x42, 0x19, 0x45, 0x9a, 0x8b, 0x54, 0x50, 0xa6, 0x82,
+	0xf1, 0x7e, 0xeb, 0x21, 0x57, 0x8a, 0xa7, 0x18, 0xb2, 0x5c, 0x84, 0x4c, 0x4a, 0x65, 0x98, 0x11,
+	0x4a, 0xea, 0xb9, 0xef, 0x9d, 0x55, 0xa1, 0xd1, 0xd7, 0xfc, 0x1d, 0x9b, 0x1c, 0xaa, 0xa2, 0x97,
+	0xaa, 0x58, 0x93, 0x6d, 0x58, 0xd5, 0x82, 0x4b, 0x2c, 0xa8, 0xd3, 0x71, 0xfc, 0xfa, 0xe0, 0x22,
+	0x22, 0x2e, 0x80, 0x64, 0x19, 0xea, 0x9c, 0x0d, 0x51, 0xd3, 0x6a, 0xa7, 0xe6, 0xaf, 0x0f, 0x2e,
+	0x11, 0xd2, 0x06, 0x28, 0x7b, 0x46, 0x5a, 0x9c, 0xa2, 0xa6, 0xb5, 0x4e, 0xcd, 0xdf, 0x18, 0xd4,
+	0x4b, 0x72, 0x54, 0x02, 0xf2, 0x14, 0x36, 0x75, 0xc2, 0x0a, 0x8c, 0x86, 0x2a, 0xcb, 0x84, 0xc9,
+	0x50, 0x1a, 0x4d, 0x57, 0x6c, 0x95, 0xa6, 0x4d, 0xbc, 0xfa, 0xc3, 0xc9, 0x2e, 0x34, 0xe6, 0xf2,
+	0x18, 0x0b, 0x5d, 0x8e, 0x4b, 0xef, 0xda, 0x7a, 0x1b, 0x96, 0xbe, 0xbf, 0x80, 0xa4, 0x0b, 0x9b,
+	0x52, 0x99, 0x28, 0xc6, 0x91, 0x2a, 0x30, 0x4a, 0x50, 0xf0, 0xc4, 0xd0, 0x7a, 0xc7, 0xf1, 0x57,
+	0x06, 0xf7, 0xa4, 0x32, 0x3d, 0xcb, 0x5f, 0x5b, 0x4c, 0x7c, 0x68, 0x96, 0x2e, 0x1b, 0x19, 0x2c,
+	0x16, 0x2a, 0x58, 0xb5, 0x21, 0x95, 0x79, 0x59, 0xe2, 0xb9, 0xe9, 0x51, 0xd8, 0xbe, 0xba, 0x92,
+	0x01, 0xea, 0x5c, 0x49, 0x8d, 0xde, 0x08, 0xee, 0xf7, 0x35, 0x3f, 0x42, 0x53, 0xe2, 0x43, 0xc4,
+	0xde, 0xf1, 0x47, 0x8e, 0xe6, 0x9f, 0x1b, 0xdb, 0x82, 0x3b, 0xa9, 0xc8, 0x84, 0xa1, 0x55, 0xdb,
+	0x67, 0x1e, 0x90, 0xc7, 0xb0, 0x8e, 0xb9, 0x1a, 0x26, 0x51, 0x8a, 0x92, 0x9b, 0x84, 0xd6, 0x6c,
+	0x72, 0xcd, 0xb2, 0xb7, 0x16, 0x79, 0x6d, 0x78, 0x70, 0x43, 0x9f, 0xc5, 0x18, 0xcf, 0xcf, 0xaa,
+	0x50, 0xeb, 0x6b, 0x4e, 0x4e, 0x61, 0xed, 0xf2, 0xc5, 0x75, 0x82, 0xeb, 0x97, 0x1f, 0x5c, 0x3d,
+	0x47, 0xcb, 0xff, 0x9f, 0xb1, 0x3c, 0xe9, 0xa3, 0x4f, 0xdf, 0x7f, 0x7d, 0xad, 0xee, 0x78, 0x5b,
+	0xcb, 0x27, 0x96, 0xb3, 0xc9, 0x48, 0x15, 0x65, 0xa4, 0x0f, 0x9c, 0x2e, 0xf9, 0xec, 0x40, 0xf3,
+	0xaf, 0x45, 0xec, 0xde, 0x58, 0xff, 0xba, 0xd6, 0xda, 0xbb, 0x95, 0xb6, 0x9c, 0xe5, 0x89, 0x9d,
+	0xa5, 0xed, 0xd1, 0xe5, 0x2c, 0xf6, 0x9d, 0x8d, 0x10, 0xa3, 0xd8, 0x9a, 0x07, 0x4e, 0xb7, 0xf7,
+	0xe6, 0xdb, 0xd4, 0x75, 0xce, 0xa7, 0xae, 0xf3, 0x73, 0xea, 0x3a, 0x5f, 0x66, 0x6e, 0xe5, 0x7c,
+	0xe6, 0x56, 0x7e, 0xcc, 0xdc, 0xca, 0x87, 0x67, 0x5c, 0x98, 0xe4, 0x38, 0x0e, 0x86, 0x2a, 0x0b,
+	0x17, 0x7d, 0x55, 0xc1, 0x97, 0xdf, 0x7b, 0x2c, 0xcf, 0xc3, 0x93, 0x79, 0x6d, 0x33, 0xc9, 0x51,
+	0xc7, 0xab, 0xf6, 0xdf, 0x78, 0xf1, 0x7b, 0x00, 0xbc, 0x7c, 0xeb, 0x06, 0x68, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -201,6 +339,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// PayForBlobs allows the user to pay for the inclusion of one or more blobs
 	PayForBlobs(ctx context.Context, in *MsgPayForBlobs, opts ...grpc.CallOption) (*MsgPayForBlobsResponse, error)
+	// SetBlobFeeBudget sets a budget that caps the fees that the signer can pay
+	// for txs with a MsgPayForBlobs per epoch.
+	SetBlobFeeBudget(ctx context.Context, in *MsgSetBlobFeeBudget, opts ...grpc.CallOption) (*MsgSetBlobFeeBudgetResponse, error)
 }
 
 type msgClient struct {
@@ -220,10 +361,22 @@ func (c *msgClient) PayForBlobs(ctx context.Context, in *MsgPayForBlobs, opts ..
 	return out, nil
 }
 
+func (c *msgClient) SetBlobFeeBudget(ctx context.Context, in *MsgSetBlobFeeBudget, opts ...grpc.CallOption) (*MsgSetBlobFeeBudgetResponse, error) {
+	out := new(MsgSetBlobFeeBudgetResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/SetBlobFeeBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// PayForBlobs allows the user to pay for the inclusion of one or more blobs
 	PayForBlobs(context.Context, *MsgPayForBlobs) (*MsgPayForBlobsResponse, error)
+	// SetBlobFeeBudget sets a budget that caps the fees that the signer can pay
+	// for txs with a MsgPayForBlobs per epoch.
+	SetBlobFeeBudget(context.Context, *MsgSetBlobFeeBudget) (*MsgSetBlobFeeBudgetResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -233,6 +386,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) PayForBlobs(ctx context.Context, req *MsgPayForBlobs) (*MsgPayForBlobsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayForBlobs not implemented")
 }
+func (*UnimplementedMsgServer) SetBlobFeeBudget(ctx context.Context, req *MsgSetBlobFeeBudget) (*MsgSetBlobFeeBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlobFeeBudget not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -256,6 +412,24 @@ func _Msg_PayForBlobs_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetBlobFeeBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBlobFeeBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBlobFeeBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/SetBlobFeeBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBlobFeeBudget(ctx, req.(*MsgSetBlobFeeBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.blob.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -264,6 +438,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PayForBlobs",
 			Handler:    _Msg_PayForBlobs_Handler,
 		},
+		{
+			MethodName: "SetBlobFeeBudget",
+			Handler:    _Msg_SetBlobFeeBudget_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/blob/v1/tx.proto",
@@ -289,6 +467,16 @@ func (m *MsgPayForBlobs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NotAfterHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NotAfterHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.NotBeforeHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NotBeforeHeight))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.ShareVersions) > 0 {
 		dAtA2 := make([]byte, len(m.ShareVersions)*10)
 		var j1 int
@@ -376,6 +564,69 @@ func (m *MsgPayForBlobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetBlobFeeBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBlobFeeBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBlobFeeBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBlobFeeBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBlobFeeBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBlobFeeBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -423,6 +674,12 @@ func (m *MsgPayForBlobs) Size() (n int) {
 		}
 		n += 1 + sovTx(uint64(l)) + l
 	}
+	if m.NotBeforeHeight != 0 {
+		n += 1 + sovTx(uint64(m.NotBeforeHeight))
+	}
+	if m.NotAfterHeight != 0 {
+		n += 1 + sovTx(uint64(m.NotAfterHeight))
+	}
 	return n
 }
 
@@ -435,6 +692,34 @@ func (m *MsgPayForBlobsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetBlobFeeBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovTx(uint64(m.EpochLength))
+	}
+	return n
+}
+
+func (m *MsgSetBlobFeeBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -718,6 +1003,44 @@ func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersions", wireType)
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBeforeHeight", wireType)
+			}
+			m.NotBeforeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotBeforeHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfterHeight", wireType)
+			}
+			m.NotAfterHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotAfterHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -789,6 +1112,176 @@ func (m *MsgPayForBlobsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetBlobFeeBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBlobFeeBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBlobFeeBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBlobFeeBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBlobFeeBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBlobFeeBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
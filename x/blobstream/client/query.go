@@ -24,7 +24,7 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdQueryAttestationByNonce(), CmdQueryEVMAddress())
+	cmd.AddCommand(CmdQueryAttestationByNonce(), CmdQueryEVMAddress(), CmdQueryValsetProof(), CmdQueryAttestationsInBlockRange())
 
 	return cmd
 }
@@ -105,6 +105,72 @@ func CmdQueryEVMAddress() *cobra.Command {
 	return cmd
 }
 
+func CmdQueryValsetProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valset-proof <trusted_nonce> <nonce>",
+		Short: "query the valsets from the trusted valset at trusted_nonce up to the valset at nonce",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trustedNonce, err := strconv.ParseUint(args[0], 10, 0)
+			if err != nil {
+				return err
+			}
+			nonce, err := strconv.ParseUint(args[1], 10, 0)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.ValsetProof(
+				cmd.Context(),
+				&types.QueryValsetProofRequest{TrustedNonce: trustedNonce, Nonce: nonce},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAttestationsInBlockRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestations-in-range <begin_block> <end_block>",
+		Short: "query the data commitments and the valsets of the end exclusive block range",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			beginBlock, err := strconv.ParseUint(args[0], 10, 0)
+			if err != nil {
+				return err
+			}
+			endBlock, err := strconv.ParseUint(args[1], 10, 0)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.AttestationsInBlockRange(
+				cmd.Context(),
+				&types.QueryAttestationsInBlockRangeRequest{BeginBlock: beginBlock, EndBlock: endBlock},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // unmarshallAttestation unmarshal a wrapper protobuf `Any` type to an `AttestationRequestI`.
 func unmarshallAttestation(attestation *codectypes.Any) (types.AttestationRequestI, error) {
 	var unmarshalledAttestation types.AttestationRequestI
@@ -14,8 +14,10 @@ import (
 
 	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/spf13/cobra"
 	tmlog "github.com/tendermint/tendermint/libs/log"
@@ -91,7 +93,7 @@ func txCmd() *cobra.Command {
 				return err
 			}
 
-			_, err = VerifyShares(cmd.Context(), logger, config, tx.Height, uint64(shareRange.Start), uint64(shareRange.End))
+			_, err = VerifyShares(cmd.Context(), logger, config, tx.Height, shareRange)
 			return err
 		},
 	}
@@ -152,15 +154,13 @@ func blobCmd() *cobra.Command {
 				return err
 			}
 
-			version := blockRes.Block.Header.Version.App
-			maxSquareSize := appconsts.SquareSizeUpperBound(version)
-			subtreeRootThreshold := appconsts.SubtreeRootThreshold(version)
-			blobShareRange, err := square.BlobShareRange(blockRes.Block.Txs.ToSliceOfBytes(), int(tx.Index), blobIndexInt, maxSquareSize, subtreeRootThreshold)
+			location, err := proof.NewBlobLocation(tx.Height, blockRes.Block.Header.Version.App, blockRes.Block.Txs.ToSliceOfBytes(), int(tx.Index), blobIndexInt)
 			if err != nil {
 				return err
 			}
+			logger.Info("found blob", "namespace", hex.EncodeToString(location.Namespace.Bytes()))
 
-			_, err = VerifyShares(cmd.Context(), logger, config, tx.Height, uint64(blobShareRange.Start), uint64(blobShareRange.End))
+			_, err = VerifyShares(cmd.Context(), logger, config, location.Height, location.Range)
 			return err
 		},
 	}
@@ -180,11 +180,11 @@ func sharesCmd() *cobra.Command {
 			if height < 0 {
 				return fmt.Errorf("height must be a positive integer")
 			}
-			startShare, err := strconv.ParseUint(args[1], 10, 0)
+			startShare, err := strconv.Atoi(args[1])
 			if err != nil {
 				return err
 			}
-			endShare, err := strconv.ParseUint(args[2], 10, 0)
+			endShare, err := strconv.Atoi(args[2])
 			if err != nil {
 				return err
 			}
@@ -196,14 +196,16 @@ func sharesCmd() *cobra.Command {
 
 			logger := tmlog.NewTMLogger(os.Stdout)
 
-			_, err = VerifyShares(cmd.Context(), logger, config, height, startShare, endShare)
+			_, err = VerifyShares(cmd.Context(), logger, config, height, share.NewRange(startShare, endShare))
 			return err
 		},
 	}
 	return addVerifyFlags(command)
 }
 
-func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig, height int64, startShare uint64, endShare uint64) (isCommittedTo bool, err error) {
+// VerifyShares verifies that the end exclusive shareRange of the block at
+// height has been committed to by the Blobstream contract.
+func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig, height int64, shareRange share.Range) (isCommittedTo bool, err error) {
 	trpc, err := http.New(config.TendermintRPC, "/websocket")
 	if err != nil {
 		return false, err
@@ -224,18 +226,21 @@ func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig,
 		"height",
 		height,
 		"start_share",
-		startShare,
+		shareRange.Start,
 		"end_share",
-		endShare,
+		shareRange.End,
 	)
 
 	if height < 0 {
 		return false, fmt.Errorf("height must be a positive integer")
 	}
+	if shareRange.Start < 0 || shareRange.End <= shareRange.Start {
+		return false, fmt.Errorf("invalid share range [%d, %d)", shareRange.Start, shareRange.End)
+	}
 
 	unsignedHeight := uint64(height)
 	logger.Debug("getting shares proof from tendermint node")
-	sharesProofs, err := trpc.ProveShares(ctx, unsignedHeight, startShare, endShare)
+	sharesProofs, err := trpc.ProveShares(ctx, unsignedHeight, uint64(shareRange.Start), uint64(shareRange.End))
 	if err != nil {
 		return false, err
 	}
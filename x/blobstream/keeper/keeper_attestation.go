@@ -3,8 +3,10 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/errors"
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // SetAttestationRequest sets a new attestation request to the store to be
@@ -137,3 +139,53 @@ func (k Keeper) DeleteAttestation(ctx sdk.Context, nonce uint64) {
 	}
 	store.Delete(key)
 }
+
+// GetAttestationsInBlockRange returns the data commitments whose block range
+// overlaps [beginBlock, endBlock) and the valsets created at heights in it, in
+// ascending nonce order. At most types.MaxAttestationsInBlockRange
+// attestations are returned.
+func (k Keeper) GetAttestationsInBlockRange(ctx sdk.Context, beginBlock, endBlock uint64) ([]types.DataCommitment, []types.Valset, error) {
+	if !k.CheckLatestAttestationNonce(ctx) {
+		return nil, nil, types.ErrLatestAttestationNonceStillNotInitialized
+	}
+	if !k.CheckEarliestAvailableAttestationNonce(ctx) {
+		return nil, nil, types.ErrEarliestAvailableNonceStillNotInitialized
+	}
+	if beginBlock >= endBlock {
+		return nil, nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "begin block %d is not lower than end block %d", beginBlock, endBlock)
+	}
+
+	dataCommitments := make([]types.DataCommitment, 0)
+	valsets := make([]types.Valset, 0)
+	latestNonce := k.GetLatestAttestationNonce(ctx)
+	for nonce := k.GetEarliestAvailableAttestationNonce(ctx); nonce <= latestNonce; nonce++ {
+		at, found, err := k.GetAttestationByNonce(ctx, nonce)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !found {
+			return nil, nil, errors.Wrap(types.ErrNilAttestation, fmt.Sprintf("nonce=%d", nonce))
+		}
+		switch at := at.(type) {
+		case *types.DataCommitment:
+			if at.BeginBlock >= endBlock || at.EndBlock <= beginBlock {
+				continue
+			}
+			dataCommitments = append(dataCommitments, *at)
+		case *types.Valset:
+			if at.Height < beginBlock || at.Height >= endBlock {
+				continue
+			}
+			valsets = append(valsets, *at)
+		default:
+			return nil, nil, errors.Wrapf(types.ErrUnknownAttestationType, "nonce=%d", nonce)
+		}
+		if len(dataCommitments)+len(valsets) > types.MaxAttestationsInBlockRange {
+			return nil, nil, errors.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"more than %d attestations between blocks %d and %d", types.MaxAttestationsInBlockRange, beginBlock, endBlock,
+			)
+		}
+	}
+	return dataCommitments, valsets, nil
+}
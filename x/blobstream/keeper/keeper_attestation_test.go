@@ -5,7 +5,11 @@ import (
 
 	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCheckLatestAttestationNonce(t *testing.T) {
@@ -39,3 +43,125 @@ func TestCheckEarliestAvailableAttestationNonce(t *testing.T) {
 	input.BlobstreamKeeper.CheckEarliestAvailableAttestationNonce(input.Context)
 	assert.Equal(t, blobstream.InitialEarliestAvailableAttestationNonce, input.BlobstreamKeeper.GetEarliestAvailableAttestationNonce(input.Context))
 }
+
+func TestLatestRelayableAttestation(t *testing.T) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	goCtx := sdk.WrapSDKContext(sdkCtx)
+
+	initialValset, err := k.GetCurrentValset(sdkCtx)
+	require.NoError(t, err)
+	err = k.SetAttestationRequest(sdkCtx, &initialValset)
+	require.NoError(t, err)
+
+	// the first attestation has no valset before it
+	res, err := k.LatestRelayableAttestation(goCtx, &types.QueryLatestRelayableAttestationRequest{})
+	require.NoError(t, err)
+	assert.Nil(t, res.Valset)
+	assert.Equal(t, &initialValset, res.Attestation.GetCachedValue())
+
+	dc := types.NewDataCommitment(2, 1, 100, sdkCtx.BlockTime())
+	err = k.SetAttestationRequest(sdkCtx, dc)
+	require.NoError(t, err)
+
+	res, err = k.LatestRelayableAttestation(goCtx, &types.QueryLatestRelayableAttestationRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, dc, res.Attestation.GetCachedValue())
+	assert.Equal(t, &initialValset, res.Valset)
+}
+
+func TestAttestationsByNonce(t *testing.T) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	goCtx := sdk.WrapSDKContext(sdkCtx)
+
+	initialValset, err := k.GetCurrentValset(sdkCtx)
+	require.NoError(t, err)
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &initialValset))
+	dc := types.NewDataCommitment(2, 1, 100, sdkCtx.BlockTime())
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, dc))
+
+	valsetRes, err := k.ValsetByNonce(goCtx, &types.QueryValsetByNonceRequest{Nonce: 1})
+	require.NoError(t, err)
+	assert.Equal(t, &initialValset, valsetRes.Valset)
+	assert.Nil(t, valsetRes.PreviousValset)
+
+	dcRes, err := k.DataCommitmentByNonce(goCtx, &types.QueryDataCommitmentByNonceRequest{Nonce: 2})
+	require.NoError(t, err)
+	assert.Equal(t, dc, dcRes.DataCommitment)
+	assert.Equal(t, &initialValset, dcRes.Valset)
+
+	_, err = k.DataCommitmentByNonce(goCtx, &types.QueryDataCommitmentByNonceRequest{Nonce: 1})
+	assert.ErrorIs(t, err, types.ErrAttestationNotDataCommitmentRequest)
+	_, err = k.ValsetByNonce(goCtx, &types.QueryValsetByNonceRequest{Nonce: 2})
+	assert.ErrorIs(t, err, types.ErrAttestationNotValsetRequest)
+	_, err = k.ValsetByNonce(goCtx, &types.QueryValsetByNonceRequest{Nonce: 3})
+	assert.ErrorIs(t, err, types.ErrNonceHigherThanLatestAttestationNonce)
+}
+
+func TestAttestationsInBlockRange(t *testing.T) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	goCtx := sdk.WrapSDKContext(sdkCtx)
+
+	initialValset, err := k.GetCurrentValset(sdkCtx)
+	require.NoError(t, err)
+	initialValset.Height = 1
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &initialValset))
+	dc1 := types.NewDataCommitment(2, 1, 101, sdkCtx.BlockTime())
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, dc1))
+	updatedValset := initialValset
+	updatedValset.Nonce = 3
+	updatedValset.Height = 150
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &updatedValset))
+	dc2 := types.NewDataCommitment(4, 101, 201, sdkCtx.BlockTime())
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, dc2))
+
+	tests := []struct {
+		name                string
+		beginBlock          uint64
+		endBlock            uint64
+		wantDataCommitments []types.DataCommitment
+		wantValsets         []types.Valset
+	}{
+		{
+			name:                "all blocks",
+			beginBlock:          1,
+			endBlock:            201,
+			wantDataCommitments: []types.DataCommitment{*dc1, *dc2},
+			wantValsets:         []types.Valset{initialValset, updatedValset},
+		},
+		{
+			name:                "range within a data commitment",
+			beginBlock:          120,
+			endBlock:            160,
+			wantDataCommitments: []types.DataCommitment{*dc2},
+			wantValsets:         []types.Valset{updatedValset},
+		},
+		{
+			name:                "end block is exclusive",
+			beginBlock:          2,
+			endBlock:            101,
+			wantDataCommitments: []types.DataCommitment{*dc1},
+			wantValsets:         []types.Valset{},
+		},
+		{
+			name:                "range after the latest data commitment",
+			beginBlock:          201,
+			endBlock:            300,
+			wantDataCommitments: []types.DataCommitment{},
+			wantValsets:         []types.Valset{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := k.AttestationsInBlockRange(goCtx, &types.QueryAttestationsInBlockRangeRequest{BeginBlock: tt.beginBlock, EndBlock: tt.endBlock})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDataCommitments, res.DataCommitments)
+			assert.Equal(t, tt.wantValsets, res.Valsets)
+		})
+	}
+
+	_, err = k.AttestationsInBlockRange(goCtx, &types.QueryAttestationsInBlockRangeRequest{BeginBlock: 10, EndBlock: 10})
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
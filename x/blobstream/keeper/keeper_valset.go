@@ -205,6 +205,57 @@ func (k Keeper) GetLatestValsetBeforeNonce(ctx sdk.Context, nonce uint64) (*type
 	)
 }
 
+// GetValsetsInRange returns the valsets from the valset at fromNonce to the
+// valset at toNonce, both inclusive, in ascending nonce order. Both nonces must
+// be the nonces of valsets. At most types.MaxValsetProofLength valsets are
+// returned.
+func (k Keeper) GetValsetsInRange(ctx sdk.Context, fromNonce, toNonce uint64) ([]types.Valset, error) {
+	if !k.CheckLatestAttestationNonce(ctx) {
+		return nil, types.ErrLatestAttestationNonceStillNotInitialized
+	}
+	if !k.CheckEarliestAvailableAttestationNonce(ctx) {
+		return nil, types.ErrEarliestAvailableNonceStillNotInitialized
+	}
+	if fromNonce > toNonce {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "from nonce %d is higher than to nonce %d", fromNonce, toNonce)
+	}
+	if fromNonce < k.GetEarliestAvailableAttestationNonce(ctx) {
+		return nil, types.ErrRequestedNonceWasPruned
+	}
+	if toNonce > k.GetLatestAttestationNonce(ctx) {
+		return nil, types.ErrNonceHigherThanLatestAttestationNonce
+	}
+
+	valsets := make([]types.Valset, 0)
+	for nonce := fromNonce; nonce <= toNonce; nonce++ {
+		at, found, err := k.GetAttestationByNonce(ctx, nonce)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, errors.Wrap(
+				types.ErrNilAttestation,
+				fmt.Sprintf("nonce=%d", nonce),
+			)
+		}
+		valset, ok := at.(*types.Valset)
+		if !ok {
+			if nonce == fromNonce || nonce == toNonce {
+				return nil, errors.Wrap(types.ErrAttestationNotValsetRequest, fmt.Sprintf("nonce=%d", nonce))
+			}
+			continue
+		}
+		if len(valsets) == types.MaxValsetProofLength {
+			return nil, errors.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"more than %d valsets between nonces %d and %d", types.MaxValsetProofLength, fromNonce, toNonce,
+			)
+		}
+		valsets = append(valsets, *valset)
+	}
+	return valsets, nil
+}
+
 func (k Keeper) SetEVMAddress(ctx sdk.Context, valAddress sdk.ValAddress, evmAddress gethcommon.Address) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetEVMKey(valAddress), evmAddress.Bytes())
@@ -9,6 +9,8 @@ import (
 
 	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -224,3 +226,69 @@ func TestEVMAddresses(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "", resp.EvmAddress)
 }
+
+func TestValsetProof(t *testing.T) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	goCtx := sdk.WrapSDKContext(sdkCtx)
+
+	initialValset, err := k.GetCurrentValset(sdkCtx)
+	require.NoError(t, err)
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &initialValset))
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, types.NewDataCommitment(2, 1, 100, sdkCtx.BlockTime())))
+	updatedValset := initialValset
+	updatedValset.Nonce = 3
+	updatedValset.Members = append([]types.BridgeValidator{}, initialValset.Members...)
+	updatedValset.Members[0].Power++
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &updatedValset))
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, types.NewDataCommitment(4, 100, 200, sdkCtx.BlockTime())))
+
+	res, err := k.ValsetProof(goCtx, &types.QueryValsetProofRequest{TrustedNonce: 1, Nonce: 3})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Valset{initialValset, updatedValset}, res.Valsets)
+
+	trustedCheckpoint, err := initialValset.SignBytes()
+	require.NoError(t, err)
+	checkpoints, err := types.VerifyValsetProof(trustedCheckpoint, res.Valsets)
+	require.NoError(t, err)
+	updatedCheckpoint, err := updatedValset.SignBytes()
+	require.NoError(t, err)
+	assert.Equal(t, updatedCheckpoint, checkpoints[1])
+
+	// a proof that doesn't start at the trusted valset is rejected
+	_, err = types.VerifyValsetProof(updatedCheckpoint, res.Valsets)
+	assert.ErrorIs(t, err, types.ErrInvalidValsetProof)
+
+	tests := []struct {
+		name          string
+		req           *types.QueryValsetProofRequest
+		expectedError error
+	}{
+		{
+			name:          "trusted nonce is not a valset",
+			req:           &types.QueryValsetProofRequest{TrustedNonce: 2, Nonce: 3},
+			expectedError: types.ErrAttestationNotValsetRequest,
+		},
+		{
+			name:          "nonce is not a valset",
+			req:           &types.QueryValsetProofRequest{TrustedNonce: 1, Nonce: 4},
+			expectedError: types.ErrAttestationNotValsetRequest,
+		},
+		{
+			name:          "nonce higher than the latest nonce",
+			req:           &types.QueryValsetProofRequest{TrustedNonce: 1, Nonce: 5},
+			expectedError: types.ErrNonceHigherThanLatestAttestationNonce,
+		},
+		{
+			name:          "trusted nonce higher than nonce",
+			req:           &types.QueryValsetProofRequest{TrustedNonce: 3, Nonce: 1},
+			expectedError: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := k.ValsetProof(goCtx, tt.req)
+			assert.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
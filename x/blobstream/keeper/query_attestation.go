@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/errors"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
@@ -48,3 +49,126 @@ func (k Keeper) LatestAttestationNonce(
 		Nonce: k.GetLatestAttestationNonce(sdk.UnwrapSDKContext(ctx)),
 	}, nil
 }
+
+// LatestRelayableAttestation returns the latest attestation and the valset
+// that needs to have signed it for it to be relayed to the Blobstream
+// contract.
+func (k Keeper) LatestRelayableAttestation(
+	ctx context.Context,
+	_ *types.QueryLatestRelayableAttestationRequest,
+) (*types.QueryLatestRelayableAttestationResponse, error) {
+	unwrappedCtx := sdk.UnwrapSDKContext(ctx)
+	if !k.CheckLatestAttestationNonce(unwrappedCtx) {
+		return nil, types.ErrLatestAttestationNonceStillNotInitialized
+	}
+	nonce := k.GetLatestAttestationNonce(unwrappedCtx)
+	attestation, found, err := k.GetAttestationByNonce(unwrappedCtx, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrAttestationNotFound
+	}
+	val, err := codectypes.NewAnyWithValue(attestation)
+	if err != nil {
+		return nil, err
+	}
+
+	valset, err := k.signingValset(unwrappedCtx, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryLatestRelayableAttestationResponse{
+		Attestation: val,
+		Valset:      valset,
+	}, nil
+}
+
+// DataCommitmentByNonce returns the data commitment at the requested nonce and
+// the valset that must have signed it.
+func (k Keeper) DataCommitmentByNonce(
+	ctx context.Context,
+	request *types.QueryDataCommitmentByNonceRequest,
+) (*types.QueryDataCommitmentByNonceResponse, error) {
+	unwrappedCtx := sdk.UnwrapSDKContext(ctx)
+	attestation, err := k.attestationByNonce(unwrappedCtx, request.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	dc, ok := attestation.(*types.DataCommitment)
+	if !ok {
+		return nil, errors.Wrapf(types.ErrAttestationNotDataCommitmentRequest, "nonce=%d", request.Nonce)
+	}
+	valset, err := k.signingValset(unwrappedCtx, request.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDataCommitmentByNonceResponse{DataCommitment: dc, Valset: valset}, nil
+}
+
+// ValsetByNonce returns the valset at the requested nonce and the valset that
+// must have signed it.
+func (k Keeper) ValsetByNonce(
+	ctx context.Context,
+	request *types.QueryValsetByNonceRequest,
+) (*types.QueryValsetByNonceResponse, error) {
+	unwrappedCtx := sdk.UnwrapSDKContext(ctx)
+	attestation, err := k.attestationByNonce(unwrappedCtx, request.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	valset, ok := attestation.(*types.Valset)
+	if !ok {
+		return nil, errors.Wrapf(types.ErrAttestationNotValsetRequest, "nonce=%d", request.Nonce)
+	}
+	previousValset, err := k.signingValset(unwrappedCtx, request.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryValsetByNonceResponse{Valset: valset, PreviousValset: previousValset}, nil
+}
+
+// AttestationsInBlockRange returns the data commitments and the valsets of the
+// requested block range.
+func (k Keeper) AttestationsInBlockRange(
+	ctx context.Context,
+	request *types.QueryAttestationsInBlockRangeRequest,
+) (*types.QueryAttestationsInBlockRangeResponse, error) {
+	dataCommitments, valsets, err := k.GetAttestationsInBlockRange(sdk.UnwrapSDKContext(ctx), request.BeginBlock, request.EndBlock)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAttestationsInBlockRangeResponse{DataCommitments: dataCommitments, Valsets: valsets}, nil
+}
+
+// attestationByNonce returns the attestation at nonce or an error if it
+// doesn't exist or was pruned.
+func (k Keeper) attestationByNonce(ctx sdk.Context, nonce uint64) (types.AttestationRequestI, error) {
+	if !k.CheckLatestAttestationNonce(ctx) {
+		return nil, types.ErrLatestAttestationNonceStillNotInitialized
+	}
+	if nonce > k.GetLatestAttestationNonce(ctx) {
+		return nil, types.ErrNonceHigherThanLatestAttestationNonce
+	}
+	if k.CheckEarliestAvailableAttestationNonce(ctx) && nonce < k.GetEarliestAvailableAttestationNonce(ctx) {
+		return nil, types.ErrRequestedNonceWasPruned
+	}
+	attestation, found, err := k.GetAttestationByNonce(ctx, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.Wrapf(types.ErrAttestationNotFound, "nonce=%d", nonce)
+	}
+	return attestation, nil
+}
+
+// signingValset returns the valset that must have signed the attestation at
+// nonce. It is nil for the first attestation, which is checked against the
+// valset the contract was deployed with.
+func (k Keeper) signingValset(ctx sdk.Context, nonce uint64) (*types.Valset, error) {
+	if nonce == 1 {
+		return nil, nil
+	}
+	return k.GetLatestValsetBeforeNonce(ctx, nonce)
+}
@@ -20,3 +20,15 @@ func (k Keeper) LatestValsetRequestBeforeNonce(
 	}
 	return &types.QueryLatestValsetRequestBeforeNonceResponse{Valset: vs}, nil
 }
+
+// ValsetProof queries the valsets from the trusted nonce up to nonce.
+func (k Keeper) ValsetProof(
+	c context.Context,
+	req *types.QueryValsetProofRequest,
+) (*types.QueryValsetProofResponse, error) {
+	valsets, err := k.GetValsetsInRange(sdk.UnwrapSDKContext(c), req.TrustedNonce, req.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryValsetProofResponse{Valsets: valsets}, nil
+}
@@ -7,6 +7,10 @@ import (
 	"github.com/gogo/protobuf/proto"
 )
 
+// MaxAttestationsInBlockRange is the max number of attestations that the
+// AttestationsInBlockRange query returns.
+const MaxAttestationsInBlockRange = 100
+
 // AttestationRequestI is either a DataCommitment or a Valset. This was decided
 // as part of the universal nonce approach under:
 // https://github.com/celestiaorg/celestia-app/issues/468#issuecomment-1156887715
@@ -27,4 +27,6 @@ var (
 	ErrEVMAddressNotHex                          = errors.Register(ModuleName, 36, "the provided evm address is not a valid hex address")
 	ErrEVMAddressAlreadyExists                   = errors.Register(ModuleName, 37, "the provided evm address already exists")
 	ErrEVMAddressNotFound                        = errors.Register(ModuleName, 38, "EVM address not found")
+	ErrInvalidValsetProof                        = errors.Register(ModuleName, 39, "invalid valset proof")
+	ErrAttestationNotDataCommitmentRequest       = errors.Register(ModuleName, 40, "attestation is not a data commitment request")
 )
@@ -372,6 +372,106 @@ func (m *QueryEarliestAttestationNonceResponse) GetNonce() uint64 {
 	return 0
 }
 
+// QueryLatestRelayableAttestationRequest latest relayable attestation request
+type QueryLatestRelayableAttestationRequest struct {
+}
+
+func (m *QueryLatestRelayableAttestationRequest) Reset() {
+	*m = QueryLatestRelayableAttestationRequest{}
+}
+func (m *QueryLatestRelayableAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestRelayableAttestationRequest) ProtoMessage()    {}
+func (*QueryLatestRelayableAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{8}
+}
+func (m *QueryLatestRelayableAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestRelayableAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestRelayableAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestRelayableAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestRelayableAttestationRequest.Merge(m, src)
+}
+func (m *QueryLatestRelayableAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestRelayableAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestRelayableAttestationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLatestRelayableAttestationRequest proto.InternalMessageInfo
+
+// QueryLatestRelayableAttestationResponse latest relayable attestation
+// response
+type QueryLatestRelayableAttestationResponse struct {
+	// Attestation is the latest attestation, either a Data Commitment or a
+	// Valset.
+	Attestation *types.Any `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	// Valset is the valset that must have signed the attestation for it to be
+	// accepted by the Blobstream contract. It is nil if the attestation is the
+	// first one, i.e. nonce 1, since there is no valset before it.
+	Valset *Valset `protobuf:"bytes,2,opt,name=valset,proto3" json:"valset,omitempty"`
+}
+
+func (m *QueryLatestRelayableAttestationResponse) Reset() {
+	*m = QueryLatestRelayableAttestationResponse{}
+}
+func (m *QueryLatestRelayableAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestRelayableAttestationResponse) ProtoMessage()    {}
+func (*QueryLatestRelayableAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{9}
+}
+func (m *QueryLatestRelayableAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestRelayableAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestRelayableAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestRelayableAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestRelayableAttestationResponse.Merge(m, src)
+}
+func (m *QueryLatestRelayableAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestRelayableAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestRelayableAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLatestRelayableAttestationResponse proto.InternalMessageInfo
+
+func (m *QueryLatestRelayableAttestationResponse) GetAttestation() *types.Any {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+func (m *QueryLatestRelayableAttestationResponse) GetValset() *Valset {
+	if m != nil {
+		return m.Valset
+	}
+	return nil
+}
+
 // QueryLatestValsetRequestBeforeNonceRequest latest Valset request before
 // universal nonce request
 type QueryLatestValsetRequestBeforeNonceRequest struct {
@@ -386,7 +486,7 @@ func (m *QueryLatestValsetRequestBeforeNonceRequest) String() string {
 }
 func (*QueryLatestValsetRequestBeforeNonceRequest) ProtoMessage() {}
 func (*QueryLatestValsetRequestBeforeNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{8}
+	return fileDescriptor_c8535c57355a2b91, []int{10}
 }
 func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,7 +536,7 @@ func (m *QueryLatestValsetRequestBeforeNonceResponse) String() string {
 }
 func (*QueryLatestValsetRequestBeforeNonceResponse) ProtoMessage() {}
 func (*QueryLatestValsetRequestBeforeNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{9}
+	return fileDescriptor_c8535c57355a2b91, []int{11}
 }
 func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -472,22 +572,28 @@ func (m *QueryLatestValsetRequestBeforeNonceResponse) GetValset() *Valset {
 	return nil
 }
 
-// QueryLatestUnbondingHeightRequest
-type QueryLatestUnbondingHeightRequest struct {
+// QueryValsetProofRequest is the request type for the ValsetProof RPC
+// method.
+type QueryValsetProofRequest struct {
+	// nonce is the nonce of the valset to prove.
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// trusted_nonce is the nonce of the trusted valset that the proof starts
+	// at. It must be lower than or equal to nonce.
+	TrustedNonce uint64 `protobuf:"varint,2,opt,name=trusted_nonce,json=trustedNonce,proto3" json:"trusted_nonce,omitempty"`
 }
 
-func (m *QueryLatestUnbondingHeightRequest) Reset()         { *m = QueryLatestUnbondingHeightRequest{} }
-func (m *QueryLatestUnbondingHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestUnbondingHeightRequest) ProtoMessage()    {}
-func (*QueryLatestUnbondingHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{10}
+func (m *QueryValsetProofRequest) Reset()         { *m = QueryValsetProofRequest{} }
+func (m *QueryValsetProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetProofRequest) ProtoMessage()    {}
+func (*QueryValsetProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{12}
 }
-func (m *QueryLatestUnbondingHeightRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryValsetProofRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestUnbondingHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValsetProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestUnbondingHeightRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValsetProofRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -497,35 +603,52 @@ func (m *QueryLatestUnbondingHeightRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestUnbondingHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestUnbondingHeightRequest.Merge(m, src)
+func (m *QueryValsetProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetProofRequest.Merge(m, src)
 }
-func (m *QueryLatestUnbondingHeightRequest) XXX_Size() int {
+func (m *QueryValsetProofRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestUnbondingHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestUnbondingHeightRequest.DiscardUnknown(m)
+func (m *QueryValsetProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetProofRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestUnbondingHeightRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryValsetProofRequest proto.InternalMessageInfo
 
-// QueryLatestUnbondingHeightResponse
-type QueryLatestUnbondingHeightResponse struct {
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+func (m *QueryValsetProofRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
 
-func (m *QueryLatestUnbondingHeightResponse) Reset()         { *m = QueryLatestUnbondingHeightResponse{} }
-func (m *QueryLatestUnbondingHeightResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestUnbondingHeightResponse) ProtoMessage()    {}
-func (*QueryLatestUnbondingHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{11}
+func (m *QueryValsetProofRequest) GetTrustedNonce() uint64 {
+	if m != nil {
+		return m.TrustedNonce
+	}
+	return 0
 }
-func (m *QueryLatestUnbondingHeightResponse) XXX_Unmarshal(b []byte) error {
+
+// QueryValsetProofResponse is the response type for the ValsetProof RPC
+// method.
+type QueryValsetProofResponse struct {
+	// valsets are the valsets from trusted_nonce to nonce in ascending nonce
+	// order.
+	Valsets []Valset `protobuf:"bytes,1,rep,name=valsets,proto3" json:"valsets"`
+}
+
+func (m *QueryValsetProofResponse) Reset()         { *m = QueryValsetProofResponse{} }
+func (m *QueryValsetProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetProofResponse) ProtoMessage()    {}
+func (*QueryValsetProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{13}
+}
+func (m *QueryValsetProofResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestUnbondingHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValsetProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestUnbondingHeightResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValsetProofResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -535,41 +658,43 @@ func (m *QueryLatestUnbondingHeightResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestUnbondingHeightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestUnbondingHeightResponse.Merge(m, src)
+func (m *QueryValsetProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetProofResponse.Merge(m, src)
 }
-func (m *QueryLatestUnbondingHeightResponse) XXX_Size() int {
+func (m *QueryValsetProofResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestUnbondingHeightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestUnbondingHeightResponse.DiscardUnknown(m)
+func (m *QueryValsetProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetProofResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestUnbondingHeightResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryValsetProofResponse proto.InternalMessageInfo
 
-func (m *QueryLatestUnbondingHeightResponse) GetHeight() uint64 {
+func (m *QueryValsetProofResponse) GetValsets() []Valset {
 	if m != nil {
-		return m.Height
+		return m.Valsets
 	}
-	return 0
+	return nil
 }
 
-// QueryLatestDataCommitmentRequest
-type QueryLatestDataCommitmentRequest struct {
+// QueryDataCommitmentByNonceRequest is the request type for the
+// DataCommitmentByNonce RPC method.
+type QueryDataCommitmentByNonceRequest struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *QueryLatestDataCommitmentRequest) Reset()         { *m = QueryLatestDataCommitmentRequest{} }
-func (m *QueryLatestDataCommitmentRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestDataCommitmentRequest) ProtoMessage()    {}
-func (*QueryLatestDataCommitmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{12}
+func (m *QueryDataCommitmentByNonceRequest) Reset()         { *m = QueryDataCommitmentByNonceRequest{} }
+func (m *QueryDataCommitmentByNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataCommitmentByNonceRequest) ProtoMessage()    {}
+func (*QueryDataCommitmentByNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{14}
 }
-func (m *QueryLatestDataCommitmentRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDataCommitmentByNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestDataCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDataCommitmentByNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestDataCommitmentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDataCommitmentByNonceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -579,35 +704,47 @@ func (m *QueryLatestDataCommitmentRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestDataCommitmentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestDataCommitmentRequest.Merge(m, src)
+func (m *QueryDataCommitmentByNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataCommitmentByNonceRequest.Merge(m, src)
 }
-func (m *QueryLatestDataCommitmentRequest) XXX_Size() int {
+func (m *QueryDataCommitmentByNonceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestDataCommitmentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestDataCommitmentRequest.DiscardUnknown(m)
+func (m *QueryDataCommitmentByNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataCommitmentByNonceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestDataCommitmentRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDataCommitmentByNonceRequest proto.InternalMessageInfo
 
-// QueryLatestDataCommitmentResponse
-type QueryLatestDataCommitmentResponse struct {
+func (m *QueryDataCommitmentByNonceRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// QueryDataCommitmentByNonceResponse is the response type for the
+// DataCommitmentByNonce RPC method.
+type QueryDataCommitmentByNonceResponse struct {
 	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
+	// valset is the valset that must have signed the data commitment for it to
+	// be accepted by the Blobstream contract. It is nil if the data commitment
+	// is the first attestation.
+	Valset *Valset `protobuf:"bytes,2,opt,name=valset,proto3" json:"valset,omitempty"`
 }
 
-func (m *QueryLatestDataCommitmentResponse) Reset()         { *m = QueryLatestDataCommitmentResponse{} }
-func (m *QueryLatestDataCommitmentResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestDataCommitmentResponse) ProtoMessage()    {}
-func (*QueryLatestDataCommitmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{13}
+func (m *QueryDataCommitmentByNonceResponse) Reset()         { *m = QueryDataCommitmentByNonceResponse{} }
+func (m *QueryDataCommitmentByNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataCommitmentByNonceResponse) ProtoMessage()    {}
+func (*QueryDataCommitmentByNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{15}
 }
-func (m *QueryLatestDataCommitmentResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDataCommitmentByNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestDataCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDataCommitmentByNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestDataCommitmentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDataCommitmentByNonceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -617,44 +754,50 @@ func (m *QueryLatestDataCommitmentResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestDataCommitmentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestDataCommitmentResponse.Merge(m, src)
+func (m *QueryDataCommitmentByNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataCommitmentByNonceResponse.Merge(m, src)
 }
-func (m *QueryLatestDataCommitmentResponse) XXX_Size() int {
+func (m *QueryDataCommitmentByNonceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestDataCommitmentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestDataCommitmentResponse.DiscardUnknown(m)
+func (m *QueryDataCommitmentByNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataCommitmentByNonceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestDataCommitmentResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDataCommitmentByNonceResponse proto.InternalMessageInfo
 
-func (m *QueryLatestDataCommitmentResponse) GetDataCommitment() *DataCommitment {
+func (m *QueryDataCommitmentByNonceResponse) GetDataCommitment() *DataCommitment {
 	if m != nil {
 		return m.DataCommitment
 	}
 	return nil
 }
 
-// QueryDataCommitmentRangeForHeightRequest
-type QueryDataCommitmentRangeForHeightRequest struct {
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+func (m *QueryDataCommitmentByNonceResponse) GetValset() *Valset {
+	if m != nil {
+		return m.Valset
+	}
+	return nil
 }
 
-func (m *QueryDataCommitmentRangeForHeightRequest) Reset() {
-	*m = QueryDataCommitmentRangeForHeightRequest{}
+// QueryValsetByNonceRequest is the request type for the ValsetByNonce RPC
+// method.
+type QueryValsetByNonceRequest struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDataCommitmentRangeForHeightRequest) ProtoMessage()    {}
-func (*QueryDataCommitmentRangeForHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{14}
+
+func (m *QueryValsetByNonceRequest) Reset()         { *m = QueryValsetByNonceRequest{} }
+func (m *QueryValsetByNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetByNonceRequest) ProtoMessage()    {}
+func (*QueryValsetByNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{16}
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryValsetByNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValsetByNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValsetByNonceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -664,46 +807,47 @@ func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.Merge(m, src)
+func (m *QueryValsetByNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetByNonceRequest.Merge(m, src)
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Size() int {
+func (m *QueryValsetByNonceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.DiscardUnknown(m)
+func (m *QueryValsetByNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetByNonceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryValsetByNonceRequest proto.InternalMessageInfo
 
-func (m *QueryDataCommitmentRangeForHeightRequest) GetHeight() uint64 {
+func (m *QueryValsetByNonceRequest) GetNonce() uint64 {
 	if m != nil {
-		return m.Height
+		return m.Nonce
 	}
 	return 0
 }
 
-// QueryDataCommitmentRangeForHeightResponse
-type QueryDataCommitmentRangeForHeightResponse struct {
-	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
+// QueryValsetByNonceResponse is the response type for the ValsetByNonce RPC
+// method.
+type QueryValsetByNonceResponse struct {
+	Valset *Valset `protobuf:"bytes,1,opt,name=valset,proto3" json:"valset,omitempty"`
+	// previous_valset is the valset that must have signed valset for it to be
+	// accepted by the Blobstream contract. It is nil if valset is the first
+	// attestation.
+	PreviousValset *Valset `protobuf:"bytes,2,opt,name=previous_valset,json=previousValset,proto3" json:"previous_valset,omitempty"`
 }
 
-func (m *QueryDataCommitmentRangeForHeightResponse) Reset() {
-	*m = QueryDataCommitmentRangeForHeightResponse{}
-}
-func (m *QueryDataCommitmentRangeForHeightResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*QueryDataCommitmentRangeForHeightResponse) ProtoMessage() {}
-func (*QueryDataCommitmentRangeForHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{15}
+func (m *QueryValsetByNonceResponse) Reset()         { *m = QueryValsetByNonceResponse{} }
+func (m *QueryValsetByNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetByNonceResponse) ProtoMessage()    {}
+func (*QueryValsetByNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{17}
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryValsetByNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValsetByNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValsetByNonceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -713,42 +857,53 @@ func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.Merge(m, src)
+func (m *QueryValsetByNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetByNonceResponse.Merge(m, src)
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Size() int {
+func (m *QueryValsetByNonceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.DiscardUnknown(m)
+func (m *QueryValsetByNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetByNonceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryValsetByNonceResponse proto.InternalMessageInfo
 
-func (m *QueryDataCommitmentRangeForHeightResponse) GetDataCommitment() *DataCommitment {
+func (m *QueryValsetByNonceResponse) GetValset() *Valset {
 	if m != nil {
-		return m.DataCommitment
+		return m.Valset
 	}
 	return nil
 }
 
-// QueryEVMAddressRequest
-type QueryEVMAddressRequest struct {
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+func (m *QueryValsetByNonceResponse) GetPreviousValset() *Valset {
+	if m != nil {
+		return m.PreviousValset
+	}
+	return nil
 }
 
-func (m *QueryEVMAddressRequest) Reset()         { *m = QueryEVMAddressRequest{} }
-func (m *QueryEVMAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEVMAddressRequest) ProtoMessage()    {}
-func (*QueryEVMAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{16}
+// QueryAttestationsInBlockRangeRequest is the request type for the
+// AttestationsInBlockRange RPC method.
+type QueryAttestationsInBlockRangeRequest struct {
+	// begin_block is the first block of the range.
+	BeginBlock uint64 `protobuf:"varint,1,opt,name=begin_block,json=beginBlock,proto3" json:"begin_block,omitempty"`
+	// end_block is the end exclusive last block of the range.
+	EndBlock uint64 `protobuf:"varint,2,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
 }
-func (m *QueryEVMAddressRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryAttestationsInBlockRangeRequest) Reset()         { *m = QueryAttestationsInBlockRangeRequest{} }
+func (m *QueryAttestationsInBlockRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsInBlockRangeRequest) ProtoMessage()    {}
+func (*QueryAttestationsInBlockRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{18}
+}
+func (m *QueryAttestationsInBlockRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEVMAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestationsInBlockRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEVMAddressRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestationsInBlockRangeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -758,42 +913,55 @@ func (m *QueryEVMAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryEVMAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEVMAddressRequest.Merge(m, src)
+func (m *QueryAttestationsInBlockRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsInBlockRangeRequest.Merge(m, src)
 }
-func (m *QueryEVMAddressRequest) XXX_Size() int {
+func (m *QueryAttestationsInBlockRangeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEVMAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEVMAddressRequest.DiscardUnknown(m)
+func (m *QueryAttestationsInBlockRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsInBlockRangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEVMAddressRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestationsInBlockRangeRequest proto.InternalMessageInfo
 
-func (m *QueryEVMAddressRequest) GetValidatorAddress() string {
+func (m *QueryAttestationsInBlockRangeRequest) GetBeginBlock() uint64 {
 	if m != nil {
-		return m.ValidatorAddress
+		return m.BeginBlock
 	}
-	return ""
+	return 0
 }
 
-// QueryEVMAddressResponse
-type QueryEVMAddressResponse struct {
-	EvmAddress string `protobuf:"bytes,1,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+func (m *QueryAttestationsInBlockRangeRequest) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
 }
 
-func (m *QueryEVMAddressResponse) Reset()         { *m = QueryEVMAddressResponse{} }
-func (m *QueryEVMAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEVMAddressResponse) ProtoMessage()    {}
-func (*QueryEVMAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{17}
+// QueryAttestationsInBlockRangeResponse is the response type for the
+// AttestationsInBlockRange RPC method.
+type QueryAttestationsInBlockRangeResponse struct {
+	// data_commitments are the data commitments whose block range overlaps the
+	// requested range in ascending nonce order.
+	DataCommitments []DataCommitment `protobuf:"bytes,1,rep,name=data_commitments,json=dataCommitments,proto3" json:"data_commitments"`
+	// valsets are the valsets created in the requested range in ascending nonce
+	// order.
+	Valsets []Valset `protobuf:"bytes,2,rep,name=valsets,proto3" json:"valsets"`
 }
-func (m *QueryEVMAddressResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryAttestationsInBlockRangeResponse) Reset()         { *m = QueryAttestationsInBlockRangeResponse{} }
+func (m *QueryAttestationsInBlockRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsInBlockRangeResponse) ProtoMessage()    {}
+func (*QueryAttestationsInBlockRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{19}
+}
+func (m *QueryAttestationsInBlockRangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEVMAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestationsInBlockRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEVMAddressResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestationsInBlockRangeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -803,966 +971,1169 @@ func (m *QueryEVMAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryEVMAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEVMAddressResponse.Merge(m, src)
+func (m *QueryAttestationsInBlockRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsInBlockRangeResponse.Merge(m, src)
 }
-func (m *QueryEVMAddressResponse) XXX_Size() int {
+func (m *QueryAttestationsInBlockRangeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEVMAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEVMAddressResponse.DiscardUnknown(m)
+func (m *QueryAttestationsInBlockRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsInBlockRangeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEVMAddressResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestationsInBlockRangeResponse proto.InternalMessageInfo
 
-func (m *QueryEVMAddressResponse) GetEvmAddress() string {
+func (m *QueryAttestationsInBlockRangeResponse) GetDataCommitments() []DataCommitment {
 	if m != nil {
-		return m.EvmAddress
+		return m.DataCommitments
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.qgb.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.qgb.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryAttestationRequestByNonceRequest)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceRequest")
-	proto.RegisterType((*QueryAttestationRequestByNonceResponse)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceResponse")
-	proto.RegisterType((*QueryLatestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceRequest")
-	proto.RegisterType((*QueryLatestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceResponse")
-	proto.RegisterType((*QueryEarliestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceRequest")
-	proto.RegisterType((*QueryEarliestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceResponse")
-	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceRequest)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceRequest")
-	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceResponse)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceResponse")
-	proto.RegisterType((*QueryLatestUnbondingHeightRequest)(nil), "celestia.qgb.v1.QueryLatestUnbondingHeightRequest")
-	proto.RegisterType((*QueryLatestUnbondingHeightResponse)(nil), "celestia.qgb.v1.QueryLatestUnbondingHeightResponse")
-	proto.RegisterType((*QueryLatestDataCommitmentRequest)(nil), "celestia.qgb.v1.QueryLatestDataCommitmentRequest")
-	proto.RegisterType((*QueryLatestDataCommitmentResponse)(nil), "celestia.qgb.v1.QueryLatestDataCommitmentResponse")
-	proto.RegisterType((*QueryDataCommitmentRangeForHeightRequest)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightRequest")
-	proto.RegisterType((*QueryDataCommitmentRangeForHeightResponse)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightResponse")
-	proto.RegisterType((*QueryEVMAddressRequest)(nil), "celestia.qgb.v1.QueryEVMAddressRequest")
-	proto.RegisterType((*QueryEVMAddressResponse)(nil), "celestia.qgb.v1.QueryEVMAddressResponse")
+func (m *QueryAttestationsInBlockRangeResponse) GetValsets() []Valset {
+	if m != nil {
+		return m.Valsets
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("celestia/qgb/v1/query.proto", fileDescriptor_c8535c57355a2b91) }
-
-var fileDescriptor_c8535c57355a2b91 = []byte{
-	// 910 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0x63, 0xd4, 0x46, 0xe2, 0x45, 0xea, 0x8f, 0x49, 0x9a, 0xed, 0xba, 0xc5, 0xbb, 0x75,
-	0xb2, 0xe9, 0x96, 0x65, 0x3d, 0xda, 0x2c, 0x5d, 0x44, 0x5b, 0x0e, 0x1b, 0x28, 0x2a, 0x52, 0x81,
-	0x12, 0x89, 0x1e, 0x38, 0xb0, 0x1a, 0x27, 0x53, 0xaf, 0x45, 0xec, 0x49, 0x6c, 0x27, 0x22, 0x02,
-	0x2e, 0xfc, 0x05, 0x48, 0x1c, 0x39, 0x73, 0xe5, 0x84, 0xb8, 0x70, 0xe4, 0x52, 0xf5, 0x54, 0x89,
-	0x0b, 0x27, 0x84, 0x76, 0xf9, 0x0f, 0xf8, 0x07, 0x50, 0xe6, 0x47, 0xd6, 0x71, 0x6c, 0x27, 0x59,
-	0xf5, 0xe6, 0x99, 0xf7, 0xde, 0xf7, 0x7d, 0x9e, 0x33, 0xfe, 0x4e, 0xe0, 0x46, 0x87, 0xf6, 0x68,
-	0x18, 0xb9, 0x04, 0x0f, 0x1c, 0x1b, 0x8f, 0xf6, 0xf0, 0x60, 0x48, 0x83, 0xb1, 0xd5, 0x0f, 0x58,
-	0xc4, 0xd0, 0x65, 0x15, 0xb4, 0x06, 0x8e, 0x6d, 0x8d, 0xf6, 0xf4, 0x37, 0x92, 0xd9, 0x0e, 0xf5,
-	0x69, 0xe8, 0x86, 0x22, 0x5f, 0x9f, 0x13, 0x8b, 0xc6, 0x7d, 0xaa, 0x82, 0x37, 0x1d, 0xc6, 0x9c,
-	0x1e, 0xc5, 0xa4, 0xef, 0x62, 0xe2, 0xfb, 0x2c, 0x22, 0x91, 0xcb, 0x7c, 0x15, 0xad, 0x38, 0xcc,
-	0x61, 0xfc, 0x11, 0x4f, 0x9e, 0xe4, 0xee, 0x7a, 0x87, 0x85, 0x1e, 0x0b, 0x8f, 0x44, 0x40, 0x2c,
-	0x54, 0x48, 0xca, 0xf1, 0x95, 0x3d, 0x7c, 0x86, 0x89, 0x2f, 0xb1, 0xcd, 0x0a, 0xa0, 0xcf, 0x26,
-	0x53, 0x3c, 0x21, 0x01, 0xf1, 0xc2, 0x36, 0x1d, 0x0c, 0x69, 0x18, 0x99, 0x8f, 0xa1, 0x3c, 0xb3,
-	0x1b, 0xf6, 0x99, 0x1f, 0x52, 0x74, 0x17, 0x8a, 0x7d, 0xbe, 0x73, 0x5d, 0xdb, 0xd4, 0xb6, 0x4b,
-	0xcd, 0x35, 0x2b, 0x31, 0xb4, 0x25, 0x0a, 0x5a, 0x17, 0x9e, 0xff, 0xbd, 0x51, 0x68, 0xcb, 0x64,
-	0xf3, 0x3d, 0xd8, 0xe2, 0x6a, 0x87, 0x51, 0x44, 0x43, 0x31, 0x8a, 0x6c, 0xd4, 0x1a, 0x7f, 0xc2,
-	0xfc, 0x0e, 0x95, 0x2b, 0x54, 0x81, 0x8b, 0xfe, 0x64, 0xcd, 0xe5, 0x2f, 0xb4, 0xc5, 0xc2, 0x1c,
-	0x43, 0x63, 0x51, 0xb9, 0xe4, 0xfb, 0x14, 0x4a, 0xe4, 0x2c, 0x49, 0x42, 0x56, 0x2c, 0x31, 0xbd,
-	0xa5, 0xa6, 0xb7, 0x0e, 0xfd, 0x71, 0x6b, 0xed, 0xc5, 0xaf, 0xbb, 0xe5, 0x79, 0xc5, 0x8f, 0xda,
-	0x71, 0x05, 0xb3, 0x0e, 0x26, 0x6f, 0xfd, 0x98, 0x4c, 0xf6, 0x62, 0xe9, 0x71, 0x6c, 0xf3, 0x3e,
-	0xd4, 0x72, 0xb3, 0x24, 0x5d, 0xfa, 0x74, 0x0d, 0xa8, 0xf3, 0xe2, 0x87, 0x24, 0xe8, 0xb9, 0x39,
-	0x4d, 0xd4, 0x4b, 0xcc, 0xce, 0xcb, 0x6d, 0xd3, 0x82, 0x37, 0x63, 0x8c, 0x4f, 0x49, 0x2f, 0xa4,
-	0x91, 0x7a, 0x8b, 0xf4, 0x19, 0x0b, 0xe8, 0x12, 0x3f, 0xc4, 0x97, 0xb0, 0xb3, 0x94, 0x86, 0x04,
-	0xc1, 0x50, 0x1c, 0xf1, 0x9c, 0xcc, 0xd3, 0x22, 0x25, 0x64, 0x9a, 0x59, 0x83, 0x5b, 0x31, 0xfd,
-	0xcf, 0x7d, 0x9b, 0xf9, 0x5d, 0xd7, 0x77, 0x1e, 0x51, 0xd7, 0x39, 0x56, 0x8d, 0xcc, 0x07, 0x33,
-	0x3f, 0xc9, 0x5c, 0x92, 0xec, 0x5d, 0x85, 0xe2, 0x31, 0xdf, 0x91, 0x13, 0xc8, 0x95, 0x69, 0xc2,
-	0x66, 0xac, 0xfa, 0x03, 0x12, 0x91, 0xf7, 0x99, 0xe7, 0xb9, 0x91, 0x47, 0xfd, 0x69, 0x07, 0x6f,
-	0x06, 0x23, 0x99, 0x23, 0x1b, 0x3c, 0x82, 0xcb, 0x5d, 0x12, 0x91, 0xa3, 0xce, 0x34, 0x24, 0xa7,
-	0xdc, 0x98, 0x9b, 0x32, 0xa1, 0x70, 0xa9, 0x3b, 0xb3, 0x36, 0x5b, 0xb0, 0xcd, 0xdb, 0x25, 0xd2,
-	0x88, 0xef, 0xd0, 0x0f, 0x59, 0x30, 0x33, 0x7c, 0xe6, 0x58, 0x43, 0xb8, 0xb3, 0x84, 0xc6, 0x2b,
-	0x47, 0x7f, 0x08, 0x55, 0x71, 0x26, 0x9f, 0x7e, 0x7c, 0xd8, 0xed, 0x06, 0x34, 0x54, 0x06, 0x82,
-	0x76, 0xe0, 0xea, 0x88, 0xf4, 0xdc, 0x2e, 0x89, 0x58, 0x70, 0x44, 0x44, 0x8c, 0x77, 0x79, 0xbd,
-	0x7d, 0x65, 0x1a, 0x90, 0x35, 0xe6, 0x3d, 0x58, 0x9b, 0x93, 0x91, 0xac, 0x1b, 0x50, 0xa2, 0x23,
-	0x2f, 0xa1, 0x00, 0x74, 0xe4, 0xc9, 0xc4, 0xe6, 0x7f, 0x25, 0xb8, 0xc8, 0x8b, 0xd1, 0x57, 0x50,
-	0x14, 0xee, 0x83, 0x6a, 0x73, 0x73, 0xcc, 0x5b, 0x9c, 0x5e, 0xcf, 0x4f, 0x12, 0xfd, 0xcd, 0xea,
-	0xf7, 0x7f, 0xfe, 0xfb, 0xe3, 0x6b, 0x57, 0xd0, 0x25, 0xe5, 0xd2, 0xc2, 0xd2, 0xd0, 0xef, 0x1a,
-	0xac, 0x67, 0xfa, 0x11, 0x3a, 0x48, 0xd7, 0x5e, 0xe4, 0x7f, 0xfa, 0x3b, 0x2b, 0xd7, 0x49, 0xcc,
-	0x5d, 0x8e, 0x79, 0x1b, 0x6d, 0x29, 0xcc, 0x98, 0x89, 0x85, 0x38, 0x10, 0x45, 0x21, 0xfe, 0x86,
-	0x7f, 0xc7, 0xdf, 0xa1, 0x5f, 0x34, 0xa8, 0xa6, 0x9b, 0x15, 0xda, 0x4f, 0x47, 0xc8, 0x35, 0x40,
-	0xfd, 0xed, 0xd5, 0x8a, 0x24, 0xf4, 0x1d, 0x0e, 0x5d, 0x43, 0xb7, 0x52, 0xa1, 0x39, 0x2a, 0xee,
-	0x71, 0x09, 0xf4, 0x9b, 0x06, 0xd7, 0xb3, 0x8c, 0x0f, 0xdd, 0x4d, 0xef, 0xbe, 0xc0, 0x50, 0xf5,
-	0x83, 0x55, 0xcb, 0x24, 0xf6, 0x0e, 0xc7, 0xde, 0x42, 0xb5, 0x1c, 0x6c, 0x2a, 0x45, 0xd0, 0x0b,
-	0x0d, 0x8c, 0x7c, 0xbb, 0x44, 0xf7, 0xf3, 0x5e, 0xde, 0x02, 0xa3, 0xd6, 0x1f, 0x9c, 0xaf, 0x38,
-	0xeb, 0xd8, 0x08, 0x23, 0x56, 0x07, 0x06, 0xdb, 0xbc, 0x66, 0x7a, 0x6c, 0x7e, 0xd2, 0xe0, 0x5a,
-	0xaa, 0xed, 0xa2, 0x66, 0x1e, 0x46, 0xba, 0x91, 0xeb, 0xfb, 0x2b, 0xd5, 0x48, 0xe2, 0x75, 0x4e,
-	0x5c, 0x46, 0x57, 0x15, 0xf1, 0x50, 0x25, 0xa2, 0x3f, 0x34, 0xb8, 0x99, 0xe7, 0x7f, 0xe8, 0xdd,
-	0xf4, 0x86, 0x4b, 0xf8, 0xae, 0x7e, 0xef, 0x3c, 0xa5, 0x12, 0xf9, 0x2d, 0x8e, 0xdc, 0x40, 0x75,
-	0x85, 0x9c, 0x30, 0x5f, 0x1c, 0x4c, 0xea, 0xb0, 0x70, 0x72, 0xf4, 0xb3, 0x06, 0x95, 0xb4, 0x8b,
-	0x07, 0xed, 0xe5, 0xbd, 0xae, 0xd4, 0x8b, 0x4c, 0x6f, 0xae, 0x52, 0x22, 0x69, 0x1b, 0x9c, 0x76,
-	0x13, 0x19, 0x59, 0xb4, 0xf2, 0x8b, 0xfc, 0x16, 0xe0, 0xcc, 0xae, 0xd1, 0xed, 0x8c, 0x6f, 0x29,
-	0x79, 0x2f, 0xe8, 0xdb, 0x8b, 0x13, 0x25, 0xc8, 0x0d, 0x0e, 0x72, 0x0d, 0x95, 0x15, 0x48, 0xec,
-	0x1e, 0x68, 0x3d, 0x79, 0x7e, 0x62, 0x68, 0x2f, 0x4f, 0x0c, 0xed, 0x9f, 0x13, 0x43, 0xfb, 0xe1,
-	0xd4, 0x28, 0xbc, 0x3c, 0x35, 0x0a, 0x7f, 0x9d, 0x1a, 0x85, 0x2f, 0x0e, 0x1c, 0x37, 0x3a, 0x1e,
-	0xda, 0x56, 0x87, 0x79, 0x58, 0xb5, 0x62, 0x81, 0x33, 0x7d, 0xde, 0x25, 0xfd, 0x3e, 0xfe, 0x1a,
-	0xdb, 0x3d, 0x66, 0x87, 0x51, 0x40, 0x89, 0x27, 0xfe, 0x77, 0xdb, 0x45, 0xfe, 0xef, 0x70, 0xff,
-	0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x42, 0x6c, 0x12, 0x89, 0xe4, 0x0b, 0x00, 0x00,
+// QueryLatestUnbondingHeightRequest
+type QueryLatestUnbondingHeightRequest struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryLatestUnbondingHeightRequest) Reset()         { *m = QueryLatestUnbondingHeightRequest{} }
+func (m *QueryLatestUnbondingHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestUnbondingHeightRequest) ProtoMessage()    {}
+func (*QueryLatestUnbondingHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{20}
+}
+func (m *QueryLatestUnbondingHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestUnbondingHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestUnbondingHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestUnbondingHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestUnbondingHeightRequest.Merge(m, src)
+}
+func (m *QueryLatestUnbondingHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestUnbondingHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestUnbondingHeightRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryLatestUnbondingHeightRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the current parameters for the blobstream module
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// AttestationRequestByNonce queries attestation request by nonce.
-	// Returns nil if not found.
-	AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error)
-	// LatestAttestationNonce queries latest attestation nonce.
-	LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error)
-	// EarliestAttestationNonce queries the earliest attestation nonce.
-	EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error)
-	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
-	// And, even if the current nonce is a valset, it will return the previous
-	// one.
-	// If the provided nonce is 1, it will return an error, because, there is
-	// no valset before nonce 1.
-	LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error)
-	// LatestUnbondingHeight returns the latest unbonding height
-	LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error)
-	// DataCommitmentRangeForHeight returns the data commitment window
-	// that includes the provided height
-	DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error)
-	// LatestDataCommitment returns the latest data commitment in store
-	LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error)
-	// EVMAddress returns the evm address associated with a supplied
-	// validator address
-	EVMAddress(ctx context.Context, in *QueryEVMAddressRequest, opts ...grpc.CallOption) (*QueryEVMAddressResponse, error)
+// QueryLatestUnbondingHeightResponse
+type QueryLatestUnbondingHeightResponse struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryLatestUnbondingHeightResponse) Reset()         { *m = QueryLatestUnbondingHeightResponse{} }
+func (m *QueryLatestUnbondingHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestUnbondingHeightResponse) ProtoMessage()    {}
+func (*QueryLatestUnbondingHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{21}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryLatestUnbondingHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLatestUnbondingHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestUnbondingHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error) {
-	out := new(QueryAttestationRequestByNonceResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/AttestationRequestByNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLatestUnbondingHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestUnbondingHeightResponse.Merge(m, src)
+}
+func (m *QueryLatestUnbondingHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestUnbondingHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestUnbondingHeightResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error) {
-	out := new(QueryLatestAttestationNonceResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestAttestationNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLatestUnbondingHeightResponse proto.InternalMessageInfo
+
+func (m *QueryLatestUnbondingHeightResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error) {
-	out := new(QueryEarliestAttestationNonceResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/EarliestAttestationNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryLatestDataCommitmentRequest
+type QueryLatestDataCommitmentRequest struct {
 }
 
-func (c *queryClient) LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
-	out := new(QueryLatestValsetRequestBeforeNonceResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestValsetRequestBeforeNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLatestDataCommitmentRequest) Reset()         { *m = QueryLatestDataCommitmentRequest{} }
+func (m *QueryLatestDataCommitmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestDataCommitmentRequest) ProtoMessage()    {}
+func (*QueryLatestDataCommitmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{22}
+}
+func (m *QueryLatestDataCommitmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestDataCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestDataCommitmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLatestDataCommitmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestDataCommitmentRequest.Merge(m, src)
+}
+func (m *QueryLatestDataCommitmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestDataCommitmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestDataCommitmentRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error) {
-	out := new(QueryLatestUnbondingHeightResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestUnbondingHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryLatestDataCommitmentRequest proto.InternalMessageInfo
+
+// QueryLatestDataCommitmentResponse
+type QueryLatestDataCommitmentResponse struct {
+	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
 }
 
-func (c *queryClient) DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error) {
-	out := new(QueryDataCommitmentRangeForHeightResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/DataCommitmentRangeForHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLatestDataCommitmentResponse) Reset()         { *m = QueryLatestDataCommitmentResponse{} }
+func (m *QueryLatestDataCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestDataCommitmentResponse) ProtoMessage()    {}
+func (*QueryLatestDataCommitmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{23}
+}
+func (m *QueryLatestDataCommitmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestDataCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestDataCommitmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLatestDataCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestDataCommitmentResponse.Merge(m, src)
+}
+func (m *QueryLatestDataCommitmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestDataCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestDataCommitmentResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error) {
-	out := new(QueryLatestDataCommitmentResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestDataCommitment", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLatestDataCommitmentResponse proto.InternalMessageInfo
+
+func (m *QueryLatestDataCommitmentResponse) GetDataCommitment() *DataCommitment {
+	if m != nil {
+		return m.DataCommitment
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) EVMAddress(ctx context.Context, in *QueryEVMAddressRequest, opts ...grpc.CallOption) (*QueryEVMAddressResponse, error) {
-	out := new(QueryEVMAddressResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/EVMAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDataCommitmentRangeForHeightRequest
+type QueryDataCommitmentRangeForHeightRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the current parameters for the blobstream module
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// AttestationRequestByNonce queries attestation request by nonce.
-	// Returns nil if not found.
-	AttestationRequestByNonce(context.Context, *QueryAttestationRequestByNonceRequest) (*QueryAttestationRequestByNonceResponse, error)
-	// LatestAttestationNonce queries latest attestation nonce.
-	LatestAttestationNonce(context.Context, *QueryLatestAttestationNonceRequest) (*QueryLatestAttestationNonceResponse, error)
-	// EarliestAttestationNonce queries the earliest attestation nonce.
-	EarliestAttestationNonce(context.Context, *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error)
-	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
-	// And, even if the current nonce is a valset, it will return the previous
-	// one.
-	// If the provided nonce is 1, it will return an error, because, there is
-	// no valset before nonce 1.
-	LatestValsetRequestBeforeNonce(context.Context, *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error)
-	// LatestUnbondingHeight returns the latest unbonding height
-	LatestUnbondingHeight(context.Context, *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error)
-	// DataCommitmentRangeForHeight returns the data commitment window
-	// that includes the provided height
-	DataCommitmentRangeForHeight(context.Context, *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error)
-	// LatestDataCommitment returns the latest data commitment in store
-	LatestDataCommitment(context.Context, *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error)
-	// EVMAddress returns the evm address associated with a supplied
-	// validator address
-	EVMAddress(context.Context, *QueryEVMAddressRequest) (*QueryEVMAddressResponse, error)
+func (m *QueryDataCommitmentRangeForHeightRequest) Reset() {
+	*m = QueryDataCommitmentRangeForHeightRequest{}
+}
+func (m *QueryDataCommitmentRangeForHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataCommitmentRangeForHeightRequest) ProtoMessage()    {}
+func (*QueryDataCommitmentRangeForHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{24}
+}
+func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.Merge(m, src)
+}
+func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataCommitmentRangeForHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest proto.InternalMessageInfo
+
+func (m *QueryDataCommitmentRangeForHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+// QueryDataCommitmentRangeForHeightResponse
+type QueryDataCommitmentRangeForHeightResponse struct {
+	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
 }
-func (*UnimplementedQueryServer) AttestationRequestByNonce(ctx context.Context, req *QueryAttestationRequestByNonceRequest) (*QueryAttestationRequestByNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AttestationRequestByNonce not implemented")
+
+func (m *QueryDataCommitmentRangeForHeightResponse) Reset() {
+	*m = QueryDataCommitmentRangeForHeightResponse{}
 }
-func (*UnimplementedQueryServer) LatestAttestationNonce(ctx context.Context, req *QueryLatestAttestationNonceRequest) (*QueryLatestAttestationNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestAttestationNonce not implemented")
+func (m *QueryDataCommitmentRangeForHeightResponse) String() string {
+	return proto.CompactTextString(m)
 }
-func (*UnimplementedQueryServer) EarliestAttestationNonce(ctx context.Context, req *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EarliestAttestationNonce not implemented")
+func (*QueryDataCommitmentRangeForHeightResponse) ProtoMessage() {}
+func (*QueryDataCommitmentRangeForHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{25}
 }
-func (*UnimplementedQueryServer) LatestValsetRequestBeforeNonce(ctx context.Context, req *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestValsetRequestBeforeNonce not implemented")
+func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LatestUnbondingHeight(ctx context.Context, req *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestUnbondingHeight not implemented")
+func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DataCommitmentRangeForHeight(ctx context.Context, req *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DataCommitmentRangeForHeight not implemented")
+func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LatestDataCommitment(ctx context.Context, req *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestDataCommitment not implemented")
+func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) EVMAddress(ctx context.Context, req *QueryEVMAddressRequest) (*QueryEVMAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EVMAddress not implemented")
+func (m *QueryDataCommitmentRangeForHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryDataCommitmentRangeForHeightResponse) GetDataCommitment() *DataCommitment {
+	if m != nil {
+		return m.DataCommitment
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AttestationRequestByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAttestationRequestByNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AttestationRequestByNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/AttestationRequestByNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AttestationRequestByNonce(ctx, req.(*QueryAttestationRequestByNonceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryEVMAddressRequest
+type QueryEVMAddressRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func _Query_LatestAttestationNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestAttestationNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestAttestationNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/LatestAttestationNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestAttestationNonce(ctx, req.(*QueryLatestAttestationNonceRequest))
+func (m *QueryEVMAddressRequest) Reset()         { *m = QueryEVMAddressRequest{} }
+func (m *QueryEVMAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEVMAddressRequest) ProtoMessage()    {}
+func (*QueryEVMAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{26}
+}
+func (m *QueryEVMAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEVMAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEVMAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEVMAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEVMAddressRequest.Merge(m, src)
+}
+func (m *QueryEVMAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEVMAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEVMAddressRequest.DiscardUnknown(m)
 }
 
-func _Query_EarliestAttestationNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEarliestAttestationNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EarliestAttestationNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/EarliestAttestationNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EarliestAttestationNonce(ctx, req.(*QueryEarliestAttestationNonceRequest))
+var xxx_messageInfo_QueryEVMAddressRequest proto.InternalMessageInfo
+
+func (m *QueryEVMAddressRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_LatestValsetRequestBeforeNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestValsetRequestBeforeNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestValsetRequestBeforeNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/LatestValsetRequestBeforeNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestValsetRequestBeforeNonce(ctx, req.(*QueryLatestValsetRequestBeforeNonceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryEVMAddressResponse
+type QueryEVMAddressResponse struct {
+	EvmAddress string `protobuf:"bytes,1,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
 }
 
-func _Query_LatestUnbondingHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestUnbondingHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestUnbondingHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/LatestUnbondingHeight",
+func (m *QueryEVMAddressResponse) Reset()         { *m = QueryEVMAddressResponse{} }
+func (m *QueryEVMAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEVMAddressResponse) ProtoMessage()    {}
+func (*QueryEVMAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{27}
+}
+func (m *QueryEVMAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEVMAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEVMAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestUnbondingHeight(ctx, req.(*QueryLatestUnbondingHeightRequest))
+}
+func (m *QueryEVMAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEVMAddressResponse.Merge(m, src)
+}
+func (m *QueryEVMAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEVMAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEVMAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEVMAddressResponse proto.InternalMessageInfo
+
+func (m *QueryEVMAddressResponse) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_DataCommitmentRangeForHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDataCommitmentRangeForHeightRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.qgb.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.qgb.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAttestationRequestByNonceRequest)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceRequest")
+	proto.RegisterType((*QueryAttestationRequestByNonceResponse)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceResponse")
+	proto.RegisterType((*QueryLatestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceRequest")
+	proto.RegisterType((*QueryLatestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceResponse")
+	proto.RegisterType((*QueryEarliestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceRequest")
+	proto.RegisterType((*QueryEarliestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceResponse")
+	proto.RegisterType((*QueryLatestRelayableAttestationRequest)(nil), "celestia.qgb.v1.QueryLatestRelayableAttestationRequest")
+	proto.RegisterType((*QueryLatestRelayableAttestationResponse)(nil), "celestia.qgb.v1.QueryLatestRelayableAttestationResponse")
+	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceRequest)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceRequest")
+	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceResponse)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceResponse")
+	proto.RegisterType((*QueryValsetProofRequest)(nil), "celestia.qgb.v1.QueryValsetProofRequest")
+	proto.RegisterType((*QueryValsetProofResponse)(nil), "celestia.qgb.v1.QueryValsetProofResponse")
+	proto.RegisterType((*QueryDataCommitmentByNonceRequest)(nil), "celestia.qgb.v1.QueryDataCommitmentByNonceRequest")
+	proto.RegisterType((*QueryDataCommitmentByNonceResponse)(nil), "celestia.qgb.v1.QueryDataCommitmentByNonceResponse")
+	proto.RegisterType((*QueryValsetByNonceRequest)(nil), "celestia.qgb.v1.QueryValsetByNonceRequest")
+	proto.RegisterType((*QueryValsetByNonceResponse)(nil), "celestia.qgb.v1.QueryValsetByNonceResponse")
+	proto.RegisterType((*QueryAttestationsInBlockRangeRequest)(nil), "celestia.qgb.v1.QueryAttestationsInBlockRangeRequest")
+	proto.RegisterType((*QueryAttestationsInBlockRangeResponse)(nil), "celestia.qgb.v1.QueryAttestationsInBlockRangeResponse")
+	proto.RegisterType((*QueryLatestUnbondingHeightRequest)(nil), "celestia.qgb.v1.QueryLatestUnbondingHeightRequest")
+	proto.RegisterType((*QueryLatestUnbondingHeightResponse)(nil), "celestia.qgb.v1.QueryLatestUnbondingHeightResponse")
+	proto.RegisterType((*QueryLatestDataCommitmentRequest)(nil), "celestia.qgb.v1.QueryLatestDataCommitmentRequest")
+	proto.RegisterType((*QueryLatestDataCommitmentResponse)(nil), "celestia.qgb.v1.QueryLatestDataCommitmentResponse")
+	proto.RegisterType((*QueryDataCommitmentRangeForHeightRequest)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightRequest")
+	proto.RegisterType((*QueryDataCommitmentRangeForHeightResponse)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightResponse")
+	proto.RegisterType((*QueryEVMAddressRequest)(nil), "celestia.qgb.v1.QueryEVMAddressRequest")
+	proto.RegisterType((*QueryEVMAddressResponse)(nil), "celestia.qgb.v1.QueryEVMAddressResponse")
+}
+
+func init() { proto.RegisterFile("celestia/qgb/v1/query.proto", fileDescriptor_c8535c57355a2b91) }
+
+var fileDescriptor_c8535c57355a2b91 = []byte{
+	// 1251 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0x33, 0xf9, 0xb5, 0xf9, 0xd1, 0x27, 0xb4, 0x49, 0x27, 0xef, 0x9b, 0xe0, 0x24, 0xe3,
+	0xbc, 0x36, 0xc4, 0xab, 0x24, 0x34, 0xa1, 0x2f, 0x48, 0xc4, 0x50, 0xd4, 0x4a, 0x05, 0x82, 0x81,
+	0x1e, 0x38, 0x60, 0xcd, 0xda, 0x93, 0xcd, 0xaa, 0xf6, 0x8e, 0xb3, 0xbb, 0xb6, 0xb0, 0x80, 0x0b,
+	0x27, 0x4e, 0x80, 0xc4, 0x91, 0x03, 0x27, 0x4e, 0x08, 0x90, 0x2a, 0xc4, 0x05, 0x89, 0x0b, 0x97,
+	0xaa, 0xa7, 0x4a, 0x5c, 0x38, 0x21, 0x94, 0xf0, 0x87, 0x20, 0xcf, 0x3e, 0xeb, 0xac, 0xed, 0xdd,
+	0xb5, 0x1d, 0x95, 0x9b, 0x67, 0x9e, 0x97, 0xf9, 0x3c, 0xcf, 0x3e, 0xb3, 0xfb, 0x95, 0x61, 0xb6,
+	0x20, 0x4a, 0xc2, 0xf5, 0x2c, 0xae, 0x1f, 0x9b, 0x86, 0x5e, 0xdb, 0xd2, 0x8f, 0xab, 0xc2, 0xa9,
+	0x67, 0x2a, 0x8e, 0xf4, 0x24, 0x1d, 0x09, 0x8c, 0x99, 0x63, 0xd3, 0xc8, 0xd4, 0xb6, 0xb4, 0x17,
+	0xda, 0xbd, 0x4d, 0x61, 0x0b, 0xd7, 0x72, 0x7d, 0x7f, 0xad, 0x23, 0x99, 0x57, 0xaf, 0x88, 0xc0,
+	0x38, 0x67, 0x4a, 0x69, 0x96, 0x84, 0xce, 0x2b, 0x96, 0xce, 0x6d, 0x5b, 0x7a, 0xdc, 0xb3, 0xa4,
+	0x1d, 0x58, 0xc7, 0x4d, 0x69, 0x4a, 0xf5, 0x53, 0x6f, 0xfc, 0xc2, 0xdd, 0x99, 0x82, 0x74, 0xcb,
+	0xd2, 0xcd, 0xfb, 0x06, 0x7f, 0x11, 0x98, 0x30, 0x9d, 0x5a, 0x19, 0xd5, 0x43, 0x9d, 0xdb, 0x88,
+	0xcd, 0xc6, 0x81, 0xbe, 0xd3, 0xa8, 0xe2, 0x80, 0x3b, 0xbc, 0xec, 0xe6, 0xc4, 0x71, 0x55, 0xb8,
+	0x1e, 0xbb, 0x0f, 0x63, 0x2d, 0xbb, 0x6e, 0x45, 0xda, 0xae, 0xa0, 0xd7, 0x61, 0xa8, 0xa2, 0x76,
+	0xa6, 0xc9, 0x02, 0x59, 0x1b, 0xde, 0x9e, 0xca, 0xb4, 0x15, 0x9d, 0xf1, 0x03, 0xb2, 0x17, 0x1e,
+	0xff, 0x35, 0x3f, 0x90, 0x43, 0x67, 0xf6, 0x0a, 0x2c, 0xab, 0x6c, 0xfb, 0x9e, 0x27, 0x5c, 0xbf,
+	0x14, 0x3c, 0x28, 0x5b, 0x7f, 0x4b, 0xda, 0x05, 0x81, 0x2b, 0x3a, 0x0e, 0x17, 0xed, 0xc6, 0x5a,
+	0xa5, 0xbf, 0x90, 0xf3, 0x17, 0xac, 0x0e, 0x2b, 0xdd, 0xc2, 0x91, 0xef, 0x6d, 0x18, 0xe6, 0x67,
+	0x4e, 0x08, 0x39, 0x9e, 0xf1, 0xab, 0xcf, 0x04, 0xd5, 0x67, 0xf6, 0xed, 0x7a, 0x76, 0xea, 0xc9,
+	0xcf, 0x9b, 0x63, 0x9d, 0x19, 0xef, 0xe5, 0xc2, 0x19, 0xd8, 0x12, 0x30, 0x75, 0xf4, 0x7d, 0xde,
+	0xd8, 0x0b, 0xb9, 0x87, 0xb1, 0xd9, 0x2d, 0x48, 0x27, 0x7a, 0x21, 0x5d, 0x74, 0x75, 0x2b, 0xb0,
+	0xa4, 0x82, 0xef, 0x70, 0xa7, 0x64, 0x25, 0x1c, 0x12, 0x34, 0x31, 0xde, 0x2f, 0xf1, 0x98, 0x35,
+	0x58, 0x09, 0x31, 0xe6, 0x44, 0x89, 0xd7, 0xb9, 0x51, 0x12, 0x9d, 0x1d, 0x60, 0xdf, 0x13, 0x58,
+	0xed, 0xea, 0xfa, 0x1f, 0x35, 0x9c, 0xea, 0x30, 0x54, 0xe3, 0x25, 0x57, 0x78, 0xd3, 0x83, 0x31,
+	0x13, 0xf6, 0x40, 0x99, 0x73, 0xe8, 0xc6, 0xb2, 0x70, 0x2d, 0x04, 0x8b, 0x46, 0x9c, 0x0e, 0x71,
+	0x28, 0x1d, 0xd1, 0xc3, 0x80, 0x7d, 0x08, 0x1b, 0x3d, 0xe5, 0xc0, 0xa2, 0xcf, 0x18, 0x49, 0x6f,
+	0x8c, 0xef, 0xc1, 0x94, 0xca, 0xef, 0x6f, 0x1f, 0x38, 0x52, 0x1e, 0x26, 0x02, 0xd1, 0x34, 0x5c,
+	0xf6, 0x9c, 0xaa, 0xeb, 0x89, 0x62, 0xde, 0xb7, 0x0e, 0x2a, 0xeb, 0xf3, 0xb8, 0xa9, 0x70, 0xd8,
+	0xbb, 0x30, 0xdd, 0x99, 0x15, 0x11, 0xf7, 0xe0, 0xff, 0xfe, 0xd9, 0x8d, 0x9b, 0xfa, 0xbf, 0x04,
+	0x46, 0xbc, 0xa9, 0x81, 0x37, 0xbb, 0x01, 0x8b, 0x2a, 0xe9, 0xeb, 0xdc, 0xe3, 0xaf, 0xc9, 0x72,
+	0xd9, 0xf2, 0xca, 0xc2, 0xee, 0xed, 0x9a, 0x7e, 0x4b, 0x80, 0x25, 0xc5, 0x22, 0xda, 0x5d, 0x18,
+	0x29, 0x72, 0x8f, 0xe7, 0x0b, 0x4d, 0x0f, 0x6c, 0xe3, 0x7c, 0x07, 0x62, 0x6b, 0xa2, 0xdc, 0x95,
+	0x62, 0xcb, 0xba, 0xff, 0x59, 0xd9, 0x82, 0x99, 0x50, 0xc7, 0x7a, 0x2a, 0xea, 0x4b, 0x02, 0x5a,
+	0x54, 0xcc, 0x39, 0x47, 0x81, 0xbe, 0x0a, 0x23, 0x15, 0x47, 0xd4, 0x2c, 0x59, 0x75, 0xf3, 0xbd,
+	0xc1, 0x5f, 0x09, 0xfc, 0xfd, 0x35, 0x2b, 0xe2, 0xfb, 0x22, 0x74, 0x95, 0xdc, 0x7b, 0x76, 0xb6,
+	0x24, 0x0b, 0x0f, 0x73, 0xdc, 0x36, 0x9b, 0xf5, 0xcc, 0xc3, 0xb0, 0x21, 0x4c, 0xcb, 0xce, 0x1b,
+	0x0d, 0x13, 0x56, 0x05, 0x6a, 0x4b, 0x39, 0xd3, 0x59, 0xb8, 0x24, 0xec, 0x22, 0x9a, 0xfd, 0x01,
+	0x7b, 0x4e, 0xd8, 0x45, 0x65, 0x64, 0x8f, 0x08, 0x2c, 0x77, 0x39, 0x06, 0x5b, 0x70, 0x00, 0xa3,
+	0x6d, 0xcf, 0x33, 0x98, 0xb9, 0x6e, 0x0f, 0x14, 0x67, 0x6f, 0xa4, 0xf5, 0xb1, 0xba, 0xe1, 0xe1,
+	0x1d, 0xec, 0x6b, 0x78, 0xd3, 0xb0, 0x18, 0xba, 0xc7, 0xef, 0xdb, 0x86, 0xb4, 0x8b, 0x96, 0x6d,
+	0xde, 0x15, 0x96, 0x79, 0x14, 0x5c, 0x68, 0x76, 0x1b, 0x58, 0x92, 0x13, 0x56, 0x35, 0x09, 0x43,
+	0x47, 0x6a, 0x07, 0x1b, 0x87, 0x2b, 0xc6, 0x60, 0x21, 0x14, 0xdd, 0x36, 0xa0, 0x78, 0x42, 0x19,
+	0x16, 0x13, 0x7c, 0x9e, 0xf5, 0x35, 0x60, 0x59, 0x58, 0x8b, 0xb8, 0x76, 0xea, 0x21, 0xbd, 0x21,
+	0x9d, 0x96, 0xe2, 0x63, 0xcb, 0xaa, 0xc2, 0x7a, 0x0f, 0x39, 0x9e, 0x39, 0xfa, 0x1d, 0x98, 0xf4,
+	0xbf, 0x69, 0x0f, 0xde, 0xdc, 0x2f, 0x16, 0x1d, 0xe1, 0x06, 0x02, 0x84, 0x6e, 0xc0, 0xd5, 0x1a,
+	0x2f, 0x59, 0x45, 0xee, 0x49, 0x27, 0xcf, 0x7d, 0x9b, 0x3a, 0xe5, 0x52, 0x6e, 0xb4, 0x69, 0xc0,
+	0x18, 0x76, 0x13, 0xa6, 0x3a, 0xd2, 0x20, 0xeb, 0x3c, 0x0c, 0x8b, 0x5a, 0xb9, 0x2d, 0x03, 0x88,
+	0x5a, 0x19, 0x1d, 0xb7, 0x1f, 0x51, 0xb8, 0xa8, 0x82, 0xe9, 0x43, 0x18, 0xf2, 0xd5, 0x0b, 0x4d,
+	0x77, 0xd4, 0xd1, 0x29, 0x91, 0xb4, 0xa5, 0x64, 0x27, 0xff, 0x7c, 0x36, 0xf9, 0xd9, 0x1f, 0xff,
+	0x7c, 0x3d, 0x38, 0x4a, 0xaf, 0x04, 0x2a, 0xcf, 0x97, 0x44, 0xf4, 0x57, 0x02, 0x33, 0xb1, 0x7a,
+	0x86, 0xee, 0x46, 0xe7, 0xee, 0xa6, 0x9f, 0xb4, 0xbd, 0xbe, 0xe3, 0x10, 0x73, 0x53, 0x61, 0xae,
+	0xd2, 0xe5, 0x00, 0x33, 0xf4, 0x4d, 0x76, 0x75, 0xc7, 0x0f, 0x72, 0xf5, 0x8f, 0xd5, 0x4b, 0xf1,
+	0x53, 0xfa, 0x23, 0x81, 0xc9, 0x68, 0xb1, 0x43, 0x77, 0xa2, 0x11, 0x12, 0x05, 0x94, 0xf6, 0x52,
+	0x7f, 0x41, 0x08, 0xbd, 0xae, 0xa0, 0xd3, 0x74, 0x31, 0x12, 0x5a, 0xa1, 0xea, 0x25, 0x95, 0x82,
+	0xfe, 0x42, 0x60, 0x3a, 0x4e, 0x38, 0xd1, 0xeb, 0xd1, 0xa7, 0x77, 0x11, 0x64, 0xda, 0x6e, 0xbf,
+	0x61, 0x88, 0xbd, 0xa1, 0xb0, 0x97, 0x69, 0x3a, 0x01, 0x5b, 0x60, 0x12, 0xfa, 0x1b, 0x01, 0x2d,
+	0x5e, 0x87, 0xd1, 0xbd, 0xa4, 0xc6, 0x25, 0x88, 0x3c, 0xed, 0xe5, 0xfe, 0x03, 0x7b, 0x1c, 0x15,
+	0x0c, 0x0d, 0x3a, 0xff, 0x84, 0x40, 0x2a, 0x59, 0x57, 0xd1, 0x5b, 0x49, 0x2c, 0x5d, 0x14, 0x9d,
+	0x76, 0xfb, 0x7c, 0xc1, 0x71, 0xc5, 0xf8, 0x9f, 0x92, 0x60, 0xe2, 0x75, 0x43, 0xc5, 0x34, 0xe7,
+	0xfe, 0x73, 0x02, 0xc3, 0x21, 0xb9, 0x45, 0xd7, 0xa2, 0x0f, 0xef, 0xd4, 0x79, 0xda, 0x7a, 0x0f,
+	0x9e, 0xc8, 0xb4, 0xa4, 0x98, 0x52, 0x74, 0xae, 0x8d, 0xa9, 0xd2, 0xf0, 0x6a, 0xa2, 0xfc, 0x44,
+	0x60, 0x22, 0x52, 0x68, 0xd1, 0xed, 0xe8, 0xa3, 0x92, 0x14, 0x9d, 0xb6, 0xd3, 0x57, 0x4c, 0x5c,
+	0xf3, 0xda, 0xbe, 0x0a, 0x38, 0xcb, 0x01, 0xf1, 0x17, 0x04, 0x2e, 0xb7, 0xa8, 0x28, 0x7a, 0x2d,
+	0xa9, 0x29, 0x6d, 0x84, 0x1b, 0x3d, 0xf9, 0x76, 0x69, 0x61, 0x2b, 0xd0, 0x0f, 0x04, 0xa6, 0xe3,
+	0xe4, 0x4d, 0xdc, 0x4b, 0xa1, 0x8b, 0xea, 0xd2, 0x76, 0xfb, 0x0d, 0x43, 0x62, 0xa6, 0x88, 0xe7,
+	0xa8, 0x16, 0x7d, 0xab, 0x14, 0xd2, 0x37, 0x04, 0x26, 0x22, 0x55, 0x4b, 0xdc, 0x23, 0x4f, 0xd2,
+	0x41, 0xda, 0x4e, 0x5f, 0x31, 0x88, 0x39, 0xa3, 0x30, 0xc7, 0xe8, 0xd5, 0x00, 0xb3, 0x1a, 0x38,
+	0xd2, 0xdf, 0x09, 0xcc, 0x25, 0xc9, 0x07, 0x7a, 0xa3, 0x97, 0x19, 0x8b, 0x94, 0x2d, 0xda, 0xcd,
+	0xf3, 0x84, 0x22, 0xf2, 0x8b, 0x0a, 0x79, 0x85, 0x2e, 0xc5, 0x4d, 0xa9, 0x6a, 0xae, 0xee, 0x0b,
+	0x21, 0xfa, 0x1d, 0x81, 0xf1, 0x28, 0xdd, 0x46, 0xb7, 0x92, 0xda, 0x15, 0xa9, 0x03, 0xb5, 0xed,
+	0x7e, 0x42, 0x90, 0x76, 0x45, 0xd1, 0x2e, 0xd0, 0x54, 0x1c, 0x2d, 0xbe, 0x56, 0x3f, 0x01, 0x38,
+	0x53, 0x3b, 0x74, 0x35, 0xe6, 0x53, 0xd4, 0x2e, 0xab, 0xb4, 0xb5, 0xee, 0x8e, 0x08, 0x32, 0xab,
+	0x40, 0x26, 0xe8, 0x58, 0x00, 0x12, 0x92, 0x51, 0xd9, 0x83, 0xc7, 0x27, 0x29, 0xf2, 0xf4, 0x24,
+	0x45, 0xfe, 0x3e, 0x49, 0x91, 0xaf, 0x4e, 0x53, 0x03, 0x4f, 0x4f, 0x53, 0x03, 0x7f, 0x9e, 0xa6,
+	0x06, 0x3e, 0xd8, 0x35, 0x2d, 0xef, 0xa8, 0x6a, 0x64, 0x0a, 0xb2, 0xac, 0x07, 0x47, 0x49, 0xc7,
+	0x6c, 0xfe, 0xde, 0xe4, 0x95, 0x8a, 0xfe, 0x91, 0x6e, 0x94, 0xa4, 0xe1, 0x7a, 0x8e, 0xe0, 0x65,
+	0xff, 0x6f, 0x2f, 0x63, 0x48, 0xfd, 0x57, 0xb0, 0xf3, 0xef, 0x00, 0xca, 0xb0, 0xe7, 0x39, 0x63,
+	0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the current parameters for the blobstream module
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AttestationRequestByNonce queries attestation request by nonce.
+	// Returns nil if not found.
+	AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error)
+	// LatestAttestationNonce queries latest attestation nonce.
+	LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error)
+	// EarliestAttestationNonce queries the earliest attestation nonce.
+	EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error)
+	// LatestRelayableAttestation queries the latest attestation along with the
+	// valset whose signatures are needed to relay it to the Blobstream contract.
+	// Orchestrator signatures are not stored in state, so relayers must still
+	// collect them from the P2P network for the returned nonce.
+	LatestRelayableAttestation(ctx context.Context, in *QueryLatestRelayableAttestationRequest, opts ...grpc.CallOption) (*QueryLatestRelayableAttestationResponse, error)
+	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
+	// And, even if the current nonce is a valset, it will return the previous
+	// one.
+	// If the provided nonce is 1, it will return an error, because, there is
+	// no valset before nonce 1.
+	LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error)
+	// ValsetProof returns the valsets from the valset at trusted_nonce up to the
+	// valset at nonce, both inclusive. Light clients that trust the checkpoint
+	// of the valset at trusted_nonce, e.g. because the Blobstream contract
+	// stores it, can verify the returned valsets against it and recompute the
+	// checkpoint of every transition instead of trusting the node.
+	ValsetProof(ctx context.Context, in *QueryValsetProofRequest, opts ...grpc.CallOption) (*QueryValsetProofResponse, error)
+	// DataCommitmentByNonce queries the data commitment at nonce along with the
+	// valset whose signatures are needed to relay it.
+	DataCommitmentByNonce(ctx context.Context, in *QueryDataCommitmentByNonceRequest, opts ...grpc.CallOption) (*QueryDataCommitmentByNonceResponse, error)
+	// ValsetByNonce queries the valset at nonce along with the valset whose
+	// signatures are needed to relay it.
+	ValsetByNonce(ctx context.Context, in *QueryValsetByNonceRequest, opts ...grpc.CallOption) (*QueryValsetByNonceResponse, error)
+	// AttestationsInBlockRange queries the data commitments that commit to
+	// blocks in [begin_block, end_block) and the valsets created at heights in
+	// that range. Orchestrator signatures are not stored in state, so relayers
+	// must still collect them from the P2P network for the returned nonces.
+	AttestationsInBlockRange(ctx context.Context, in *QueryAttestationsInBlockRangeRequest, opts ...grpc.CallOption) (*QueryAttestationsInBlockRangeResponse, error)
+	// LatestUnbondingHeight returns the latest unbonding height
+	LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error)
+	// DataCommitmentRangeForHeight returns the data commitment window
+	// that includes the provided height
+	DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error)
+	// LatestDataCommitment returns the latest data commitment in store
+	LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error)
+	// EVMAddress returns the evm address associated with a supplied
+	// validator address
+	EVMAddress(ctx context.Context, in *QueryEVMAddressRequest, opts ...grpc.CallOption) (*QueryEVMAddressResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DataCommitmentRangeForHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/DataCommitmentRangeForHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DataCommitmentRangeForHeight(ctx, req.(*QueryDataCommitmentRangeForHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_LatestDataCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestDataCommitmentRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error) {
+	out := new(QueryAttestationRequestByNonceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/AttestationRequestByNonce", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestDataCommitment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/LatestDataCommitment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestDataCommitment(ctx, req.(*QueryLatestDataCommitmentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_EVMAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEVMAddressRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error) {
+	out := new(QueryLatestAttestationNonceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestAttestationNonce", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EVMAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/EVMAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EVMAddress(ctx, req.(*QueryEVMAddressRequest))
+	return out, nil
+}
+
+func (c *queryClient) EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error) {
+	out := new(QueryEarliestAttestationNonceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/EarliestAttestationNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celestia.qgb.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "AttestationRequestByNonce",
-			Handler:    _Query_AttestationRequestByNonce_Handler,
-		},
-		{
-			MethodName: "LatestAttestationNonce",
-			Handler:    _Query_LatestAttestationNonce_Handler,
-		},
-		{
-			MethodName: "EarliestAttestationNonce",
-			Handler:    _Query_EarliestAttestationNonce_Handler,
-		},
-		{
-			MethodName: "LatestValsetRequestBeforeNonce",
-			Handler:    _Query_LatestValsetRequestBeforeNonce_Handler,
-		},
-		{
-			MethodName: "LatestUnbondingHeight",
-			Handler:    _Query_LatestUnbondingHeight_Handler,
-		},
-		{
-			MethodName: "DataCommitmentRangeForHeight",
-			Handler:    _Query_DataCommitmentRangeForHeight_Handler,
-		},
-		{
-			MethodName: "LatestDataCommitment",
-			Handler:    _Query_LatestDataCommitment_Handler,
-		},
-		{
-			MethodName: "EVMAddress",
-			Handler:    _Query_EVMAddress_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "celestia/qgb/v1/query.proto",
+func (c *queryClient) LatestRelayableAttestation(ctx context.Context, in *QueryLatestRelayableAttestationRequest, opts ...grpc.CallOption) (*QueryLatestRelayableAttestationResponse, error) {
+	out := new(QueryLatestRelayableAttestationResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestRelayableAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
+	out := new(QueryLatestValsetRequestBeforeNonceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestValsetRequestBeforeNonce", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValsetProof(ctx context.Context, in *QueryValsetProofRequest, opts ...grpc.CallOption) (*QueryValsetProofResponse, error) {
+	out := new(QueryValsetProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/ValsetProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) DataCommitmentByNonce(ctx context.Context, in *QueryDataCommitmentByNonceRequest, opts ...grpc.CallOption) (*QueryDataCommitmentByNonceResponse, error) {
+	out := new(QueryDataCommitmentByNonceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/DataCommitmentByNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValsetByNonce(ctx context.Context, in *QueryValsetByNonceRequest, opts ...grpc.CallOption) (*QueryValsetByNonceResponse, error) {
+	out := new(QueryValsetByNonceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/ValsetByNonce", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryAttestationRequestByNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AttestationsInBlockRange(ctx context.Context, in *QueryAttestationsInBlockRangeRequest, opts ...grpc.CallOption) (*QueryAttestationsInBlockRangeResponse, error) {
+	out := new(QueryAttestationsInBlockRangeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/AttestationsInBlockRange", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAttestationRequestByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAttestationRequestByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error) {
+	out := new(QueryLatestUnbondingHeightResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestUnbondingHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAttestationRequestByNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error) {
+	out := new(QueryDataCommitmentRangeForHeightResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/DataCommitmentRangeForHeight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAttestationRequestByNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAttestationRequestByNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Attestation != nil {
-		{
-			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error) {
+	out := new(QueryLatestDataCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestDataCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLatestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EVMAddress(ctx context.Context, in *QueryEVMAddressRequest, opts ...grpc.CallOption) (*QueryEVMAddressResponse, error) {
+	out := new(QueryEVMAddressResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/EVMAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLatestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the current parameters for the blobstream module
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AttestationRequestByNonce queries attestation request by nonce.
+	// Returns nil if not found.
+	AttestationRequestByNonce(context.Context, *QueryAttestationRequestByNonceRequest) (*QueryAttestationRequestByNonceResponse, error)
+	// LatestAttestationNonce queries latest attestation nonce.
+	LatestAttestationNonce(context.Context, *QueryLatestAttestationNonceRequest) (*QueryLatestAttestationNonceResponse, error)
+	// EarliestAttestationNonce queries the earliest attestation nonce.
+	EarliestAttestationNonce(context.Context, *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error)
+	// LatestRelayableAttestation queries the latest attestation along with the
+	// valset whose signatures are needed to relay it to the Blobstream contract.
+	// Orchestrator signatures are not stored in state, so relayers must still
+	// collect them from the P2P network for the returned nonce.
+	LatestRelayableAttestation(context.Context, *QueryLatestRelayableAttestationRequest) (*QueryLatestRelayableAttestationResponse, error)
+	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
+	// And, even if the current nonce is a valset, it will return the previous
+	// one.
+	// If the provided nonce is 1, it will return an error, because, there is
+	// no valset before nonce 1.
+	LatestValsetRequestBeforeNonce(context.Context, *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error)
+	// ValsetProof returns the valsets from the valset at trusted_nonce up to the
+	// valset at nonce, both inclusive. Light clients that trust the checkpoint
+	// of the valset at trusted_nonce, e.g. because the Blobstream contract
+	// stores it, can verify the returned valsets against it and recompute the
+	// checkpoint of every transition instead of trusting the node.
+	ValsetProof(context.Context, *QueryValsetProofRequest) (*QueryValsetProofResponse, error)
+	// DataCommitmentByNonce queries the data commitment at nonce along with the
+	// valset whose signatures are needed to relay it.
+	DataCommitmentByNonce(context.Context, *QueryDataCommitmentByNonceRequest) (*QueryDataCommitmentByNonceResponse, error)
+	// ValsetByNonce queries the valset at nonce along with the valset whose
+	// signatures are needed to relay it.
+	ValsetByNonce(context.Context, *QueryValsetByNonceRequest) (*QueryValsetByNonceResponse, error)
+	// AttestationsInBlockRange queries the data commitments that commit to
+	// blocks in [begin_block, end_block) and the valsets created at heights in
+	// that range. Orchestrator signatures are not stored in state, so relayers
+	// must still collect them from the P2P network for the returned nonces.
+	AttestationsInBlockRange(context.Context, *QueryAttestationsInBlockRangeRequest) (*QueryAttestationsInBlockRangeResponse, error)
+	// LatestUnbondingHeight returns the latest unbonding height
+	LatestUnbondingHeight(context.Context, *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error)
+	// DataCommitmentRangeForHeight returns the data commitment window
+	// that includes the provided height
+	DataCommitmentRangeForHeight(context.Context, *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error)
+	// LatestDataCommitment returns the latest data commitment in store
+	LatestDataCommitment(context.Context, *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error)
+	// EVMAddress returns the evm address associated with a supplied
+	// validator address
+	EVMAddress(context.Context, *QueryEVMAddressRequest) (*QueryEVMAddressResponse, error)
 }
 
-func (m *QueryLatestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryLatestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryLatestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) AttestationRequestByNonce(ctx context.Context, req *QueryAttestationRequestByNonceRequest) (*QueryAttestationRequestByNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationRequestByNonce not implemented")
 }
-
-func (m *QueryLatestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) LatestAttestationNonce(ctx context.Context, req *QueryLatestAttestationNonceRequest) (*QueryLatestAttestationNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestAttestationNonce not implemented")
 }
-
-func (m *QueryEarliestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) EarliestAttestationNonce(ctx context.Context, req *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EarliestAttestationNonce not implemented")
 }
-
-func (m *QueryEarliestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) LatestRelayableAttestation(ctx context.Context, req *QueryLatestRelayableAttestationRequest) (*QueryLatestRelayableAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestRelayableAttestation not implemented")
 }
-
-func (m *QueryEarliestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) LatestValsetRequestBeforeNonce(ctx context.Context, req *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestValsetRequestBeforeNonce not implemented")
 }
-
-func (m *QueryEarliestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ValsetProof(ctx context.Context, req *QueryValsetProofRequest) (*QueryValsetProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValsetProof not implemented")
 }
-
-func (m *QueryEarliestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) DataCommitmentByNonce(ctx context.Context, req *QueryDataCommitmentByNonceRequest) (*QueryDataCommitmentByNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataCommitmentByNonce not implemented")
+}
+func (*UnimplementedQueryServer) ValsetByNonce(ctx context.Context, req *QueryValsetByNonceRequest) (*QueryValsetByNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValsetByNonce not implemented")
+}
+func (*UnimplementedQueryServer) AttestationsInBlockRange(ctx context.Context, req *QueryAttestationsInBlockRangeRequest) (*QueryAttestationsInBlockRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationsInBlockRange not implemented")
+}
+func (*UnimplementedQueryServer) LatestUnbondingHeight(ctx context.Context, req *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestUnbondingHeight not implemented")
+}
+func (*UnimplementedQueryServer) DataCommitmentRangeForHeight(ctx context.Context, req *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataCommitmentRangeForHeight not implemented")
+}
+func (*UnimplementedQueryServer) LatestDataCommitment(ctx context.Context, req *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestDataCommitment not implemented")
+}
+func (*UnimplementedQueryServer) EVMAddress(ctx context.Context, req *QueryEVMAddressRequest) (*QueryEVMAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EVMAddress not implemented")
 }
 
-func (m *QueryEarliestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+func _Query_AttestationRequestByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationRequestByNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationRequestByNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/AttestationRequestByNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationRequestByNonce(ctx, req.(*QueryAttestationRequestByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LatestAttestationNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestAttestationNonceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLatestValsetRequestBeforeNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryLatestValsetRequestBeforeNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Valset != nil {
-		{
-			size, err := m.Valset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).LatestAttestationNonce(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/LatestAttestationNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestAttestationNonce(ctx, req.(*QueryLatestAttestationNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestUnbondingHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EarliestAttestationNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEarliestAttestationNonceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLatestUnbondingHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryLatestUnbondingHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).EarliestAttestationNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/EarliestAttestationNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EarliestAttestationNonce(ctx, req.(*QueryEarliestAttestationNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestUnbondingHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LatestRelayableAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestRelayableAttestationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLatestUnbondingHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryLatestUnbondingHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).LatestRelayableAttestation(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/LatestRelayableAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestRelayableAttestation(ctx, req.(*QueryLatestRelayableAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestDataCommitmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LatestValsetRequestBeforeNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestValsetRequestBeforeNonceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLatestDataCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryLatestDataCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).LatestValsetRequestBeforeNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/LatestValsetRequestBeforeNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestValsetRequestBeforeNonce(ctx, req.(*QueryLatestValsetRequestBeforeNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestDataCommitmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ValsetProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValsetProofRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLatestDataCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryLatestDataCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DataCommitment != nil {
-		{
-			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ValsetProof(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/ValsetProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValsetProof(ctx, req.(*QueryValsetProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDataCommitmentRangeForHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DataCommitmentByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataCommitmentByNonceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDataCommitmentRangeForHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).DataCommitmentByNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/DataCommitmentByNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataCommitmentByNonce(ctx, req.(*QueryDataCommitmentByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDataCommitmentRangeForHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func _Query_ValsetByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValsetByNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ValsetByNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/ValsetByNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValsetByNonce(ctx, req.(*QueryValsetByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDataCommitmentRangeForHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AttestationsInBlockRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationsInBlockRangeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationsInBlockRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/AttestationsInBlockRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationsInBlockRange(ctx, req.(*QueryAttestationsInBlockRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDataCommitmentRangeForHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func _Query_LatestUnbondingHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestUnbondingHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestUnbondingHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/LatestUnbondingHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestUnbondingHeight(ctx, req.(*QueryLatestUnbondingHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DataCommitmentRangeForHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataCommitmentRangeForHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataCommitmentRangeForHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/DataCommitmentRangeForHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataCommitmentRangeForHeight(ctx, req.(*QueryDataCommitmentRangeForHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestDataCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestDataCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestDataCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/LatestDataCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestDataCommitment(ctx, req.(*QueryLatestDataCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EVMAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEVMAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EVMAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/EVMAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EVMAddress(ctx, req.(*QueryEVMAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.qgb.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AttestationRequestByNonce",
+			Handler:    _Query_AttestationRequestByNonce_Handler,
+		},
+		{
+			MethodName: "LatestAttestationNonce",
+			Handler:    _Query_LatestAttestationNonce_Handler,
+		},
+		{
+			MethodName: "EarliestAttestationNonce",
+			Handler:    _Query_EarliestAttestationNonce_Handler,
+		},
+		{
+			MethodName: "LatestRelayableAttestation",
+			Handler:    _Query_LatestRelayableAttestation_Handler,
+		},
+		{
+			MethodName: "LatestValsetRequestBeforeNonce",
+			Handler:    _Query_LatestValsetRequestBeforeNonce_Handler,
+		},
+		{
+			MethodName: "ValsetProof",
+			Handler:    _Query_ValsetProof_Handler,
+		},
+		{
+			MethodName: "DataCommitmentByNonce",
+			Handler:    _Query_DataCommitmentByNonce_Handler,
+		},
+		{
+			MethodName: "ValsetByNonce",
+			Handler:    _Query_ValsetByNonce_Handler,
+		},
+		{
+			MethodName: "AttestationsInBlockRange",
+			Handler:    _Query_AttestationsInBlockRange_Handler,
+		},
+		{
+			MethodName: "LatestUnbondingHeight",
+			Handler:    _Query_LatestUnbondingHeight_Handler,
+		},
+		{
+			MethodName: "DataCommitmentRangeForHeight",
+			Handler:    _Query_DataCommitmentRangeForHeight_Handler,
+		},
+		{
+			MethodName: "LatestDataCommitment",
+			Handler:    _Query_LatestDataCommitment_Handler,
+		},
+		{
+			MethodName: "EVMAddress",
+			Handler:    _Query_EVMAddress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/qgb/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDataCommitmentRangeForHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DataCommitment != nil {
-		{
-			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEVMAddressRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAttestationRequestByNonceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1772,27 +2143,25 @@ func (m *QueryEVMAddressRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryEVMAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAttestationRequestByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEVMAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAttestationRequestByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEVMAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAttestationRequestByNonceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1802,250 +2171,1874 @@ func (m *QueryEVMAddressResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryEVMAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAttestationRequestByNonceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEVMAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAttestationRequestByNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EvmAddress) > 0 {
-		i -= len(m.EvmAddress)
-		copy(dAtA[i:], m.EvmAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmAddress)))
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryLatestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryLatestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLatestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAttestationRequestByNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+func (m *QueryLatestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAttestationRequestByNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLatestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Attestation != nil {
-		l = m.Attestation.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestAttestationNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEarliestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEarliestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEarliestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestAttestationNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEarliestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEarliestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEarliestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEarliestAttestationNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLatestRelayableAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestRelayableAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestRelayableAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEarliestAttestationNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLatestRelayableAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestRelayableAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestRelayableAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if m.Valset != nil {
+		{
+			size, err := m.Valset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLatestValsetRequestBeforeNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLatestValsetRequestBeforeNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Valset != nil {
-		l = m.Valset.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Valset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestUnbondingHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValsetProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLatestUnbondingHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValsetProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if m.TrustedNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TrustedNonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestDataCommitmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValsetProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLatestDataCommitmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DataCommitment != nil {
-		l = m.DataCommitment.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryValsetProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Valsets) > 0 {
+		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataCommitmentByNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataCommitmentByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataCommitmentByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataCommitmentByNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataCommitmentByNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataCommitmentByNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Valset != nil {
+		{
+			size, err := m.Valset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DataCommitment != nil {
+		{
+			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetByNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetByNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetByNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetByNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousValset != nil {
+		{
+			size, err := m.PreviousValset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valset != nil {
+		{
+			size, err := m.Valset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsInBlockRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsInBlockRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsInBlockRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BeginBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BeginBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsInBlockRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsInBlockRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsInBlockRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Valsets) > 0 {
+		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DataCommitments) > 0 {
+		for iNdEx := len(m.DataCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestUnbondingHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestUnbondingHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestUnbondingHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestUnbondingHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestUnbondingHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestUnbondingHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestDataCommitmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestDataCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestDataCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestDataCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestDataCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestDataCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		{
+			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataCommitmentRangeForHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataCommitmentRangeForHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataCommitmentRangeForHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataCommitmentRangeForHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataCommitmentRangeForHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataCommitmentRangeForHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		{
+			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEVMAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEVMAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEVMAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEVMAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEVMAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEVMAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAttestationRequestByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryAttestationRequestByNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestAttestationNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestAttestationNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryEarliestAttestationNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEarliestAttestationNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryLatestRelayableAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestRelayableAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Valset != nil {
+		l = m.Valset.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valset != nil {
+		l = m.Valset.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValsetProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if m.TrustedNonce != 0 {
+		n += 1 + sovQuery(uint64(m.TrustedNonce))
+	}
+	return n
+}
+
+func (m *QueryValsetProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDataCommitmentByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryDataCommitmentByNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		l = m.DataCommitment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Valset != nil {
+		l = m.Valset.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValsetByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryValsetByNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valset != nil {
+		l = m.Valset.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PreviousValset != nil {
+		l = m.PreviousValset.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationsInBlockRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeginBlock != 0 {
+		n += 1 + sovQuery(uint64(m.BeginBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovQuery(uint64(m.EndBlock))
+	}
+	return n
+}
+
+func (m *QueryAttestationsInBlockRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DataCommitments) > 0 {
+		for _, e := range m.DataCommitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLatestUnbondingHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestUnbondingHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryLatestDataCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestDataCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		l = m.DataCommitment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataCommitmentRangeForHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryDataCommitmentRangeForHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		l = m.DataCommitment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEVMAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEVMAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &types.Any{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestAttestationNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestAttestationNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEarliestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEarliestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryDataCommitmentRangeForHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryDataCommitmentRangeForHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DataCommitment != nil {
-		l = m.DataCommitment.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLatestRelayableAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestRelayableAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestRelayableAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryEVMAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryEVMAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EvmAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLatestRelayableAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestRelayableAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestRelayableAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &types.Any{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Valset == nil {
+				m.Valset = &Valset{}
+			}
+			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestValsetRequestBeforeNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2068,12 +4061,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2095,7 +4107,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestValsetRequestBeforeNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2118,15 +4130,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2153,7 +4165,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Valset == nil {
+				m.Valset = &Valset{}
+			}
+			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2178,7 +4193,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValsetProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2201,10 +4216,10 @@ func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValsetProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValsetProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2226,6 +4241,25 @@ func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedNonce", wireType)
+			}
+			m.TrustedNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrustedNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2247,7 +4281,7 @@ func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValsetProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2270,15 +4304,15 @@ func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValsetProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValsetProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2305,10 +4339,8 @@ func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attestation == nil {
-				m.Attestation = &types.Any{}
-			}
-			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Valsets = append(m.Valsets, Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2333,7 +4365,7 @@ func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDataCommitmentByNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2356,12 +4388,31 @@ func (m *QueryLatestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestAttestationNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataCommitmentByNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataCommitmentByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2383,7 +4434,7 @@ func (m *QueryLatestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDataCommitmentByNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2406,17 +4457,17 @@ func (m *QueryLatestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestAttestationNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataCommitmentByNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataCommitmentByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitment", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2426,11 +4477,64 @@ func (m *QueryLatestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataCommitment == nil {
+				m.DataCommitment = &DataCommitment{}
+			}
+			if err := m.DataCommitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Valset == nil {
+				m.Valset = &Valset{}
+			}
+			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2452,7 +4556,7 @@ func (m *QueryLatestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEarliestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValsetByNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2475,12 +4579,31 @@ func (m *QueryEarliestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValsetByNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValsetByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2502,7 +4625,7 @@ func (m *QueryEarliestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEarliestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValsetByNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2525,17 +4648,17 @@ func (m *QueryEarliestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValsetByNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValsetByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2545,11 +4668,64 @@ func (m *QueryEarliestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Valset == nil {
+				m.Valset = &Valset{}
+			}
+			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousValset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousValset == nil {
+				m.PreviousValset = &Valset{}
+			}
+			if err := m.PreviousValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2571,7 +4747,7 @@ func (m *QueryEarliestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestValsetRequestBeforeNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationsInBlockRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2594,17 +4770,17 @@ func (m *QueryLatestValsetRequestBeforeNonceRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationsInBlockRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationsInBlockRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
 			}
-			m.Nonce = 0
+			m.BeginBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2614,7 +4790,26 @@ func (m *QueryLatestValsetRequestBeforeNonceRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.BeginBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2640,7 +4835,7 @@ func (m *QueryLatestValsetRequestBeforeNonceRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryLatestValsetRequestBeforeNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationsInBlockRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2663,15 +4858,15 @@ func (m *QueryLatestValsetRequestBeforeNonceResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationsInBlockRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationsInBlockRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2698,10 +4893,42 @@ func (m *QueryLatestValsetRequestBeforeNonceResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Valset == nil {
-				m.Valset = &Valset{}
+			m.DataCommitments = append(m.DataCommitments, DataCommitment{})
+			if err := m.DataCommitments[len(m.DataCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valsets = append(m.Valsets, Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
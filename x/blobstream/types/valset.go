@@ -89,3 +89,35 @@ func (v *Valset) TwoThirdsThreshold() uint64 {
 func (v *Valset) BlockTime() time.Time {
 	return v.Time
 }
+
+// MaxValsetProofLength is the maximum number of valsets returned by the
+// ValsetProof query. Light clients that are further behind need to advance
+// their trusted valset in several steps.
+const MaxValsetProofLength = 100
+
+// VerifyValsetProof verifies that valsets, as returned by the ValsetProof
+// query, start at the valset whose checkpoint is trustedCheckpoint and are in
+// strictly ascending nonce order. It returns the checkpoints of the valsets
+// so that every transition can be checked against the checkpoints accepted by
+// the Blobstream contract or the orchestrator signatures over them, which are
+// not part of the state.
+func VerifyValsetProof(trustedCheckpoint ethcmn.Hash, valsets []Valset) ([]ethcmn.Hash, error) {
+	if len(valsets) == 0 {
+		return nil, errors.Wrap(ErrInvalidValsetProof, "no valsets")
+	}
+	checkpoints := make([]ethcmn.Hash, len(valsets))
+	for i := range valsets {
+		if i > 0 && valsets[i].Nonce <= valsets[i-1].Nonce {
+			return nil, errors.Wrapf(ErrInvalidValsetProof, "valset nonce %d doesn't follow nonce %d", valsets[i].Nonce, valsets[i-1].Nonce)
+		}
+		checkpoint, err := valsets[i].SignBytes()
+		if err != nil {
+			return nil, err
+		}
+		checkpoints[i] = checkpoint
+	}
+	if checkpoints[0] != trustedCheckpoint {
+		return nil, errors.Wrapf(ErrInvalidValsetProof, "checkpoint %s of the first valset doesn't match the trusted checkpoint %s", checkpoints[0].Hex(), trustedCheckpoint.Hex())
+	}
+	return checkpoints, nil
+}
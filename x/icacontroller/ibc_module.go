@@ -0,0 +1,59 @@
+package icacontroller
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	controllertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = disabledIBCModule{}
+
+// disabledIBCModule rejects all the callbacks of the ICA controller port. It
+// is routed to in the app versions that don't have the icacontroller module,
+// whose store isn't mounted in these versions.
+type disabledIBCModule struct{}
+
+// NewDisabledIBCModule returns an IBC module that rejects all the callbacks of
+// the ICA controller port.
+func NewDisabledIBCModule() porttypes.IBCModule {
+	return disabledIBCModule{}
+}
+
+func (disabledIBCModule) OnChanOpenInit(sdk.Context, channeltypes.Order, []string, string, string, *capabilitytypes.Capability, channeltypes.Counterparty, string) (string, error) {
+	return "", controllertypes.ErrControllerSubModuleDisabled
+}
+
+func (disabledIBCModule) OnChanOpenTry(sdk.Context, channeltypes.Order, []string, string, string, *capabilitytypes.Capability, channeltypes.Counterparty, string) (string, error) {
+	return "", controllertypes.ErrControllerSubModuleDisabled
+}
+
+func (disabledIBCModule) OnChanOpenAck(sdk.Context, string, string, string, string) error {
+	return controllertypes.ErrControllerSubModuleDisabled
+}
+
+func (disabledIBCModule) OnChanOpenConfirm(sdk.Context, string, string) error {
+	return controllertypes.ErrControllerSubModuleDisabled
+}
+
+func (disabledIBCModule) OnChanCloseInit(sdk.Context, string, string) error {
+	return controllertypes.ErrControllerSubModuleDisabled
+}
+
+func (disabledIBCModule) OnChanCloseConfirm(sdk.Context, string, string) error {
+	return controllertypes.ErrControllerSubModuleDisabled
+}
+
+func (disabledIBCModule) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) exported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(controllertypes.ErrControllerSubModuleDisabled)
+}
+
+func (disabledIBCModule) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) error {
+	return controllertypes.ErrControllerSubModuleDisabled
+}
+
+func (disabledIBCModule) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) error {
+	return controllertypes.ErrControllerSubModuleDisabled
+}
@@ -0,0 +1,151 @@
+package icacontroller
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
+	controllerkeeper "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/keeper"
+	controllertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	genesistypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/genesis/types"
+)
+
+// ModuleName is the name of the icacontroller module. It is the name of the
+// controller submodule of the interchain accounts module.
+const ModuleName = controllertypes.SubModuleName
+
+var (
+	_ sdkmodule.AppModule      = AppModule{}
+	_ sdkmodule.AppModuleBasic = AppModuleBasic{}
+)
+
+// AppModuleBasic defines the basic application module used by the
+// icacontroller module. The interfaces, gRPC gateway routes and CLI commands
+// of the ICA controller are registered by the interchain accounts module.
+type AppModuleBasic struct{}
+
+// RegisterInterfaces does nothing. The interchain accounts module registers
+// the interfaces of the ICA controller.
+func (AppModuleBasic) RegisterInterfaces(_ cdctypes.InterfaceRegistry) {}
+
+// Name returns the icacontroller module's name.
+func (AppModuleBasic) Name() string {
+	return ModuleName
+}
+
+// RegisterLegacyAminoCodec does nothing. The icacontroller module doesn't use Amino.
+func (AppModuleBasic) RegisterLegacyAminoCodec(*codec.LegacyAmino) {}
+
+// DefaultGenesis returns default genesis state as raw bytes for the
+// icacontroller module. The ICA controller is enabled by default.
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	gs := genesistypes.DefaultControllerGenesis()
+	return cdc.MustMarshalJSON(&gs)
+}
+
+// ValidateGenesis performs genesis state validation for the icacontroller module.
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var data genesistypes.ControllerGenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+	return data.Validate()
+}
+
+// RegisterRESTRoutes registers the REST service handlers for the module.
+func (AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {}
+
+// RegisterGRPCGatewayRoutes does nothing. The interchain accounts module
+// registers the gRPC gateway routes of the ICA controller.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {}
+
+// GetTxCmd returns a dummy command. The tx commands of the ICA controller are
+// part of the interchain accounts module.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	// Return a dummy command
+	return &cobra.Command{}
+}
+
+// GetQueryCmd returns a dummy command. The query commands of the ICA
+// controller are part of the interchain accounts module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	// Return a dummy command
+	return &cobra.Command{}
+}
+
+// AppModule implements an application module for the icacontroller module.
+// It registers the msg and query servers of the ICA controller keeper and
+// manages its state, so that the ICA controller can be added to a chain in a
+// later app version than the ICA host.
+type AppModule struct {
+	AppModuleBasic
+	keeper *controllerkeeper.Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper *controllerkeeper.Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         keeper,
+	}
+}
+
+// RegisterInvariants registers the icacontroller module invariants.
+func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+
+// Route returns the message routing key for the icacontroller module.
+func (am AppModule) Route() sdk.Route {
+	return sdk.Route{}
+}
+
+// QuerierRoute returns the icacontroller module's querier route name.
+func (am AppModule) QuerierRoute() string {
+	return ModuleName
+}
+
+// LegacyQuerierHandler returns the icacontroller module's Querier.
+func (am AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
+	return nil
+}
+
+// RegisterServices registers the msg server for MsgRegisterInterchainAccount
+// and MsgSendTx and the query server of the ICA controller.
+func (am AppModule) RegisterServices(cfg sdkmodule.Configurator) {
+	controllertypes.RegisterMsgServer(cfg.MsgServer(), controllerkeeper.NewMsgServerImpl(am.keeper))
+	controllertypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+}
+
+// InitGenesis performs genesis initialization for the icacontroller module. It returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState genesistypes.ControllerGenesisState
+	cdc.MustUnmarshalJSON(gs, &genesisState)
+	controllerkeeper.InitGenesis(ctx, *am.keeper, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the icacontroller module.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	gs := controllerkeeper.ExportGenesis(ctx, *am.keeper)
+	return cdc.MustMarshalJSON(&gs)
+}
+
+// BeginBlock returns the begin blocker for the icacontroller module.
+func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
+// EndBlock returns the end blocker for the icacontroller module. It returns no validator updates.
+func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
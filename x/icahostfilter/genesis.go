@@ -0,0 +1,32 @@
+package icahostfilter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultGenesis returns the default genesis state in which no connection
+// overrides the allowlist of the ICA host.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis performs basic validation of genesis data returning an error for any failed validation criteria.
+func ValidateGenesis(genesis *GenesisState) error {
+	return ValidateConnectionAllowlists(genesis.ConnectionAllowlists)
+}
+
+// InitGenesis sets the connection allowlists of the genesis state. The param
+// is left unset if there are none so that the state of chains that don't use
+// the module doesn't change.
+func InitGenesis(ctx sdk.Context, subspace paramtypes.Subspace, genesis *GenesisState) {
+	if len(genesis.ConnectionAllowlists) == 0 {
+		return
+	}
+	subspace.Set(ctx, KeyConnectionAllowlists, genesis.ConnectionAllowlists)
+}
+
+// ExportGenesis returns the icahostfilter module's exported genesis.
+func ExportGenesis(ctx sdk.Context, subspace paramtypes.Subspace) *GenesisState {
+	return &GenesisState{ConnectionAllowlists: GetConnectionAllowlists(ctx, subspace)}
+}
@@ -0,0 +1,58 @@
+package icahostfilter
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ QueryServer = &QueryServerImpl{}
+
+// QueryServerImpl implements the icahostfilter gRPC query server.
+type QueryServerImpl struct {
+	subspace   paramtypes.Subspace
+	hostKeeper HostKeeper
+}
+
+// NewQueryServerImpl creates a new QueryServerImpl.
+func NewQueryServerImpl(subspace paramtypes.Subspace, hostKeeper HostKeeper) *QueryServerImpl {
+	return &QueryServerImpl{subspace: subspace, hostKeeper: hostKeeper}
+}
+
+// ConnectionAllowlists returns the allowlists of all the connections that
+// override the allowlist of the ICA host.
+func (q *QueryServerImpl) ConnectionAllowlists(ctx context.Context, _ *QueryConnectionAllowlistsRequest) (*QueryConnectionAllowlistsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &QueryConnectionAllowlistsResponse{ConnectionAllowlists: GetConnectionAllowlists(sdkCtx, q.subspace)}, nil
+}
+
+// AllowMessages returns the messages that the interchain accounts of a
+// connection are allowed to execute, i.e. the allowlist of the ICA host
+// narrowed by the allowlist of the connection if it has one.
+func (q *QueryServerImpl) AllowMessages(ctx context.Context, req *QueryAllowMessagesRequest) (*QueryAllowMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	allowMessages := q.hostKeeper.GetAllowMessages(sdkCtx)
+	connectionAllowMessages, found := GetConnectionAllowlist(sdkCtx, q.subspace, req.ConnectionId)
+	if !found {
+		return &QueryAllowMessagesResponse{AllowMessages: allowMessages}, nil
+	}
+
+	narrowed := make([]string, 0, len(connectionAllowMessages))
+	for _, typeURL := range connectionAllowMessages {
+		if isAllowed(allowMessages, typeURL) {
+			narrowed = append(narrowed, typeURL)
+		}
+	}
+	return &QueryAllowMessagesResponse{AllowMessages: narrowed, Overridden: true}, nil
+}
@@ -0,0 +1,50 @@
+package icahostfilter_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/x/icahostfilter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestQueryAllowMessages(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	subspace := testApp.GetSubspace(icahostfilter.ModuleName)
+	queryServer := icahostfilter.NewQueryServerImpl(subspace, testApp.ICAHostKeeper)
+
+	sdkCtx := testApp.NewContext(false, tmproto.Header{Height: 1})
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	hostAllowMessages := testApp.ICAHostKeeper.GetAllowMessages(sdkCtx)
+	require.NotEmpty(t, hostAllowMessages)
+
+	// no connection overrides the allowlist of the ICA host by default.
+	allowlists, err := queryServer.ConnectionAllowlists(ctx, &icahostfilter.QueryConnectionAllowlistsRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, allowlists.ConnectionAllowlists)
+
+	want := []icahostfilter.ConnectionAllowlist{
+		{ConnectionId: "connection-0", AllowMessages: []string{"/ibc.applications.transfer.v1.MsgTransfer", "/cosmos.authz.v1beta1.MsgExec"}},
+	}
+	subspace.Set(sdkCtx, icahostfilter.KeyConnectionAllowlists, want)
+	allowlists, err = queryServer.ConnectionAllowlists(ctx, &icahostfilter.QueryConnectionAllowlistsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, want, allowlists.ConnectionAllowlists)
+
+	// the allowlist of the connection is narrowed to the messages that are
+	// also allowed by the ICA host.
+	resp, err := queryServer.AllowMessages(ctx, &icahostfilter.QueryAllowMessagesRequest{ConnectionId: "connection-0"})
+	require.NoError(t, err)
+	assert.Equal(t, &icahostfilter.QueryAllowMessagesResponse{AllowMessages: []string{"/ibc.applications.transfer.v1.MsgTransfer"}, Overridden: true}, resp)
+
+	resp, err = queryServer.AllowMessages(ctx, &icahostfilter.QueryAllowMessagesRequest{ConnectionId: "connection-1"})
+	require.NoError(t, err)
+	assert.Equal(t, &icahostfilter.QueryAllowMessagesResponse{AllowMessages: hostAllowMessages}, resp)
+
+	_, err = queryServer.AllowMessages(ctx, &icahostfilter.QueryAllowMessagesRequest{ConnectionId: ""})
+	assert.Error(t, err)
+}
@@ -0,0 +1,130 @@
+package icahostfilter
+
+import (
+	"slices"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+// ChannelKeeper is the subset of the IBC channel keeper that is used to find
+// the connection of a channel.
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+}
+
+// HostKeeper is the subset of the ICA host keeper that is used to read the
+// allowlist of the ICA host.
+type HostKeeper interface {
+	GetAllowMessages(ctx sdk.Context) []string
+}
+
+// icaHostFilterMiddleware directly inherits the IBCModule interface. Only with
+// OnRecvPacket, does it wrap the ICA host with additional logic for rejecting
+// the messages of an interchain account tx that are not in the allowlist of the
+// connection of the channel. Connections without an allowlist are only subject
+// to the allowlist of the ICA host, which is still enforced by the ICA host for
+// all connections.
+type icaHostFilterMiddleware struct {
+	porttypes.IBCModule
+	cdc           codec.BinaryCodec
+	subspace      paramtypes.Subspace
+	channelKeeper ChannelKeeper
+}
+
+// NewIBCMiddleware creates a new instance of the ICA host filter middleware for
+// the ICA host module.
+func NewIBCMiddleware(ibcModule porttypes.IBCModule, cdc codec.BinaryCodec, subspace paramtypes.Subspace, channelKeeper ChannelKeeper) porttypes.IBCModule {
+	return &icaHostFilterMiddleware{
+		IBCModule:     ibcModule,
+		cdc:           cdc,
+		subspace:      RegisterParamTable(subspace),
+		channelKeeper: channelKeeper,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. It is called whenever a new
+// packet from another chain is received on this chain. Here, the middleware
+// unmarshals the InterchainAccountPacketData of a tx and checks that all of its
+// messages are in the allowlist of the connection. If not, it returns an
+// ErrorAcknowledgement. Packets that can't be decoded are passed on to the ICA
+// host which rejects them.
+func (m *icaHostFilterMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	channel, found := m.channelKeeper.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found || len(channel.ConnectionHops) == 0 {
+		return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	allowMessages, found := GetConnectionAllowlist(ctx, m.subspace, channel.ConnectionHops[0])
+	if !found {
+		return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	var data icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil || data.Type != icatypes.EXECUTE_TX {
+		return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	msgs, err := icatypes.DeserializeCosmosTx(m.cdc, data.Data)
+	if err != nil {
+		return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	if err := filterMsgs(allowMessages, msgs); err != nil {
+		ackErr := errors.Wrapf(err, "connection %s", channel.ConnectionHops[0])
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				icatypes.EventTypePacket,
+				sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+				sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, packet.GetDestChannel()),
+				sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, "false"),
+				sdk.NewAttribute(icatypes.AttributeKeyAckError, ackErr.Error()),
+			),
+		)
+		return channeltypes.NewErrorAcknowledgement(ackErr)
+	}
+
+	return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// filterMsgs returns an error if any of msgs isn't in allowMessages. The
+// messages nested in an authz MsgExec must be allowed as well, otherwise an
+// interchain account that is granted an authorization could execute any
+// message by wrapping it.
+func filterMsgs(allowMessages []string, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		if !isAllowed(allowMessages, typeURL) {
+			return errors.Wrapf(sdkerrors.ErrUnauthorized, "message type %s is not allowed", typeURL)
+		}
+
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			nestedMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := filterMsgs(allowMessages, nestedMsgs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isAllowed returns true if typeURL is in allowMessages. It treats the
+// wildcard the same way as the ICA host so that it can also be used with the
+// allowlist of the ICA host.
+func isAllowed(allowMessages []string, typeURL string) bool {
+	return slices.Contains(allowMessages, icahosttypes.AllowAllHostMsgs) || slices.Contains(allowMessages, typeURL)
+}
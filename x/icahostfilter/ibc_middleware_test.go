@@ -0,0 +1,134 @@
+package icahostfilter_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/x/icahostfilter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestOnRecvPacket(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(false, tmproto.Header{Height: 1})
+	subspace := testApp.GetSubspace(icahostfilter.ModuleName)
+	subspace.Set(ctx, icahostfilter.KeyConnectionAllowlists, []icahostfilter.ConnectionAllowlist{
+		{ConnectionId: "connection-0", AllowMessages: []string{sdk.MsgTypeURL(&transfertypes.MsgTransfer{})}},
+		{ConnectionId: "connection-1"},
+		{ConnectionId: "connection-4", AllowMessages: []string{sdk.MsgTypeURL(&authz.MsgExec{}), sdk.MsgTypeURL(&transfertypes.MsgTransfer{})}},
+	})
+	channelKeeper := mockChannelKeeper{
+		"channel-0": "connection-0",
+		"channel-1": "connection-1",
+		"channel-2": "connection-2",
+		"channel-4": "connection-4",
+	}
+	nestedSend := authz.NewMsgExec(sdk.AccAddress{}, []sdk.Msg{&banktypes.MsgSend{}})
+	nestedTransfer := authz.NewMsgExec(sdk.AccAddress{}, []sdk.Msg{&transfertypes.MsgTransfer{}})
+
+	newPacket := func(channelID string, msgs ...proto.Message) channeltypes.Packet {
+		bz, err := icatypes.SerializeCosmosTx(testApp.AppCodec(), msgs)
+		require.NoError(t, err)
+		data := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: bz}
+		return channeltypes.NewPacket(data.GetBytes(), 1, "icacontroller-owner", "channel-5", icatypes.HostPortID, channelID, clienttypes.Height{}, 0)
+	}
+
+	testCases := []struct {
+		name   string
+		packet channeltypes.Packet
+		err    bool
+	}{
+		{
+			name:   "allowed message",
+			packet: newPacket("channel-0", &transfertypes.MsgTransfer{}),
+			err:    false,
+		},
+		{
+			name:   "message not in the allowlist of the connection",
+			packet: newPacket("channel-0", &transfertypes.MsgTransfer{}, &banktypes.MsgSend{}),
+			err:    true,
+		},
+		{
+			name:   "connection that denies all messages",
+			packet: newPacket("channel-1", &transfertypes.MsgTransfer{}),
+			err:    true,
+		},
+		{
+			name:   "message nested in an authz exec that is not in the allowlist",
+			packet: newPacket("channel-4", &nestedSend),
+			err:    true,
+		},
+		{
+			name:   "allowed message nested in an authz exec",
+			packet: newPacket("channel-4", &nestedTransfer),
+			err:    false,
+		},
+		{
+			name:   "authz exec that is not in the allowlist",
+			packet: newPacket("channel-0", &nestedTransfer),
+			err:    true,
+		},
+		{
+			name:   "connection without an allowlist",
+			packet: newPacket("channel-2", &stakingtypes.MsgDelegate{}),
+			err:    false,
+		},
+		{
+			name:   "unknown channel",
+			packet: newPacket("channel-3", &stakingtypes.MsgDelegate{}),
+			err:    false,
+		},
+		{
+			name:   "random packet",
+			packet: channeltypes.NewPacket([]byte{1, 2, 3, 4}, 1, "icacontroller-owner", "channel-5", icatypes.HostPortID, "channel-1", clienttypes.Height{}, 0),
+			err:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			module := &mockIBCModule{}
+			middleware := icahostfilter.NewIBCMiddleware(module, testApp.AppCodec(), subspace, channelKeeper)
+
+			ack := middleware.OnRecvPacket(ctx.WithEventManager(sdk.NewEventManager()), tc.packet, []byte{})
+			assert.Equal(t, !tc.err, module.called)
+			assert.Equal(t, !tc.err, ack.Success())
+		})
+	}
+}
+
+// mockChannelKeeper maps the ids of the channels to the ids of their
+// connections.
+type mockChannelKeeper map[string]string
+
+func (m mockChannelKeeper) GetChannel(_ sdk.Context, _, channelID string) (channeltypes.Channel, bool) {
+	connectionID, found := m[channelID]
+	if !found {
+		return channeltypes.Channel{}, false
+	}
+	return channeltypes.Channel{ConnectionHops: []string{connectionID}}, true
+}
+
+type mockIBCModule struct {
+	porttypes.IBCModule
+	called bool
+}
+
+func (m *mockIBCModule) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	m.called = true
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
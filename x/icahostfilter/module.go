@@ -0,0 +1,140 @@
+package icahostfilter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	_ sdkmodule.AppModule      = AppModule{}
+	_ sdkmodule.AppModuleBasic = AppModuleBasic{}
+)
+
+// AppModuleBasic defines the basic application module used by the icahostfilter module.
+type AppModuleBasic struct{}
+
+// RegisterInterfaces registers the module's interfaces with the interface registry.
+func (AppModuleBasic) RegisterInterfaces(_ cdctypes.InterfaceRegistry) {}
+
+// Name returns the icahostfilter module's name.
+func (AppModuleBasic) Name() string {
+	return ModuleName
+}
+
+// RegisterLegacyAminoCodec does nothing. The icahostfilter module doesn't use Amino.
+func (AppModuleBasic) RegisterLegacyAminoCodec(*codec.LegacyAmino) {}
+
+// DefaultGenesis returns default genesis state as raw bytes for the icahostfilter module.
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(DefaultGenesis())
+}
+
+// ValidateGenesis performs genesis state validation for the icahostfilter module.
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var data GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+	return ValidateGenesis(&data)
+}
+
+// RegisterRESTRoutes registers the REST service handlers for the module.
+func (AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// GetTxCmd returns a dummy command. The allowlists are changed by param
+// change proposals.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	// Return a dummy command
+	return &cobra.Command{}
+}
+
+// GetQueryCmd returns a dummy command.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	// Return a dummy command
+	return &cobra.Command{}
+}
+
+// AppModule implements an application module for the icahostfilter module.
+type AppModule struct {
+	AppModuleBasic
+	subspace   paramtypes.Subspace
+	hostKeeper HostKeeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(subspace paramtypes.Subspace, hostKeeper HostKeeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		// Register the parameter key table in its associated subspace.
+		subspace:   RegisterParamTable(subspace),
+		hostKeeper: hostKeeper,
+	}
+}
+
+// RegisterInvariants registers the icahostfilter module invariants.
+func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+
+// Route returns the message routing key for the icahostfilter module.
+func (am AppModule) Route() sdk.Route {
+	return sdk.Route{}
+}
+
+// QuerierRoute returns the icahostfilter module's querier route name.
+func (am AppModule) QuerierRoute() string {
+	return ModuleName
+}
+
+// LegacyQuerierHandler returns the icahostfilter module's Querier.
+func (am AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
+	return nil
+}
+
+// RegisterServices registers module services.
+func (am AppModule) RegisterServices(cfg sdkmodule.Configurator) {
+	RegisterQueryServer(cfg.QueryServer(), NewQueryServerImpl(am.subspace, am.hostKeeper))
+}
+
+// InitGenesis performs genesis initialization for the icahostfilter module. It returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	cdc.MustUnmarshalJSON(gs, &genesisState)
+	InitGenesis(ctx, am.subspace, &genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the icahostfilter module.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(ExportGenesis(ctx, am.subspace))
+}
+
+// BeginBlock returns the begin blocker for the icahostfilter module.
+func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
+// EndBlock returns the end blocker for the icahostfilter module. It returns no validator updates.
+func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
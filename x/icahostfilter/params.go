@@ -0,0 +1,97 @@
+package icahostfilter
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+const ModuleName = "icahostfilter"
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var KeyConnectionAllowlists = []byte("ConnectionAllowlists")
+
+type Params struct {
+	ConnectionAllowlists []ConnectionAllowlist
+}
+
+// RegisterParamTable returns a subspace with a key table attached.
+func RegisterParamTable(subspace paramtypes.Subspace) paramtypes.Subspace {
+	if subspace.HasKeyTable() {
+		return subspace
+	}
+	return subspace.WithKeyTable(ParamKeyTable())
+}
+
+// ParamKeyTable returns the param key table for the icahostfilter module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs gets the param key-value pair
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyConnectionAllowlists, &p.ConnectionAllowlists, ValidateConnectionAllowlists),
+	}
+}
+
+// ValidateConnectionAllowlists validates the param type, that every connection
+// has at most one allowlist and that the allowlists are explicit lists of
+// distinct messages. An empty allowlist denies all the messages of the
+// connection.
+func ValidateConnectionAllowlists(i interface{}) error {
+	allowlists, ok := i.([]ConnectionAllowlist)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	connections := make(map[string]bool, len(allowlists))
+	for _, allowlist := range allowlists {
+		if err := host.ConnectionIdentifierValidator(allowlist.ConnectionId); err != nil {
+			return err
+		}
+		if connections[allowlist.ConnectionId] {
+			return fmt.Errorf("duplicate allowlist for connection %s", allowlist.ConnectionId)
+		}
+		connections[allowlist.ConnectionId] = true
+
+		seen := make(map[string]bool, len(allowlist.AllowMessages))
+		for _, typeURL := range allowlist.AllowMessages {
+			switch {
+			case typeURL == icahosttypes.AllowAllHostMsgs:
+				return fmt.Errorf("allowing all messages with %q is not supported", icahosttypes.AllowAllHostMsgs)
+			case typeURL == "":
+				return fmt.Errorf("empty message type for connection %s", allowlist.ConnectionId)
+			case seen[typeURL]:
+				return fmt.Errorf("duplicate message %s for connection %s", typeURL, allowlist.ConnectionId)
+			}
+			seen[typeURL] = true
+		}
+	}
+
+	return nil
+}
+
+// GetConnectionAllowlists returns the allowlists of all the connections that
+// override the allowlist of the ICA host. The param is only set once it has
+// been changed from its default of no allowlists.
+func GetConnectionAllowlists(ctx sdk.Context, subspace paramtypes.Subspace) []ConnectionAllowlist {
+	var allowlists []ConnectionAllowlist
+	subspace.GetIfExists(ctx, KeyConnectionAllowlists, &allowlists)
+	return allowlists
+}
+
+// GetConnectionAllowlist returns the allowlist of a connection and whether the
+// connection has one.
+func GetConnectionAllowlist(ctx sdk.Context, subspace paramtypes.Subspace, connectionID string) ([]string, bool) {
+	for _, allowlist := range GetConnectionAllowlists(ctx, subspace) {
+		if allowlist.ConnectionId == connectionID {
+			return allowlist.AllowMessages, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,71 @@
+package icahostfilter_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/x/icahostfilter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateConnectionAllowlists(t *testing.T) {
+	testCases := []struct {
+		name       string
+		allowlists interface{}
+		wantErr    bool
+	}{
+		{
+			name:       "no allowlists",
+			allowlists: []icahostfilter.ConnectionAllowlist{},
+		},
+		{
+			name: "allowlists",
+			allowlists: []icahostfilter.ConnectionAllowlist{
+				{ConnectionId: "connection-0", AllowMessages: []string{"/ibc.applications.transfer.v1.MsgTransfer"}},
+				{ConnectionId: "connection-1"},
+			},
+		},
+		{
+			name:       "invalid type",
+			allowlists: []string{"/ibc.applications.transfer.v1.MsgTransfer"},
+			wantErr:    true,
+		},
+		{
+			name:       "invalid connection",
+			allowlists: []icahostfilter.ConnectionAllowlist{{ConnectionId: "channel/0"}},
+			wantErr:    true,
+		},
+		{
+			name:       "duplicate connection",
+			allowlists: []icahostfilter.ConnectionAllowlist{{ConnectionId: "connection-0"}, {ConnectionId: "connection-0"}},
+			wantErr:    true,
+		},
+		{
+			name:       "all messages",
+			allowlists: []icahostfilter.ConnectionAllowlist{{ConnectionId: "connection-0", AllowMessages: []string{"*"}}},
+			wantErr:    true,
+		},
+		{
+			name:       "empty message",
+			allowlists: []icahostfilter.ConnectionAllowlist{{ConnectionId: "connection-0", AllowMessages: []string{""}}},
+			wantErr:    true,
+		},
+		{
+			name: "duplicate message",
+			allowlists: []icahostfilter.ConnectionAllowlist{
+				{ConnectionId: "connection-0", AllowMessages: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := icahostfilter.ValidateConnectionAllowlists(tc.allowlists)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,991 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/icahostfilter/v1/query.proto
+
+package icahostfilter
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryConnectionAllowlistsRequest is the request type for the
+// Query/ConnectionAllowlists RPC method.
+type QueryConnectionAllowlistsRequest struct {
+}
+
+func (m *QueryConnectionAllowlistsRequest) Reset()         { *m = QueryConnectionAllowlistsRequest{} }
+func (m *QueryConnectionAllowlistsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConnectionAllowlistsRequest) ProtoMessage()    {}
+func (*QueryConnectionAllowlistsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee9e7a558dde27a, []int{0}
+}
+func (m *QueryConnectionAllowlistsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionAllowlistsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionAllowlistsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionAllowlistsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionAllowlistsRequest.Merge(m, src)
+}
+func (m *QueryConnectionAllowlistsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionAllowlistsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionAllowlistsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionAllowlistsRequest proto.InternalMessageInfo
+
+// QueryConnectionAllowlistsResponse is the response type for the
+// Query/ConnectionAllowlists RPC method.
+type QueryConnectionAllowlistsResponse struct {
+	ConnectionAllowlists []ConnectionAllowlist `protobuf:"bytes,1,rep,name=connection_allowlists,json=connectionAllowlists,proto3" json:"connection_allowlists"`
+}
+
+func (m *QueryConnectionAllowlistsResponse) Reset()         { *m = QueryConnectionAllowlistsResponse{} }
+func (m *QueryConnectionAllowlistsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConnectionAllowlistsResponse) ProtoMessage()    {}
+func (*QueryConnectionAllowlistsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee9e7a558dde27a, []int{1}
+}
+func (m *QueryConnectionAllowlistsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionAllowlistsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionAllowlistsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionAllowlistsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionAllowlistsResponse.Merge(m, src)
+}
+func (m *QueryConnectionAllowlistsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionAllowlistsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionAllowlistsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionAllowlistsResponse proto.InternalMessageInfo
+
+func (m *QueryConnectionAllowlistsResponse) GetConnectionAllowlists() []ConnectionAllowlist {
+	if m != nil {
+		return m.ConnectionAllowlists
+	}
+	return nil
+}
+
+// QueryAllowMessagesRequest is the request type for the Query/AllowMessages
+// RPC method.
+type QueryAllowMessagesRequest struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (m *QueryAllowMessagesRequest) Reset()         { *m = QueryAllowMessagesRequest{} }
+func (m *QueryAllowMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowMessagesRequest) ProtoMessage()    {}
+func (*QueryAllowMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee9e7a558dde27a, []int{2}
+}
+func (m *QueryAllowMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowMessagesRequest.Merge(m, src)
+}
+func (m *QueryAllowMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowMessagesRequest proto.InternalMessageInfo
+
+func (m *QueryAllowMessagesRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// QueryAllowMessagesResponse is the response type for the Query/AllowMessages
+// RPC method.
+type QueryAllowMessagesResponse struct {
+	// allow_messages are the type URLs of the messages that are allowed for the
+	// connection.
+	AllowMessages []string `protobuf:"bytes,1,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+	// overridden is true if the connection has an allowlist that narrows the
+	// allowlist of the ICA host.
+	Overridden bool `protobuf:"varint,2,opt,name=overridden,proto3" json:"overridden,omitempty"`
+}
+
+func (m *QueryAllowMessagesResponse) Reset()         { *m = QueryAllowMessagesResponse{} }
+func (m *QueryAllowMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowMessagesResponse) ProtoMessage()    {}
+func (*QueryAllowMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee9e7a558dde27a, []int{3}
+}
+func (m *QueryAllowMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowMessagesResponse.Merge(m, src)
+}
+func (m *QueryAllowMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowMessagesResponse proto.InternalMessageInfo
+
+func (m *QueryAllowMessagesResponse) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
+func (m *QueryAllowMessagesResponse) GetOverridden() bool {
+	if m != nil {
+		return m.Overridden
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryConnectionAllowlistsRequest)(nil), "celestia.icahostfilter.v1.QueryConnectionAllowlistsRequest")
+	proto.RegisterType((*QueryConnectionAllowlistsResponse)(nil), "celestia.icahostfilter.v1.QueryConnectionAllowlistsResponse")
+	proto.RegisterType((*QueryAllowMessagesRequest)(nil), "celestia.icahostfilter.v1.QueryAllowMessagesRequest")
+	proto.RegisterType((*QueryAllowMessagesResponse)(nil), "celestia.icahostfilter.v1.QueryAllowMessagesResponse")
+}
+
+func init() {
+	proto.RegisterFile("celestia/icahostfilter/v1/query.proto", fileDescriptor_1ee9e7a558dde27a)
+}
+
+var fileDescriptor_1ee9e7a558dde27a = []byte{
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcd, 0xae, 0xd2, 0x40,
+	0x14, 0xc7, 0x3b, 0xf7, 0xaa, 0xf1, 0x8e, 0xe2, 0x62, 0x82, 0x09, 0xb7, 0x31, 0xb5, 0xd6, 0x10,
+	0xd9, 0xd8, 0x11, 0xd0, 0x44, 0x83, 0x0b, 0xc1, 0x95, 0x0b, 0x16, 0x76, 0xe9, 0x86, 0x0c, 0xed,
+	0x38, 0x4c, 0x52, 0x66, 0x4a, 0x67, 0x40, 0x8d, 0x71, 0xe3, 0x03, 0x18, 0x13, 0x17, 0x3e, 0x8e,
+	0x5b, 0x12, 0x37, 0x24, 0x6e, 0x5c, 0x19, 0x03, 0x3e, 0x88, 0x61, 0x28, 0x95, 0x26, 0x05, 0xe2,
+	0xdd, 0x4d, 0xce, 0xf9, 0x9f, 0x73, 0x7e, 0xe7, 0x63, 0x60, 0x3d, 0xa4, 0x31, 0x55, 0x9a, 0x13,
+	0xcc, 0x43, 0x32, 0x92, 0x4a, 0xbf, 0xe6, 0xb1, 0xa6, 0x29, 0x9e, 0x35, 0xf1, 0x64, 0x4a, 0xd3,
+	0x77, 0x7e, 0x92, 0x4a, 0x2d, 0xd1, 0xf9, 0x56, 0xe6, 0x17, 0x64, 0xfe, 0xac, 0x69, 0x57, 0x99,
+	0x64, 0xd2, 0xa8, 0xf0, 0xfa, 0xb5, 0x09, 0xb0, 0x6f, 0x31, 0x29, 0x59, 0x4c, 0x31, 0x49, 0x38,
+	0x26, 0x42, 0x48, 0x4d, 0x34, 0x97, 0x42, 0x65, 0xde, 0x7b, 0xfb, 0xab, 0x32, 0x2a, 0xa8, 0xe2,
+	0x99, 0xd0, 0xf3, 0xa0, 0xfb, 0x72, 0x8d, 0xf1, 0x5c, 0x0a, 0x41, 0xc3, 0x75, 0x8a, 0x6e, 0x1c,
+	0xcb, 0x37, 0x31, 0x57, 0x5a, 0x05, 0x74, 0x32, 0xa5, 0x4a, 0x7b, 0x9f, 0x00, 0xbc, 0x73, 0x40,
+	0xa4, 0x12, 0x29, 0x14, 0x45, 0x1c, 0xde, 0x0c, 0x73, 0xff, 0x80, 0xe4, 0x82, 0x1a, 0x70, 0x4f,
+	0x1b, 0xd7, 0x5a, 0xbe, 0xbf, 0xb7, 0x43, 0xbf, 0x24, 0x6f, 0xef, 0xd2, 0xfc, 0xd7, 0x6d, 0x2b,
+	0xa8, 0x86, 0x25, 0x25, 0xbd, 0x67, 0xf0, 0xdc, 0xf0, 0x18, 0x53, 0x9f, 0x2a, 0x45, 0x18, 0xdd,
+	0xd2, 0xa2, 0xbb, 0xb0, 0xb2, 0xc3, 0xc1, 0xa3, 0x1a, 0x70, 0x41, 0xe3, 0x2c, 0xb8, 0xfe, 0xcf,
+	0xf8, 0x22, 0xf2, 0x42, 0x68, 0x97, 0x65, 0xc8, 0x5a, 0xa9, 0xc3, 0x1b, 0x86, 0x7f, 0x30, 0xce,
+	0x3c, 0xa6, 0x87, 0xb3, 0xa0, 0x42, 0x76, 0xe5, 0xc8, 0x81, 0x50, 0xce, 0x68, 0x9a, 0xf2, 0x28,
+	0xa2, 0xa2, 0x76, 0xe2, 0x82, 0xc6, 0xd5, 0x60, 0xc7, 0xd2, 0xfa, 0x7a, 0x0a, 0x2f, 0x9b, 0x2a,
+	0xe8, 0x3b, 0x80, 0xd5, 0xb2, 0xe1, 0xa1, 0xce, 0x81, 0xa9, 0x1c, 0xdb, 0x8b, 0xfd, 0xf4, 0x62,
+	0xc1, 0x9b, 0x26, 0xbd, 0xc7, 0x1f, 0x7f, 0xfc, 0xf9, 0x72, 0xd2, 0x42, 0x0f, 0xf0, 0xfe, 0x5b,
+	0x29, 0x5d, 0x28, 0xfa, 0x06, 0x60, 0xa5, 0x30, 0x38, 0xf4, 0xf0, 0x18, 0x49, 0xd9, 0xa6, 0xec,
+	0x47, 0xff, 0x19, 0x95, 0x81, 0x77, 0x0d, 0x78, 0x07, 0x3d, 0x39, 0x00, 0x5e, 0x5c, 0x1f, 0x7e,
+	0x5f, 0xb8, 0x88, 0x0f, 0xbd, 0xfe, 0x7c, 0xe9, 0x80, 0xc5, 0xd2, 0x01, 0xbf, 0x97, 0x0e, 0xf8,
+	0xbc, 0x72, 0xac, 0xc5, 0xca, 0xb1, 0x7e, 0xae, 0x1c, 0xeb, 0x55, 0x9b, 0x71, 0x3d, 0x9a, 0x0e,
+	0xfd, 0x50, 0x8e, 0xf3, 0xf4, 0x32, 0x65, 0xf9, 0xfb, 0x3e, 0x49, 0x12, 0xfc, 0xb6, 0x58, 0x70,
+	0x78, 0xc5, 0xfc, 0xa5, 0xf6, 0xdf, 0x01, 0x00, 0xd8, 0x3b, 0x2f, 0x68, 0xec, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ConnectionAllowlists queries the allowlists of all the connections that
+	// override the allowlist of the ICA host.
+	ConnectionAllowlists(ctx context.Context, in *QueryConnectionAllowlistsRequest, opts ...grpc.CallOption) (*QueryConnectionAllowlistsResponse, error)
+	// AllowMessages queries the messages that the interchain accounts of a
+	// connection are allowed to execute.
+	AllowMessages(ctx context.Context, in *QueryAllowMessagesRequest, opts ...grpc.CallOption) (*QueryAllowMessagesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ConnectionAllowlists(ctx context.Context, in *QueryConnectionAllowlistsRequest, opts ...grpc.CallOption) (*QueryConnectionAllowlistsResponse, error) {
+	out := new(QueryConnectionAllowlistsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.icahostfilter.v1.Query/ConnectionAllowlists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowMessages(ctx context.Context, in *QueryAllowMessagesRequest, opts ...grpc.CallOption) (*QueryAllowMessagesResponse, error) {
+	out := new(QueryAllowMessagesResponse)
+	err := c.cc.Invoke(ctx, "/celestia.icahostfilter.v1.Query/AllowMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ConnectionAllowlists queries the allowlists of all the connections that
+	// override the allowlist of the ICA host.
+	ConnectionAllowlists(context.Context, *QueryConnectionAllowlistsRequest) (*QueryConnectionAllowlistsResponse, error)
+	// AllowMessages queries the messages that the interchain accounts of a
+	// connection are allowed to execute.
+	AllowMessages(context.Context, *QueryAllowMessagesRequest) (*QueryAllowMessagesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ConnectionAllowlists(ctx context.Context, req *QueryConnectionAllowlistsRequest) (*QueryConnectionAllowlistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionAllowlists not implemented")
+}
+func (*UnimplementedQueryServer) AllowMessages(ctx context.Context, req *QueryAllowMessagesRequest) (*QueryAllowMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowMessages not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ConnectionAllowlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConnectionAllowlistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConnectionAllowlists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.icahostfilter.v1.Query/ConnectionAllowlists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConnectionAllowlists(ctx, req.(*QueryConnectionAllowlistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.icahostfilter.v1.Query/AllowMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowMessages(ctx, req.(*QueryAllowMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.icahostfilter.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConnectionAllowlists",
+			Handler:    _Query_ConnectionAllowlists_Handler,
+		},
+		{
+			MethodName: "AllowMessages",
+			Handler:    _Query_AllowMessages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/icahostfilter/v1/query.proto",
+}
+
+func (m *QueryConnectionAllowlistsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConnectionAllowlistsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConnectionAllowlistsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConnectionAllowlistsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConnectionAllowlistsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConnectionAllowlistsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionAllowlists) > 0 {
+		for iNdEx := len(m.ConnectionAllowlists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConnectionAllowlists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Overridden {
+		i--
+		if m.Overridden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConnectionAllowlistsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConnectionAllowlistsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConnectionAllowlists) > 0 {
+		for _, e := range m.ConnectionAllowlists {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllowMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Overridden {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryConnectionAllowlistsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionAllowlistsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionAllowlistsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConnectionAllowlistsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionAllowlistsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionAllowlistsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionAllowlists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionAllowlists = append(m.ConnectionAllowlists, ConnectionAllowlist{})
+			if err := m.ConnectionAllowlists[len(m.ConnectionAllowlists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overridden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overridden = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -45,12 +45,14 @@ func ParamKeyTable() paramtypes.KeyTable {
 // ParamSetPairs gets the param key-value pair
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
-		paramtypes.NewParamSetPair(KeyNetworkMinGasPrice, &p.NetworkMinGasPrice, ValidateMinGasPrice),
+		paramtypes.NewParamSetPair(KeyNetworkMinGasPrice, &p.NetworkMinGasPrice, validateMinGasPriceType),
 	}
 }
 
-// Validate validates the param type
-func ValidateMinGasPrice(i interface{}) error {
+// validateMinGasPriceType validates the param type. The value is validated by
+// ValidateMinGasPrice in the app versions that bound it, see the bounded params
+// of the app.
+func validateMinGasPriceType(i interface{}) error {
 	_, ok := i.(sdk.Dec)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
@@ -58,3 +60,18 @@ func ValidateMinGasPrice(i interface{}) error {
 
 	return nil
 }
+
+// ValidateMinGasPrice validates the param type and that the network min gas
+// price is positive. From app version 4 onwards it is applied to governance
+// proposals that update the param so the network wide fee policy can't be
+// disabled.
+func ValidateMinGasPrice(i interface{}) error {
+	if err := validateMinGasPriceType(i); err != nil {
+		return err
+	}
+	if minGasPrice := i.(sdk.Dec); minGasPrice.IsNil() || !minGasPrice.IsPositive() {
+		return fmt.Errorf("network min gas price must be positive: %s", minGasPrice)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package minfee_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMinGasPrice(t *testing.T) {
+	testCases := []struct {
+		name    string
+		value   interface{}
+		wantErr bool
+	}{
+		{name: "default", value: minfee.DefaultNetworkMinGasPrice},
+		{name: "positive", value: sdk.NewDecWithPrec(1, 6)},
+		{name: "zero", value: sdk.ZeroDec(), wantErr: true},
+		{name: "negative", value: sdk.NewDec(-1), wantErr: true},
+		{name: "nil", value: sdk.Dec{}, wantErr: true},
+		{name: "wrong type", value: "0.002", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := minfee.ValidateMinGasPrice(tc.value)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package ante
+
+import (
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NamespaceKeeper is the subset of the namespace keeper that the decorator
+// uses.
+type NamespaceKeeper interface {
+	CanPayForBlobs(ctx sdk.Context, namespace []byte, signer string) error
+}
+
+// RestrictedNamespaceDecorator enforces that only the owner and the allowed
+// signers of a restricted namespace pay for blobs in it.
+type RestrictedNamespaceDecorator struct {
+	k NamespaceKeeper
+}
+
+func NewRestrictedNamespaceDecorator(k NamespaceKeeper) RestrictedNamespaceDecorator {
+	return RestrictedNamespaceDecorator{k}
+}
+
+// AnteHandle implements the Cosmos SDK AnteHandler function signature. It
+// returns an error if tx contains a MsgPayForBlobs with a blob in a restricted
+// namespace that the signer of the PFB isn't allowed to use. Namespaces can
+// only be registered from app version 4 onwards.
+func (d RestrictedNamespaceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeader().Version.App < v4.Version {
+		return next(ctx, tx, simulate)
+	}
+
+	lookupCtx := blobtypes.UnmeteredContext(ctx)
+	for _, m := range tx.GetMsgs() {
+		pfb, ok := m.(*blobtypes.MsgPayForBlobs)
+		if !ok {
+			continue
+		}
+		for _, namespace := range pfb.Namespaces {
+			if err := d.k.CanPayForBlobs(lookupCtx, namespace, pfb.Signer); err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,87 @@
+package ante_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	blob "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/ante"
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestRestrictedNamespaceDecorator(t *testing.T) {
+	signer := sdk.AccAddress("signer").String()
+	open := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize)).Bytes()
+	restricted := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize)).Bytes()
+	k := mockNamespaceKeeper{restricted: string(restricted)}
+
+	testCases := []struct {
+		name       string
+		appVersion uint64
+		namespaces [][]byte
+		wantErr    bool
+	}{
+		{
+			name:       "PFB in an open namespace",
+			appVersion: v4.Version,
+			namespaces: [][]byte{open},
+		},
+		{
+			name:       "PFB in a restricted namespace",
+			appVersion: v4.Version,
+			namespaces: [][]byte{open, restricted},
+			wantErr:    true,
+		},
+		{
+			name:       "restrictions don't apply before v4",
+			appVersion: v3.Version,
+			namespaces: [][]byte{restricted},
+		},
+	}
+
+	txConfig := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(&blob.MsgPayForBlobs{Signer: signer, Namespaces: tc.namespaces}))
+			ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Version: version.Consensus{App: tc.appVersion}}).WithGasMeter(sdk.NewGasMeter(1e6))
+
+			decorator := ante.NewRestrictedNamespaceDecorator(k)
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, mockNext)
+			// the lookups don't consume gas of the tx
+			assert.Zero(t, ctx.GasMeter().GasConsumed())
+			if tc.wantErr {
+				assert.ErrorIs(t, err, types.ErrUnauthorizedNamespace)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// mockNamespaceKeeper rejects all signers of one restricted namespace.
+type mockNamespaceKeeper struct {
+	restricted string
+}
+
+func (m mockNamespaceKeeper) CanPayForBlobs(ctx sdk.Context, namespace []byte, _ string) error {
+	ctx.GasMeter().ConsumeGas(1000, "lookup")
+	if string(namespace) == m.restricted {
+		return types.ErrUnauthorizedNamespace
+	}
+	return nil
+}
+
+func mockNext(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
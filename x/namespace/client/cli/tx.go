@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+// FlagAllowedSigners is the comma separated list of addresses, besides the
+// owner, that may pay for blobs in a restricted namespace.
+const FlagAllowedSigners = "allowed-signers"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdRegisterNamespace())
+	cmd.AddCommand(CmdUpdateNamespace())
+	cmd.AddCommand(CmdTransferNamespace())
+
+	return cmd
+}
+
+// CmdRegisterNamespace returns a command that registers a namespace to the
+// signer.
+func CmdRegisterNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register <namespace>",
+		Short: "Register the hex encoded namespace, including its version, to the signer for the registration fee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterNamespace(clientCtx.GetFromAddress().String(), namespace)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdUpdateNamespace returns a command that sets who may pay for blobs in a
+// namespace owned by the signer.
+func CmdUpdateNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <namespace> <restricted>",
+		Short: "Set whether only the owner and the allowed signers may pay for blobs in a namespace owned by the signer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+			restricted, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+			allowed, err := cmd.Flags().GetString(FlagAllowedSigners)
+			if err != nil {
+				return err
+			}
+			var allowedSigners []string
+			if allowed != "" {
+				allowedSigners = strings.Split(allowed, ",")
+			}
+
+			msg := types.NewMsgUpdateNamespace(clientCtx.GetFromAddress().String(), namespace, restricted, allowedSigners)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagAllowedSigners, "", "comma separated addresses that may pay for blobs in the namespace besides the owner")
+
+	return cmd
+}
+
+// CmdTransferNamespace returns a command that transfers a namespace owned by
+// the signer to a new owner.
+func CmdTransferNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer <namespace> <new_owner>",
+		Short: "Transfer the ownership of a namespace owned by the signer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferNamespace(clientCtx.GetFromAddress().String(), namespace, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
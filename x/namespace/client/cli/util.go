@@ -0,0 +1,16 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// parseNamespace decodes a hex encoded namespace, including its version.
+func parseNamespace(arg string) ([]byte, error) {
+	namespace, err := hex.DecodeString(strings.TrimPrefix(arg, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode hex namespace: %w", err)
+	}
+	return namespace, nil
+}
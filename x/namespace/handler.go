@@ -0,0 +1,34 @@
+package namespace
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/keeper"
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler uses the provided namespace keeper to create an sdk.Handler
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case *types.MsgRegisterNamespace:
+			res, err := msgServer.RegisterNamespace(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateNamespace:
+			res, err := msgServer.UpdateNamespace(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTransferNamespace:
+			res, err := msgServer.TransferNamespace(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
+			return nil, errors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
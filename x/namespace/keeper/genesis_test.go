@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/x/namespace"
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenesisRoundTrip tests that the registrations of a genesis, restricted
+// or not, are exported as they were initialized.
+func TestGenesisRoundTrip(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.NewParams(testFee),
+		Registrations: []types.Registration{
+			{Namespace: testNS, Owner: owner, Restricted: true, AllowedSigners: []string{other}},
+			{Namespace: testNS2, Owner: other},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, _, ctx := CreateKeeper(t)
+	namespace.InitGenesis(ctx, *k, genesisState)
+	got := namespace.ExportGenesis(ctx, *k)
+	require.Equal(t, genesisState, *got)
+
+	registration, ok := k.GetRegistration(ctx, testNS)
+	require.True(t, ok)
+	require.True(t, registration.CanPayForBlobs(other))
+	require.False(t, registration.CanPayForBlobs(sdk.AccAddress("stranger").String()))
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Namespace returns the registration of a namespace.
+func (k Keeper) Namespace(c context.Context, req *types.QueryNamespaceRequest) (*types.QueryNamespaceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateNamespace(req.Namespace); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	registration, ok := k.GetRegistration(ctx, req.Namespace)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "namespace %X is not registered", req.Namespace)
+	}
+	return &types.QueryNamespaceResponse{Registration: registration}, nil
+}
+
+// Namespaces returns the registered namespaces, optionally only those of an
+// owner, ordered by namespace.
+func (k Keeper) Namespaces(c context.Context, req *types.QueryNamespacesRequest) (*types.QueryNamespacesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RegistrationKeyPrefix)
+	var registrations []types.Registration
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var registration types.Registration
+		if err := k.cdc.Unmarshal(value, &registration); err != nil {
+			return false, err
+		}
+		if req.Owner != "" && registration.Owner != req.Owner {
+			return false, nil
+		}
+		if accumulate {
+			registrations = append(registrations, registration)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryNamespacesResponse{Registrations: registrations, Pagination: pageRes}, nil
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestQueryNamespace(t *testing.T) {
+	k, _, ctx := CreateKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := k.Namespace(goCtx, &types.QueryNamespaceRequest{Namespace: testNS})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	registration := types.Registration{Namespace: testNS, Owner: owner, Height: 1}
+	k.SetRegistration(ctx, registration)
+	res, err := k.Namespace(goCtx, &types.QueryNamespaceRequest{Namespace: testNS})
+	require.NoError(t, err)
+	assert.Equal(t, registration, res.Registration)
+}
+
+func TestQueryNamespaces(t *testing.T) {
+	k, _, ctx := CreateKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	k.SetRegistration(ctx, types.Registration{Namespace: testNS, Owner: owner})
+	k.SetRegistration(ctx, types.Registration{Namespace: testNS2, Owner: other})
+
+	res, err := k.Namespaces(goCtx, &types.QueryNamespacesRequest{})
+	require.NoError(t, err)
+	assert.Len(t, res.Registrations, 2)
+
+	res, err = k.Namespaces(goCtx, &types.QueryNamespacesRequest{Owner: other})
+	require.NoError(t, err)
+	require.Len(t, res.Registrations, 1)
+	assert.Equal(t, testNS2, res.Registrations[0].Namespace)
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Keeper handles all the state changes for the namespace module.
+type Keeper struct {
+	cdc         codec.BinaryCodec
+	storeKey    storetypes.StoreKey
+	paramStore  paramtypes.Subspace
+	distrKeeper types.DistributionKeeper
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	ps paramtypes.Subspace,
+	distrKeeper types.DistributionKeeper,
+) *Keeper {
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return &Keeper{
+		cdc:         cdc,
+		storeKey:    storeKey,
+		paramStore:  ps,
+		distrKeeper: distrKeeper,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetRegistration returns the registration of namespace and whether it
+// exists.
+func (k Keeper) GetRegistration(ctx sdk.Context, namespace []byte) (types.Registration, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RegistrationKey(namespace))
+	if bz == nil {
+		return types.Registration{}, false
+	}
+	var registration types.Registration
+	k.cdc.MustUnmarshal(bz, &registration)
+	return registration, true
+}
+
+// SetRegistration stores registration.
+func (k Keeper) SetRegistration(ctx sdk.Context, registration types.Registration) {
+	ctx.KVStore(k.storeKey).Set(types.RegistrationKey(registration.Namespace), k.cdc.MustMarshal(&registration))
+}
+
+// GetAllRegistrations returns all registrations ordered by namespace.
+func (k Keeper) GetAllRegistrations(ctx sdk.Context) []types.Registration {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RegistrationKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var registrations []types.Registration
+	for ; iterator.Valid(); iterator.Next() {
+		var registration types.Registration
+		k.cdc.MustUnmarshal(iterator.Value(), &registration)
+		registrations = append(registrations, registration)
+	}
+	return registrations
+}
+
+// CanPayForBlobs returns ErrUnauthorizedNamespace if namespace is registered,
+// restricted and signer is neither its owner nor one of its allowed signers.
+func (k Keeper) CanPayForBlobs(ctx sdk.Context, namespace []byte, signer string) error {
+	registration, ok := k.GetRegistration(ctx, namespace)
+	if !ok || registration.CanPayForBlobs(signer) {
+		return nil
+	}
+	return types.ErrUnauthorizedNamespace.Wrapf("%s cannot pay for blobs in namespace %X owned by %s", signer, namespace, registration.Owner)
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/keeper"
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	tmdb "github.com/tendermint/tm-db"
+)
+
+var (
+	owner   = sdk.AccAddress("owner").String()
+	other   = sdk.AccAddress("other").String()
+	testNS  = share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize)).Bytes()
+	testNS2 = share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize)).Bytes()
+	testFee = uint64(100)
+)
+
+func TestRegisterNamespace(t *testing.T) {
+	k, distr, ctx := CreateKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	_, err := msgServer.RegisterNamespace(sdk.WrapSDKContext(ctx), types.NewMsgRegisterNamespace(owner, testNS))
+	require.NoError(t, err)
+
+	registration, ok := k.GetRegistration(ctx, testNS)
+	require.True(t, ok)
+	assert.Equal(t, owner, registration.Owner)
+	assert.Equal(t, ctx.BlockHeight(), registration.Height)
+	assert.False(t, registration.Restricted)
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, sdk.NewIntFromUint64(testFee))), distr.funded[owner])
+
+	_, err = msgServer.RegisterNamespace(sdk.WrapSDKContext(ctx), types.NewMsgRegisterNamespace(other, testNS))
+	assert.ErrorIs(t, err, types.ErrNamespaceRegistered)
+	assert.Empty(t, distr.funded[other])
+}
+
+func TestRegisterNamespaceWithoutFee(t *testing.T) {
+	k, distr, ctx := CreateKeeper(t)
+	k.SetParams(ctx, types.NewParams(0))
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	_, err := msgServer.RegisterNamespace(sdk.WrapSDKContext(ctx), types.NewMsgRegisterNamespace(owner, testNS))
+	require.NoError(t, err)
+	assert.Empty(t, distr.funded)
+}
+
+func TestUpdateAndTransferNamespace(t *testing.T) {
+	k, _, ctx := CreateKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := msgServer.UpdateNamespace(goCtx, types.NewMsgUpdateNamespace(owner, testNS, true, nil))
+	assert.ErrorIs(t, err, types.ErrNamespaceNotRegistered)
+
+	_, err = msgServer.RegisterNamespace(goCtx, types.NewMsgRegisterNamespace(owner, testNS))
+	require.NoError(t, err)
+
+	_, err = msgServer.UpdateNamespace(goCtx, types.NewMsgUpdateNamespace(other, testNS, true, nil))
+	assert.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+	_, err = msgServer.TransferNamespace(goCtx, types.NewMsgTransferNamespace(other, testNS, other))
+	assert.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+
+	_, err = msgServer.UpdateNamespace(goCtx, types.NewMsgUpdateNamespace(owner, testNS, true, []string{other}))
+	require.NoError(t, err)
+	registration, _ := k.GetRegistration(ctx, testNS)
+	assert.True(t, registration.Restricted)
+	assert.Equal(t, []string{other}, registration.AllowedSigners)
+
+	_, err = msgServer.TransferNamespace(goCtx, types.NewMsgTransferNamespace(owner, testNS, other))
+	require.NoError(t, err)
+	registration, _ = k.GetRegistration(ctx, testNS)
+	assert.Equal(t, other, registration.Owner)
+	assert.True(t, registration.Restricted)
+}
+
+func TestCanPayForBlobs(t *testing.T) {
+	k, _, ctx := CreateKeeper(t)
+	third := sdk.AccAddress("third").String()
+
+	// unregistered namespaces are open to everyone
+	assert.NoError(t, k.CanPayForBlobs(ctx, testNS, other))
+
+	k.SetRegistration(ctx, types.Registration{Namespace: testNS, Owner: owner})
+	assert.NoError(t, k.CanPayForBlobs(ctx, testNS, other))
+
+	k.SetRegistration(ctx, types.Registration{Namespace: testNS, Owner: owner, Restricted: true, AllowedSigners: []string{other}})
+	assert.NoError(t, k.CanPayForBlobs(ctx, testNS, owner))
+	assert.NoError(t, k.CanPayForBlobs(ctx, testNS, other))
+	assert.ErrorIs(t, k.CanPayForBlobs(ctx, testNS, third), types.ErrUnauthorizedNamespace)
+}
+
+func CreateKeeper(t *testing.T) (*keeper.Keeper, *mockDistrKeeper, sdk.Context) {
+	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tStoreKey := storetypes.NewTransientStoreKey(paramtypes.TStoreKey)
+	namespaceStoreKey := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, nil)
+	stateStore.MountStoreWithDB(namespaceStoreKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+	ctx := sdk.NewContext(stateStore, tmproto.Header{
+		Height: 10,
+		Version: tmversion.Consensus{
+			Block: 1,
+			App:   appconsts.LatestVersion,
+		},
+	}, false, nil)
+
+	paramsSubspace := paramtypes.NewSubspace(cdc,
+		testutil.MakeTestCodec(),
+		storeKey,
+		tStoreKey,
+		types.ModuleName,
+	)
+	distr := &mockDistrKeeper{funded: map[string]sdk.Coins{}}
+	k := keeper.NewKeeper(
+		cdc,
+		namespaceStoreKey,
+		paramsSubspace,
+		distr,
+	)
+	k.SetParams(ctx, types.NewParams(testFee))
+
+	return k, distr, ctx
+}
+
+// mockDistrKeeper records the amounts that are funded to the community pool
+// per depositor.
+type mockDistrKeeper struct {
+	funded map[string]sdk.Coins
+}
+
+func (m *mockDistrKeeper) FundCommunityPool(_ sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	m.funded[sender.String()] = m.funded[sender.String()].Add(amount...)
+	return nil
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the namespace MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterNamespace registers a namespace to the signer after it paid the
+// registration fee to the community pool.
+func (k msgServer) RegisterNamespace(goCtx context.Context, msg *types.MsgRegisterNamespace) (*types.MsgRegisterNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if registration, ok := k.GetRegistration(ctx, msg.Namespace); ok {
+		return nil, types.ErrNamespaceRegistered.Wrapf("namespace %X is owned by %s", msg.Namespace, registration.Owner)
+	}
+
+	fee := k.RegistrationFee(ctx)
+	if fee > 0 {
+		amount := sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, sdk.NewIntFromUint64(fee)))
+		if err := k.distrKeeper.FundCommunityPool(ctx, amount, signer); err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetRegistration(ctx, types.Registration{
+		Namespace: msg.Namespace,
+		Owner:     msg.Signer,
+		Height:    ctx.BlockHeight(),
+	})
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRegisterNamespace{
+		Namespace: msg.Namespace,
+		Owner:     msg.Signer,
+		Fee:       fee,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterNamespaceResponse{}, nil
+}
+
+// UpdateNamespace sets who can pay for blobs in a namespace of the signer.
+func (k msgServer) UpdateNamespace(goCtx context.Context, msg *types.MsgUpdateNamespace) (*types.MsgUpdateNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	registration, err := k.ownedRegistration(ctx, msg.Namespace, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	registration.Restricted = msg.Restricted
+	registration.AllowedSigners = msg.AllowedSigners
+	k.SetRegistration(ctx, registration)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUpdateNamespace{
+		Namespace:      msg.Namespace,
+		Restricted:     msg.Restricted,
+		AllowedSigners: msg.AllowedSigners,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateNamespaceResponse{}, nil
+}
+
+// TransferNamespace transfers a namespace of the signer to the new owner. The
+// restriction and allowed signers of the namespace are kept.
+func (k msgServer) TransferNamespace(goCtx context.Context, msg *types.MsgTransferNamespace) (*types.MsgTransferNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	registration, err := k.ownedRegistration(ctx, msg.Namespace, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	registration.Owner = msg.NewOwner
+	k.SetRegistration(ctx, registration)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventTransferNamespace{
+		Namespace: msg.Namespace,
+		Owner:     msg.Signer,
+		NewOwner:  msg.NewOwner,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgTransferNamespaceResponse{}, nil
+}
+
+// ownedRegistration returns the registration of namespace if signer owns it.
+func (k Keeper) ownedRegistration(ctx sdk.Context, namespace []byte, signer string) (types.Registration, error) {
+	registration, ok := k.GetRegistration(ctx, namespace)
+	if !ok {
+		return types.Registration{}, types.ErrNamespaceNotRegistered.Wrapf("namespace %X", namespace)
+	}
+	if registration.Owner != signer {
+		return types.Registration{}, types.ErrNotNamespaceOwner.Wrapf("namespace %X is owned by %s", namespace, registration.Owner)
+	}
+	return registration, nil
+}
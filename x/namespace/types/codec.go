@@ -0,0 +1,26 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterNamespace{}, URLMsgRegisterNamespace, nil)
+	cdc.RegisterConcrete(&MsgUpdateNamespace{}, URLMsgUpdateNamespace, nil)
+	cdc.RegisterConcrete(&MsgTransferNamespace{}, URLMsgTransferNamespace, nil)
+}
+
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterNamespace{},
+		&MsgUpdateNamespace{},
+		&MsgTransferNamespace{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,18 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	ErrInvalidNamespace       = errors.RegisterWithGRPCCode(ModuleName, 2, codes.InvalidArgument, "invalid namespace")
+	ErrNamespaceRegistered    = errors.RegisterWithGRPCCode(ModuleName, 3, codes.AlreadyExists, "namespace is already registered")
+	ErrNamespaceNotRegistered = errors.RegisterWithGRPCCode(ModuleName, 4, codes.NotFound, "namespace is not registered")
+	ErrNotNamespaceOwner      = errors.RegisterWithGRPCCode(ModuleName, 5, codes.PermissionDenied, "signer is not the owner of the namespace")
+	ErrUnauthorizedNamespace  = errors.RegisterWithGRPCCode(ModuleName, 6, codes.PermissionDenied, "signer cannot pay for blobs in restricted namespace")
+	ErrInvalidRegistration    = errors.RegisterWithGRPCCode(ModuleName, 7, codes.InvalidArgument, "invalid namespace registration")
+	ErrTooManyAllowedSigners  = errors.RegisterWithGRPCCode(ModuleName, 8, codes.InvalidArgument, "too many allowed signers")
+)
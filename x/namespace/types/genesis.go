@@ -0,0 +1,33 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// DefaultGenesis returns the default namespace genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(gs.Registrations))
+	for _, registration := range gs.Registrations {
+		if err := registration.Validate(); err != nil {
+			return err
+		}
+		namespace := hex.EncodeToString(registration.Namespace)
+		if seen[namespace] {
+			return fmt.Errorf("duplicate registration of namespace %s", namespace)
+		}
+		seen[namespace] = true
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "namespace"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route of the module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// RegistrationKeyPrefix is the prefix of the keys of the registrations.
+var RegistrationKeyPrefix = []byte{0x01}
+
+// RegistrationKey returns the key of the registration of namespace.
+func RegistrationKey(namespace []byte) []byte {
+	return append(append([]byte{}, RegistrationKeyPrefix...), namespace...)
+}
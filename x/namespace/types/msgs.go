@@ -0,0 +1,152 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const (
+	URLMsgRegisterNamespace = "/celestia.namespace.v1.MsgRegisterNamespace"
+	URLMsgUpdateNamespace   = "/celestia.namespace.v1.MsgUpdateNamespace"
+	URLMsgTransferNamespace = "/celestia.namespace.v1.MsgTransferNamespace"
+)
+
+var (
+	_ sdk.Msg            = &MsgRegisterNamespace{}
+	_ sdk.Msg            = &MsgUpdateNamespace{}
+	_ sdk.Msg            = &MsgTransferNamespace{}
+	_ legacytx.LegacyMsg = &MsgRegisterNamespace{}
+	_ legacytx.LegacyMsg = &MsgUpdateNamespace{}
+	_ legacytx.LegacyMsg = &MsgTransferNamespace{}
+)
+
+// NewMsgRegisterNamespace returns a msg that registers namespace to signer.
+func NewMsgRegisterNamespace(signer string, namespace []byte) *MsgRegisterNamespace {
+	return &MsgRegisterNamespace{
+		Signer:    signer,
+		Namespace: namespace,
+	}
+}
+
+// Route fulfills the legacytx.LegacyMsg interface
+func (msg *MsgRegisterNamespace) Route() string { return RouterKey }
+
+// Type fulfills the legacytx.LegacyMsg interface
+func (msg *MsgRegisterNamespace) Type() string {
+	return URLMsgRegisterNamespace
+}
+
+// ValidateBasic fulfills the sdk.Msg interface by performing stateless
+// validity checks on the msg.
+func (msg *MsgRegisterNamespace) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+	return ValidateNamespace(msg.Namespace)
+}
+
+// GetSignBytes fulfills the legacytx.LegacyMsg interface by returning the
+// bytes that are signed over.
+func (msg *MsgRegisterNamespace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners fulfills the sdk.Msg interface by returning the signer's address
+func (msg *MsgRegisterNamespace) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Signer)}
+}
+
+// NewMsgUpdateNamespace returns a msg that sets who can pay for blobs in
+// namespace.
+func NewMsgUpdateNamespace(signer string, namespace []byte, restricted bool, allowedSigners []string) *MsgUpdateNamespace {
+	return &MsgUpdateNamespace{
+		Signer:         signer,
+		Namespace:      namespace,
+		Restricted:     restricted,
+		AllowedSigners: allowedSigners,
+	}
+}
+
+// Route fulfills the legacytx.LegacyMsg interface
+func (msg *MsgUpdateNamespace) Route() string { return RouterKey }
+
+// Type fulfills the legacytx.LegacyMsg interface
+func (msg *MsgUpdateNamespace) Type() string {
+	return URLMsgUpdateNamespace
+}
+
+// ValidateBasic fulfills the sdk.Msg interface by performing stateless
+// validity checks on the msg.
+func (msg *MsgUpdateNamespace) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+	if err := ValidateNamespace(msg.Namespace); err != nil {
+		return err
+	}
+	return validateAllowedSigners(msg.AllowedSigners)
+}
+
+// GetSignBytes fulfills the legacytx.LegacyMsg interface by returning the
+// bytes that are signed over.
+func (msg *MsgUpdateNamespace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners fulfills the sdk.Msg interface by returning the signer's address
+func (msg *MsgUpdateNamespace) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Signer)}
+}
+
+// NewMsgTransferNamespace returns a msg that transfers namespace to
+// newOwner.
+func NewMsgTransferNamespace(signer string, namespace []byte, newOwner string) *MsgTransferNamespace {
+	return &MsgTransferNamespace{
+		Signer:    signer,
+		Namespace: namespace,
+		NewOwner:  newOwner,
+	}
+}
+
+// Route fulfills the legacytx.LegacyMsg interface
+func (msg *MsgTransferNamespace) Route() string { return RouterKey }
+
+// Type fulfills the legacytx.LegacyMsg interface
+func (msg *MsgTransferNamespace) Type() string {
+	return URLMsgTransferNamespace
+}
+
+// ValidateBasic fulfills the sdk.Msg interface by performing stateless
+// validity checks on the msg.
+func (msg *MsgTransferNamespace) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+	if err := ValidateNamespace(msg.Namespace); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address %s: %s", msg.NewOwner, err)
+	}
+	return nil
+}
+
+// GetSignBytes fulfills the legacytx.LegacyMsg interface by returning the
+// bytes that are signed over.
+func (msg *MsgTransferNamespace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners fulfills the sdk.Msg interface by returning the signer's address
+func (msg *MsgTransferNamespace) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Signer)}
+}
+
+func validateSigner(signer string) error {
+	if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address %s: %s", signer, err)
+	}
+	return nil
+}
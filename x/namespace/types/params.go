@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyRegistrationFee = []byte("RegistrationFee")
+	// DefaultRegistrationFee is 1 TIA so that squatting many namespaces is
+	// costly.
+	DefaultRegistrationFee uint64 = 1_000_000
+)
+
+// ParamKeyTable returns the param key table for the namespace module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(registrationFee uint64) Params {
+	return Params{
+		RegistrationFee: registrationFee,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(DefaultRegistrationFee)
+}
+
+// ParamSetPairs gets the list of param key-value pairs
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRegistrationFee, &p.RegistrationFee, validateRegistrationFee),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	return validateRegistrationFee(p.RegistrationFee)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// validateRegistrationFee validates the RegistrationFee param. Any value is
+// valid, 0 makes registrations free.
+func validateRegistrationFee(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
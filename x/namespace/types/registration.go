@@ -0,0 +1,71 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxAllowedSigners is the maximum number of allowed signers of a namespace.
+// It bounds the size of a registration that PFBs in the namespace read.
+const MaxAllowedSigners = 100
+
+// ValidateNamespace returns an error if namespace can't be registered, i.e.
+// if blobs can't use it.
+func ValidateNamespace(namespace []byte) error {
+	ns, err := share.NewNamespaceFromBytes(namespace)
+	if err != nil {
+		return errors.Wrap(ErrInvalidNamespace, err.Error())
+	}
+	if err := blobtypes.ValidateBlobNamespace(ns); err != nil {
+		return errors.Wrap(ErrInvalidNamespace, err.Error())
+	}
+	return nil
+}
+
+// Validate returns an error if the registration is invalid.
+func (r Registration) Validate() error {
+	if err := ValidateNamespace(r.Namespace); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address %s: %s", r.Owner, err)
+	}
+	if r.Height < 0 {
+		return errors.Wrap(ErrInvalidRegistration, "height cannot be negative")
+	}
+	return validateAllowedSigners(r.AllowedSigners)
+}
+
+// CanPayForBlobs returns whether signer can pay for blobs in the namespace.
+// Anyone can pay for blobs in a namespace that isn't restricted.
+func (r Registration) CanPayForBlobs(signer string) bool {
+	if !r.Restricted || signer == r.Owner {
+		return true
+	}
+	for _, allowed := range r.AllowedSigners {
+		if signer == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func validateAllowedSigners(signers []string) error {
+	if len(signers) > MaxAllowedSigners {
+		return errors.Wrapf(ErrTooManyAllowedSigners, "%d allowed signers exceed the maximum of %d", len(signers), MaxAllowedSigners)
+	}
+	seen := make(map[string]bool, len(signers))
+	for _, signer := range signers {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowed signer address %s: %s", signer, err)
+		}
+		if seen[signer] {
+			return errors.Wrapf(ErrInvalidRegistration, "duplicate allowed signer %s", signer)
+		}
+		seen[signer] = true
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	owner     = sdk.AccAddress("owner").String()
+	allowed   = sdk.AccAddress("allowed").String()
+	namespace = share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize)).Bytes()
+)
+
+func TestValidateNamespace(t *testing.T) {
+	testCases := []struct {
+		name      string
+		namespace []byte
+		wantErr   bool
+	}{
+		{name: "blob namespace", namespace: namespace},
+		{name: "empty namespace", namespace: nil, wantErr: true},
+		{name: "reserved namespace", namespace: share.TxNamespace.Bytes(), wantErr: true},
+		{name: "parity namespace", namespace: share.ParitySharesNamespace.Bytes(), wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateNamespace(tc.namespace)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, types.ErrInvalidNamespace)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRegistrationValidate(t *testing.T) {
+	tooMany := make([]string, types.MaxAllowedSigners+1)
+	for i := range tooMany {
+		tooMany[i] = sdk.AccAddress(bytes.Repeat([]byte{byte(i)}, 20)).String()
+	}
+
+	testCases := []struct {
+		name         string
+		registration types.Registration
+		wantErr      bool
+	}{
+		{
+			name:         "valid registration",
+			registration: types.Registration{Namespace: namespace, Owner: owner, Restricted: true, AllowedSigners: []string{allowed}},
+		},
+		{
+			name:         "invalid owner",
+			registration: types.Registration{Namespace: namespace, Owner: "owner"},
+			wantErr:      true,
+		},
+		{
+			name:         "negative height",
+			registration: types.Registration{Namespace: namespace, Owner: owner, Height: -1},
+			wantErr:      true,
+		},
+		{
+			name:         "duplicate allowed signer",
+			registration: types.Registration{Namespace: namespace, Owner: owner, AllowedSigners: []string{allowed, allowed}},
+			wantErr:      true,
+		},
+		{
+			name:         "too many allowed signers",
+			registration: types.Registration{Namespace: namespace, Owner: owner, AllowedSigners: tooMany},
+			wantErr:      true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.registration.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRegistrationCanPayForBlobs(t *testing.T) {
+	stranger := sdk.AccAddress("stranger").String()
+
+	open := types.Registration{Namespace: namespace, Owner: owner}
+	assert.True(t, open.CanPayForBlobs(stranger))
+
+	restricted := types.Registration{Namespace: namespace, Owner: owner, Restricted: true, AllowedSigners: []string{allowed}}
+	assert.True(t, restricted.CanPayForBlobs(owner))
+	assert.True(t, restricted.CanPayForBlobs(allowed))
+	assert.False(t, restricted.CanPayForBlobs(stranger))
+}
+
+func TestGenesisValidate(t *testing.T) {
+	registration := types.Registration{Namespace: namespace, Owner: owner}
+
+	assert.NoError(t, types.DefaultGenesis().Validate())
+	assert.NoError(t, types.GenesisState{Params: types.DefaultParams(), Registrations: []types.Registration{registration}}.Validate())
+	assert.Error(t, types.GenesisState{Params: types.DefaultParams(), Registrations: []types.Registration{registration, registration}}.Validate())
+}
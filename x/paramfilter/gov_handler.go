@@ -14,7 +14,49 @@ import (
 // ParamBlockList keeps track of parameters that cannot be changed by governance
 // proposals
 type ParamBlockList struct {
-	params map[string]bool
+	params     map[string]bool
+	added      map[string]uint64
+	bounds     map[string]BoundedParam
+	rateLimits map[string]RateLimitedParam
+}
+
+// AddedParam is a parameter that was added to an existing subspace in app
+// version FromVersion. Governance proposals can only change it in blocks of
+// app version FromVersion or later, because the binaries of the earlier app
+// versions don't know the parameter.
+type AddedParam struct {
+	Subspace    string
+	Key         string
+	FromVersion uint64
+}
+
+// BoundsCheck returns an error if the JSON encoded value of a parameter is
+// outside of the bounds that governance proposals are allowed to set it to.
+type BoundsCheck func(value string) error
+
+// BoundedParam is a parameter that can be changed by governance proposals as
+// long as the new value passes Check. The bounds only apply to proposals that
+// are executed in blocks of app version FromVersion or later, so that adding
+// bounds doesn't change how the blocks of earlier app versions are executed.
+type BoundedParam struct {
+	Subspace    string
+	Key         string
+	Check       BoundsCheck
+	FromVersion uint64
+}
+
+// ChangeCheck returns an error if the change of a parameter from its JSON
+// encoded current value to the JSON encoded new value is too large.
+type ChangeCheck func(current, value string) error
+
+// RateLimitedParam is a parameter that a single governance proposal can only
+// change as far as Check allows. Like the bounds of a BoundedParam, the limit
+// only applies in blocks of app version FromVersion or later.
+type RateLimitedParam struct {
+	Subspace    string
+	Key         string
+	Check       ChangeCheck
+	FromVersion uint64
 }
 
 // NewParamBlockList creates a new ParamBlockList that can be used to block gov
@@ -24,7 +66,56 @@ func NewParamBlockList(blockedParams ...[2]string) ParamBlockList {
 	for _, param := range blockedParams {
 		consolidatedParams[fmt.Sprintf("%s-%s", param[0], param[1])] = true
 	}
-	return ParamBlockList{params: consolidatedParams}
+	return ParamBlockList{
+		params:     consolidatedParams,
+		added:      make(map[string]uint64),
+		bounds:     make(map[string]BoundedParam),
+		rateLimits: make(map[string]RateLimitedParam),
+	}
+}
+
+// WithAddedParams returns a copy of the ParamBlockList that also rejects
+// proposals that change any of the added parameters before the app version
+// that added it.
+func (pbl ParamBlockList) WithAddedParams(addedParams ...AddedParam) ParamBlockList {
+	added := make(map[string]uint64, len(pbl.added)+len(addedParams))
+	for key, fromVersion := range pbl.added {
+		added[key] = fromVersion
+	}
+	for _, param := range addedParams {
+		added[fmt.Sprintf("%s-%s", param.Subspace, param.Key)] = param.FromVersion
+	}
+	pbl.added = added
+	return pbl
+}
+
+// WithBounds returns a copy of the ParamBlockList that also rejects proposals
+// that set any of the bounded parameters to a value outside of its bounds.
+func (pbl ParamBlockList) WithBounds(boundedParams ...BoundedParam) ParamBlockList {
+	bounds := make(map[string]BoundedParam, len(pbl.bounds)+len(boundedParams))
+	for key, param := range pbl.bounds {
+		bounds[key] = param
+	}
+	for _, param := range boundedParams {
+		bounds[fmt.Sprintf("%s-%s", param.Subspace, param.Key)] = param
+	}
+	pbl.bounds = bounds
+	return pbl
+}
+
+// WithRateLimits returns a copy of the ParamBlockList that also rejects
+// proposals that change any of the rate limited parameters by more than its
+// check allows.
+func (pbl ParamBlockList) WithRateLimits(rateLimitedParams ...RateLimitedParam) ParamBlockList {
+	rateLimits := make(map[string]RateLimitedParam, len(pbl.rateLimits)+len(rateLimitedParams))
+	for key, param := range pbl.rateLimits {
+		rateLimits[key] = param
+	}
+	for _, param := range rateLimitedParams {
+		rateLimits[fmt.Sprintf("%s-%s", param.Subspace, param.Key)] = param
+	}
+	pbl.rateLimits = rateLimits
+	return pbl
 }
 
 // IsBlocked returns true if the given parameter is blocked.
@@ -32,6 +123,41 @@ func (pbl ParamBlockList) IsBlocked(subspace string, key string) bool {
 	return pbl.params[fmt.Sprintf("%s-%s", subspace, key)]
 }
 
+// IsAdded returns false if the given parameter is added in a later app version
+// than appVersion.
+func (pbl ParamBlockList) IsAdded(appVersion uint64, subspace string, key string) bool {
+	fromVersion, ok := pbl.added[fmt.Sprintf("%s-%s", subspace, key)]
+	return !ok || appVersion >= fromVersion
+}
+
+// CheckBounds returns an error if value is outside of the bounds that the
+// given parameter has in appVersion. Parameters without bounds in appVersion
+// accept any value.
+func (pbl ParamBlockList) CheckBounds(appVersion uint64, subspace string, key string, value string) error {
+	param, ok := pbl.bounds[fmt.Sprintf("%s-%s", subspace, key)]
+	if !ok || appVersion < param.FromVersion {
+		return nil
+	}
+	if err := param.Check(value); err != nil {
+		return sdkerrors.Wrapf(ErrParameterOutOfBounds, "key: %s, value: %s, err: %s", key, value, err.Error())
+	}
+	return nil
+}
+
+// CheckRateOfChange returns an error if changing the given parameter from
+// current to value is a larger change than its rate limit in appVersion
+// allows. Parameters without a rate limit in appVersion accept any change.
+func (pbl ParamBlockList) CheckRateOfChange(appVersion uint64, subspace string, key string, current string, value string) error {
+	param, ok := pbl.rateLimits[fmt.Sprintf("%s-%s", subspace, key)]
+	if !ok || appVersion < param.FromVersion {
+		return nil
+	}
+	if err := param.Check(current, value); err != nil {
+		return sdkerrors.Wrapf(ErrParameterChangeTooLarge, "key: %s, current: %s, value: %s, err: %s", key, current, value, err.Error())
+	}
+	return nil
+}
+
 // GovHandler creates a new governance Handler for a ParamChangeProposal using
 // the underlying ParamBlockList.
 func (pbl ParamBlockList) GovHandler(pk paramskeeper.Keeper) govtypes.Handler {
@@ -51,11 +177,31 @@ func (pbl ParamBlockList) handleParameterChangeProposal(
 	pk paramskeeper.Keeper,
 	p *proposal.ParameterChangeProposal,
 ) error {
-	// throw an error if any of the parameter changes are blocked
+	// throw an error if any of the parameter changes are blocked, not added
+	// yet or out of bounds
 	for _, c := range p.Changes {
 		if pbl.IsBlocked(c.Subspace, c.Key) {
 			return ErrBlockedParameter
 		}
+		if !pbl.IsAdded(ctx.BlockHeader().Version.App, c.Subspace, c.Key) {
+			return sdkerrors.Wrapf(ErrBlockedParameter, "key %s is not added in app version %d", c.Key, ctx.BlockHeader().Version.App)
+		}
+		if err := pbl.CheckBounds(ctx.BlockHeader().Version.App, c.Subspace, c.Key, c.Value); err != nil {
+			return err
+		}
+	}
+
+	// changes are checked against the values before the proposal so that
+	// splitting a change of a parameter across several changes of the same
+	// proposal doesn't bypass its rate limit.
+	for _, c := range p.Changes {
+		ss, ok := pk.GetSubspace(c.Subspace)
+		if !ok {
+			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
+		}
+		if err := pbl.CheckRateOfChange(ctx.BlockHeader().Version.App, c.Subspace, c.Key, string(ss.GetRaw(ctx, []byte(c.Key))), c.Value); err != nil {
+			return err
+		}
 	}
 
 	for _, c := range p.Changes {
@@ -39,7 +39,7 @@ type GovParamsTestSuite struct {
 func (suite *GovParamsTestSuite) SetupTest() {
 	suite.app, _ = testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
 	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{})
-	suite.govHandler = paramfilter.NewParamBlockList(suite.app.BlockedParams()...).GovHandler(suite.app.ParamsKeeper)
+	suite.govHandler = paramfilter.NewParamBlockList(suite.app.BlockedParams()...).WithBounds(suite.app.BoundedParams()...).GovHandler(suite.app.ParamsKeeper)
 }
 
 func TestGovParamsTestSuite(t *testing.T) {
@@ -387,11 +387,11 @@ func (suite *GovParamsTestSuite) TestModifiableParams() {
 			testProposal(proposal.ParamChange{
 				Subspace: icahosttypes.SubModuleName,
 				Key:      string(icahosttypes.KeyAllowMessages),
-				Value:    `["foo"]`,
+				Value:    `["/cosmos.bank.v1beta1.MsgSend"]`,
 			}),
 			func() {
 				got := suite.app.ICAHostKeeper.GetParams(suite.ctx).AllowMessages
-				want := []string{"foo"}
+				want := []string{"/cosmos.bank.v1beta1.MsgSend"}
 				assert.Equal(want, got)
 			},
 		},
@@ -400,11 +400,11 @@ func (suite *GovParamsTestSuite) TestModifiableParams() {
 			testProposal(proposal.ParamChange{
 				Subspace: slashingtypes.ModuleName,
 				Key:      string(slashingtypes.KeyDowntimeJailDuration),
-				Value:    `"2"`,
+				Value:    `"120000000000"`,
 			}),
 			func() {
 				got := suite.app.SlashingKeeper.GetParams(suite.ctx).DowntimeJailDuration
-				want := time.Duration(2)
+				want := 2 * time.Minute
 				assert.Equal(want, got)
 			},
 		},
@@ -413,11 +413,11 @@ func (suite *GovParamsTestSuite) TestModifiableParams() {
 			testProposal(proposal.ParamChange{
 				Subspace: slashingtypes.ModuleName,
 				Key:      string(slashingtypes.KeyMinSignedPerWindow),
-				Value:    `"1"`,
+				Value:    `"0.9"`,
 			}),
 			func() {
 				got := suite.app.SlashingKeeper.GetParams(suite.ctx).MinSignedPerWindow
-				want := sdk.NewDec(1)
+				want := sdk.NewDecWithPrec(9, 1)
 				assert.Equal(want, got)
 			},
 		},
@@ -426,11 +426,11 @@ func (suite *GovParamsTestSuite) TestModifiableParams() {
 			testProposal(proposal.ParamChange{
 				Subspace: slashingtypes.ModuleName,
 				Key:      string(slashingtypes.KeySignedBlocksWindow),
-				Value:    `"1"`,
+				Value:    `"10000"`,
 			}),
 			func() {
 				got := suite.app.SlashingKeeper.GetParams(suite.ctx).SignedBlocksWindow
-				want := int64(1)
+				want := int64(10000)
 				assert.Equal(want, got)
 			},
 		},
@@ -439,11 +439,11 @@ func (suite *GovParamsTestSuite) TestModifiableParams() {
 			testProposal(proposal.ParamChange{
 				Subspace: slashingtypes.ModuleName,
 				Key:      string(slashingtypes.KeySlashFractionDoubleSign),
-				Value:    `"1"`,
+				Value:    `"0.05"`,
 			}),
 			func() {
 				got := suite.app.SlashingKeeper.GetParams(suite.ctx).SlashFractionDoubleSign
-				want := sdk.NewDec(1)
+				want := sdk.NewDecWithPrec(5, 2)
 				assert.Equal(want, got)
 			},
 		},
@@ -452,11 +452,11 @@ func (suite *GovParamsTestSuite) TestModifiableParams() {
 			testProposal(proposal.ParamChange{
 				Subspace: slashingtypes.ModuleName,
 				Key:      string(slashingtypes.KeySlashFractionDowntime),
-				Value:    `"1"`,
+				Value:    `"0.001"`,
 			}),
 			func() {
 				got := suite.app.SlashingKeeper.GetParams(suite.ctx).SlashFractionDowntime
-				want := sdk.NewDec(1)
+				want := sdk.NewDecWithPrec(1, 3)
 				assert.Equal(want, got)
 			},
 		},
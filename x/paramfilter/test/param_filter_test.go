@@ -1,17 +1,30 @@
 package test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
 	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v3/x/icahostfilter"
+	"github.com/celestiaorg/celestia-app/v3/x/minfee"
 	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icagenesistypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/genesis/types"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 	"github.com/stretchr/testify/require"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
 )
 
 func TestParamFilter(t *testing.T) {
@@ -60,6 +73,283 @@ func TestParamFilter(t *testing.T) {
 	}
 }
 
+func TestParamFilterAddedParams(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+
+	pph := paramfilter.NewParamBlockList(testApp.BlockedParams()...).WithAddedParams(testApp.AddedParams()...)
+	handler := pph.GovHandler(testApp.ParamsKeeper)
+	ctx := sdk.NewContext(testApp.CommitMultiStore(), types.Header{Version: version.Consensus{App: v3.Version}}, false, tmlog.NewNopLogger())
+
+	for _, p := range testApp.AddedParams() {
+		require.False(t, pph.IsAdded(p.FromVersion-1, p.Subspace, p.Key))
+		require.True(t, pph.IsAdded(p.FromVersion, p.Subspace, p.Key))
+	}
+
+	change := proposal.NewParamChange(blobtypes.ModuleName, string(blobtypes.KeyMaxBlobsPerPFB), "10")
+	err := handler(ctx, testProposal(change))
+	require.ErrorIs(t, err, paramfilter.ErrBlockedParameter)
+	require.Zero(t, testApp.BlobKeeper.MaxBlobsPerPFB(ctx))
+
+	v4Ctx := ctx.WithBlockHeader(types.Header{Version: version.Consensus{App: v4.Version}})
+	require.NoError(t, handler(v4Ctx, testProposal(change)))
+	require.EqualValues(t, 10, testApp.BlobKeeper.MaxBlobsPerPFB(v4Ctx))
+}
+
+func TestParamFilterBounds(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+
+	require.Greater(t, len(testApp.BoundedParams()), 0)
+
+	pph := paramfilter.NewParamBlockList(testApp.BlockedParams()...).WithBounds(testApp.BoundedParams()...)
+	handler := pph.GovHandler(testApp.ParamsKeeper)
+	ctx := sdk.NewContext(testApp.CommitMultiStore(), types.Header{Version: version.Consensus{App: v4.Version}}, false, tmlog.NewNopLogger())
+
+	testCases := []struct {
+		name      string
+		key       []byte
+		value     string
+		expectErr bool
+	}{
+		{"signed blocks window within bounds", slashingtypes.KeySignedBlocksWindow, `"10000"`, false},
+		{"signed blocks window too short", slashingtypes.KeySignedBlocksWindow, `"100"`, true},
+		{"signed blocks window too long", slashingtypes.KeySignedBlocksWindow, `"1000000"`, true},
+		{"min signed per window within bounds", slashingtypes.KeyMinSignedPerWindow, `"0.5"`, false},
+		{"min signed per window too high", slashingtypes.KeyMinSignedPerWindow, `"1"`, true},
+		{"downtime jail duration too short", slashingtypes.KeyDowntimeJailDuration, `"2"`, true},
+		{"slash fraction double sign too high", slashingtypes.KeySlashFractionDoubleSign, `"0.5"`, true},
+		{"slash fraction downtime within bounds", slashingtypes.KeySlashFractionDowntime, `"0"`, false},
+		{"slash fraction downtime too high", slashingtypes.KeySlashFractionDowntime, `"0.1"`, true},
+		{"malformed value", slashingtypes.KeySlashFractionDowntime, `0.1`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			before := testApp.SlashingKeeper.GetParams(ctx)
+			err := handler(ctx, testProposal(proposal.NewParamChange(slashingtypes.ModuleName, string(tc.key), tc.value)))
+			if !tc.expectErr {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, paramfilter.ErrParameterOutOfBounds)
+			require.Equal(t, before, testApp.SlashingKeeper.GetParams(ctx))
+		})
+	}
+
+	// the default preset must be within the bounds
+	preset := app.DASlashingParams()
+	for _, pair := range preset.ParamSetPairs() {
+		value, err := codec.NewLegacyAmino().MarshalJSON(pair.Value)
+		require.NoError(t, err)
+		require.NoError(t, pph.CheckBounds(v4.Version, slashingtypes.ModuleName, string(pair.Key), string(value)), string(pair.Key))
+	}
+
+	// the bounds only apply from app version 4 onwards
+	v3Ctx := ctx.WithBlockHeader(types.Header{Version: version.Consensus{App: v3.Version}})
+	require.NoError(t, handler(v3Ctx, testProposal(proposal.NewParamChange(slashingtypes.ModuleName, string(slashingtypes.KeySignedBlocksWindow), `"100"`))))
+	require.Equal(t, int64(100), testApp.SlashingKeeper.GetParams(v3Ctx).SignedBlocksWindow)
+}
+
+func TestParamFilterNetworkMinGasPriceBounds(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+
+	pph := paramfilter.NewParamBlockList(testApp.BlockedParams()...).WithBounds(testApp.BoundedParams()...)
+	handler := pph.GovHandler(testApp.ParamsKeeper)
+	ctx := sdk.NewContext(testApp.CommitMultiStore(), types.Header{Version: version.Consensus{App: v4.Version}}, false, tmlog.NewNopLogger())
+	subspace := testApp.GetSubspace(minfee.ModuleName)
+	change := func(value string) *proposal.ParameterChangeProposal {
+		return testProposal(proposal.NewParamChange(minfee.ModuleName, string(minfee.KeyNetworkMinGasPrice), value))
+	}
+
+	require.NoError(t, handler(ctx, change(`"0.002000000000000000"`)))
+	for _, value := range []string{`"0.000000000000000000"`, `"-0.002000000000000000"`} {
+		err := handler(ctx, change(value))
+		require.ErrorIs(t, err, paramfilter.ErrParameterOutOfBounds, value)
+	}
+	var minGasPrice sdk.Dec
+	subspace.Get(ctx, minfee.KeyNetworkMinGasPrice, &minGasPrice)
+	require.Equal(t, sdk.NewDecWithPrec(2, 3), minGasPrice)
+
+	// the network min gas price is only bounded from app version 4 onwards
+	v3Ctx := ctx.WithBlockHeader(types.Header{Version: version.Consensus{App: v3.Version}})
+	require.NoError(t, handler(v3Ctx, change(`"0.000000000000000000"`)))
+	subspace.Get(v3Ctx, minfee.KeyNetworkMinGasPrice, &minGasPrice)
+	require.True(t, minGasPrice.IsZero())
+}
+
+func TestParamFilterGovMaxSquareSizeBounds(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+
+	pph := paramfilter.NewParamBlockList(testApp.BlockedParams()...).WithBounds(testApp.BoundedParams()...)
+	handler := pph.GovHandler(testApp.ParamsKeeper)
+	ctx := sdk.NewContext(testApp.CommitMultiStore(), types.Header{Version: version.Consensus{App: v4.Version}}, false, tmlog.NewNopLogger())
+	upperBound := appconsts.SquareSizeUpperBound(testApp.AppVersion())
+
+	for _, size := range []int{upperBound / 2, upperBound} {
+		err := handler(ctx, testProposal(squareSizeChanges(fmt.Sprintf(`"%d"`, size))...))
+		require.NoError(t, err)
+		require.EqualValues(t, size, testApp.BlobKeeper.GovMaxSquareSize(ctx))
+	}
+
+	err := handler(ctx, testProposal(squareSizeChanges(fmt.Sprintf(`"%d"`, 2*upperBound))...))
+	require.ErrorIs(t, err, paramfilter.ErrParameterOutOfBounds)
+	require.EqualValues(t, upperBound, testApp.BlobKeeper.GovMaxSquareSize(ctx))
+
+	// the bound only applies from app version 4 onwards
+	v3Ctx := ctx.WithBlockHeader(types.Header{Version: version.Consensus{App: v3.Version}})
+	require.NoError(t, handler(v3Ctx, testProposal(squareSizeChanges(fmt.Sprintf(`"%d"`, 2*upperBound))...)))
+	require.EqualValues(t, 2*upperBound, testApp.BlobKeeper.GovMaxSquareSize(v3Ctx))
+}
+
+func TestParamFilterICAAllowMessages(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+
+	pph := paramfilter.NewParamBlockList(testApp.BlockedParams()...).WithBounds(testApp.BoundedParams()...)
+	handler := pph.GovHandler(testApp.ParamsKeeper)
+	ctx := sdk.NewContext(testApp.CommitMultiStore(), types.Header{Version: version.Consensus{App: v4.Version}}, false, tmlog.NewNopLogger())
+
+	testCases := []struct {
+		name      string
+		value     string
+		expectErr bool
+	}{
+		{"known messages", `["/cosmos.bank.v1beta1.MsgSend","/cosmos.staking.v1beta1.MsgDelegate"]`, false},
+		{"no messages", `[]`, false},
+		{"unknown message", `["/cosmos.bank.v1beta1.MsgSend","foo"]`, true},
+		{"duplicate message", `["/cosmos.bank.v1beta1.MsgSend","/cosmos.bank.v1beta1.MsgSend"]`, true},
+		{"all messages", `["*"]`, true},
+		{"malformed value", `"/cosmos.bank.v1beta1.MsgSend"`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			before := testApp.ICAHostKeeper.GetAllowMessages(ctx)
+			err := handler(ctx, testProposal(proposal.NewParamChange(icahosttypes.SubModuleName, string(icahosttypes.KeyAllowMessages), tc.value)))
+			if !tc.expectErr {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, paramfilter.ErrParameterOutOfBounds)
+			require.Equal(t, before, testApp.ICAHostKeeper.GetAllowMessages(ctx))
+		})
+	}
+
+	// the default genesis allowlist must be within the bounds
+	var genesis icagenesistypes.GenesisState
+	testApp.AppCodec().MustUnmarshalJSON(app.ModuleBasics.DefaultGenesis(testApp.AppCodec())[icatypes.ModuleName], &genesis)
+	require.NotEmpty(t, genesis.HostGenesisState.Params.AllowMessages)
+	value, err := codec.NewLegacyAmino().MarshalJSON(genesis.HostGenesisState.Params.AllowMessages)
+	require.NoError(t, err)
+	require.NoError(t, pph.CheckBounds(v4.Version, icahosttypes.SubModuleName, string(icahosttypes.KeyAllowMessages), string(value)))
+
+	// the allowlist is only restricted from app version 4 onwards
+	v3Ctx := ctx.WithBlockHeader(types.Header{Version: version.Consensus{App: v3.Version}})
+	require.NoError(t, handler(v3Ctx, testProposal(proposal.NewParamChange(icahosttypes.SubModuleName, string(icahosttypes.KeyAllowMessages), `["*"]`))))
+	require.Equal(t, []string{icahosttypes.AllowAllHostMsgs}, testApp.ICAHostKeeper.GetAllowMessages(v3Ctx))
+}
+
+func TestParamFilterICAConnectionAllowlists(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+
+	pph := paramfilter.NewParamBlockList(testApp.BlockedParams()...).WithBounds(testApp.BoundedParams()...)
+	handler := pph.GovHandler(testApp.ParamsKeeper)
+	ctx := sdk.NewContext(testApp.CommitMultiStore(), types.Header{}, false, tmlog.NewNopLogger())
+	subspace := testApp.GetSubspace(icahostfilter.ModuleName)
+
+	testCases := []struct {
+		name      string
+		value     string
+		expectErr bool
+	}{
+		{"no allowlists", `[]`, false},
+		{"known messages", `[{"connection_id":"connection-0","allow_messages":["/ibc.applications.transfer.v1.MsgTransfer"]}]`, false},
+		{"no messages", `[{"connection_id":"connection-1","allow_messages":[]}]`, false},
+		{"unknown message", `[{"connection_id":"connection-0","allow_messages":["foo"]}]`, true},
+		{"duplicate message", `[{"connection_id":"connection-0","allow_messages":["/cosmos.bank.v1beta1.MsgSend","/cosmos.bank.v1beta1.MsgSend"]}]`, true},
+		{"duplicate connection", `[{"connection_id":"connection-0"},{"connection_id":"connection-0"}]`, true},
+		{"invalid connection", `[{"connection_id":"","allow_messages":["/cosmos.bank.v1beta1.MsgSend"]}]`, true},
+		{"all messages", `[{"connection_id":"connection-0","allow_messages":["*"]}]`, true},
+		{"malformed value", `{"connection_id":"connection-0"}`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			before := icahostfilter.GetConnectionAllowlists(ctx, subspace)
+			err := handler(ctx, testProposal(proposal.NewParamChange(icahostfilter.ModuleName, string(icahostfilter.KeyConnectionAllowlists), tc.value)))
+			if !tc.expectErr {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, paramfilter.ErrParameterOutOfBounds)
+			require.Equal(t, before, icahostfilter.GetConnectionAllowlists(ctx, subspace))
+		})
+	}
+
+	// an empty allowlist denies all the messages of the connection.
+	allowMessages, found := icahostfilter.GetConnectionAllowlist(ctx, subspace, "connection-1")
+	require.True(t, found)
+	require.Empty(t, allowMessages)
+}
+
+func TestParamFilterRateLimits(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+
+	pph := paramfilter.NewParamBlockList(testApp.BlockedParams()...).WithRateLimits(testApp.RateLimitedParams()...)
+	handler := pph.GovHandler(testApp.ParamsKeeper)
+	ctx := sdk.NewContext(testApp.CommitMultiStore(), types.Header{Version: version.Consensus{App: v4.Version}}, false, tmlog.NewNopLogger())
+	require.Equal(t, uint64(64), testApp.BlobKeeper.GovMaxSquareSize(ctx))
+	require.Equal(t, uint32(8), testApp.BlobKeeper.GasPerBlobByte(ctx))
+
+	testCases := []struct {
+		name      string
+		changes   []proposal.ParamChange
+		expectErr bool
+	}{
+		{"double the max square size", squareSizeChanges(`"128"`), false},
+		{"halve the max square size", squareSizeChanges(`"32"`), false},
+		{"quadruple the max square size", squareSizeChanges(`"256"`), true},
+		{"quarter the max square size", squareSizeChanges(`"16"`), true},
+		{"split a too large change", squareSizeChanges(`"128"`, `"256"`), true},
+		{"increase the gas per blob byte by 25%", gasPerBlobByteChanges(`10`), false},
+		{"decrease the gas per blob byte by 20%", gasPerBlobByteChanges(`7`), false},
+		{"increase the gas per blob byte by 37.5%", gasPerBlobByteChanges(`11`), true},
+		{"decrease the gas per blob byte by 25%", gasPerBlobByteChanges(`6`), true},
+		{"malformed value", gasPerBlobByteChanges(`"abc"`), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			before := testApp.BlobKeeper.GetParams(cacheCtx)
+			err := handler(cacheCtx, testProposal(tc.changes...))
+			if !tc.expectErr {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, paramfilter.ErrParameterChangeTooLarge)
+			require.Equal(t, before, testApp.BlobKeeper.GetParams(cacheCtx))
+		})
+	}
+
+	// the rate limits only apply from app version 4 onwards
+	v3Ctx := ctx.WithBlockHeader(types.Header{Version: version.Consensus{App: v3.Version}})
+	require.NoError(t, handler(v3Ctx, testProposal(gasPerBlobByteChanges(`16`)...)))
+	require.Equal(t, uint32(16), testApp.BlobKeeper.GasPerBlobByte(v3Ctx))
+}
+
+func squareSizeChanges(values ...string) (changes []proposal.ParamChange) {
+	for _, value := range values {
+		changes = append(changes, proposal.NewParamChange(blobtypes.ModuleName, string(blobtypes.KeyGovMaxSquareSize), value))
+	}
+	return changes
+}
+
+func gasPerBlobByteChanges(values ...string) (changes []proposal.ParamChange) {
+	for _, value := range values {
+		changes = append(changes, proposal.NewParamChange(blobtypes.ModuleName, string(blobtypes.KeyGasPerBlobByte), value))
+	}
+	return changes
+}
+
 func testProposal(changes ...proposal.ParamChange) *proposal.ParameterChangeProposal {
 	return proposal.NewParameterChangeProposal("title", "description", changes)
 }
@@ -13,3 +13,11 @@ const (
 // ErrBlockedParameter is the error wrapped when a proposal to change a
 // blocked parameter is submitted.
 var ErrBlockedParameter = sdkerrors.Register(ModuleName, baseErrorCode, "parameter can not be modified")
+
+// ErrParameterOutOfBounds is the error wrapped when a proposal sets a bounded
+// parameter to a value outside of its bounds.
+var ErrParameterOutOfBounds = sdkerrors.Register(ModuleName, baseErrorCode+1, "parameter value out of bounds")
+
+// ErrParameterChangeTooLarge is the error wrapped when a proposal changes a
+// rate limited parameter by more than its limit allows.
+var ErrParameterChangeTooLarge = sdkerrors.Register(ModuleName, baseErrorCode+2, "parameter change too large")
@@ -0,0 +1,9 @@
+package ratelimit
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// ErrQuotaExceeded is returned when a transfer would exceed the max outflow
+// of the rate limit of its channel and denom.
+var ErrQuotaExceeded = errors.Register(ModuleName, 2, "rate limit quota exceeded")
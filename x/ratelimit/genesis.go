@@ -0,0 +1,57 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default genesis state in which no channel has a
+// rate limit.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis performs basic validation of genesis data returning an error for any failed validation criteria.
+func ValidateGenesis(genesis *GenesisState) error {
+	if err := ValidateRateLimits(genesis.RateLimits); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(genesis.Flows))
+	for _, flow := range genesis.Flows {
+		if err := validateChannelDenom(flow.ChannelId, flow.Denom); err != nil {
+			return err
+		}
+		key := string(FlowKey(flow.ChannelId, flow.Denom))
+		if seen[key] {
+			return fmt.Errorf("duplicate flow for %s on channel %s", flow.Denom, flow.ChannelId)
+		}
+		seen[key] = true
+
+		if flow.Outflow.IsNil() || flow.Outflow.IsNegative() {
+			return fmt.Errorf("outflow of %s on channel %s must not be negative", flow.Denom, flow.ChannelId)
+		}
+	}
+	return nil
+}
+
+// InitGenesis sets the rate limits and flows of the genesis state. The param
+// is left unset if there are no rate limits so that the state of chains that
+// don't use the module doesn't change.
+func InitGenesis(ctx sdk.Context, keeper Keeper, genesis *GenesisState) {
+	if len(genesis.RateLimits) != 0 {
+		keeper.subspace.Set(ctx, KeyRateLimits, genesis.RateLimits)
+	}
+	for _, flow := range genesis.Flows {
+		keeper.SetFlow(ctx, flow)
+	}
+}
+
+// ExportGenesis returns the ratelimit module's exported genesis.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) *GenesisState {
+	return &GenesisState{
+		RateLimits: keeper.GetRateLimits(ctx),
+		Flows:      keeper.GetFlows(ctx),
+	}
+}
@@ -0,0 +1,46 @@
+package ratelimit
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ QueryServer = &QueryServerImpl{}
+
+// QueryServerImpl implements the ratelimit gRPC query server.
+type QueryServerImpl struct {
+	keeper Keeper
+}
+
+// NewQueryServerImpl creates a new QueryServerImpl.
+func NewQueryServerImpl(keeper Keeper) *QueryServerImpl {
+	return &QueryServerImpl{keeper: keeper}
+}
+
+// RateLimits returns the rate limits of all the channels.
+func (q *QueryServerImpl) RateLimits(ctx context.Context, _ *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &QueryRateLimitsResponse{RateLimits: q.keeper.GetRateLimits(sdkCtx)}, nil
+}
+
+// Flow returns the rate limit of a denom over a channel and its outflow in
+// the current period.
+func (q *QueryServerImpl) Flow(ctx context.Context, req *QueryFlowRequest) (*QueryFlowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	rateLimit, found := GetRateLimit(sdkCtx, q.keeper.subspace, req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no rate limit for %s on channel %s", req.Denom, req.ChannelId)
+	}
+	return &QueryFlowResponse{RateLimit: rateLimit, Flow: q.keeper.GetFlow(sdkCtx, rateLimit)}, nil
+}
@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+)
+
+// rateLimitMiddleware directly inherits the IBCModule interface. With
+// OnAcknowledgementPacket and OnTimeoutPacket, it reverts the outflow of the
+// transfers that failed because their amount is refunded by the transfer
+// module. The outflow is added by the SendPacket of the Keeper.
+type rateLimitMiddleware struct {
+	porttypes.IBCModule
+	keeper Keeper
+}
+
+// NewIBCMiddleware creates a new instance of the rate limit middleware for the
+// transfer module.
+func NewIBCMiddleware(ibcModule porttypes.IBCModule, keeper Keeper) porttypes.IBCModule {
+	return &rateLimitMiddleware{
+		IBCModule: ibcModule,
+		keeper:    keeper,
+	}
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. It reverts the
+// outflow of a transfer that was acknowledged with an error.
+func (m *rateLimitMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := m.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil || ack.Success() {
+		return nil
+	}
+	m.revertOutflow(ctx, packet)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. It reverts the outflow
+// of a transfer that timed out.
+func (m *rateLimitMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := m.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	m.revertOutflow(ctx, packet)
+	return nil
+}
+
+func (m *rateLimitMiddleware) revertOutflow(ctx sdk.Context, packet channeltypes.Packet) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return
+	}
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return
+	}
+	m.keeper.RevertOutflow(ctx, packet.GetSourceChannel(), data.Denom, amount)
+}
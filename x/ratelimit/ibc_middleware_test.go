@@ -0,0 +1,106 @@
+package ratelimit_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/x/ratelimit"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	"github.com/stretchr/testify/assert"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestOnAcknowledgementAndTimeoutPacket(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+	rateLimit := newRateLimit("channel-0", "utia", 100)
+	testApp.GetSubspace(ratelimit.ModuleName).Set(ctx, ratelimit.KeyRateLimits, []ratelimit.RateLimit{rateLimit})
+	keeper := testApp.RateLimitKeeper
+
+	newPacket := func(amount string) channeltypes.Packet {
+		return channeltypes.NewPacket(transferData("utia", amount), 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-5", clienttypes.Height{}, 0)
+	}
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	errorAck := channeltypes.NewErrorAcknowledgement(errors.New("failed")).Acknowledgement()
+
+	testCases := []struct {
+		name        string
+		moduleErr   error
+		handle      func(middleware porttypes.IBCModule) error
+		wantOutflow int64
+	}{
+		{
+			name: "successful acknowledgement",
+			handle: func(middleware porttypes.IBCModule) error {
+				return middleware.OnAcknowledgementPacket(ctx, newPacket("10"), successAck, nil)
+			},
+			wantOutflow: 80,
+		},
+		{
+			name: "error acknowledgement",
+			handle: func(middleware porttypes.IBCModule) error {
+				return middleware.OnAcknowledgementPacket(ctx, newPacket("10"), errorAck, nil)
+			},
+			wantOutflow: 70,
+		},
+		{
+			name: "timeout",
+			handle: func(middleware porttypes.IBCModule) error {
+				return middleware.OnTimeoutPacket(ctx, newPacket("10"), nil)
+			},
+			wantOutflow: 70,
+		},
+		{
+			name:      "timeout that fails",
+			moduleErr: errors.New("failed"),
+			handle: func(middleware porttypes.IBCModule) error {
+				return middleware.OnTimeoutPacket(ctx, newPacket("10"), nil)
+			},
+			wantOutflow: 80,
+		},
+		{
+			name: "random packet",
+			handle: func(middleware porttypes.IBCModule) error {
+				packet := channeltypes.NewPacket([]byte{1, 2, 3}, 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-5", clienttypes.Height{}, 0)
+				return middleware.OnTimeoutPacket(ctx, packet, nil)
+			},
+			wantOutflow: 80,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keeper.SetFlow(ctx, ratelimit.Flow{ChannelId: "channel-0", Denom: "utia", Outflow: sdk.NewInt(80), PeriodStart: ctx.BlockTime()})
+			module := &mockIBCModule{err: tc.moduleErr}
+			middleware := ratelimit.NewIBCMiddleware(module, keeper)
+
+			err := tc.handle(middleware)
+			assert.Equal(t, tc.moduleErr, err)
+			assert.True(t, module.called)
+			assert.Equal(t, sdk.NewInt(tc.wantOutflow), keeper.GetFlow(ctx, rateLimit).Outflow)
+		})
+	}
+}
+
+type mockIBCModule struct {
+	porttypes.IBCModule
+	err    error
+	called bool
+}
+
+func (m *mockIBCModule) OnAcknowledgementPacket(_ sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress) error {
+	m.called = true
+	return m.err
+}
+
+func (m *mockIBCModule) OnTimeoutPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) error {
+	m.called = true
+	return m.err
+}
@@ -0,0 +1,151 @@
+package ratelimit
+
+import (
+	"cosmossdk.io/errors"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// Keeper wraps the ICS4Wrapper of the transfer module to cap the outflow of
+// the transfers out of this chain. It also tracks the outflows of the current
+// periods of the rate limits.
+type Keeper struct {
+	porttypes.ICS4Wrapper
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	subspace paramtypes.Subspace
+}
+
+// NewKeeper creates a new ratelimit Keeper instance.
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, subspace paramtypes.Subspace, wrapper porttypes.ICS4Wrapper) Keeper {
+	return Keeper{
+		ICS4Wrapper: wrapper,
+		cdc:         cdc,
+		storeKey:    storeKey,
+		subspace:    RegisterParamTable(subspace),
+	}
+}
+
+// SendPacket implements the ICS4Wrapper interface. It adds the amount of a
+// transfer to the outflow of its channel and denom and rejects the transfer if
+// the outflow would exceed the max outflow of the rate limit. Packets that
+// aren't transfers are passed on.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	// The store of the module was added in v4.
+	if ctx.BlockHeader().Version.App >= v4.Version && sourcePort == transfertypes.PortID {
+		var packetData transfertypes.FungibleTokenPacketData
+		if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err == nil {
+			if amount, ok := sdk.NewIntFromString(packetData.Amount); ok {
+				if err := k.AddOutflow(ctx, sourceChannel, packetData.Denom, amount); err != nil {
+					return 0, err
+				}
+			}
+		}
+	}
+	return k.ICS4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// AddOutflow adds an amount to the outflow of a denom over a channel. It
+// returns ErrQuotaExceeded if the outflow would exceed the max outflow of the
+// rate limit in the current period. Denoms without a rate limit aren't
+// tracked.
+func (k Keeper) AddOutflow(ctx sdk.Context, channelID, denom string, amount sdk.Int) error {
+	rateLimit, found := GetRateLimit(ctx, k.subspace, channelID, denom)
+	if !found {
+		return nil
+	}
+
+	flow := k.GetFlow(ctx, rateLimit)
+	outflow := flow.Outflow.Add(amount)
+	if outflow.GT(rateLimit.MaxOutflow) {
+		return errors.Wrapf(ErrQuotaExceeded, "transferring %s%s over %s would exceed the max outflow of %s in the period that started at %s",
+			amount, denom, channelID, rateLimit.MaxOutflow, flow.PeriodStart)
+	}
+	flow.Outflow = outflow
+	k.SetFlow(ctx, flow)
+	return nil
+}
+
+// RevertOutflow subtracts the amount of a transfer that failed from the
+// outflow of its denom and channel since the amount is refunded to the sender.
+// The amount is subtracted from the outflow of the current period even if the
+// transfer was sent in an earlier period, so the outflow only counts the
+// transfers that weren't refunded.
+func (k Keeper) RevertOutflow(ctx sdk.Context, channelID, denom string, amount sdk.Int) {
+	rateLimit, found := GetRateLimit(ctx, k.subspace, channelID, denom)
+	if !found {
+		return
+	}
+
+	flow := k.GetFlow(ctx, rateLimit)
+	flow.Outflow = sdk.MaxInt(flow.Outflow.Sub(amount), sdk.ZeroInt())
+	k.SetFlow(ctx, flow)
+}
+
+// GetFlow returns the flow of the denom and channel of a rate limit in the
+// current period. The flow of a new period is returned if the stored period
+// has ended.
+func (k Keeper) GetFlow(ctx sdk.Context, rateLimit RateLimit) Flow {
+	flow, found := k.getFlow(ctx, rateLimit.ChannelId, rateLimit.Denom)
+	if found && ctx.BlockTime().Before(flow.PeriodStart.Add(rateLimit.Period)) {
+		return flow
+	}
+	return Flow{
+		ChannelId:   rateLimit.ChannelId,
+		Denom:       rateLimit.Denom,
+		Outflow:     sdk.ZeroInt(),
+		PeriodStart: ctx.BlockTime(),
+	}
+}
+
+func (k Keeper) getFlow(ctx sdk.Context, channelID, denom string) (Flow, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(FlowKey(channelID, denom))
+	if bz == nil {
+		return Flow{}, false
+	}
+	var flow Flow
+	k.cdc.MustUnmarshal(bz, &flow)
+	return flow, true
+}
+
+// SetFlow stores the flow of a denom over a channel.
+func (k Keeper) SetFlow(ctx sdk.Context, flow Flow) {
+	ctx.KVStore(k.storeKey).Set(FlowKey(flow.ChannelId, flow.Denom), k.cdc.MustMarshal(&flow))
+}
+
+// GetFlows returns all the stored flows.
+func (k Keeper) GetFlows(ctx sdk.Context) []Flow {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), FlowKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var flows []Flow
+	for ; iterator.Valid(); iterator.Next() {
+		var flow Flow
+		k.cdc.MustUnmarshal(iterator.Value(), &flow)
+		flows = append(flows, flow)
+	}
+	return flows
+}
+
+// GetRateLimits returns the rate limits of all the channels.
+func (k Keeper) GetRateLimits(ctx sdk.Context) []RateLimit {
+	return GetRateLimits(ctx, k.subspace)
+}
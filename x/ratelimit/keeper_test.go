@@ -0,0 +1,103 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/x/ratelimit"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestSendPacket(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	start := time.Now()
+	ctx := testApp.NewContext(false, tmproto.Header{Height: 1, Time: start, Version: tmversion.Consensus{App: v4.Version}})
+	testApp.GetSubspace(ratelimit.ModuleName).Set(ctx, ratelimit.KeyRateLimits, []ratelimit.RateLimit{
+		newRateLimit("channel-0", "utia", 100),
+	})
+	wrapper := &mockICS4Wrapper{}
+	keeper := ratelimit.NewKeeper(testApp.AppCodec(), testApp.GetKey(ratelimit.StoreKey), testApp.GetSubspace(ratelimit.ModuleName), wrapper)
+
+	send := func(ctx sdk.Context, port, channel string, data []byte) error {
+		wrapper.sent = 0
+		_, err := keeper.SendPacket(ctx, nil, port, channel, clienttypes.Height{}, 0, data)
+		if err == nil {
+			assert.Equal(t, 1, wrapper.sent)
+		} else {
+			assert.Equal(t, 0, wrapper.sent)
+		}
+		return err
+	}
+
+	require.NoError(t, send(ctx, transfertypes.PortID, "channel-0", transferData("utia", "60")))
+	err := send(ctx, transfertypes.PortID, "channel-0", transferData("utia", "41"))
+	assert.ErrorIs(t, err, ratelimit.ErrQuotaExceeded)
+	require.NoError(t, send(ctx, transfertypes.PortID, "channel-0", transferData("utia", "40")))
+	assert.Equal(t, sdk.NewInt(100), keeper.GetFlow(ctx, newRateLimit("channel-0", "utia", 100)).Outflow)
+
+	// Transfers without a rate limit and packets that aren't transfers are
+	// passed on.
+	require.NoError(t, send(ctx, transfertypes.PortID, "channel-1", transferData("utia", "1000")))
+	require.NoError(t, send(ctx, transfertypes.PortID, "channel-0", transferData("transfer/channel-0/uatom", "1000")))
+	require.NoError(t, send(ctx, "icacontroller-owner", "channel-0", transferData("utia", "1000")))
+	require.NoError(t, send(ctx, transfertypes.PortID, "channel-0", []byte{1, 2, 3}))
+
+	// The outflow isn't capped before v4.
+	require.NoError(t, send(ctx.WithBlockHeader(tmproto.Header{Time: start, Version: tmversion.Consensus{App: v3.Version}}), transfertypes.PortID, "channel-0", transferData("utia", "1000")))
+
+	// The outflow is reset once the period ends.
+	ctx = ctx.WithBlockTime(start.Add(24*time.Hour - time.Nanosecond))
+	assert.ErrorIs(t, send(ctx, transfertypes.PortID, "channel-0", transferData("utia", "1")), ratelimit.ErrQuotaExceeded)
+	ctx = ctx.WithBlockTime(start.Add(24 * time.Hour))
+	require.NoError(t, send(ctx, transfertypes.PortID, "channel-0", transferData("utia", "100")))
+	flows := keeper.GetFlows(ctx)
+	require.Len(t, flows, 1)
+	assert.Equal(t, sdk.NewInt(100), flows[0].Outflow)
+	assert.Equal(t, start.Add(24*time.Hour).UTC(), flows[0].PeriodStart.UTC())
+}
+
+func TestRevertOutflow(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	start := time.Now()
+	ctx := testApp.NewContext(false, tmproto.Header{Height: 1, Time: start})
+	rateLimit := newRateLimit("channel-0", "utia", 100)
+	testApp.GetSubspace(ratelimit.ModuleName).Set(ctx, ratelimit.KeyRateLimits, []ratelimit.RateLimit{rateLimit})
+	keeper := testApp.RateLimitKeeper
+
+	require.NoError(t, keeper.AddOutflow(ctx, "channel-0", "utia", sdk.NewInt(80)))
+	keeper.RevertOutflow(ctx, "channel-0", "utia", sdk.NewInt(30))
+	assert.Equal(t, sdk.NewInt(50), keeper.GetFlow(ctx, rateLimit).Outflow)
+	keeper.RevertOutflow(ctx, "channel-0", "utia", sdk.NewInt(70))
+	assert.Equal(t, sdk.ZeroInt(), keeper.GetFlow(ctx, rateLimit).Outflow)
+
+	// Denoms without a rate limit aren't tracked.
+	require.NoError(t, keeper.AddOutflow(ctx, "channel-0", "uatom", sdk.NewInt(1000)))
+	keeper.RevertOutflow(ctx, "channel-1", "utia", sdk.NewInt(10))
+	assert.Len(t, keeper.GetFlows(ctx), 1)
+}
+
+func transferData(denom, amount string) []byte {
+	return transfertypes.NewFungibleTokenPacketData(denom, amount, "sender", "receiver", "").GetBytes()
+}
+
+type mockICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+	sent int
+}
+
+func (m *mockICS4Wrapper) SendPacket(_ sdk.Context, _ *capabilitytypes.Capability, _, _ string, _ clienttypes.Height, _ uint64, _ []byte) (uint64, error) {
+	m.sent++
+	return 1, nil
+}
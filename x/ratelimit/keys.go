@@ -0,0 +1,24 @@
+package ratelimit
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "ratelimit"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+// FlowKeyPrefix is the prefix of the keys of the flows.
+var FlowKeyPrefix = []byte{0x01}
+
+// FlowKey returns the key of the flow of a denom over a channel. The channel
+// id is length prefixed because the denoms of vouchers contain slashes.
+func FlowKey(channelID, denom string) []byte {
+	key := append([]byte{}, FlowKeyPrefix...)
+	key = append(key, address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, denom...)
+}
@@ -0,0 +1,96 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var KeyRateLimits = []byte("RateLimits")
+
+type Params struct {
+	RateLimits []RateLimit
+}
+
+// RegisterParamTable returns a subspace with a key table attached.
+func RegisterParamTable(subspace paramtypes.Subspace) paramtypes.Subspace {
+	if subspace.HasKeyTable() {
+		return subspace
+	}
+	return subspace.WithKeyTable(ParamKeyTable())
+}
+
+// ParamKeyTable returns the param key table for the ratelimit module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs gets the param key-value pair
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRateLimits, &p.RateLimits, ValidateRateLimits),
+	}
+}
+
+// ValidateRateLimits validates the param type and that every denom of a
+// channel has at most one rate limit with a positive max outflow and period.
+func ValidateRateLimits(i interface{}) error {
+	rateLimits, ok := i.([]RateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		if err := validateChannelDenom(rateLimit.ChannelId, rateLimit.Denom); err != nil {
+			return err
+		}
+		key := string(FlowKey(rateLimit.ChannelId, rateLimit.Denom))
+		if seen[key] {
+			return fmt.Errorf("duplicate rate limit for %s on channel %s", rateLimit.Denom, rateLimit.ChannelId)
+		}
+		seen[key] = true
+
+		if rateLimit.MaxOutflow.IsNil() || rateLimit.MaxOutflow.IsNegative() {
+			return fmt.Errorf("max outflow of %s on channel %s must not be negative", rateLimit.Denom, rateLimit.ChannelId)
+		}
+		if rateLimit.Period <= 0 {
+			return fmt.Errorf("period of %s on channel %s must be positive", rateLimit.Denom, rateLimit.ChannelId)
+		}
+	}
+
+	return nil
+}
+
+func validateChannelDenom(channelID, denom string) error {
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return fmt.Errorf("channel %s: %w", channelID, err)
+	}
+	return nil
+}
+
+// GetRateLimits returns the rate limits of all the channels. The param is only
+// set once it has been changed from its default of no rate limits.
+func GetRateLimits(ctx sdk.Context, subspace paramtypes.Subspace) []RateLimit {
+	var rateLimits []RateLimit
+	subspace.GetIfExists(ctx, KeyRateLimits, &rateLimits)
+	return rateLimits
+}
+
+// GetRateLimit returns the rate limit of a denom over a channel and whether it
+// has one.
+func GetRateLimit(ctx sdk.Context, subspace paramtypes.Subspace, channelID, denom string) (RateLimit, bool) {
+	for _, rateLimit := range GetRateLimits(ctx, subspace) {
+		if rateLimit.ChannelId == channelID && rateLimit.Denom == denom {
+			return rateLimit, true
+		}
+	}
+	return RateLimit{}, false
+}
@@ -0,0 +1,99 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/x/ratelimit"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestValidateRateLimits(t *testing.T) {
+	testCases := []struct {
+		name       string
+		rateLimits interface{}
+		wantErr    bool
+	}{
+		{
+			name:       "no rate limits",
+			rateLimits: []ratelimit.RateLimit{},
+		},
+		{
+			name: "rate limits",
+			rateLimits: []ratelimit.RateLimit{
+				newRateLimit("channel-0", "utia", 100),
+				newRateLimit("channel-1", "utia", 0),
+				newRateLimit("channel-0", "transfer/channel-0/uatom", 100),
+			},
+		},
+		{
+			name:       "invalid type",
+			rateLimits: []string{"channel-0"},
+			wantErr:    true,
+		},
+		{
+			name:       "invalid channel",
+			rateLimits: []ratelimit.RateLimit{newRateLimit("connection/0", "utia", 100)},
+			wantErr:    true,
+		},
+		{
+			name:       "invalid denom",
+			rateLimits: []ratelimit.RateLimit{newRateLimit("channel-0", "", 100)},
+			wantErr:    true,
+		},
+		{
+			name:       "duplicate rate limit",
+			rateLimits: []ratelimit.RateLimit{newRateLimit("channel-0", "utia", 100), newRateLimit("channel-0", "utia", 200)},
+			wantErr:    true,
+		},
+		{
+			name:       "negative max outflow",
+			rateLimits: []ratelimit.RateLimit{newRateLimit("channel-0", "utia", -1)},
+			wantErr:    true,
+		},
+		{
+			name:       "nil max outflow",
+			rateLimits: []ratelimit.RateLimit{{ChannelId: "channel-0", Denom: "utia", Period: time.Hour}},
+			wantErr:    true,
+		},
+		{
+			name:       "zero period",
+			rateLimits: []ratelimit.RateLimit{{ChannelId: "channel-0", Denom: "utia", MaxOutflow: sdk.NewInt(100)}},
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ratelimit.ValidateRateLimits(tc.rateLimits)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// TestUpdateRateLimits verifies that the rate limits can be changed with the
+// JSON value of a param change proposal.
+func TestUpdateRateLimits(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(false, tmproto.Header{Height: 1})
+	subspace := testApp.GetSubspace(ratelimit.ModuleName)
+
+	value := `[{"channel_id":"channel-0","denom":"utia","max_outflow":"1000000","period":"86400000000000"}]`
+	require.NoError(t, subspace.Update(ctx, ratelimit.KeyRateLimits, []byte(value)))
+	assert.Equal(t, []ratelimit.RateLimit{newRateLimit("channel-0", "utia", 1000000)}, ratelimit.GetRateLimits(ctx, subspace))
+
+	require.Error(t, subspace.Update(ctx, ratelimit.KeyRateLimits, []byte(`[{"channel_id":"channel-0","denom":"utia","max_outflow":"-1","period":"86400000000000"}]`)))
+}
+
+func newRateLimit(channelID, denom string, maxOutflow int64) ratelimit.RateLimit {
+	return ratelimit.RateLimit{ChannelId: channelID, Denom: denom, MaxOutflow: sdk.NewInt(maxOutflow), Period: 24 * time.Hour}
+}